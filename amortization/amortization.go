@@ -0,0 +1,84 @@
+package amortization
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+// Schedule splits a loan into monthly installments, the first due one month after start. Annuity installments
+// are all the same size with interest charged on the outstanding principal, flat installments repay equal
+// principal and charge interest on the original principal throughout. Amounts are rounded to cents and the
+// last installment takes whatever principal the rounding left over.
+func Schedule(method string, principal, annualRate float64, termMonths int32, start time.Time) ([]models.LoanInstallment, error) {
+	if principal <= 0 {
+		return nil, fmt.Errorf("principal must be positive")
+	}
+	if annualRate < 0 {
+		return nil, fmt.Errorf("interest rate must not be negative")
+	}
+	if termMonths < 1 {
+		return nil, fmt.Errorf("term must be at least one month")
+	}
+
+	monthlyRate := annualRate / 12
+	n := int(termMonths)
+
+	var payment float64
+	switch method {
+	case models.LoanMethodAnnuity:
+		if monthlyRate == 0 {
+			payment = principal / float64(n)
+		} else {
+			payment = principal * monthlyRate / (1 - math.Pow(1+monthlyRate, -float64(n)))
+		}
+		payment = helpers.RoundMoney(payment)
+	case models.LoanMethodFlat:
+	default:
+		return nil, fmt.Errorf("unsupported amortization method: %s", method)
+	}
+
+	installments := make([]models.LoanInstallment, n)
+	balance := principal
+	for i := range installments {
+		var interest, repaid float64
+		if method == models.LoanMethodAnnuity {
+			interest = helpers.RoundMoney(balance * monthlyRate)
+			repaid = helpers.RoundMoney(payment - interest)
+		} else {
+			interest = helpers.RoundMoney(principal * monthlyRate)
+			repaid = helpers.RoundMoney(principal / float64(n))
+		}
+
+		if i == n-1 || repaid > balance {
+			repaid = balance
+		}
+		balance = helpers.RoundMoney(balance - repaid)
+
+		installments[i] = models.LoanInstallment{
+			Number:    int32(i + 1),
+			DueDate:   AddMonths(start, i+1),
+			Principal: repaid,
+			Interest:  interest,
+			Amount:    helpers.RoundMoney(repaid + interest),
+		}
+	}
+
+	return installments, nil
+}
+
+// AddMonths moves the date forward by whole months, keeping the day of the month where it exists and
+// using the last day of the month otherwise, so a loan taken out on January 31 falls due on February 28
+func AddMonths(date time.Time, months int) time.Time {
+	year, month, day := date.Date()
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, time.UTC)
+}
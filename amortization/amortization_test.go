@@ -0,0 +1,82 @@
+package amortization
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestScheduleAnnuity(t *testing.T) {
+	installments, err := Schedule(models.LoanMethodAnnuity, 1200, 0.12, 12, date(2024, 1, 15))
+	require.NoError(t, err)
+	require.Len(t, installments, 12)
+
+	require.Equal(t, int32(1), installments[0].Number)
+	require.Equal(t, date(2024, 2, 15), installments[0].DueDate)
+	require.Equal(t, 12.0, installments[0].Interest)
+	require.Equal(t, 94.62, installments[0].Principal)
+	require.Equal(t, 106.62, installments[0].Amount)
+
+	var principal float64
+	for _, installment := range installments[:11] {
+		require.Equal(t, 106.62, installment.Amount)
+		principal += installment.Principal
+	}
+	principal += installments[11].Principal
+	require.InDelta(t, 1200, principal, 1e-9)
+
+	// interest shrinks as the principal is repaid
+	require.Less(t, installments[11].Interest, installments[0].Interest)
+	require.Equal(t, date(2025, 1, 15), installments[11].DueDate)
+}
+
+func TestScheduleFlat(t *testing.T) {
+	installments, err := Schedule(models.LoanMethodFlat, 1000, 0.06, 3, date(2024, 1, 1))
+	require.NoError(t, err)
+	require.Len(t, installments, 3)
+
+	for _, installment := range installments {
+		require.Equal(t, 5.0, installment.Interest)
+	}
+	require.Equal(t, 333.33, installments[0].Principal)
+	require.Equal(t, 333.33, installments[1].Principal)
+	require.Equal(t, 333.34, installments[2].Principal)
+	require.Equal(t, 338.34, installments[2].Amount)
+}
+
+func TestScheduleZeroRate(t *testing.T) {
+	installments, err := Schedule(models.LoanMethodAnnuity, 100, 0, 3, date(2024, 1, 1))
+	require.NoError(t, err)
+
+	var total float64
+	for _, installment := range installments {
+		require.Zero(t, installment.Interest)
+		total += installment.Amount
+	}
+	require.Equal(t, 100.0, helpers.RoundMoney(total))
+}
+
+func TestScheduleInvalid(t *testing.T) {
+	_, err := Schedule("balloon", 1000, 0.05, 12, date(2024, 1, 1))
+	require.Error(t, err)
+
+	_, err = Schedule(models.LoanMethodFlat, 0, 0.05, 12, date(2024, 1, 1))
+	require.Error(t, err)
+
+	_, err = Schedule(models.LoanMethodFlat, 1000, 0.05, 0, date(2024, 1, 1))
+	require.Error(t, err)
+}
+
+func TestAddMonths(t *testing.T) {
+	require.Equal(t, date(2024, 2, 29), AddMonths(date(2024, 1, 31), 1))
+	require.Equal(t, date(2024, 3, 31), AddMonths(date(2024, 1, 31), 2))
+	require.Equal(t, date(2025, 2, 28), AddMonths(date(2024, 2, 29), 12))
+	require.Equal(t, date(2025, 1, 15), AddMonths(date(2024, 12, 15), 1))
+}
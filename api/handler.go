@@ -11,12 +11,36 @@ type HandlerProvider interface {
 	CreateAccount(ctx *gin.Context)
 	GetAccount(ctx *gin.Context)
 	ListAccounts(ctx *gin.Context)
-	UpdateAccount(ctx *gin.Context)
-	DeleteAccount(ctx *gin.Context)
+	UpdateAccountStatus(ctx *gin.Context)
+	CloseAccount(ctx *gin.Context)
+	ListAccountHolders(ctx *gin.Context)
+	InviteAccountHolder(ctx *gin.Context)
+	AcceptAccountInvitation(ctx *gin.Context)
 	TransferMoney(ctx *gin.Context)
+	BatchTransfer(ctx *gin.Context)
 	CreateUser(ctx *gin.Context)
 	GetUser(ctx *gin.Context)
 	ListUsers(ctx *gin.Context)
+	CreateHold(ctx *gin.Context)
+	GetHold(ctx *gin.Context)
+	ListHolds(ctx *gin.Context)
+	CaptureHold(ctx *gin.Context)
+	VoidHold(ctx *gin.Context)
+	ListAccountProducts(ctx *gin.Context)
+	ListInterestAccruals(ctx *gin.Context)
+	ListTellerReasonCodes(ctx *gin.Context)
+	TellerDeposit(ctx *gin.Context)
+	TellerWithdrawal(ctx *gin.Context)
+	TellerAdjustment(ctx *gin.Context)
+	TellerUpdateAccountStatus(ctx *gin.Context)
+	GetTellerOperation(ctx *gin.Context)
+	ListTellerOperations(ctx *gin.Context)
+	ApproveTellerOperation(ctx *gin.Context)
+	RejectTellerOperation(ctx *gin.Context)
+	UpdateUserRole(ctx *gin.Context)
+	ListFraudScreenings(ctx *gin.Context)
+	GetFraudScreening(ctx *gin.Context)
+	ResolveFraudScreening(ctx *gin.Context)
 	GetGin() *gin.Engine
 	StartServer(address string) error
 	GetTokenMaker() token.Maker
@@ -1,9 +1,14 @@
 package api
 
 import (
+	"database/sql"
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -41,6 +46,7 @@ func newHandlerImpl(c config.Config, s service.ServiceProvider) (*handlerImpl, e
 	// register currency validator
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		v.RegisterValidation("currency", helpers.ValidCurrency)
+		v.RegisterValidation("account_number", helpers.ValidAccountNumber)
 	}
 
 	// inProduction := false
@@ -88,14 +94,46 @@ func (h *handlerImpl) registerRoutes() {
 		authRoutes := v1.Group("/").Use(auth(h.tokenMaker))
 
 		authRoutes.POST("/account", h.CreateAccount)
-		authRoutes.GET("/account/:id", h.GetAccount)
+		authRoutes.GET("/account/:number", h.GetAccount)
 		authRoutes.GET("/accounts", h.ListAccounts)
-		authRoutes.PUT("/account/:id", h.UpdateAccount)
-		authRoutes.DELETE("/account/:id", h.DeleteAccount)
+		authRoutes.PATCH("/account/:number/status", h.UpdateAccountStatus)
+		authRoutes.DELETE("/account/:number", h.CloseAccount)
+		authRoutes.GET("/account/:number/holders", h.ListAccountHolders)
+		authRoutes.POST("/account/:number/holders", h.InviteAccountHolder)
+		authRoutes.POST("/account/:number/holders/accept", h.AcceptAccountInvitation)
 
 		authRoutes.GET("/users/:id", h.GetUser)
 		authRoutes.GET("/users", h.ListUsers)
 		authRoutes.POST("/transfer", h.TransferMoney)
+		authRoutes.POST("/transfers/batch", h.BatchTransfer)
+
+		authRoutes.GET("/account/:number/holds", h.ListHolds)
+		authRoutes.POST("/holds", h.CreateHold)
+		authRoutes.GET("/holds/:id", h.GetHold)
+		authRoutes.POST("/holds/:id/capture", h.CaptureHold)
+		authRoutes.POST("/holds/:id/void", h.VoidHold)
+
+		authRoutes.GET("/account_products", h.ListAccountProducts)
+		authRoutes.GET("/account/:number/interest_accruals", h.ListInterestAccruals)
+
+		tellerRoutes := v1.Group("/teller").Use(auth(h.tokenMaker), requireRole(models.RoleTeller, models.RoleAdmin))
+
+		tellerRoutes.GET("/reason_codes", h.ListTellerReasonCodes)
+		tellerRoutes.POST("/deposits", h.TellerDeposit)
+		tellerRoutes.POST("/withdrawals", h.TellerWithdrawal)
+		tellerRoutes.POST("/adjustments", h.TellerAdjustment)
+		tellerRoutes.PATCH("/accounts/:number/status", h.TellerUpdateAccountStatus)
+		tellerRoutes.GET("/operations", h.ListTellerOperations)
+		tellerRoutes.GET("/operations/:id", h.GetTellerOperation)
+		tellerRoutes.POST("/operations/:id/approve", h.ApproveTellerOperation)
+		tellerRoutes.POST("/operations/:id/reject", h.RejectTellerOperation)
+
+		adminRoutes := v1.Group("/admin").Use(auth(h.tokenMaker), requireRole(models.RoleAdmin))
+
+		adminRoutes.PUT("/users/:username/role", h.UpdateUserRole)
+		adminRoutes.GET("/fraud_screenings", h.ListFraudScreenings)
+		adminRoutes.GET("/fraud_screenings/:id", h.GetFraudScreening)
+		adminRoutes.POST("/fraud_screenings/:id/resolve", h.ResolveFraudScreening)
 	}
 
 }
@@ -110,6 +148,11 @@ func (h *handlerImpl) CreateAccount(ctx *gin.Context) {
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 	createdAccount, err := h.service.CreateAccount(ctx, req, authPayload.UserName)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = fmt.Errorf("unknown account product: %s", req.ProductCode)
+			ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+			return
+		}
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			ctx.JSON(http.StatusForbidden, h.errorResponse(err))
@@ -129,7 +172,7 @@ func (h *handlerImpl) GetAccount(ctx *gin.Context) {
 		return
 	}
 
-	account, err := h.service.GetAccount(ctx, req.ID)
+	account, err := h.service.GetAccountByNumber(ctx, req.AccountNumber)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			ctx.JSON(http.StatusNotFound, h.errorResponse(err))
@@ -140,10 +183,7 @@ func (h *handlerImpl) GetAccount(ctx *gin.Context) {
 	}
 
 	// authorization rule
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if account.Owner != authPayload.UserName {
-		err := errors.New("account does not belong to the authenticated user")
-		ctx.JSON(http.StatusUnauthorized, h.errorResponse(err))
+	if !h.authorizeAccount(ctx, account, models.PermissionView) {
 		return
 	}
 
@@ -167,42 +207,205 @@ func (h *handlerImpl) ListAccounts(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, accounts)
 }
 
-func (h *handlerImpl) UpdateAccount(ctx *gin.Context) {
+func (h *handlerImpl) UpdateAccountStatus(ctx *gin.Context) {
 	var req models.GetAccountRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
 		return
 	}
 
-	var update models.UpdateAccountRequest
+	var update models.UpdateAccountStatusRequest
 	if err := ctx.ShouldBindJSON(&update); err != nil {
 		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
 		return
 	}
 
-	account, err := h.service.AddAccountBalance(ctx, req.ID, update.Balance)
+	account, valid := h.authorizedAccount(ctx, req.AccountNumber, models.PermissionManage)
+	if !valid {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	updated, err := h.service.UpdateAccountStatus(ctx, models.UpdateAccountStatusParams{
+		AccountID: account.ID,
+		Status:    update.Status,
+		Reason:    update.Reason,
+		ChangedBy: authPayload.UserName,
+	})
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			ctx.JSON(http.StatusNotFound, h.errorResponse(err))
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updated)
+}
+
+func (h *handlerImpl) CloseAccount(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	var closeReq models.CloseAccountRequest
+	if err := ctx.ShouldBindQuery(&closeReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	account, valid := h.authorizedAccount(ctx, req.AccountNumber, models.PermissionManage)
+	if !valid {
+		return
+	}
+
+	var sweepToAccountID int64
+	if closeReq.SweepToAccountNumber != "" {
+		sweepTo, err := h.service.GetAccountByNumber(ctx, closeReq.SweepToAccountNumber)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				err = models.ErrSweepAccountInvalid
+			}
+			ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+			return
+		}
+
+		// the sweep moves the whole balance without the checks of a transfer, it may only go to the caller's own account
+		if !h.authorizeAccount(ctx, sweepTo, models.PermissionTransact) {
 			return
 		}
+		sweepToAccountID = sweepTo.ID
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	result, err := h.service.CloseAccount(ctx, models.CloseAccountTxParams{
+		AccountID:        account.ID,
+		SweepToAccountID: sweepToAccountID,
+		ChangedBy:        authPayload.UserName,
+	})
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// authorizedAccount loads the account and checks that the authenticated user's holder role grants the permission
+func (h *handlerImpl) authorizedAccount(ctx *gin.Context, accountNumber string, permission string) (models.Account, bool) {
+	account, err := h.service.GetAccountByNumber(ctx, accountNumber)
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return account, false
+	}
+
+	return account, h.authorizeAccount(ctx, account, permission)
+}
+
+// authorizeAccount checks that the authenticated user is an active holder of the account with a role that
+// grants the permission, and writes the error response when not
+func (h *handlerImpl) authorizeAccount(ctx *gin.Context, account models.Account, permission string) bool {
+	allowed, err := h.holderCan(ctx, account.ID, permission)
+	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+		return false
+	}
+
+	if !allowed {
+		err := fmt.Errorf("authenticated user does not have %s permission on account [%s]", permission, account.AccountNumber)
+		ctx.JSON(http.StatusUnauthorized, h.errorResponse(err))
+		return false
+	}
+
+	return true
+}
+
+func (h *handlerImpl) holderCan(ctx *gin.Context, accountID int64, permission string) (bool, error) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	holder, err := h.service.GetAccountHolder(ctx, accountID, authPayload.UserName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return holder.Can(permission), nil
+}
+
+func (h *handlerImpl) ListAccountHolders(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, account)
+	account, valid := h.authorizedAccount(ctx, req.AccountNumber, models.PermissionView)
+	if !valid {
+		return
+	}
+
+	holders, err := h.service.ListAccountHolders(ctx, account.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, holders)
 }
 
-func (h *handlerImpl) DeleteAccount(ctx *gin.Context) {
+func (h *handlerImpl) InviteAccountHolder(ctx *gin.Context) {
 	var req models.GetAccountRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
 		return
 	}
 
-	err := h.service.DeleteAccount(ctx, req.ID)
+	var invite models.InviteAccountHolderRequest
+	if err := ctx.ShouldBindJSON(&invite); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	account, valid := h.authorizedAccount(ctx, req.AccountNumber, models.PermissionManage)
+	if !valid {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	holder, err := h.service.InviteAccountHolder(ctx, models.InviteAccountHolderParams{
+		AccountID: account.ID,
+		UserName:  invite.UserName,
+		Role:      invite.Role,
+		InvitedBy: authPayload.UserName,
+	})
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, holder)
+}
+
+// AcceptAccountInvitation lets the invited user take up their role, there is no account check because an
+// invitation is all the authorization they have until it is accepted
+func (h *handlerImpl) AcceptAccountInvitation(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	account, err := h.service.GetAccountByNumber(ctx, req.AccountNumber)
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	holder, err := h.service.AcceptAccountInvitation(ctx, account.ID, authPayload.UserName)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
+			err = errors.New("no pending invitation to this account")
 			ctx.JSON(http.StatusNotFound, h.errorResponse(err))
 			return
 		}
@@ -210,7 +413,7 @@ func (h *handlerImpl) DeleteAccount(ctx *gin.Context) {
 		return
 	}
 
-	ctx.String(http.StatusOK, "account deleted")
+	ctx.JSON(http.StatusOK, holder)
 }
 
 func (h *handlerImpl) CreateUser(ctx *gin.Context) {
@@ -258,13 +461,13 @@ func (h *handlerImpl) LoginUser(ctx *gin.Context) {
 		return
 	}
 
-	accessToken, accessTokenPayload, err := h.tokenMaker.CreateToken(req.UserName, h.config.AccessTokenDuration)
+	accessToken, accessTokenPayload, err := h.tokenMaker.CreateToken(req.UserName, user.Role, h.config.AccessTokenDuration)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
 		return
 	}
 
-	refreshToken, refreshTokenPayload, err := h.tokenMaker.CreateToken(req.UserName, h.config.RefreshTokenDuration)
+	refreshToken, refreshTokenPayload, err := h.tokenMaker.CreateToken(req.UserName, user.Role, h.config.RefreshTokenDuration)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
 		return
@@ -338,8 +541,21 @@ func (h *handlerImpl) RenewAccessToken(ctx *gin.Context) {
 		return
 	}
 
+	// the role is read again rather than taken from the refresh token, so a demoted user doesn't keep
+	// the old role for as long as the refresh token lives
+	user, err := h.service.GetUser(ctx, refreshTokenPayload.UserName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			ctx.JSON(http.StatusUnauthorized, h.errorResponse(fmt.Errorf("user not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+		return
+	}
+
 	accessToken, accessTokenPayload, err := h.tokenMaker.CreateToken(
-		refreshTokenPayload.UserName,
+		user.UserName,
+		user.Role,
 		h.config.AccessTokenDuration,
 	)
 	if err != nil {
@@ -396,52 +612,264 @@ func (h *handlerImpl) TransferMoney(ctx *gin.Context) {
 		return
 	}
 
-	fromAccount, valid := h.validAccount(ctx, req.FromAccountID, req.Currency)
+	// a beneficiary or alias names the recipient by id, otherwise the request carries its number
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	var toAccountID int64
+	if req.BeneficiaryID != 0 {
+		beneficiary, err := h.service.GetBeneficiary(ctx, req.BeneficiaryID, authPayload.UserName)
+		if err != nil {
+			ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+			return
+		}
+
+		toAccountID = beneficiary.AccountID
+	}
+
+	if req.ToAlias != "" {
+		account, err := h.service.ResolvePaymentAlias(ctx, req.ToAlias, req.Currency)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				err = fmt.Errorf("no %s account receives payments for %s", req.Currency, req.ToAlias)
+				ctx.JSON(http.StatusNotFound, h.errorResponse(err))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+			return
+		}
+
+		toAccountID = account.ID
+	}
+
+	fromAccount, valid := h.validAccount(ctx, req.FromAccountNumber, req.Currency)
 	// check if the currencies match
 	if !valid {
 		return
 	}
 
-	// check if sender has enough money
-	if fromAccount.Balance < req.Amount {
+	// check if sender has enough money that isn't reserved by a hold, counting any arranged overdraft
+	if fromAccount.AvailableBalance+fromAccount.OverdraftLimit < req.Amount {
 		err := errors.New("sender does not have sufficient funds to transfer")
 		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
 		return
 	}
 
 	// authorization rule
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if fromAccount.Owner != authPayload.UserName {
-		err := errors.New("sender account does not belong to the authenticated user")
-		ctx.JSON(http.StatusUnauthorized, h.errorResponse(err))
+	if !h.authorizeAccount(ctx, fromAccount, models.PermissionTransact) {
 		return
 	}
 
-	_, valid = h.validAccount(ctx, req.ToAccountID, req.Currency)
+	var toAccount models.Account
+	if toAccountID != 0 {
+		account, err := h.service.GetAccount(ctx, toAccountID)
+		toAccount, valid = h.matchCurrency(ctx, account, err, req.Currency)
+	} else {
+		toAccount, valid = h.validAccount(ctx, req.ToAccountNumber, req.Currency)
+	}
 	if !valid {
 		return
 	}
 
+	// a saved beneficiary's limits apply however the recipient was named
+	if err := h.service.CheckPayeeTransfer(ctx, authPayload.UserName, toAccount.ID, req.Amount); err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
 	arg := models.TransferTxParams{
-		FromAccountID: req.FromAccountID,
-		ToAccountID:   req.ToAccountID,
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
 		Amount:        req.Amount,
 		Currency:      req.Currency,
 		Description:   req.Description,
 		Fee:           req.Fee,
+		InitiatedBy:   authPayload.UserName,
+		UserAgent:     ctx.Request.UserAgent(),
 	}
 
 	createdAccount, err := h.service.TransferTx(ctx, arg)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	// large transfers only reserve the funds until someone approves them
+	if createdAccount.Pending != nil {
+		ctx.JSON(http.StatusAccepted, createdAccount)
 		return
 	}
 
 	ctx.JSON(http.StatusOK, createdAccount)
 }
 
-func (h *handlerImpl) validAccount(ctx *gin.Context, accountID int64, currency string) (models.Account, bool) {
-	account, err := h.service.GetAccount(ctx, accountID)
+// BatchTransfer accepts the batch either as JSON or as a multipart form whose "file" field is a CSV with
+// a to_account_number,amount,description header. The form carries from_account_number, currency and mode.
+func (h *handlerImpl) BatchTransfer(ctx *gin.Context) {
+	var req models.BatchTransferRequest
+	if ctx.ContentType() == binding.MIMEMultipartPOSTForm {
+		if err := h.bindBatchTransferCSV(ctx, &req); err != nil {
+			ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+			return
+		}
+	} else if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	fromAccount, valid := h.validAccount(ctx, req.FromAccountNumber, req.Currency)
+	if !valid {
+		return
+	}
+
+	// authorization rule
+	if !h.authorizeAccount(ctx, fromAccount, models.PermissionTransact) {
+		return
+	}
+
+	numbers := make([]string, len(req.Lines))
+	for i, line := range req.Lines {
+		numbers[i] = line.ToAccountNumber
+	}
+
+	// unknown numbers are left at id 0, the batch reports those lines as having no recipient
+	ids, err := h.service.ListAccountIDsByNumber(ctx, numbers)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	arg := models.BatchTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		Currency:      req.Currency,
+		Mode:          req.Mode,
+		Lines:         make([]models.BatchTransferLine, len(req.Lines)),
+		InitiatedBy:   authPayload.UserName,
+		UserAgent:     ctx.Request.UserAgent(),
+	}
+	for i, line := range req.Lines {
+		arg.Lines[i] = models.BatchTransferLine{
+			ToAccountID: ids[line.ToAccountNumber],
+			Amount:      line.Amount,
+			Description: line.Description,
+		}
+
+		if arg.Lines[i].ToAccountID == 0 {
+			continue
+		}
+
+		err := h.service.CheckPayeeTransfer(ctx, authPayload.UserName, arg.Lines[i].ToAccountID, line.Amount)
+		// in best effort mode a line over a beneficiary limit fails on its own like any other bad line
+		if req.Mode == models.BatchModeBestEffort &&
+			(errors.Is(err, models.ErrBeneficiaryLimitExceeded) || errors.Is(err, models.ErrBeneficiaryCoolingOff)) {
+			arg.Lines[i].Refused = err
+			continue
+		}
+		if err != nil {
+			err = fmt.Errorf("line %d: %w", i+1, err)
+			ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+			return
+		}
+	}
+
+	result, err := h.service.BatchTransferTx(ctx, arg)
+	for i := range result.Lines {
+		result.Lines[i].ToAccountNumber = req.Lines[i].ToAccountNumber
+	}
+	if err != nil {
+		if errors.Is(err, models.ErrBatchRejected) {
+			// the per line results say which lines have to be fixed
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "lines": result.Lines})
+			return
+		}
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// bindBatchTransferCSV parses the uploaded file before binding the form so that validation sees the lines
+func (h *handlerImpl) bindBatchTransferCSV(ctx *gin.Context, req *models.BatchTransferRequest) error {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return err
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	req.Lines, err = parseBatchTransferCSV(file)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ShouldBindWith(req, binding.FormMultipart)
+}
+
+// parseBatchTransferCSV reads the lines of a batch, the header names the columns so they may come in any
+// order and description may be left out
+func parseBatchTransferCSV(r io.Reader) ([]models.BatchTransferLineRequest, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range []string{"to_account_number", "amount"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("csv header is missing the %s column", name)
+		}
+	}
+
+	var lines []models.BatchTransferLineRequest
+	for lineNo := 2; ; lineNo++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		var line models.BatchTransferLineRequest
+		line.ToAccountNumber = field("to_account_number")
+		if line.Amount, err = strconv.ParseFloat(field("amount"), 64); err != nil {
+			return nil, fmt.Errorf("csv line %d: invalid amount", lineNo)
+		}
+		line.Description = field("description")
+
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+func (h *handlerImpl) validAccount(ctx *gin.Context, accountNumber string, currency string) (models.Account, bool) {
+	account, err := h.service.GetAccountByNumber(ctx, accountNumber)
+	return h.matchCurrency(ctx, account, err, currency)
+}
+
+// matchCurrency writes the error response when the account could not be loaded or holds another currency
+func (h *handlerImpl) matchCurrency(ctx *gin.Context, account models.Account, err error, currency string) (models.Account, bool) {
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			ctx.JSON(http.StatusNotFound, h.errorResponse(err))
@@ -452,7 +880,7 @@ func (h *handlerImpl) validAccount(ctx *gin.Context, accountID int64, currency s
 	}
 
 	if account.Currency != currency {
-		err = fmt.Errorf("account [%d] currency mismatch: %s vs %s", accountID, account.Currency, currency)
+		err = fmt.Errorf("account [%s] currency mismatch: %s vs %s", account.AccountNumber, account.Currency, currency)
 		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
 		return account, false
 	}
@@ -460,6 +888,509 @@ func (h *handlerImpl) validAccount(ctx *gin.Context, accountID int64, currency s
 	return account, true
 }
 
+func (h *handlerImpl) CreateHold(ctx *gin.Context) {
+	var req models.CreateHoldRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	account, valid := h.validAccount(ctx, req.AccountNumber, req.Currency)
+	if !valid {
+		return
+	}
+
+	// authorization rule
+	if !h.authorizeAccount(ctx, account, models.PermissionTransact) {
+		return
+	}
+
+	if account.AvailableBalance+account.OverdraftLimit < req.Amount {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(models.ErrInsufficientFunds))
+		return
+	}
+
+	toAccount, valid := h.validAccount(ctx, req.ToAccountNumber, req.Currency)
+	if !valid {
+		return
+	}
+
+	req.AccountID = account.ID
+	req.ToAccountID = toAccount.ID
+	hold, err := h.service.CreateHold(ctx, req)
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, hold)
+}
+
+func (h *handlerImpl) GetHold(ctx *gin.Context) {
+	hold, valid := h.authorizedHold(ctx, false, models.PermissionView)
+	if !valid {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, hold)
+}
+
+func (h *handlerImpl) ListHolds(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	var page models.ListAccountRequest
+	if err := ctx.ShouldBindQuery(&page); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	account, valid := h.authorizedAccount(ctx, req.AccountNumber, models.PermissionView)
+	if !valid {
+		return
+	}
+
+	holds, err := h.service.ListHolds(ctx, account.ID, page.PageSize, ((page.PageID - 1) * page.PageSize))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, holds)
+}
+
+func (h *handlerImpl) CaptureHold(ctx *gin.Context) {
+	var req models.CaptureHoldRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	// only the receiving side collects the money
+	hold, valid := h.authorizedHold(ctx, true, models.PermissionTransact)
+	if !valid {
+		return
+	}
+
+	result, err := h.service.CaptureHold(ctx, models.CaptureHoldParams{
+		HoldID: hold.ID,
+		Amount: req.Amount,
+	})
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+func (h *handlerImpl) VoidHold(ctx *gin.Context) {
+	// voiding gives up the receiver's claim on the money, so the payer can't do it, only the receiving side
+	// or staff
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	var hold models.Hold
+	if authPayload.Role == models.RoleTeller || authPayload.Role == models.RoleAdmin {
+		var req models.GetHoldRequest
+		if err := ctx.ShouldBindUri(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+			return
+		}
+
+		var err error
+		hold, err = h.service.GetHold(ctx, req.ID)
+		if err != nil {
+			ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+			return
+		}
+	} else {
+		var valid bool
+		hold, valid = h.authorizedHold(ctx, true, models.PermissionTransact)
+		if !valid {
+			return
+		}
+	}
+
+	voided, err := h.service.VoidHold(ctx, hold.ID)
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, voided)
+}
+
+// authorizedHold loads the hold named in the uri and checks that the authenticated user has the permission
+// on the receiving account or, unless receiverOnly is set, the held account
+func (h *handlerImpl) authorizedHold(ctx *gin.Context, receiverOnly bool, permission string) (models.Hold, bool) {
+	var req models.GetHoldRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return models.Hold{}, false
+	}
+
+	hold, err := h.service.GetHold(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return hold, false
+	}
+
+	accountIDs := []int64{hold.ToAccountID}
+	if !receiverOnly {
+		accountIDs = append(accountIDs, hold.AccountID)
+	}
+
+	for _, id := range accountIDs {
+		allowed, err := h.holderCan(ctx, id, permission)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+			return hold, false
+		}
+
+		if allowed {
+			return hold, true
+		}
+	}
+
+	err = fmt.Errorf("authenticated user does not have %s permission on the hold's accounts", permission)
+	ctx.JSON(http.StatusUnauthorized, h.errorResponse(err))
+	return hold, false
+}
+
+func (h *handlerImpl) ListAccountProducts(ctx *gin.Context) {
+	products, err := h.service.ListAccountProducts(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, products)
+}
+
+func (h *handlerImpl) ListInterestAccruals(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	var page models.ListAccountRequest
+	if err := ctx.ShouldBindQuery(&page); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	account, valid := h.authorizedAccount(ctx, req.AccountNumber, models.PermissionView)
+	if !valid {
+		return
+	}
+
+	accruals, err := h.service.ListInterestAccruals(ctx, account.ID, page.PageSize, ((page.PageID - 1) * page.PageSize))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, accruals)
+}
+
+func (h *handlerImpl) ListTellerReasonCodes(ctx *gin.Context) {
+	codes, err := h.service.ListTellerReasonCodes(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, codes)
+}
+
+func (h *handlerImpl) TellerDeposit(ctx *gin.Context) {
+	var req models.TellerOperationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	h.createTellerOperation(ctx, models.TellerOperationDeposit, req.AccountNumber, req.Amount, req.Currency, req.ReasonCode, req.Note)
+}
+
+func (h *handlerImpl) TellerWithdrawal(ctx *gin.Context) {
+	var req models.TellerOperationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	h.createTellerOperation(ctx, models.TellerOperationWithdrawal, req.AccountNumber, -req.Amount, req.Currency, req.ReasonCode, req.Note)
+}
+
+func (h *handlerImpl) TellerAdjustment(ctx *gin.Context) {
+	var req models.AdjustmentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	h.createTellerOperation(ctx, models.TellerOperationAdjustment, req.AccountNumber, req.Amount, req.Currency, req.ReasonCode, req.Note)
+}
+
+// TellerUpdateAccountStatus lets staff change the status of any account, including lifting a freeze
+func (h *handlerImpl) TellerUpdateAccountStatus(ctx *gin.Context) {
+	var req models.GetAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	var update models.UpdateAccountStatusRequest
+	if err := ctx.ShouldBindJSON(&update); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	account, err := h.service.GetAccountByNumber(ctx, req.AccountNumber)
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	updated, err := h.service.UpdateAccountStatus(ctx, models.UpdateAccountStatusParams{
+		AccountID: account.ID,
+		Status:    update.Status,
+		Reason:    update.Reason,
+		ChangedBy: authPayload.UserName,
+		ByStaff:   true,
+	})
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updated)
+}
+
+// createTellerOperation records the operation for the authenticated teller, amount is signed from the customer's side.
+// Operations above the approval threshold are accepted but wait for a second teller.
+func (h *handlerImpl) createTellerOperation(ctx *gin.Context, kind string, accountNumber string, amount float64, currency, reasonCode, note string) {
+	account, err := h.service.GetAccountByNumber(ctx, accountNumber)
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	op, err := h.service.CreateTellerOperation(ctx, models.CreateTellerOperationParams{
+		Kind:        kind,
+		AccountID:   account.ID,
+		Amount:      amount,
+		Currency:    currency,
+		ReasonCode:  reasonCode,
+		Note:        note,
+		InitiatedBy: authPayload.UserName,
+	})
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	if op.Status == models.TellerOperationPendingApproval {
+		ctx.JSON(http.StatusAccepted, op)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, op)
+}
+
+func (h *handlerImpl) GetTellerOperation(ctx *gin.Context) {
+	var req models.GetTellerOperationRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	op, err := h.service.GetTellerOperation(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, op)
+}
+
+func (h *handlerImpl) ListTellerOperations(ctx *gin.Context) {
+	var req models.ListTellerOperationsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	ops, err := h.service.ListTellerOperations(ctx, req.Status, req.PageSize, ((req.PageID - 1) * req.PageSize))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ops)
+}
+
+func (h *handlerImpl) ApproveTellerOperation(ctx *gin.Context) {
+	h.decideTellerOperation(ctx, true)
+}
+
+func (h *handlerImpl) RejectTellerOperation(ctx *gin.Context) {
+	h.decideTellerOperation(ctx, false)
+}
+
+func (h *handlerImpl) decideTellerOperation(ctx *gin.Context, approve bool) {
+	var req models.GetTellerOperationRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	op, err := h.service.DecideTellerOperation(ctx, models.DecideTellerOperationParams{
+		OperationID: req.ID,
+		DecidedBy:   authPayload.UserName,
+		Approve:     approve,
+	})
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, op)
+}
+
+func (h *handlerImpl) UpdateUserRole(ctx *gin.Context) {
+	var req models.UpdateUserRoleRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	var body models.UpdateUserRoleBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	user, err := h.service.UpdateUser(ctx, models.UpdateUserParams{
+		UserName: req.UserName,
+		Role:     sql.NullString{String: body.Role, Valid: true},
+	})
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, user)
+}
+
+// ListFraudScreenings is the admins' review queue, pass status=open for the transfers still waiting on them
+func (h *handlerImpl) ListFraudScreenings(ctx *gin.Context) {
+	var req models.ListFraudScreeningsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	screenings, err := h.service.ListFraudScreenings(ctx, req.Status, req.PageSize, ((req.PageID - 1) * req.PageSize))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, screenings)
+}
+
+func (h *handlerImpl) GetFraudScreening(ctx *gin.Context) {
+	var req models.GetFraudScreeningRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	screening, err := h.service.GetFraudScreening(ctx, req.ID)
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, screening)
+}
+
+func (h *handlerImpl) ResolveFraudScreening(ctx *gin.Context) {
+	var req models.GetFraudScreeningRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	var body models.ResolveFraudScreeningRequest
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.JSON(http.StatusBadRequest, h.errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	screening, err := h.service.ResolveFraudScreening(ctx, models.ResolveFraudScreeningParams{
+		ID:         req.ID,
+		ReviewedBy: authPayload.UserName,
+		Resolution: body.Resolution,
+		Note:       body.Note,
+	})
+	if err != nil {
+		ctx.JSON(h.errorStatus(err), h.errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, screening)
+}
+
+// errorStatus maps service errors to the status code they should be reported with
+func (h *handlerImpl) errorStatus(err error) int {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return http.StatusNotFound
+	case errors.Is(err, models.ErrInsufficientFunds),
+		errors.Is(err, models.ErrHoldNotActive),
+		errors.Is(err, models.ErrCaptureExceedsHold),
+		errors.Is(err, models.ErrAccountNotMatured),
+		errors.Is(err, models.ErrAccountFrozen),
+		errors.Is(err, models.ErrAccountClosing),
+		errors.Is(err, models.ErrAccountClosed),
+		errors.Is(err, models.ErrInvalidStatusTransition),
+		errors.Is(err, models.ErrAccountNotEmpty),
+		errors.Is(err, models.ErrAccountHasHolds),
+		errors.Is(err, models.ErrAccountHasLoan),
+		errors.Is(err, models.ErrSweepAccountInvalid),
+		errors.Is(err, models.ErrCurrencyMismatch),
+		errors.Is(err, models.ErrInvalidReasonCode),
+		errors.Is(err, models.ErrOperationNotPending),
+		errors.Is(err, models.ErrPendingTransferExpired),
+		errors.Is(err, models.ErrScreeningNotOpen),
+		errors.Is(err, models.ErrScreeningOpen),
+		errors.Is(err, models.ErrBeneficiaryLimitExceeded),
+		errors.Is(err, models.ErrInvalidDefaultAccount),
+		errors.Is(err, models.ErrBeneficiaryCoolingOff):
+		return http.StatusBadRequest
+	case errors.Is(err, models.ErrApproverIsInitiator),
+		errors.Is(err, models.ErrFreezeNeedsStaff),
+		errors.Is(err, models.ErrTransferBlocked):
+		return http.StatusForbidden
+	case errors.Is(err, models.ErrAlreadyAccountHolder),
+		errors.Is(err, models.ErrBeneficiaryExists),
+		errors.Is(err, models.ErrAliasTaken),
+		errors.Is(err, models.ErrBusinessDateClosed):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
 func (h *handlerImpl) errorResponse(err error) gin.H {
 	return gin.H{
 		"error": err.Error(),
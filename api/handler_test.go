@@ -5,13 +5,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/require"
@@ -56,105 +59,1213 @@ func TestGetAccountAPI(t *testing.T) {
 
 	testCases := []struct {
 		name          string
-		accountID     int64
+		accountNumber string
 		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
 		buildStubs    func(service *mockedproviders.MockServiceProvider)
 		checkResponse func(recorder *httptest.ResponseRecorder)
 	}{
 		{
-			name:      "OK",
-			accountID: account.ID,
+			name:          "OK",
+			accountNumber: account.AccountNumber,
 			buildStubs: func(service *mockedproviders.MockServiceProvider) {
 				service.EXPECT().
-					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
 					Times(1).
 					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(account, user.UserName, models.HolderRoleOwner), nil)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchWithAccount(t, recorder.Body, account)
+			},
+		},
+		{
+			name:          "UNAUTHORIZED USER",
+			accountNumber: account.AccountNumber,
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq("unauthorized_user")).
+					Times(1).
+					Return(models.AccountHolder{}, pgx.ErrNoRows)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:          "VIEWER",
+			accountNumber: account.AccountNumber,
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq("viewer")).
+					Times(1).
+					Return(randomHolder(account, "viewer", models.HolderRoleViewer), nil)
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "viewer", models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchWithAccount(t, recorder.Body, account)
+			},
+		},
+		{
+			name:          "PENDING INVITATION",
+			accountNumber: account.AccountNumber,
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				invited := randomHolder(account, "invited", models.HolderRoleCoOwner)
+				invited.Status = models.HolderStatusInvited
+
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq("invited")).
+					Times(1).
+					Return(invited, nil)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "invited", models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:          "NO AUTHORIZATION",
+			accountNumber: account.AccountNumber,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:          "NOT FOUND",
+			accountNumber: account.AccountNumber,
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(models.Account{}, pgx.ErrNoRows)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:          "INTERNAL ERROR",
+			accountNumber: account.AccountNumber,
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(models.Account{}, pgx.ErrTxClosed)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:          "INVALID NUMBER",
+			accountNumber: "SB00000000000001",
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockedproviders.NewMockServiceProvider(ctrl)
+			// build stubs
+			tc.buildStubs(service)
+
+			// create server
+			server, err := NewHandler(testConfig, service)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/sb/api/v1/account/%s", tc.accountNumber)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.H.GetTokenMaker())
+			server.H.GetGin().ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestCloseAccountAPI(t *testing.T) {
+	user, _ := randomUser()
+	account := randomAccount(user.UserName)
+	sweepTo := randomAccount(user.UserName)
+	thirdParty := randomAccount(helpers.RandomOwner())
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(service *mockedproviders.MockServiceProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "?sweep_to_account_number=" + sweepTo.AccountNumber,
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(account, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(sweepTo.AccountNumber)).
+					Times(1).
+					Return(sweepTo, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(sweepTo.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(sweepTo, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					CloseAccount(gomock.Any(), gomock.Eq(models.CloseAccountTxParams{
+						AccountID:        account.ID,
+						SweepToAccountID: sweepTo.ID,
+						ChangedBy:        user.UserName,
+					})).
+					Times(1).
+					Return(models.CloseAccountTxResult{Account: account}, nil)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "SWEEP TO SOMEONE ELSE'S ACCOUNT",
+			query: "?sweep_to_account_number=" + thirdParty.AccountNumber,
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(account, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(thirdParty.AccountNumber)).
+					Times(1).
+					Return(thirdParty, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(thirdParty.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(models.AccountHolder{}, pgx.ErrNoRows)
+				service.EXPECT().
+					CloseAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "UNAUTHORIZED USER",
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq("unauthorized_user")).
+					Times(1).
+					Return(models.AccountHolder{}, pgx.ErrNoRows)
+				service.EXPECT().
+					CloseAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "VIEWER CANNOT CLOSE",
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq("viewer")).
+					Times(1).
+					Return(randomHolder(account, "viewer", models.HolderRoleViewer), nil)
+				service.EXPECT().
+					CloseAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "viewer", models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "BALANCE NOT ZERO",
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(account, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					CloseAccount(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(models.CloseAccountTxResult{}, models.ErrAccountNotEmpty)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "INVALID SWEEP ACCOUNT",
+			query: "?sweep_to_account_number=SB12345",
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					CloseAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockedproviders.NewMockServiceProvider(ctrl)
+			// build stubs
+			tc.buildStubs(service)
+
+			// create server
+			server, err := NewHandler(testConfig, service)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/sb/api/v1/account/%s%s", account.AccountNumber, tc.query)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.H.GetTokenMaker())
+			server.H.GetGin().ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestUpdateAccountStatusAPI(t *testing.T) {
+	user, _ := randomUser()
+	account := randomAccount(user.UserName)
+	account.Status = models.AccountStatusFrozen
+
+	testCases := []struct {
+		name          string
+		path          string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(service *mockedproviders.MockServiceProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "CUSTOMER CANNOT UNFREEZE",
+			path: "/sb/api/v1/account/%s/status",
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(account, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					UpdateAccountStatus(gomock.Any(), gomock.Eq(models.UpdateAccountStatusParams{
+						AccountID: account.ID,
+						Status:    models.AccountStatusActive,
+						ChangedBy: user.UserName,
+					})).
+					Times(1).
+					Return(models.Account{}, models.ErrFreezeNeedsStaff)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "TELLER UNFREEZES",
+			path: "/sb/api/v1/teller/accounts/%s/status",
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				unfrozen := account
+				unfrozen.Status = models.AccountStatusActive
+
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+				service.EXPECT().
+					UpdateAccountStatus(gomock.Any(), gomock.Eq(models.UpdateAccountStatusParams{
+						AccountID: account.ID,
+						Status:    models.AccountStatusActive,
+						ChangedBy: "teller",
+						ByStaff:   true,
+					})).
+					Times(1).
+					Return(unfrozen, nil)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "teller", models.RoleTeller, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "CUSTOMER CANNOT USE TELLER ROUTE",
+			path: "/sb/api/v1/teller/accounts/%s/status",
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					UpdateAccountStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockedproviders.NewMockServiceProvider(ctrl)
+			// build stubs
+			tc.buildStubs(service)
+
+			// create server
+			server, err := NewHandler(testConfig, service)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"status": models.AccountStatusActive})
+			require.NoError(t, err)
+
+			url := fmt.Sprintf(tc.path, account.AccountNumber)
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.H.GetTokenMaker())
+			server.H.GetGin().ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestVoidHoldAPI(t *testing.T) {
+	payer, _ := randomUser()
+	merchant, _ := randomUser()
+	account := randomAccount(payer.UserName)
+	toAccount := randomAccount(merchant.UserName)
+	hold := models.Hold{
+		ID:          helpers.RandomInt(1, 1000),
+		AccountID:   account.ID,
+		ToAccountID: toAccount.ID,
+		Amount:      50,
+		Currency:    account.Currency,
+		Status:      models.HoldStatusActive,
+	}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(service *mockedproviders.MockServiceProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "RECEIVER VOIDS",
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetHold(gomock.Any(), gomock.Eq(hold.ID)).
+					Times(1).
+					Return(hold, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(toAccount.ID), gomock.Eq(merchant.UserName)).
+					Times(1).
+					Return(randomHolder(toAccount, merchant.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					VoidHold(gomock.Any(), gomock.Eq(hold.ID)).
+					Times(1).
+					Return(hold, nil)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, merchant.UserName, models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "PAYER CANNOT VOID",
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetHold(gomock.Any(), gomock.Eq(hold.ID)).
+					Times(1).
+					Return(hold, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(toAccount.ID), gomock.Eq(payer.UserName)).
+					Times(1).
+					Return(models.AccountHolder{}, pgx.ErrNoRows)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Any()).
+					Times(0)
+				service.EXPECT().
+					VoidHold(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, payer.UserName, models.RoleCustomer, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "TELLER VOIDS",
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetHold(gomock.Any(), gomock.Eq(hold.ID)).
+					Times(1).
+					Return(hold, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+				service.EXPECT().
+					VoidHold(gomock.Any(), gomock.Eq(hold.ID)).
+					Times(1).
+					Return(hold, nil)
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "teller", models.RoleTeller, time.Minute)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockedproviders.NewMockServiceProvider(ctrl)
+			// build stubs
+			tc.buildStubs(service)
+
+			// create server
+			server, err := NewHandler(testConfig, service)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/sb/api/v1/holds/%d/void", hold.ID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.H.GetTokenMaker())
+			server.H.GetGin().ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestTransferMoneyAPI(t *testing.T) {
+	user, _ := randomUser()
+	fromAccount := randomAccount(user.UserName)
+	fromAccount.AvailableBalance = 1000
+	toAccount := randomAccount(helpers.RandomOwner())
+	toAccount.Currency = fromAccount.Currency
+
+	beneficiary := models.Beneficiary{
+		ID:        helpers.RandomInt(1, 1000),
+		Owner:     user.UserName,
+		AccountID: toAccount.ID,
+		Currency:  toAccount.Currency,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(service *mockedproviders.MockServiceProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK BENEFICIARY",
+			body: gin.H{
+				"from_account_number": fromAccount.AccountNumber,
+				"beneficiary_id":      beneficiary.ID,
+				"amount":              10,
+				"fee":                 1,
+				"currency":            fromAccount.Currency,
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetBeneficiary(gomock.Any(), gomock.Eq(beneficiary.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(beneficiary, nil)
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(fromAccount.AccountNumber)).
+					Times(1).
+					Return(fromAccount, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(fromAccount.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(fromAccount, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(toAccount, nil)
+				service.EXPECT().
+					CheckPayeeTransfer(gomock.Any(), gomock.Eq(user.UserName), gomock.Eq(toAccount.ID), gomock.Eq(float64(10))).
+					Times(1).
+					Return(nil)
+				service.EXPECT().
+					TransferTx(gomock.Any(), gomock.Eq(models.TransferTxParams{
+						FromAccountID: fromAccount.ID,
+						ToAccountID:   toAccount.ID,
+						Amount:        10,
+						Fee:           1,
+						Currency:      fromAccount.Currency,
+						InitiatedBy:   user.UserName,
+					})).
+					Times(1).
+					Return(models.TransferTxResult{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "PENDING APPROVAL",
+			body: gin.H{
+				"from_account_number": fromAccount.AccountNumber,
+				"to_account_number":   toAccount.AccountNumber,
+				"amount":              500,
+				"fee":                 1,
+				"currency":            fromAccount.Currency,
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(fromAccount.AccountNumber)).
+					Times(1).
+					Return(fromAccount, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(fromAccount.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(fromAccount, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(toAccount.AccountNumber)).
+					Times(1).
+					Return(toAccount, nil)
+				service.EXPECT().
+					CheckPayeeTransfer(gomock.Any(), gomock.Eq(user.UserName), gomock.Eq(toAccount.ID), gomock.Eq(float64(500))).
+					Times(1).
+					Return(nil)
+				service.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(models.TransferTxResult{
+						Pending: &models.PendingTransfer{
+							ID:     1,
+							Status: models.PendingTransferPendingApproval,
+						},
+					}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+				require.Contains(t, recorder.Body.String(), models.PendingTransferPendingApproval)
+			},
+		},
+		{
+			name: "OK ALIAS",
+			body: gin.H{
+				"from_account_number": fromAccount.AccountNumber,
+				"to_alias":            toAccount.Owner,
+				"amount":              10,
+				"fee":                 1,
+				"currency":            fromAccount.Currency,
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					ResolvePaymentAlias(gomock.Any(), gomock.Eq(toAccount.Owner), gomock.Eq(fromAccount.Currency)).
+					Times(1).
+					Return(toAccount, nil)
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(fromAccount.AccountNumber)).
+					Times(1).
+					Return(fromAccount, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(fromAccount.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(fromAccount, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(toAccount, nil)
+				service.EXPECT().
+					CheckPayeeTransfer(gomock.Any(), gomock.Eq(user.UserName), gomock.Eq(toAccount.ID), gomock.Eq(float64(10))).
+					Times(1).
+					Return(nil)
+				service.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(models.TransferTxResult{}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-				requireBodyMatchWithAccount(t, recorder.Body, account)
 			},
 		},
 		{
-			name:      "UNAUTHORIZED USER",
-			accountID: account.ID,
+			name: "UNKNOWN ALIAS",
+			body: gin.H{
+				"from_account_number": fromAccount.AccountNumber,
+				"to_alias":            "+15550000000",
+				"amount":              10,
+				"fee":                 1,
+				"currency":            fromAccount.Currency,
+			},
 			buildStubs: func(service *mockedproviders.MockServiceProvider) {
 				service.EXPECT().
-					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					ResolvePaymentAlias(gomock.Any(), gomock.Eq("+15550000000"), gomock.Eq(fromAccount.Currency)).
 					Times(1).
-					Return(account, nil)
+					Return(models.Account{}, pgx.ErrNoRows)
+				service.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
 			},
-			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", time.Minute)
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "ALIAS AND BENEFICIARY",
+			body: gin.H{
+				"from_account_number": fromAccount.AccountNumber,
+				"to_alias":            toAccount.Owner,
+				"beneficiary_id":      beneficiary.ID,
+				"amount":              10,
+				"fee":                 1,
+				"currency":            fromAccount.Currency,
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					ResolvePaymentAlias(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			name:      "NO AUTHORIZATION",
-			accountID: account.ID,
-			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			name: "BENEFICIARY COOLING OFF",
+			body: gin.H{
+				"from_account_number": fromAccount.AccountNumber,
+				"beneficiary_id":      beneficiary.ID,
+				"amount":              900,
+				"fee":                 1,
+				"currency":            fromAccount.Currency,
 			},
 			buildStubs: func(service *mockedproviders.MockServiceProvider) {
 				service.EXPECT().
-					GetAccount(gomock.Any(), gomock.Any()).
+					GetBeneficiary(gomock.Any(), gomock.Eq(beneficiary.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(beneficiary, nil)
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(fromAccount.AccountNumber)).
+					Times(1).
+					Return(fromAccount, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(fromAccount.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(fromAccount, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).
+					Times(1).
+					Return(toAccount, nil)
+				service.EXPECT().
+					CheckPayeeTransfer(gomock.Any(), gomock.Eq(user.UserName), gomock.Eq(toAccount.ID), gomock.Eq(float64(900))).
+					Times(1).
+					Return(models.ErrBeneficiaryCoolingOff)
+				service.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			name:      "NOT FOUND",
-			accountID: account.ID,
+			name: "COOLING OFF BY ACCOUNT NUMBER",
+			body: gin.H{
+				"from_account_number": fromAccount.AccountNumber,
+				"to_account_number":   toAccount.AccountNumber,
+				"amount":              900,
+				"fee":                 1,
+				"currency":            fromAccount.Currency,
+			},
 			buildStubs: func(service *mockedproviders.MockServiceProvider) {
 				service.EXPECT().
-					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					GetAccountByNumber(gomock.Any(), gomock.Eq(fromAccount.AccountNumber)).
 					Times(1).
-					Return(models.Account{}, pgx.ErrNoRows)
+					Return(fromAccount, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(fromAccount.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(fromAccount, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(toAccount.AccountNumber)).
+					Times(1).
+					Return(toAccount, nil)
+				service.EXPECT().
+					CheckPayeeTransfer(gomock.Any(), gomock.Eq(user.UserName), gomock.Eq(toAccount.ID), gomock.Eq(float64(900))).
+					Times(1).
+					Return(models.ErrBeneficiaryCoolingOff)
+				service.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
 			},
-			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, time.Minute)
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UNKNOWN BENEFICIARY",
+			body: gin.H{
+				"from_account_number": fromAccount.AccountNumber,
+				"beneficiary_id":      beneficiary.ID,
+				"amount":              10,
+				"fee":                 1,
+				"currency":            fromAccount.Currency,
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetBeneficiary(gomock.Any(), gomock.Eq(beneficiary.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(models.Beneficiary{}, pgx.ErrNoRows)
+				service.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
 			},
 		},
 		{
-			name:      "INTERNAL ERROR",
-			accountID: account.ID,
+			name: "ACCOUNT AND BENEFICIARY",
+			body: gin.H{
+				"from_account_number": fromAccount.AccountNumber,
+				"to_account_number":   toAccount.AccountNumber,
+				"beneficiary_id":      beneficiary.ID,
+				"amount":              10,
+				"fee":                 1,
+				"currency":            fromAccount.Currency,
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetBeneficiary(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NO RECIPIENT",
+			body: gin.H{
+				"from_account_number": fromAccount.AccountNumber,
+				"amount":              10,
+				"fee":                 1,
+				"currency":            fromAccount.Currency,
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					TransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockedproviders.NewMockServiceProvider(ctrl)
+			// build stubs
+			tc.buildStubs(service)
+
+			// create server
+			server, err := NewHandler(testConfig, service)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/sb/api/v1/transfer", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.H.GetTokenMaker(), authorizationTypeBearer, user.UserName, models.RoleCustomer, time.Minute)
+			server.H.GetGin().ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestBatchTransferAPI(t *testing.T) {
+	user, _ := randomUser()
+	account := randomAccount(user.UserName)
+
+	recipients := []models.Account{randomAccount(helpers.RandomOwner()), randomAccount(helpers.RandomOwner())}
+	numbers := []string{recipients[0].AccountNumber, recipients[1].AccountNumber}
+	ids := map[string]int64{numbers[0]: recipients[0].ID, numbers[1]: recipients[1].ID}
+
+	lines := []models.BatchTransferLineRequest{
+		{ToAccountNumber: numbers[0], Amount: 10, Description: "salary"},
+		{ToAccountNumber: numbers[1], Amount: 20.5},
+	}
+	arg := models.BatchTransferTxParams{
+		FromAccountID: account.ID,
+		Currency:      account.Currency,
+		Mode:          models.BatchModeBestEffort,
+		Lines: []models.BatchTransferLine{
+			{ToAccountID: recipients[0].ID, Amount: 10, Description: "salary"},
+			{ToAccountID: recipients[1].ID, Amount: 20.5},
+		},
+		InitiatedBy: user.UserName,
+	}
+
+	csvForm := func(t *testing.T, csv string) (io.Reader, string) {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		require.NoError(t, writer.WriteField("from_account_number", account.AccountNumber))
+		require.NoError(t, writer.WriteField("currency", account.Currency))
+		require.NoError(t, writer.WriteField("mode", models.BatchModeBestEffort))
+
+		part, err := writer.CreateFormFile("file", "payroll.csv")
+		require.NoError(t, err)
+		_, err = part.Write([]byte(csv))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		return body, writer.FormDataContentType()
+	}
+
+	jsonBody := func(t *testing.T, mode string) (io.Reader, string) {
+		data, err := json.Marshal(gin.H{
+			"from_account_number": account.AccountNumber,
+			"currency":            account.Currency,
+			"mode":                mode,
+			"lines":               lines,
+		})
+		require.NoError(t, err)
+		return bytes.NewReader(data), binding.MIMEJSON
+	}
+
+	testCases := []struct {
+		name          string
+		body          func(t *testing.T) (io.Reader, string)
+		buildStubs    func(service *mockedproviders.MockServiceProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK JSON",
+			body: func(t *testing.T) (io.Reader, string) {
+				return jsonBody(t, models.BatchModeBestEffort)
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(account, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					ListAccountIDsByNumber(gomock.Any(), gomock.Eq(numbers)).
+					Times(1).
+					Return(ids, nil)
+				service.EXPECT().
+					CheckPayeeTransfer(gomock.Any(), gomock.Eq(user.UserName), gomock.Any(), gomock.Any()).
+					Times(2).
+					Return(nil)
+				service.EXPECT().
+					BatchTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(models.BatchTransferTxResult{FromAccount: account, Completed: 2}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "OK CSV",
+			body: func(t *testing.T) (io.Reader, string) {
+				return csvForm(t, "amount,to_account_number,description\n10,"+numbers[0]+",salary\n20.5,"+numbers[1]+"\n")
+			},
 			buildStubs: func(service *mockedproviders.MockServiceProvider) {
 				service.EXPECT().
-					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
 					Times(1).
-					Return(models.Account{}, pgx.ErrTxClosed)
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(account, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					ListAccountIDsByNumber(gomock.Any(), gomock.Eq(numbers)).
+					Times(1).
+					Return(ids, nil)
+				service.EXPECT().
+					CheckPayeeTransfer(gomock.Any(), gomock.Eq(user.UserName), gomock.Any(), gomock.Any()).
+					Times(2).
+					Return(nil)
+				service.EXPECT().
+					BatchTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(models.BatchTransferTxResult{FromAccount: account, Completed: 2}, nil)
 			},
-			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, time.Minute)
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "INVALID CSV AMOUNT",
+			body: func(t *testing.T) (io.Reader, string) {
+				return csvForm(t, "to_account_number,amount\n"+numbers[0]+",ten\n")
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					BatchTransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			name:      "INVALID ID",
-			accountID: 0,
+			name: "NEGATIVE CSV AMOUNT",
+			body: func(t *testing.T) (io.Reader, string) {
+				return csvForm(t, "to_account_number,amount\n"+numbers[0]+",-5\n")
+			},
 			buildStubs: func(service *mockedproviders.MockServiceProvider) {
 				service.EXPECT().
-					GetAccount(gomock.Any(), gomock.Any()).
+					BatchTransferTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
-			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.UserName, time.Minute)
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "BEST EFFORT LINE REFUSED",
+			body: func(t *testing.T) (io.Reader, string) {
+				return jsonBody(t, models.BatchModeBestEffort)
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(account, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					ListAccountIDsByNumber(gomock.Any(), gomock.Eq(numbers)).
+					Times(1).
+					Return(ids, nil)
+				service.EXPECT().
+					CheckPayeeTransfer(gomock.Any(), gomock.Eq(user.UserName), gomock.Eq(recipients[0].ID), gomock.Any()).
+					Times(1).
+					Return(models.ErrBeneficiaryCoolingOff)
+				service.EXPECT().
+					CheckPayeeTransfer(gomock.Any(), gomock.Eq(user.UserName), gomock.Eq(recipients[1].ID), gomock.Any()).
+					Times(1).
+					Return(nil)
+
+				refused := arg
+				refused.Lines = slices.Clone(arg.Lines)
+				refused.Lines[0].Refused = models.ErrBeneficiaryCoolingOff
+				service.EXPECT().
+					BatchTransferTx(gomock.Any(), gomock.Eq(refused)).
+					Times(1).
+					Return(models.BatchTransferTxResult{FromAccount: account, Completed: 1, Failed: 1}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "ALL OR NOTHING LINE REFUSED",
+			body: func(t *testing.T) (io.Reader, string) {
+				return jsonBody(t, models.BatchModeAllOrNothing)
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(account, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					ListAccountIDsByNumber(gomock.Any(), gomock.Eq(numbers)).
+					Times(1).
+					Return(ids, nil)
+				service.EXPECT().
+					CheckPayeeTransfer(gomock.Any(), gomock.Eq(user.UserName), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(models.ErrBeneficiaryCoolingOff)
+				service.EXPECT().
+					BatchTransferTx(gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name: "BATCH REJECTED",
+			body: func(t *testing.T) (io.Reader, string) {
+				return jsonBody(t, models.BatchModeAllOrNothing)
+			},
+			buildStubs: func(service *mockedproviders.MockServiceProvider) {
+				service.EXPECT().
+					GetAccountByNumber(gomock.Any(), gomock.Eq(account.AccountNumber)).
+					Times(1).
+					Return(account, nil)
+				service.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Eq(account.ID), gomock.Eq(user.UserName)).
+					Times(1).
+					Return(randomHolder(account, user.UserName, models.HolderRoleOwner), nil)
+				service.EXPECT().
+					ListAccountIDsByNumber(gomock.Any(), gomock.Eq(numbers)).
+					Times(1).
+					Return(ids, nil)
+				service.EXPECT().
+					CheckPayeeTransfer(gomock.Any(), gomock.Eq(user.UserName), gomock.Any(), gomock.Any()).
+					Times(2).
+					Return(nil)
+				service.EXPECT().
+					BatchTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(models.BatchTransferTxResult{Failed: 1}, models.ErrBatchRejected)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -174,11 +1285,12 @@ func TestGetAccountAPI(t *testing.T) {
 			require.NoError(t, err)
 			recorder := httptest.NewRecorder()
 
-			url := fmt.Sprintf("/sb/api/v1/account/%d", tc.accountID)
-			request, err := http.NewRequest(http.MethodGet, url, nil)
+			body, contentType := tc.body(t)
+			request, err := http.NewRequest(http.MethodPost, "/sb/api/v1/transfers/batch", body)
 			require.NoError(t, err)
+			request.Header.Set("Content-Type", contentType)
 
-			tc.setupAuth(t, request, server.H.GetTokenMaker())
+			addAuthorization(t, request, server.H.GetTokenMaker(), authorizationTypeBearer, user.UserName, models.RoleCustomer, time.Minute)
 			server.H.GetGin().ServeHTTP(recorder, request)
 			tc.checkResponse(recorder)
 		})
@@ -339,12 +1451,72 @@ func TestCreateUserAPI(t *testing.T) {
 	}
 }
 
+func TestRenewAccessTokenAPI(t *testing.T) {
+	user, _ := randomUser()
+	user.Role = models.RoleCustomer
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := mockedproviders.NewMockServiceProvider(ctrl)
+	server, err := NewHandler(testConfig, service)
+	require.NoError(t, err)
+
+	// the user was a teller when they logged in and has been demoted since
+	refreshToken, refreshPayload, err := server.H.GetTokenMaker().CreateToken(user.UserName, models.RoleTeller, time.Hour)
+	require.NoError(t, err)
+
+	service.EXPECT().
+		FetchSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+		Times(1).
+		Return(models.Session{
+			ID:           refreshPayload.ID,
+			UserName:     user.UserName,
+			RefreshToken: refreshToken,
+			ExpiresAt:    refreshPayload.ExpiredAt,
+		}, nil)
+	service.EXPECT().
+		GetUser(gomock.Any(), gomock.Eq(user.UserName)).
+		Times(1).
+		Return(user, nil)
+
+	data, err := json.Marshal(gin.H{"refresh_token": refreshToken})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/sb/api/v1/tokens/renew_access", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	server.H.GetGin().ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var rsp struct {
+		AccessToken string `json:"access_token"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+
+	payload, err := server.H.GetTokenMaker().VerifyToken(rsp.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, user.UserName, payload.UserName)
+	require.Equal(t, models.RoleCustomer, payload.Role)
+}
+
 func randomAccount(owner string) models.Account {
 	return models.Account{
-		ID:       helpers.RandomInt(1, 1000),
-		Owner:    owner,
-		Balance:  float64(helpers.RandomMoney()),
-		Currency: helpers.RandomCurrency(),
+		ID:            helpers.RandomInt(1, 1000),
+		AccountNumber: helpers.RandomAccountNumber(),
+		Owner:         owner,
+		Balance:       float64(helpers.RandomMoney()),
+		Currency:      helpers.RandomCurrency(),
+	}
+}
+
+func randomHolder(account models.Account, username, role string) models.AccountHolder {
+	return models.AccountHolder{
+		AccountID: account.ID,
+		UserName:  username,
+		Role:      role,
+		Status:    models.HolderStatusActive,
 	}
 }
 
@@ -352,9 +1524,13 @@ func requireBodyMatchWithAccount(t *testing.T, body *bytes.Buffer, account model
 	data, err := io.ReadAll(body)
 	require.NoError(t, err)
 
+	// the internal id never leaves the API, the account number stands in for it
+	require.NotContains(t, string(data), `"id"`)
+
 	var gotAccount models.Account
 	err = json.Unmarshal(data, &gotAccount)
 	require.NoError(t, err)
+	account.ID = 0
 	require.Equal(t, gotAccount, account)
 }
 
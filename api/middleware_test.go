@@ -10,6 +10,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
 	mockedproviders "github.com/zde37/Swift_Bank/mock"
+	"github.com/zde37/Swift_Bank/models"
 	"github.com/zde37/Swift_Bank/token"
 	"go.uber.org/mock/gomock"
 )
@@ -20,9 +21,10 @@ func addAuthorization(
 	tokenMaker token.Maker,
 	authorizationType string,
 	username string,
+	role string,
 	duration time.Duration,
 ) {
-	token, payload, err := tokenMaker.CreateToken(username, duration)
+	token, payload, err := tokenMaker.CreateToken(username, role, duration)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 
@@ -40,7 +42,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "OK",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", models.RoleCustomer, time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -57,7 +59,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "UNSUPPORTED AUTHORIZATION",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, "unsupported", "user", time.Minute)
+				addAuthorization(t, request, tokenMaker, "unsupported", "user", models.RoleCustomer, time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -66,7 +68,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "INVALID AUTHORIZATION FORMAT",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, "", "user", time.Minute)
+				addAuthorization(t, request, tokenMaker, "", "user", models.RoleCustomer, time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -75,7 +77,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "EXPIRED AUTHORIZATION TOKEN",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", -time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", models.RoleCustomer, -time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -108,3 +110,53 @@ func TestAuthMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestRequireRoleMiddleware(t *testing.T) {
+
+	testCases := []struct {
+		name          string
+		role          string
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			role: models.RoleTeller,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "FORBIDDEN ROLE",
+			role: models.RoleCustomer,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := mockedproviders.NewMockServiceProvider(ctrl)
+			server, err := NewHandler(testConfig, service)
+			require.NoError(t, err)
+
+			rolePath := "/role"
+			tokenMaker := server.H.GetTokenMaker()
+			server.H.GetGin().GET(rolePath, auth(tokenMaker), requireRole(models.RoleTeller, models.RoleAdmin), func(ctx *gin.Context) {
+				ctx.JSON(http.StatusOK, gin.H{})
+			})
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, rolePath, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", tc.role, time.Minute)
+			server.H.GetGin().ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
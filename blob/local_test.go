@@ -0,0 +1,41 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	key := "attachments/receipt"
+
+	err = store.Put(ctx, key, strings.NewReader("first"))
+	require.NoError(t, err)
+
+	err = store.Put(ctx, key, strings.NewReader("second"))
+	require.NoError(t, err)
+
+	file, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	content, err := io.ReadAll(file)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	require.Equal(t, "second", string(content))
+
+	require.NoError(t, store.Delete(ctx, key))
+	require.NoError(t, store.Delete(ctx, key))
+
+	_, err = store.Get(ctx, key)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	for _, bad := range []string{"../outside", "/etc/passwd", ""} {
+		require.Error(t, store.Put(ctx, bad, strings.NewReader("x")), bad)
+	}
+}
@@ -7,20 +7,55 @@ import (
 )
 
 type Config struct {
-	Dsn                  string        `mapstructure:"DSN"`
-	HttpServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GrpcServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	RedisAddress         string        `mapstructure:"REDIS_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
-	JwtSecretKey         string        `mapstructure:"JWT_SECRET_KEY"`
-	RefreshJwtSecretKey  string        `mapstructure:"REFRESH_JWT_SECRET_KEY"`
-	MigrationURL         string        `mapstructure:"MIGRATION_URL"`
-	Environment          string        `mapstructure:"ENVIRONMENT"`
-	EmailAddress         string        `mapstructure:"EMAIL_ADDRESS"`
-	EmailSender          string        `mapstructure:"EMAIL_SENDER"`
-	EmailPassword        string        `mapstructure:"EMAIL_PASSWORD"`
+	Dsn                       string        `mapstructure:"DSN"`
+	HttpServerAddress         string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GrpcServerAddress         string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	RedisAddress              string        `mapstructure:"REDIS_ADDRESS"`
+	TokenSymmetricKey         string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	AccessTokenDuration       time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration      time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	JwtSecretKey              string        `mapstructure:"JWT_SECRET_KEY"`
+	RefreshJwtSecretKey       string        `mapstructure:"REFRESH_JWT_SECRET_KEY"`
+	MigrationURL              string        `mapstructure:"MIGRATION_URL"`
+	Environment               string        `mapstructure:"ENVIRONMENT"`
+	EmailAddress              string        `mapstructure:"EMAIL_ADDRESS"`
+	EmailSender               string        `mapstructure:"EMAIL_SENDER"`
+	EmailPassword             string        `mapstructure:"EMAIL_PASSWORD"`
+	EmailTemplateDir          string        `mapstructure:"EMAIL_TEMPLATE_DIR"`   // overrides the embedded email templates
+	EmailDefaultLocale        string        `mapstructure:"EMAIL_DEFAULT_LOCALE"` // used when a template isn't in the user's locale
+	AppBaseURL                string        `mapstructure:"APP_BASE_URL"`         // where links in emails point to
+	EmailTransport            string        `mapstructure:"EMAIL_TRANSPORT"`      // gmail, smtp or mbox
+	EmailMboxPath             string        `mapstructure:"EMAIL_MBOX_PATH"`      // where the mbox transport appends messages
+	SMTPHost                  string        `mapstructure:"SMTP_HOST"`
+	SMTPPort                  int           `mapstructure:"SMTP_PORT"`
+	SMTPTLSMode               string        `mapstructure:"SMTP_TLS_MODE"` // starttls, tls or none
+	SMTPUsername              string        `mapstructure:"SMTP_USERNAME"` // no authentication when empty
+	SMTPPassword              string        `mapstructure:"SMTP_PASSWORD"`
+	SMSTransport              string        `mapstructure:"SMS_TRANSPORT"`             // webhook or log, texts fail when unset
+	SMSWebhookURL             string        `mapstructure:"SMS_WEBHOOK_URL"`           // gateway the webhook transport posts to
+	TellerApprovalThreshold   float64       `mapstructure:"TELLER_APPROVAL_THRESHOLD"` // larger teller operations need a second approver
+	BeneficiaryCoolingOff     time.Duration `mapstructure:"BENEFICIARY_COOLING_OFF"`
+	BeneficiaryLargeAmount    float64       `mapstructure:"BENEFICIARY_LARGE_AMOUNT"`    // transfers above this wait out the cooling-off period
+	TransferApprovalThreshold float64       `mapstructure:"TRANSFER_APPROVAL_THRESHOLD"` // larger transfers wait for a second approver
+	PendingTransferTTL        time.Duration `mapstructure:"PENDING_TRANSFER_TTL"`
+	FraudNewDeviceAmount      float64       `mapstructure:"FRAUD_NEW_DEVICE_AMOUNT"` // zero turns a fraud rule off
+	FraudNewDeviceWindow      time.Duration `mapstructure:"FRAUD_NEW_DEVICE_WINDOW"` // a device first seen within this is new
+	FraudNewDeviceAction      string        `mapstructure:"FRAUD_NEW_DEVICE_ACTION"` // review or block
+	FraudNewPayeeAmount       float64       `mapstructure:"FRAUD_NEW_PAYEE_AMOUNT"`
+	FraudNewPayeeAction       string        `mapstructure:"FRAUD_NEW_PAYEE_ACTION"`
+	FraudVelocityLimit        int64         `mapstructure:"FRAUD_VELOCITY_LIMIT"` // transfers allowed within the window
+	FraudVelocityWindow       time.Duration `mapstructure:"FRAUD_VELOCITY_WINDOW"`
+	FraudVelocityAction       string        `mapstructure:"FRAUD_VELOCITY_ACTION"`
+	FraudUnusualMultiplier    float64       `mapstructure:"FRAUD_UNUSUAL_MULTIPLIER"` // times the account's average transfer
+	FraudUnusualHistory       time.Duration `mapstructure:"FRAUD_UNUSUAL_HISTORY"`
+	FraudUnusualAction        string        `mapstructure:"FRAUD_UNUSUAL_ACTION"`
+	OverdraftInterestRate     float64       `mapstructure:"OVERDRAFT_INTEREST_RATE"` // annual, given to overdrafts when approved
+	OverdraftFee              float64       `mapstructure:"OVERDRAFT_FEE"`           // charged each time an account goes overdrawn
+	LoanLateFee               float64       `mapstructure:"LOAN_LATE_FEE"`           // added to a loan installment that can't be collected
+	BlobStoragePath           string        `mapstructure:"BLOB_STORAGE_PATH"`       // where the local blob store keeps uploaded files
+	DisputeHoldDuration       time.Duration `mapstructure:"DISPUTE_HOLD_DURATION"`   // how long disputed funds stay held
+	GLExportFormat            string        `mapstructure:"GL_EXPORT_FORMAT"`        // csv or fixed_width, used by the daily export
+	GLFixedWidthLayout        string        `mapstructure:"GL_FIXED_WIDTH_LAYOUT"`   // name:width of each fixed width field in order
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "holds";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "hold_balance";
@@ -0,0 +1,30 @@
+ALTER TABLE "accounts" ADD COLUMN "hold_balance" float NOT NULL DEFAULT 0;
+
+CREATE TABLE "holds" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" float NOT NULL,
+  "captured_amount" float NOT NULL DEFAULT 0,
+  "currency" varchar NOT NULL,
+  "description" varchar NOT NULL DEFAULT '',
+  "status" varchar NOT NULL DEFAULT 'active',
+  "transaction_id" bigint,
+  "expires_at" timestamptz NOT NULL,
+  "released_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "holds" ("account_id");
+
+CREATE INDEX ON "holds" ("status", "expires_at");
+
+COMMENT ON COLUMN "accounts"."hold_balance" IS 'sum of active holds, available balance is balance - hold_balance';
+
+COMMENT ON COLUMN "holds"."status" IS 'active, captured, voided or expired';
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
@@ -0,0 +1,20 @@
+DROP TABLE IF EXISTS "interest_accruals";
+
+DROP TABLE IF EXISTS "internal_accounts";
+
+DELETE FROM "entries" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'swiftbank');
+
+DELETE FROM "transactions" WHERE "from_account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'swiftbank')
+  OR "to_account_id" IN (SELECT "id" FROM "accounts" WHERE "owner" = 'swiftbank');
+
+DELETE FROM "accounts" WHERE "owner" = 'swiftbank';
+
+DELETE FROM "users" WHERE "username" = 'swiftbank';
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "matures_at";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "product_code";
+
+DROP TABLE IF EXISTS "account_products";
+
+ALTER TABLE "accounts" ADD CONSTRAINT "owner_currency_key" UNIQUE ("owner", "currency");
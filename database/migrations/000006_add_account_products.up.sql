@@ -0,0 +1,73 @@
+CREATE TABLE "account_products" (
+  "code" varchar PRIMARY KEY,
+  "name" varchar NOT NULL,
+  "account_type" varchar NOT NULL,
+  "interest_rate" float NOT NULL DEFAULT 0,
+  "day_count_convention" varchar NOT NULL DEFAULT 'ACT/365',
+  "term_months" int NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+INSERT INTO "account_products" ("code", "name", "account_type", "interest_rate", "day_count_convention", "term_months") VALUES
+  ('checking', 'Checking', 'checking', 0, 'ACT/365', 0),
+  ('savings', 'Savings', 'savings', 0.02, 'ACT/365', 0),
+  ('fixed_term_12m', 'Fixed term 12 months', 'fixed_term', 0.04, '30/360', 12);
+
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "owner_currency_key";
+
+ALTER TABLE "accounts" ADD COLUMN "product_code" varchar NOT NULL DEFAULT 'checking';
+
+ALTER TABLE "accounts" ADD COLUMN "matures_at" timestamptz;
+
+CREATE TABLE "internal_accounts" (
+  "code" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "account_id" bigint UNIQUE NOT NULL,
+  PRIMARY KEY ("code", "currency")
+);
+
+CREATE TABLE "interest_accruals" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "accrual_date" date NOT NULL,
+  "balance" float NOT NULL,
+  "interest_rate" float NOT NULL,
+  "day_count_convention" varchar NOT NULL,
+  "amount" float NOT NULL,
+  "transaction_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "interest_accruals" ("account_id", "accrual_date");
+
+CREATE INDEX ON "interest_accruals" ("transaction_id");
+
+COMMENT ON COLUMN "account_products"."account_type" IS 'checking, savings or fixed_term';
+
+COMMENT ON COLUMN "account_products"."interest_rate" IS 'annual rate, 0.02 is 2%';
+
+COMMENT ON COLUMN "account_products"."day_count_convention" IS 'ACT/365, ACT/360, ACT/ACT or 30/360';
+
+COMMENT ON COLUMN "accounts"."matures_at" IS 'set for fixed term accounts, debits are rejected until then';
+
+COMMENT ON COLUMN "interest_accruals"."transaction_id" IS 'null until the accrual has been paid out';
+
+ALTER TABLE "accounts" ADD FOREIGN KEY ("product_code") REFERENCES "account_products" ("code");
+
+ALTER TABLE "internal_accounts" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
+
+-- the bank's own accounts belong to a system user that cannot log in
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email") VALUES
+  ('swiftbank', '!', 'Swift Bank', 'system@swiftbank.internal');
+
+WITH "created" AS (
+  INSERT INTO "accounts" ("owner", "balance", "currency")
+  SELECT 'swiftbank', 0, "currency" FROM (VALUES ('USD'), ('EUR'), ('CAD')) AS c ("currency")
+  RETURNING "id", "currency"
+)
+INSERT INTO "internal_accounts" ("code", "currency", "account_id")
+SELECT 'interest_expense', "currency", "id" FROM "created";
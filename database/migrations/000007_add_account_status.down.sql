@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "account_status_changes";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "closed_at";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "status";
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "teller_operations";
+
+DROP TABLE IF EXISTS "teller_reason_codes";
+
+DELETE FROM "internal_accounts" WHERE "code" IN ('cash', 'suspense');
+
+ALTER TABLE IF EXISTS "users" DROP COLUMN IF EXISTS "role";
@@ -0,0 +1,68 @@
+ALTER TABLE "users" ADD COLUMN "role" varchar NOT NULL DEFAULT 'customer';
+
+COMMENT ON COLUMN "users"."role" IS 'customer, teller or admin';
+
+CREATE TABLE "teller_reason_codes" (
+  "code" varchar PRIMARY KEY,
+  "kind" varchar NOT NULL,
+  "description" varchar NOT NULL
+);
+
+INSERT INTO "teller_reason_codes" ("code", "kind", "description") VALUES
+  ('cash_deposit', 'deposit', 'Cash paid in at the counter'),
+  ('cheque_deposit', 'deposit', 'Cheque paid in at the counter'),
+  ('cash_withdrawal', 'withdrawal', 'Cash paid out at the counter'),
+  ('fee_refund', 'adjustment', 'Refund of a fee charged in error'),
+  ('error_correction', 'adjustment', 'Correction of a posting error'),
+  ('goodwill', 'adjustment', 'Goodwill payment to the customer');
+
+CREATE TABLE "teller_operations" (
+  "id" bigserial PRIMARY KEY,
+  "kind" varchar NOT NULL,
+  "account_id" bigint NOT NULL,
+  "amount" float NOT NULL,
+  "currency" varchar NOT NULL,
+  "reason_code" varchar NOT NULL,
+  "note" varchar NOT NULL DEFAULT '',
+  "status" varchar NOT NULL,
+  "initiated_by" varchar NOT NULL,
+  "decided_by" varchar,
+  "transaction_id" bigint,
+  "decided_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "teller_operations" ("account_id");
+
+CREATE INDEX ON "teller_operations" ("status");
+
+COMMENT ON COLUMN "teller_reason_codes"."kind" IS 'deposit, withdrawal or adjustment';
+
+COMMENT ON COLUMN "teller_operations"."amount" IS 'signed from the customer account side, negative for debits';
+
+COMMENT ON COLUMN "teller_operations"."status" IS 'pending_approval, completed or rejected';
+
+ALTER TABLE "teller_operations" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "teller_operations" ADD FOREIGN KEY ("reason_code") REFERENCES "teller_reason_codes" ("code");
+
+ALTER TABLE "teller_operations" ADD FOREIGN KEY ("initiated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "teller_operations" ADD FOREIGN KEY ("decided_by") REFERENCES "users" ("username");
+
+ALTER TABLE "teller_operations" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
+
+-- the cash drawer and the suspense account teller postings are balanced against
+WITH "cash" AS (
+  INSERT INTO "accounts" ("owner", "balance", "currency")
+  SELECT 'swiftbank', 0, "currency" FROM (VALUES ('USD'), ('EUR'), ('CAD')) AS c ("currency")
+  RETURNING "id", "currency"
+), "suspense" AS (
+  INSERT INTO "accounts" ("owner", "balance", "currency")
+  SELECT 'swiftbank', 0, "currency" FROM (VALUES ('USD'), ('EUR'), ('CAD')) AS c ("currency")
+  RETURNING "id", "currency"
+)
+INSERT INTO "internal_accounts" ("code", "currency", "account_id")
+SELECT 'cash', "currency", "id" FROM "cash"
+UNION ALL
+SELECT 'suspense', "currency", "id" FROM "suspense";
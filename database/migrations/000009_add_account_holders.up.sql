@@ -0,0 +1,25 @@
+CREATE TABLE "account_holders" (
+  "account_id" bigint NOT NULL,
+  "username" varchar NOT NULL,
+  "role" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'invited',
+  "invited_by" varchar,
+  "accepted_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "username")
+);
+
+CREATE INDEX ON "account_holders" ("username");
+
+COMMENT ON COLUMN "account_holders"."role" IS 'owner, co_owner, viewer or authorized_signer';
+
+COMMENT ON COLUMN "account_holders"."status" IS 'invited or active';
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("invited_by") REFERENCES "users" ("username");
+
+INSERT INTO "account_holders" ("account_id", "username", "role", "status", "accepted_at")
+SELECT "id", "owner", 'owner', 'active', "created_at" FROM "accounts";
@@ -0,0 +1,20 @@
+CREATE TABLE "beneficiaries" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "nickname" varchar NOT NULL,
+  "account_id" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "transfer_limit" float,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "beneficiaries" ("owner", "nickname");
+
+CREATE UNIQUE INDEX ON "beneficiaries" ("owner", "account_id");
+
+COMMENT ON COLUMN "beneficiaries"."transfer_limit" IS 'largest single transfer allowed, null for no limit';
+
+ALTER TABLE "beneficiaries" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "beneficiaries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
@@ -0,0 +1,26 @@
+CREATE TABLE "payment_aliases" (
+  "alias" varchar PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "kind" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "default_accounts" (
+  "username" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "account_id" bigint NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("username", "currency")
+);
+
+CREATE INDEX ON "payment_aliases" ("username");
+
+COMMENT ON COLUMN "payment_aliases"."kind" IS 'phone, usernames and verified emails resolve without an alias';
+
+COMMENT ON TABLE "default_accounts" IS 'the account that receives alias payments in each currency';
+
+ALTER TABLE "payment_aliases" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "default_accounts" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "default_accounts" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "pending_transfer_events";
+
+DROP TABLE IF EXISTS "pending_transfers";
@@ -0,0 +1,56 @@
+CREATE TABLE "pending_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" float NOT NULL,
+  "fee" float NOT NULL DEFAULT 0,
+  "currency" varchar NOT NULL,
+  "description" varchar NOT NULL DEFAULT '',
+  "status" varchar NOT NULL DEFAULT 'pending_approval',
+  "initiated_by" varchar NOT NULL,
+  "decided_by" varchar,
+  "comment" varchar NOT NULL DEFAULT '',
+  "hold_id" bigint NOT NULL,
+  "transaction_id" bigint,
+  "expires_at" timestamptz NOT NULL,
+  "decided_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "pending_transfer_events" (
+  "id" bigserial PRIMARY KEY,
+  "pending_transfer_id" bigint NOT NULL,
+  "from_status" varchar NOT NULL DEFAULT '',
+  "to_status" varchar NOT NULL,
+  "actor" varchar,
+  "comment" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "pending_transfers" ("status", "expires_at");
+
+CREATE INDEX ON "pending_transfers" ("from_account_id");
+
+CREATE INDEX ON "pending_transfer_events" ("pending_transfer_id");
+
+COMMENT ON COLUMN "pending_transfers"."status" IS 'pending_approval, approved, rejected or expired';
+
+COMMENT ON COLUMN "pending_transfers"."hold_id" IS 'reserves the funds while the transfer waits';
+
+COMMENT ON COLUMN "pending_transfer_events"."actor" IS 'null when the system expired the transfer';
+
+ALTER TABLE "pending_transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "pending_transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "pending_transfers" ADD FOREIGN KEY ("initiated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "pending_transfers" ADD FOREIGN KEY ("decided_by") REFERENCES "users" ("username");
+
+ALTER TABLE "pending_transfers" ADD FOREIGN KEY ("hold_id") REFERENCES "holds" ("id");
+
+ALTER TABLE "pending_transfers" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
+
+ALTER TABLE "pending_transfer_events" ADD FOREIGN KEY ("pending_transfer_id") REFERENCES "pending_transfers" ("id");
+
+ALTER TABLE "pending_transfer_events" ADD FOREIGN KEY ("actor") REFERENCES "users" ("username");
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "sessions_username_user_agent_idx";
+
+DROP TABLE IF EXISTS "fraud_screenings";
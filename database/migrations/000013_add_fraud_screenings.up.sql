@@ -0,0 +1,36 @@
+CREATE TABLE "fraud_screenings" (
+  "id" bigserial PRIMARY KEY,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" float NOT NULL,
+  "currency" varchar NOT NULL,
+  "initiated_by" varchar NOT NULL,
+  "decision" varchar NOT NULL,
+  "reasons" text[] NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'open',
+  "pending_transfer_id" bigint,
+  "reviewed_by" varchar,
+  "review_note" varchar NOT NULL DEFAULT '',
+  "reviewed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "fraud_screenings" ("status");
+
+CREATE INDEX ON "fraud_screenings" ("from_account_id");
+
+CREATE INDEX ON "sessions" ("username", "user_agent");
+
+COMMENT ON COLUMN "fraud_screenings"."decision" IS 'review or block, transfers every rule allowed are not recorded';
+
+COMMENT ON COLUMN "fraud_screenings"."status" IS 'open until an admin resolves it as legitimate or fraudulent';
+
+ALTER TABLE "fraud_screenings" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "fraud_screenings" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "fraud_screenings" ADD FOREIGN KEY ("initiated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "fraud_screenings" ADD FOREIGN KEY ("pending_transfer_id") REFERENCES "pending_transfers" ("id");
+
+ALTER TABLE "fraud_screenings" ADD FOREIGN KEY ("reviewed_by") REFERENCES "users" ("username");
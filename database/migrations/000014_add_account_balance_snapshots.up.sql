@@ -0,0 +1,16 @@
+CREATE TABLE "account_balance_snapshots" (
+  "account_id" bigint NOT NULL,
+  "snapshot_date" date NOT NULL,
+  "as_of" timestamptz NOT NULL,
+  "balance" float NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "snapshot_date")
+);
+
+CREATE INDEX ON "account_balance_snapshots" ("account_id", "as_of");
+
+CREATE INDEX ON "entries" ("account_id", "created_at");
+
+COMMENT ON COLUMN "account_balance_snapshots"."as_of" IS 'end of the snapshot day, the balance is the sum of the entries created before it';
+
+ALTER TABLE "account_balance_snapshots" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
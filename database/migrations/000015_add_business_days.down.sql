@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS "entries_reject_closed_postings" ON "entries";
+
+DROP FUNCTION IF EXISTS reject_closed_postings();
+
+DROP TABLE IF EXISTS "trial_balance_lines";
+
+DROP TABLE IF EXISTS "trial_balances";
+
+DROP TABLE IF EXISTS "business_days";
@@ -0,0 +1,54 @@
+CREATE TABLE "business_days" (
+  "business_date" date PRIMARY KEY,
+  "closed_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "trial_balances" (
+  "id" bigserial PRIMARY KEY,
+  "business_date" date NOT NULL,
+  "currency" varchar NOT NULL,
+  "total_debits" float NOT NULL,
+  "total_credits" float NOT NULL,
+  "total_balance" float NOT NULL,
+  "entry_count" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "trial_balance_lines" (
+  "trial_balance_id" bigint NOT NULL,
+  "account_id" bigint NOT NULL,
+  "owner" varchar NOT NULL,
+  "internal_code" varchar,
+  "debits" float NOT NULL,
+  "credits" float NOT NULL,
+  "balance" float NOT NULL,
+  PRIMARY KEY ("trial_balance_id", "account_id")
+);
+
+CREATE UNIQUE INDEX ON "trial_balances" ("business_date", "currency");
+
+COMMENT ON COLUMN "trial_balances"."total_balance" IS 'sum of every closing balance in the currency, must be zero';
+
+COMMENT ON COLUMN "trial_balance_lines"."internal_code" IS 'set for the bank''s own accounts';
+
+ALTER TABLE "trial_balances" ADD FOREIGN KEY ("business_date") REFERENCES "business_days" ("business_date");
+
+ALTER TABLE "trial_balance_lines" ADD FOREIGN KEY ("trial_balance_id") REFERENCES "trial_balances" ("id");
+
+ALTER TABLE "trial_balance_lines" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+-- postings dated on or before the last closed business date are rejected with SQLSTATE SB001
+CREATE FUNCTION reject_closed_postings() RETURNS trigger AS $$
+DECLARE
+  closed date;
+BEGIN
+  SELECT max(business_date) INTO closed FROM business_days;
+  IF closed IS NOT NULL AND NEW.created_at < ((closed + 1)::timestamp AT TIME ZONE 'UTC') THEN
+    RAISE EXCEPTION 'business date % is closed', closed USING ERRCODE = 'SB001';
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "entries_reject_closed_postings" BEFORE INSERT OR UPDATE ON "entries"
+  FOR EACH ROW EXECUTE FUNCTION reject_closed_postings();
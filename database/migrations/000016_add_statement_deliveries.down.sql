@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "statement_deliveries";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "statements_opt_out";
@@ -0,0 +1,13 @@
+ALTER TABLE "users" ADD COLUMN "statements_opt_out" boolean NOT NULL DEFAULT false;
+
+CREATE TABLE "statement_deliveries" (
+  "account_id" bigint NOT NULL,
+  "period" date NOT NULL,
+  "email" varchar NOT NULL,
+  "sent_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("account_id", "period")
+);
+
+COMMENT ON COLUMN "statement_deliveries"."period" IS 'first day of the statement month';
+
+ALTER TABLE "statement_deliveries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS "transactions_from_account_id_created_at_idx";
+
+DROP INDEX IF EXISTS "transactions_to_account_id_created_at_idx";
+
+DROP TABLE IF EXISTS "transaction_categories";
+
+DROP TABLE IF EXISTS "category_rules";
+
+DROP TABLE IF EXISTS "categories";
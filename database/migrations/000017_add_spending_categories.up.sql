@@ -0,0 +1,83 @@
+CREATE TABLE "categories" (
+  "code" varchar PRIMARY KEY,
+  "name" varchar NOT NULL
+);
+
+CREATE TABLE "category_rules" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar,
+  "kind" varchar NOT NULL,
+  "pattern" varchar NOT NULL,
+  "category_code" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "transaction_categories" (
+  "transaction_id" bigint NOT NULL,
+  "account_id" bigint NOT NULL,
+  "category_code" varchar NOT NULL,
+  "set_by" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("transaction_id", "account_id")
+);
+
+CREATE INDEX ON "category_rules" ("owner");
+
+CREATE UNIQUE INDEX ON "category_rules" (COALESCE("owner", ''), "kind", lower("pattern"));
+
+CREATE INDEX ON "transactions" ("from_account_id", "created_at");
+
+CREATE INDEX ON "transactions" ("to_account_id", "created_at");
+
+COMMENT ON COLUMN "category_rules"."owner" IS 'null for the rules every user gets, a user''s own rules take precedence';
+
+COMMENT ON COLUMN "category_rules"."kind" IS 'keyword matches the description, merchant matches the counterparty''s owner';
+
+COMMENT ON TABLE "transaction_categories" IS 'categories users picked for a transaction instead of the one the rules give, per side of the transaction';
+
+ALTER TABLE "category_rules" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "category_rules" ADD FOREIGN KEY ("category_code") REFERENCES "categories" ("code");
+
+ALTER TABLE "transaction_categories" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
+
+ALTER TABLE "transaction_categories" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transaction_categories" ADD FOREIGN KEY ("category_code") REFERENCES "categories" ("code");
+
+ALTER TABLE "transaction_categories" ADD FOREIGN KEY ("set_by") REFERENCES "users" ("username");
+
+INSERT INTO "categories" ("code", "name") VALUES
+  ('groceries', 'Groceries'),
+  ('dining', 'Dining'),
+  ('transport', 'Transport'),
+  ('utilities', 'Utilities'),
+  ('shopping', 'Shopping'),
+  ('entertainment', 'Entertainment'),
+  ('health', 'Health'),
+  ('income', 'Income'),
+  ('transfers', 'Transfers'),
+  ('fees', 'Fees and interest'),
+  ('uncategorized', 'Uncategorized');
+
+INSERT INTO "category_rules" ("kind", "pattern", "category_code") VALUES
+  ('keyword', 'grocer', 'groceries'),
+  ('keyword', 'supermarket', 'groceries'),
+  ('keyword', 'restaurant', 'dining'),
+  ('keyword', 'cafe', 'dining'),
+  ('keyword', 'coffee', 'dining'),
+  ('keyword', 'uber', 'transport'),
+  ('keyword', 'taxi', 'transport'),
+  ('keyword', 'fuel', 'transport'),
+  ('keyword', 'electric', 'utilities'),
+  ('keyword', 'water bill', 'utilities'),
+  ('keyword', 'internet', 'utilities'),
+  ('keyword', 'amazon', 'shopping'),
+  ('keyword', 'netflix', 'entertainment'),
+  ('keyword', 'cinema', 'entertainment'),
+  ('keyword', 'pharmacy', 'health'),
+  ('keyword', 'salary', 'income'),
+  ('keyword', 'payroll', 'income'),
+  ('keyword', 'interest', 'fees'),
+  ('keyword', 'fee', 'fees'),
+  ('merchant', 'swiftbank', 'fees');
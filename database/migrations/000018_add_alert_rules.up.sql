@@ -0,0 +1,20 @@
+CREATE TABLE "alert_rules" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "owner" varchar NOT NULL,
+  "kind" varchar NOT NULL,
+  "threshold" float NOT NULL,
+  "channel" varchar NOT NULL DEFAULT 'email',
+  "triggered" boolean NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "alert_rules" ("account_id");
+
+CREATE UNIQUE INDEX ON "alert_rules" ("account_id", "owner", "kind");
+
+COMMENT ON COLUMN "alert_rules"."triggered" IS 'set while a low balance alert has fired and the balance has not recovered yet';
+
+ALTER TABLE "alert_rules" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "alert_rules" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS "overdraft_charges";
+
+DROP TABLE IF EXISTS "overdraft_events";
+
+DROP TABLE IF EXISTS "overdraft_requests";
+
+DROP INDEX IF EXISTS "accounts_overdrawn_since_idx";
+
+DELETE FROM "internal_accounts" WHERE "code" IN ('overdraft_interest_income', 'overdraft_fee_income');
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "overdrawn_since";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "overdraft_fee";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "overdraft_interest_rate";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "overdraft_limit";
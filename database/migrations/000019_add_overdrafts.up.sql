@@ -0,0 +1,93 @@
+ALTER TABLE "accounts" ADD COLUMN "overdraft_limit" float NOT NULL DEFAULT 0;
+
+ALTER TABLE "accounts" ADD COLUMN "overdraft_interest_rate" float NOT NULL DEFAULT 0;
+
+ALTER TABLE "accounts" ADD COLUMN "overdraft_fee" float NOT NULL DEFAULT 0;
+
+ALTER TABLE "accounts" ADD COLUMN "overdrawn_since" timestamptz;
+
+CREATE TABLE "overdraft_requests" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "requested_limit" float NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending_approval',
+  "requested_by" varchar NOT NULL,
+  "decided_by" varchar,
+  "comment" varchar NOT NULL DEFAULT '',
+  "decided_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "overdraft_events" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "kind" varchar NOT NULL,
+  "balance" float NOT NULL,
+  "transaction_id" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "overdraft_charges" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "kind" varchar NOT NULL,
+  "charge_date" date NOT NULL,
+  "balance" float NOT NULL,
+  "amount" float NOT NULL,
+  "event_id" bigint UNIQUE,
+  "transaction_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "overdraft_requests" ("status");
+
+CREATE UNIQUE INDEX ON "overdraft_requests" ("account_id") WHERE "status" = 'pending_approval';
+
+CREATE INDEX ON "overdraft_events" ("account_id");
+
+CREATE UNIQUE INDEX ON "overdraft_charges" ("account_id", "charge_date") WHERE "kind" = 'interest';
+
+CREATE INDEX ON "accounts" ("overdrawn_since") WHERE "overdrawn_since" IS NOT NULL;
+
+COMMENT ON COLUMN "accounts"."overdraft_limit" IS 'how far below zero the available balance may go';
+
+COMMENT ON COLUMN "accounts"."overdraft_interest_rate" IS 'annual, charged daily on a negative balance';
+
+COMMENT ON COLUMN "accounts"."overdraft_fee" IS 'charged each time the account goes overdrawn';
+
+COMMENT ON COLUMN "accounts"."overdrawn_since" IS 'set while the ledger balance is negative';
+
+COMMENT ON COLUMN "overdraft_events"."kind" IS 'entered or left';
+
+COMMENT ON COLUMN "overdraft_charges"."kind" IS 'interest, once per day, or fee, once per entered event';
+
+ALTER TABLE "overdraft_requests" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "overdraft_requests" ADD FOREIGN KEY ("requested_by") REFERENCES "users" ("username");
+
+ALTER TABLE "overdraft_requests" ADD FOREIGN KEY ("decided_by") REFERENCES "users" ("username");
+
+ALTER TABLE "overdraft_events" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "overdraft_events" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
+
+ALTER TABLE "overdraft_charges" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "overdraft_charges" ADD FOREIGN KEY ("event_id") REFERENCES "overdraft_events" ("id");
+
+ALTER TABLE "overdraft_charges" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
+
+-- overdraft interest and fees are credited to these
+WITH "interest" AS (
+  INSERT INTO "accounts" ("owner", "balance", "currency")
+  SELECT 'swiftbank', 0, "currency" FROM (VALUES ('USD'), ('EUR'), ('CAD')) AS c ("currency")
+  RETURNING "id", "currency"
+), "fees" AS (
+  INSERT INTO "accounts" ("owner", "balance", "currency")
+  SELECT 'swiftbank', 0, "currency" FROM (VALUES ('USD'), ('EUR'), ('CAD')) AS c ("currency")
+  RETURNING "id", "currency"
+)
+INSERT INTO "internal_accounts" ("code", "currency", "account_id")
+SELECT 'overdraft_interest_income', "currency", "id" FROM "interest"
+UNION ALL
+SELECT 'overdraft_fee_income', "currency", "id" FROM "fees";
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "loan_installments";
+
+DROP TABLE IF EXISTS "loans";
+
+DELETE FROM "internal_accounts" WHERE "code" IN ('loan_principal', 'loan_interest_income', 'loan_fee_income');
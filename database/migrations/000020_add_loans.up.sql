@@ -0,0 +1,75 @@
+CREATE TABLE "loans" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "principal" float NOT NULL,
+  "interest_rate" float NOT NULL,
+  "term_months" int NOT NULL,
+  "method" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "late_fee" float NOT NULL DEFAULT 0,
+  "outstanding_principal" float NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "disbursed_by" varchar NOT NULL,
+  "transaction_id" bigint NOT NULL,
+  "paid_off_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "loan_installments" (
+  "id" bigserial PRIMARY KEY,
+  "loan_id" bigint NOT NULL,
+  "number" int NOT NULL,
+  "due_date" date NOT NULL,
+  "principal" float NOT NULL,
+  "interest" float NOT NULL,
+  "amount" float NOT NULL,
+  "late_fee" float NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'scheduled',
+  "transaction_id" bigint,
+  "paid_at" timestamptz
+);
+
+CREATE INDEX ON "loans" ("account_id");
+
+CREATE UNIQUE INDEX ON "loan_installments" ("loan_id", "number");
+
+CREATE INDEX ON "loan_installments" ("due_date") WHERE "status" IN ('scheduled', 'overdue');
+
+COMMENT ON COLUMN "loans"."method" IS 'annuity or flat';
+
+COMMENT ON COLUMN "loans"."late_fee" IS 'added once to an installment that could not be collected when due';
+
+COMMENT ON COLUMN "loans"."status" IS 'active, delinquent, defaulted or paid_off';
+
+COMMENT ON COLUMN "loan_installments"."status" IS 'scheduled, overdue, paid or cancelled';
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("disbursed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
+
+ALTER TABLE "loan_installments" ADD FOREIGN KEY ("loan_id") REFERENCES "loans" ("id");
+
+ALTER TABLE "loan_installments" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
+
+-- loans are paid out of the principal account, interest and late fees end up in the income accounts
+WITH "principal" AS (
+  INSERT INTO "accounts" ("owner", "balance", "currency")
+  SELECT 'swiftbank', 0, "currency" FROM (VALUES ('USD'), ('EUR'), ('CAD')) AS c ("currency")
+  RETURNING "id", "currency"
+), "interest" AS (
+  INSERT INTO "accounts" ("owner", "balance", "currency")
+  SELECT 'swiftbank', 0, "currency" FROM (VALUES ('USD'), ('EUR'), ('CAD')) AS c ("currency")
+  RETURNING "id", "currency"
+), "fees" AS (
+  INSERT INTO "accounts" ("owner", "balance", "currency")
+  SELECT 'swiftbank', 0, "currency" FROM (VALUES ('USD'), ('EUR'), ('CAD')) AS c ("currency")
+  RETURNING "id", "currency"
+)
+INSERT INTO "internal_accounts" ("code", "currency", "account_id")
+SELECT 'loan_principal', "currency", "id" FROM "principal"
+UNION ALL
+SELECT 'loan_interest_income', "currency", "id" FROM "interest"
+UNION ALL
+SELECT 'loan_fee_income', "currency", "id" FROM "fees";
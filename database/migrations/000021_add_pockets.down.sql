@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "pocket_entries";
+
+DROP TABLE IF EXISTS "pocket_rules";
+
+DROP TABLE IF EXISTS "pockets";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "pocket_balance";
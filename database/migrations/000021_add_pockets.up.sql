@@ -0,0 +1,55 @@
+ALTER TABLE "accounts" ADD COLUMN "pocket_balance" float NOT NULL DEFAULT 0;
+
+CREATE TABLE "pockets" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "name" varchar NOT NULL,
+  "target_amount" float NOT NULL DEFAULT 0,
+  "target_date" date,
+  "balance" float NOT NULL DEFAULT 0,
+  "closed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "pocket_entries" (
+  "id" bigserial PRIMARY KEY,
+  "pocket_id" bigint NOT NULL,
+  "amount" float NOT NULL,
+  "rule_id" bigint,
+  "transaction_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "pocket_rules" (
+  "id" bigserial PRIMARY KEY,
+  "pocket_id" bigint NOT NULL,
+  "kind" varchar NOT NULL,
+  "percent" float NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "pockets" ("account_id", lower("name")) WHERE "closed_at" IS NULL;
+
+CREATE INDEX ON "pocket_entries" ("pocket_id");
+
+CREATE UNIQUE INDEX ON "pocket_rules" ("pocket_id", "kind");
+
+COMMENT ON COLUMN "accounts"."pocket_balance" IS 'sum of open pocket balances, set aside from the available balance';
+
+COMMENT ON COLUMN "pockets"."target_amount" IS '0 when the pocket has no target';
+
+COMMENT ON COLUMN "pocket_entries"."amount" IS 'positive into the pocket, negative back to the account';
+
+COMMENT ON COLUMN "pocket_entries"."transaction_id" IS 'the transfer that set off the rule';
+
+COMMENT ON COLUMN "pocket_rules"."kind" IS 'percent_of_incoming or round_up';
+
+ALTER TABLE "pockets" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "pocket_entries" ADD FOREIGN KEY ("pocket_id") REFERENCES "pockets" ("id");
+
+ALTER TABLE "pocket_entries" ADD FOREIGN KEY ("rule_id") REFERENCES "pocket_rules" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "pocket_entries" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
+
+ALTER TABLE "pocket_rules" ADD FOREIGN KEY ("pocket_id") REFERENCES "pockets" ("id");
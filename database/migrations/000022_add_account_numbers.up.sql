@@ -0,0 +1,15 @@
+-- mirrors helpers.AccountNumberCheckDigits: SB, two mod-97 check digits and 12 random digits, 2811 is SB spelled as in an IBAN
+CREATE FUNCTION generate_account_number() RETURNS varchar AS $$
+DECLARE
+  digits varchar := lpad(floor(random() * 1000000000000)::bigint::text, 12, '0');
+BEGIN
+  RETURN 'SB' || lpad((98 - (digits || '281100')::numeric % 97)::text, 2, '0') || digits;
+END;
+$$ LANGUAGE plpgsql VOLATILE;
+
+-- the default is volatile, so every existing account gets its own number
+ALTER TABLE "accounts" ADD COLUMN "account_number" varchar NOT NULL DEFAULT generate_account_number();
+
+ALTER TABLE "accounts" ADD CONSTRAINT "accounts_account_number_key" UNIQUE ("account_number");
+
+COMMENT ON COLUMN "accounts"."account_number" IS 'the number customers see and type, the id stays internal';
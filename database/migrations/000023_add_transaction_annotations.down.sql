@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "transaction_attachments";
+
+DROP TABLE IF EXISTS "transaction_notes";
@@ -0,0 +1,35 @@
+CREATE TABLE "transaction_notes" (
+  "transaction_id" bigint NOT NULL,
+  "owner" varchar NOT NULL,
+  "note" varchar NOT NULL DEFAULT '',
+  "tags" varchar[] NOT NULL DEFAULT '{}',
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("transaction_id", "owner")
+);
+
+CREATE TABLE "transaction_attachments" (
+  "id" bigserial PRIMARY KEY,
+  "transaction_id" bigint NOT NULL,
+  "owner" varchar NOT NULL,
+  "file_name" varchar NOT NULL,
+  "content_type" varchar NOT NULL,
+  "size" bigint NOT NULL,
+  "storage_key" varchar UNIQUE NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "transaction_notes" USING GIN ("tags");
+
+CREATE INDEX ON "transaction_attachments" ("transaction_id", "owner");
+
+COMMENT ON TABLE "transaction_notes" IS 'private to the owner, other parties to the transaction never see it';
+
+COMMENT ON COLUMN "transaction_attachments"."storage_key" IS 'where the blob store keeps the file';
+
+ALTER TABLE "transaction_notes" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
+
+ALTER TABLE "transaction_notes" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "transaction_attachments" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
+
+ALTER TABLE "transaction_attachments" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
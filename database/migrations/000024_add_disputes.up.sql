@@ -0,0 +1,45 @@
+CREATE TABLE "disputes" (
+  "id" bigserial PRIMARY KEY,
+  "transaction_id" bigint NOT NULL,
+  "account_id" bigint NOT NULL,
+  "receiver_account_id" bigint NOT NULL,
+  "amount" float NOT NULL,
+  "currency" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "evidence" varchar NOT NULL DEFAULT '',
+  "status" varchar NOT NULL DEFAULT 'open',
+  "opened_by" varchar NOT NULL,
+  "hold_id" bigint,
+  "refund_transaction_id" bigint,
+  "decided_by" varchar,
+  "comment" varchar,
+  "resolved_at" timestamptz,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "disputes" ("transaction_id") WHERE "status" IN ('open', 'investigating');
+
+CREATE INDEX ON "disputes" ("account_id");
+
+CREATE INDEX ON "disputes" ("status");
+
+COMMENT ON COLUMN "disputes"."status" IS 'open, investigating, resolved_customer or rejected';
+
+COMMENT ON COLUMN "disputes"."hold_id" IS 'the disputed amount held at the receiving account while the dispute is open';
+
+COMMENT ON COLUMN "disputes"."refund_transaction_id" IS 'the transfer back to the customer when resolved in their favour';
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("receiver_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("opened_by") REFERENCES "users" ("username");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("hold_id") REFERENCES "holds" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("refund_transaction_id") REFERENCES "transactions" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("decided_by") REFERENCES "users" ("username");
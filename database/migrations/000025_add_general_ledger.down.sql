@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "gl_export_totals";
+
+DROP TABLE IF EXISTS "gl_exports";
+
+DROP TABLE IF EXISTS "gl_account_mappings";
+
+DROP TABLE IF EXISTS "gl_accounts";
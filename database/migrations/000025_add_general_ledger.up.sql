@@ -0,0 +1,77 @@
+CREATE TABLE "gl_accounts" (
+  "code" varchar PRIMARY KEY,
+  "name" varchar NOT NULL,
+  "kind" varchar NOT NULL
+);
+
+CREATE TABLE "gl_account_mappings" (
+  "source" varchar NOT NULL,
+  "source_code" varchar NOT NULL,
+  "gl_code" varchar NOT NULL,
+  PRIMARY KEY ("source", "source_code")
+);
+
+CREATE TABLE "gl_exports" (
+  "id" bigserial PRIMARY KEY,
+  "run_id" varchar UNIQUE NOT NULL,
+  "start_date" date NOT NULL,
+  "end_date" date NOT NULL,
+  "format" varchar NOT NULL,
+  "storage_key" varchar UNIQUE NOT NULL,
+  "line_count" bigint NOT NULL,
+  "created_by" varchar,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "gl_export_totals" (
+  "export_id" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "line_count" bigint NOT NULL,
+  "total_debits" float NOT NULL,
+  "total_credits" float NOT NULL,
+  PRIMARY KEY ("export_id", "currency")
+);
+
+CREATE UNIQUE INDEX ON "gl_exports" ("start_date", "end_date", "format");
+
+COMMENT ON COLUMN "gl_accounts"."kind" IS 'asset, liability, income or expense';
+
+COMMENT ON COLUMN "gl_account_mappings"."source" IS 'internal maps an internal account code, account_type maps every customer account of a product type';
+
+COMMENT ON COLUMN "gl_exports"."run_id" IS 'written on every line of the journal file';
+
+COMMENT ON COLUMN "gl_exports"."format" IS 'csv or fixed_width';
+
+COMMENT ON COLUMN "gl_exports"."created_by" IS 'null for the daily export';
+
+ALTER TABLE "gl_account_mappings" ADD FOREIGN KEY ("gl_code") REFERENCES "gl_accounts" ("code");
+
+ALTER TABLE "gl_exports" ADD FOREIGN KEY ("created_by") REFERENCES "users" ("username");
+
+ALTER TABLE "gl_export_totals" ADD FOREIGN KEY ("export_id") REFERENCES "gl_exports" ("id");
+
+INSERT INTO "gl_accounts" ("code", "name", "kind") VALUES
+  ('1000', 'Cash', 'asset'),
+  ('1200', 'Loans receivable', 'asset'),
+  ('1900', 'Suspense', 'asset'),
+  ('2000', 'Customer deposits - checking', 'liability'),
+  ('2100', 'Customer deposits - savings', 'liability'),
+  ('2200', 'Customer deposits - fixed term', 'liability'),
+  ('4000', 'Overdraft interest income', 'income'),
+  ('4100', 'Overdraft fee income', 'income'),
+  ('4200', 'Loan interest income', 'income'),
+  ('4300', 'Loan fee income', 'income'),
+  ('5000', 'Interest expense', 'expense');
+
+INSERT INTO "gl_account_mappings" ("source", "source_code", "gl_code") VALUES
+  ('internal', 'cash', '1000'),
+  ('internal', 'loan_principal', '1200'),
+  ('internal', 'suspense', '1900'),
+  ('account_type', 'checking', '2000'),
+  ('account_type', 'savings', '2100'),
+  ('account_type', 'fixed_term', '2200'),
+  ('internal', 'overdraft_interest_income', '4000'),
+  ('internal', 'overdraft_fee_income', '4100'),
+  ('internal', 'loan_interest_income', '4200'),
+  ('internal', 'loan_fee_income', '4300'),
+  ('internal', 'interest_expense', '5000');
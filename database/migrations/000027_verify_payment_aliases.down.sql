@@ -0,0 +1,7 @@
+ALTER TABLE "payment_aliases" DROP COLUMN IF EXISTS "verified_at";
+
+ALTER TABLE "payment_aliases" DROP COLUMN IF EXISTS "attempts";
+
+ALTER TABLE "payment_aliases" DROP COLUMN IF EXISTS "code_expires_at";
+
+ALTER TABLE "payment_aliases" DROP COLUMN IF EXISTS "code_hash";
@@ -0,0 +1,11 @@
+ALTER TABLE "payment_aliases" ADD COLUMN "code_hash" varchar;
+
+ALTER TABLE "payment_aliases" ADD COLUMN "code_expires_at" timestamptz;
+
+ALTER TABLE "payment_aliases" ADD COLUMN "attempts" int NOT NULL DEFAULT 0;
+
+ALTER TABLE "payment_aliases" ADD COLUMN "verified_at" timestamptz;
+
+COMMENT ON COLUMN "payment_aliases"."code_hash" IS 'hash of the one-time code sent to the phone, cleared once verified';
+
+COMMENT ON COLUMN "payment_aliases"."verified_at" IS 'only verified aliases receive payments, aliases registered before verification was required have to be verified again';
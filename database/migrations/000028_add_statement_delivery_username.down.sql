@@ -0,0 +1,7 @@
+DELETE FROM "statement_deliveries" d USING "accounts" a WHERE a."id" = d."account_id" AND d."username" <> a."owner";
+
+ALTER TABLE "statement_deliveries" DROP CONSTRAINT "statement_deliveries_pkey";
+
+ALTER TABLE "statement_deliveries" ADD PRIMARY KEY ("account_id", "period");
+
+ALTER TABLE "statement_deliveries" DROP COLUMN IF EXISTS "username";
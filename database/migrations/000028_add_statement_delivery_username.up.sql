@@ -0,0 +1,11 @@
+ALTER TABLE "statement_deliveries" ADD COLUMN "username" varchar;
+
+UPDATE "statement_deliveries" d SET "username" = a."owner" FROM "accounts" a WHERE a."id" = d."account_id";
+
+ALTER TABLE "statement_deliveries" ALTER COLUMN "username" SET NOT NULL;
+
+ALTER TABLE "statement_deliveries" DROP CONSTRAINT "statement_deliveries_pkey";
+
+ALTER TABLE "statement_deliveries" ADD PRIMARY KEY ("account_id", "period", "username");
+
+ALTER TABLE "statement_deliveries" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
@@ -0,0 +1,5 @@
+COMMENT ON COLUMN "pocket_rules"."kind" IS 'percent_of_incoming or round_up';
+
+DELETE FROM "entries" WHERE "pocket_entry_id" IS NOT NULL;
+
+ALTER TABLE "entries" DROP COLUMN IF EXISTS "pocket_entry_id";
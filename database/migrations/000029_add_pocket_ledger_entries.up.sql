@@ -0,0 +1,11 @@
+ALTER TABLE "entries" ADD COLUMN "pocket_entry_id" bigint;
+
+CREATE INDEX ON "entries" ("pocket_entry_id");
+
+COMMENT ON COLUMN "entries"."pocket_entry_id" IS 'set on both entries of a move between the account and one of its pockets';
+
+ALTER TABLE "entries" ADD FOREIGN KEY ("pocket_entry_id") REFERENCES "pocket_entries" ("id");
+
+DELETE FROM "pocket_rules" WHERE "kind" = 'round_up';
+
+COMMENT ON COLUMN "pocket_rules"."kind" IS 'percent_of_incoming';
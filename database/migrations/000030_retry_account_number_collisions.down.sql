@@ -0,0 +1,7 @@
+CREATE OR REPLACE FUNCTION generate_account_number() RETURNS varchar AS $$
+DECLARE
+  digits varchar := lpad(floor(random() * 1000000000000)::bigint::text, 12, '0');
+BEGIN
+  RETURN 'SB' || lpad((98 - (digits || '281100')::numeric % 97)::text, 2, '0') || digits;
+END;
+$$ LANGUAGE plpgsql VOLATILE;
@@ -0,0 +1,14 @@
+-- draws again until the number is free, the unique constraint alone would fail the insert on a collision
+CREATE OR REPLACE FUNCTION generate_account_number() RETURNS varchar AS $$
+DECLARE
+  digits varchar;
+  candidate varchar;
+BEGIN
+  LOOP
+    digits := lpad(floor(random() * 1000000000000)::bigint::text, 12, '0');
+    candidate := 'SB' || lpad((98 - (digits || '281100')::numeric % 97)::text, 2, '0') || digits;
+    EXIT WHEN NOT EXISTS (SELECT 1 FROM accounts WHERE account_number = candidate);
+  END LOOP;
+  RETURN candidate;
+END;
+$$ LANGUAGE plpgsql VOLATILE;
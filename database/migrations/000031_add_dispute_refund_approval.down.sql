@@ -0,0 +1,9 @@
+UPDATE "disputes" SET "status" = 'investigating' WHERE "status" = 'refund_proposed';
+
+DROP INDEX IF EXISTS "disputes_transaction_id_idx";
+
+CREATE UNIQUE INDEX ON "disputes" ("transaction_id") WHERE "status" IN ('open', 'investigating');
+
+COMMENT ON COLUMN "disputes"."status" IS 'open, investigating, resolved_customer or rejected';
+
+ALTER TABLE "disputes" DROP COLUMN IF EXISTS "refund_proposed_by";
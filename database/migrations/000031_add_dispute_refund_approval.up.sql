@@ -0,0 +1,12 @@
+ALTER TABLE "disputes" ADD COLUMN "refund_proposed_by" varchar;
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("refund_proposed_by") REFERENCES "users" ("username");
+
+-- a proposed refund still has the dispute in progress
+DROP INDEX IF EXISTS "disputes_transaction_id_idx";
+
+CREATE UNIQUE INDEX ON "disputes" ("transaction_id") WHERE "status" IN ('open', 'investigating', 'refund_proposed');
+
+COMMENT ON COLUMN "disputes"."status" IS 'open, investigating, refund_proposed, resolved_customer or rejected';
+
+COMMENT ON COLUMN "disputes"."refund_proposed_by" IS 'the agent who proposed the refund, a different agent has to approve it';
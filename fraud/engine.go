@@ -0,0 +1,88 @@
+package fraud
+
+import (
+	"github.com/zde37/Swift_Bank/config"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+// Result is the strictest decision of the rules that flagged a transfer and why they did
+type Result struct {
+	Decision string
+	Reasons  []string
+}
+
+type Engine struct {
+	rules []Rule
+}
+
+func NewEngine(rules ...Rule) *Engine {
+	return &Engine{
+		rules: rules,
+	}
+}
+
+// NewEngineFromConfig builds the default rule set, a rule whose threshold is zero is left out
+func NewEngineFromConfig(c config.Config) *Engine {
+	var rules []Rule
+
+	if c.FraudNewDeviceAmount > 0 {
+		rules = append(rules, NewDeviceRule(c.FraudNewDeviceAmount, c.FraudNewDeviceAction))
+	}
+
+	if c.FraudNewPayeeAmount > 0 {
+		rules = append(rules, NewPayeeRule(c.FraudNewPayeeAmount, c.FraudNewPayeeAction))
+	}
+
+	if c.FraudVelocityLimit > 0 {
+		rules = append(rules, VelocityRule(c.FraudVelocityLimit, c.FraudVelocityWindow, c.FraudVelocityAction))
+	}
+
+	if c.FraudUnusualMultiplier > 0 {
+		rules = append(rules, UnusualAmountRule(c.FraudUnusualMultiplier, c.FraudUnusualAction))
+	}
+
+	return NewEngine(rules...)
+}
+
+// Screen runs every rule, a transfer no rule flagged is allowed
+func (e *Engine) Screen(signals models.TransferSignals) Result {
+	result := Result{Decision: models.FraudDecisionAllow}
+
+	for _, rule := range e.rules {
+		reason, flagged := rule.Check(signals)
+		if !flagged {
+			continue
+		}
+
+		result.Reasons = append(result.Reasons, rule.Name+": "+reason)
+		if action := decision(rule.Action); severity(action) > severity(result.Decision) {
+			result.Decision = action
+		}
+	}
+
+	return result
+}
+
+// Enabled reports whether there is any rule to run, so callers can skip gathering signals
+func (e *Engine) Enabled() bool {
+	return len(e.rules) > 0
+}
+
+// decision treats an unknown action as a review so a typo in the config never lets a transfer through
+func decision(action string) string {
+	switch action {
+	case models.FraudDecisionAllow, models.FraudDecisionBlock:
+		return action
+	}
+	return models.FraudDecisionReview
+}
+
+func severity(decision string) int {
+	switch decision {
+	case models.FraudDecisionReview:
+		return 1
+	case models.FraudDecisionBlock:
+		return 2
+	}
+	return 0
+}
@@ -0,0 +1,84 @@
+package fraud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/config"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestScreen(t *testing.T) {
+	engine := NewEngineFromConfig(config.Config{
+		FraudNewDeviceAmount:   1000,
+		FraudNewDeviceAction:   models.FraudDecisionReview,
+		FraudNewPayeeAmount:    2000,
+		FraudNewPayeeAction:    "reveiw", // typos count as a review
+		FraudVelocityLimit:     5,
+		FraudVelocityWindow:    10 * time.Minute,
+		FraudVelocityAction:    models.FraudDecisionBlock,
+		FraudUnusualMultiplier: 10,
+		FraudUnusualAction:     models.FraudDecisionReview,
+	})
+	require.True(t, engine.Enabled())
+
+	testCases := []struct {
+		name     string
+		signals  models.TransferSignals
+		decision string
+		reasons  int
+	}{
+		{
+			name:     "Allow",
+			signals:  models.TransferSignals{Amount: 999, NewDevice: true, NewPayee: true, RecentTransfers: 4},
+			decision: models.FraudDecisionAllow,
+		},
+		{
+			name:     "NewDevice",
+			signals:  models.TransferSignals{Amount: 1000, NewDevice: true},
+			decision: models.FraudDecisionReview,
+			reasons:  1,
+		},
+		{
+			name:     "NewPayee",
+			signals:  models.TransferSignals{Amount: 2000, NewPayee: true},
+			decision: models.FraudDecisionReview,
+			reasons:  1,
+		},
+		{
+			name:     "UnusualAmount",
+			signals:  models.TransferSignals{Amount: 501, HistoryCount: 5, AverageAmount: 50},
+			decision: models.FraudDecisionReview,
+			reasons:  1,
+		},
+		{
+			name:     "NotEnoughHistory",
+			signals:  models.TransferSignals{Amount: 501, HistoryCount: 4, AverageAmount: 50},
+			decision: models.FraudDecisionAllow,
+		},
+		{
+			name:     "BlockWins",
+			signals:  models.TransferSignals{Amount: 2000, NewDevice: true, NewPayee: true, RecentTransfers: 5},
+			decision: models.FraudDecisionBlock,
+			reasons:  3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := engine.Screen(tc.signals)
+			require.Equal(t, tc.decision, result.Decision)
+			require.Len(t, result.Reasons, tc.reasons)
+		})
+	}
+}
+
+func TestDisabledRules(t *testing.T) {
+	engine := NewEngineFromConfig(config.Config{})
+	require.False(t, engine.Enabled())
+
+	result := engine.Screen(models.TransferSignals{Amount: 1e9, NewDevice: true, NewPayee: true, RecentTransfers: 100})
+	require.Equal(t, models.FraudDecisionAllow, result.Decision)
+	require.Empty(t, result.Reasons)
+}
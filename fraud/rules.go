@@ -0,0 +1,74 @@
+package fraud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zde37/Swift_Bank/models"
+)
+
+// Rule flags a transfer when Check reports a reason, Action is the decision a flagged transfer gets
+type Rule struct {
+	Name   string
+	Action string
+	Check  func(signals models.TransferSignals) (reason string, flagged bool)
+}
+
+// NewDeviceRule flags large transfers made from a device the user has not used before
+func NewDeviceRule(amount float64, action string) Rule {
+	return Rule{
+		Name:   "new_device",
+		Action: action,
+		Check: func(signals models.TransferSignals) (string, bool) {
+			if !signals.NewDevice || signals.Amount < amount {
+				return "", false
+			}
+			return fmt.Sprintf("%.2f sent from a new device, the limit is %.2f", signals.Amount, amount), true
+		},
+	}
+}
+
+// NewPayeeRule flags large first transfers to an account the source account never paid before
+func NewPayeeRule(amount float64, action string) Rule {
+	return Rule{
+		Name:   "new_payee",
+		Action: action,
+		Check: func(signals models.TransferSignals) (string, bool) {
+			if !signals.NewPayee || signals.Amount < amount {
+				return "", false
+			}
+			return fmt.Sprintf("first transfer to this payee is %.2f, the limit is %.2f", signals.Amount, amount), true
+		},
+	}
+}
+
+// VelocityRule flags a transfer once the source account already made limit transfers within the window
+func VelocityRule(limit int64, window time.Duration, action string) Rule {
+	return Rule{
+		Name:   "velocity",
+		Action: action,
+		Check: func(signals models.TransferSignals) (string, bool) {
+			if signals.RecentTransfers < limit {
+				return "", false
+			}
+			return fmt.Sprintf("%d transfers in the last %s", signals.RecentTransfers, window), true
+		},
+	}
+}
+
+// minHistory is how many earlier transfers the unusual amount rule needs before it trusts the average
+const minHistory = 5
+
+// UnusualAmountRule flags transfers far above what the source account normally sends
+func UnusualAmountRule(multiplier float64, action string) Rule {
+	return Rule{
+		Name:   "unusual_amount",
+		Action: action,
+		Check: func(signals models.TransferSignals) (string, bool) {
+			if signals.HistoryCount < minHistory || signals.Amount <= signals.AverageAmount*multiplier {
+				return "", false
+			}
+			return fmt.Sprintf("%.2f is more than %g times the usual %.2f", signals.Amount, multiplier, signals.AverageAmount), true
+		},
+	}
+}
@@ -2,11 +2,16 @@ package gapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
 	"github.com/zde37/Swift_Bank/token"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -44,3 +49,84 @@ func (s *Server) authorizeUser(ctx context.Context) (*token.Payload, error) {
 
 	return payload, nil
 }
+
+// getAccountByNumber looks up the account behind the external account number given in a request
+func (s *Server) getAccountByNumber(ctx context.Context, accountNumber string) (models.Account, error) {
+	account, err := s.service.GetAccountByNumber(ctx, accountNumber)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return account, status.Errorf(codes.NotFound, "account %s not found", accountNumber)
+		}
+		return account, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	return account, nil
+}
+
+// authorizeAccount checks that the user is an active holder of the account with a role that grants the permission
+func (s *Server) authorizeAccount(ctx context.Context, payload *token.Payload, accountID int64, accountNumber string, permission string) error {
+	holder, err := s.service.GetAccountHolder(ctx, accountID, payload.UserName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Errorf(codes.PermissionDenied, "user does not hold account %s", accountNumber)
+		}
+		return status.Errorf(codes.Internal, "failed to get account holder: %s", err)
+	}
+
+	if !holder.Can(permission) {
+		return status.Errorf(codes.PermissionDenied, "user does not have %s permission on account %s", permission, accountNumber)
+	}
+
+	return nil
+}
+
+// authorizePocket checks that the user has the permission on the account the pocket belongs to
+func (s *Server) authorizePocket(ctx context.Context, payload *token.Payload, pocketID int64, permission string) (models.Pocket, error) {
+	pocket, err := s.service.GetPocket(ctx, pocketID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pocket, status.Errorf(codes.NotFound, "pocket %d not found", pocketID)
+		}
+		return pocket, status.Errorf(codes.Internal, "failed to get pocket: %s", err)
+	}
+
+	return pocket, s.authorizeAccount(ctx, payload, pocket.AccountID, pocket.AccountNumber, permission)
+}
+
+// authorizeRole checks that the user has one of the staff roles allowed to call the method
+func (s *Server) authorizeRole(payload *token.Payload, roles ...string) error {
+	for _, role := range roles {
+		if payload.Role == role {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "role %s cannot perform this action", payload.Role)
+}
+
+// authorizeTransaction checks that the user has the permission on the account and that the transaction debits
+// or credits it
+func (s *Server) authorizeTransaction(ctx context.Context, payload *token.Payload, accountNumber string, transactionID int64, permission string) (models.Account, error) {
+	account, err := s.getAccountByNumber(ctx, accountNumber)
+	if err != nil {
+		return account, err
+	}
+
+	if err := s.authorizeAccount(ctx, payload, account.ID, account.AccountNumber, permission); err != nil {
+		return account, err
+	}
+
+	transaction, err := s.service.GetTransaction(ctx, transactionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return account, status.Errorf(codes.NotFound, "transaction %d not found", transactionID)
+		}
+		return account, status.Errorf(codes.Internal, "failed to get transaction: %s", err)
+	}
+
+	if transaction.FromAccountID != account.ID && transaction.ToAccountID != account.ID {
+		return account, status.Errorf(codes.FailedPrecondition, "%s", models.ErrTransactionNotOnAccount)
+	}
+
+	return account, nil
+}
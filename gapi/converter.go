@@ -1,6 +1,8 @@
 package gapi
 
 import (
+	"time"
+
 	"github.com/zde37/Swift_Bank/models"
 	"github.com/zde37/Swift_Bank/pb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -11,7 +13,396 @@ func convertUser(user models.User) *pb.User {
 		Username:          user.UserName,
 		FullName:          user.FullName,
 		Email:             user.Email,
+		StatementsOptOut:  user.StatementsOptOut,
+		Locale:            user.Locale,
 		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
 		CreatedAt:         timestamppb.New(user.CreatedAt),
 	}
 }
+
+func convertAccountHolder(holder models.AccountHolder) *pb.AccountHolder {
+	pbHolder := &pb.AccountHolder{
+		AccountNumber: holder.AccountNumber,
+		Username:      holder.UserName,
+		Role:          holder.Role,
+		Status:        holder.Status,
+		CreatedAt:     timestamppb.New(holder.CreatedAt),
+	}
+
+	if holder.InvitedBy != nil {
+		pbHolder.InvitedBy = *holder.InvitedBy
+	}
+
+	if holder.AcceptedAt != nil {
+		pbHolder.AcceptedAt = timestamppb.New(*holder.AcceptedAt)
+	}
+
+	return pbHolder
+}
+
+func convertBeneficiary(beneficiary models.Beneficiary) *pb.Beneficiary {
+	return &pb.Beneficiary{
+		Id:            beneficiary.ID,
+		Nickname:      beneficiary.Nickname,
+		AccountNumber: beneficiary.AccountNumber,
+		Currency:      beneficiary.Currency,
+		TransferLimit: beneficiary.TransferLimit,
+		CreatedAt:     timestamppb.New(beneficiary.CreatedAt),
+		UpdatedAt:     timestamppb.New(beneficiary.UpdatedAt),
+	}
+}
+
+func convertPaymentAlias(alias models.PaymentAlias) *pb.PaymentAlias {
+	pbAlias := &pb.PaymentAlias{
+		Alias:     alias.Alias,
+		Kind:      alias.Kind,
+		CreatedAt: timestamppb.New(alias.CreatedAt),
+	}
+
+	if alias.VerifiedAt != nil {
+		pbAlias.VerifiedAt = timestamppb.New(*alias.VerifiedAt)
+	}
+
+	return pbAlias
+}
+
+func convertDefaultAccount(defaultAccount models.DefaultAccount) *pb.DefaultAccount {
+	return &pb.DefaultAccount{
+		Currency:      defaultAccount.Currency,
+		AccountNumber: defaultAccount.AccountNumber,
+		UpdatedAt:     timestamppb.New(defaultAccount.UpdatedAt),
+	}
+}
+
+func convertPendingTransfer(pending models.PendingTransfer) *pb.PendingTransfer {
+	pbPending := &pb.PendingTransfer{
+		Id:                pending.ID,
+		FromAccountNumber: pending.FromAccountNumber,
+		ToAccountNumber:   pending.ToAccountNumber,
+		Amount:            pending.Amount,
+		Fee:               pending.Fee,
+		Currency:          pending.Currency,
+		Description:       pending.Description,
+		Status:            pending.Status,
+		InitiatedBy:       pending.InitiatedBy,
+		Comment:           pending.Comment,
+		HoldId:            pending.HoldID,
+		TransactionId:     pending.TransactionID,
+		ExpiresAt:         timestamppb.New(pending.ExpiresAt),
+		CreatedAt:         timestamppb.New(pending.CreatedAt),
+	}
+
+	if pending.DecidedBy != nil {
+		pbPending.DecidedBy = *pending.DecidedBy
+	}
+
+	if pending.DecidedAt != nil {
+		pbPending.DecidedAt = timestamppb.New(*pending.DecidedAt)
+	}
+
+	return pbPending
+}
+
+func convertPendingTransferEvent(event models.PendingTransferEvent) *pb.PendingTransferEvent {
+	pbEvent := &pb.PendingTransferEvent{
+		Id:         event.ID,
+		FromStatus: event.FromStatus,
+		ToStatus:   event.ToStatus,
+		Comment:    event.Comment,
+		CreatedAt:  timestamppb.New(event.CreatedAt),
+	}
+
+	if event.Actor != nil {
+		pbEvent.Actor = *event.Actor
+	}
+
+	return pbEvent
+}
+
+func convertTrialBalance(tb models.TrialBalance) *pb.TrialBalance {
+	pbTrialBalance := &pb.TrialBalance{
+		Id:           tb.ID,
+		BusinessDate: tb.BusinessDate.Format(time.DateOnly),
+		Currency:     tb.Currency,
+		TotalDebits:  tb.TotalDebits,
+		TotalCredits: tb.TotalCredits,
+		TotalBalance: tb.TotalBalance,
+		EntryCount:   tb.EntryCount,
+		Lines:        make([]*pb.TrialBalanceLine, len(tb.Lines)),
+		CreatedAt:    timestamppb.New(tb.CreatedAt),
+	}
+
+	for i, line := range tb.Lines {
+		pbTrialBalance.Lines[i] = &pb.TrialBalanceLine{
+			AccountId: line.AccountID,
+			Owner:     line.Owner,
+			Debits:    line.Debits,
+			Credits:   line.Credits,
+			Balance:   line.Balance,
+		}
+		if line.InternalCode != nil {
+			pbTrialBalance.Lines[i].InternalCode = *line.InternalCode
+		}
+	}
+
+	return pbTrialBalance
+}
+
+func convertCategoryRule(rule models.CategoryRule) *pb.CategoryRule {
+	return &pb.CategoryRule{
+		Id:        rule.ID,
+		Shared:    rule.Owner == nil,
+		Kind:      rule.Kind,
+		Pattern:   rule.Pattern,
+		Category:  rule.Category,
+		CreatedAt: timestamppb.New(rule.CreatedAt),
+	}
+}
+
+func convertAlertRule(rule models.AlertRule) *pb.AlertRule {
+	return &pb.AlertRule{
+		Id:            rule.ID,
+		AccountNumber: rule.AccountNumber,
+		Kind:          rule.Kind,
+		Threshold:     rule.Threshold,
+		Channel:       rule.Channel,
+		Triggered:     rule.Triggered,
+		CreatedAt:     timestamppb.New(rule.CreatedAt),
+	}
+}
+
+func convertOverdraftRequest(req models.OverdraftRequest) *pb.OverdraftRequest {
+	pbRequest := &pb.OverdraftRequest{
+		Id:             req.ID,
+		AccountNumber:  req.AccountNumber,
+		RequestedLimit: req.RequestedLimit,
+		Status:         req.Status,
+		RequestedBy:    req.RequestedBy,
+		Comment:        req.Comment,
+		CreatedAt:      timestamppb.New(req.CreatedAt),
+	}
+
+	if req.DecidedBy != nil {
+		pbRequest.DecidedBy = *req.DecidedBy
+	}
+
+	if req.DecidedAt != nil {
+		pbRequest.DecidedAt = timestamppb.New(*req.DecidedAt)
+	}
+
+	return pbRequest
+}
+
+func convertLoan(loan models.Loan) *pb.Loan {
+	pbLoan := &pb.Loan{
+		Id:                   loan.ID,
+		AccountNumber:        loan.AccountNumber,
+		Principal:            loan.Principal,
+		InterestRate:         loan.InterestRate,
+		TermMonths:           loan.TermMonths,
+		Method:               loan.Method,
+		Currency:             loan.Currency,
+		LateFee:              loan.LateFee,
+		OutstandingPrincipal: loan.OutstandingPrincipal,
+		Status:               loan.Status,
+		DisbursedBy:          loan.DisbursedBy,
+		TransactionId:        loan.TransactionID,
+		CreatedAt:            timestamppb.New(loan.CreatedAt),
+	}
+
+	if loan.PaidOffAt != nil {
+		pbLoan.PaidOffAt = timestamppb.New(*loan.PaidOffAt)
+	}
+
+	return pbLoan
+}
+
+func convertLoanInstallments(installments []models.LoanInstallment) []*pb.LoanInstallment {
+	pbInstallments := make([]*pb.LoanInstallment, len(installments))
+	for i, installment := range installments {
+		pbInstallments[i] = &pb.LoanInstallment{
+			Number:    installment.Number,
+			DueDate:   installment.DueDate.Format(time.DateOnly),
+			Principal: installment.Principal,
+			Interest:  installment.Interest,
+			Amount:    installment.Amount,
+			LateFee:   installment.LateFee,
+			Status:    installment.Status,
+		}
+
+		if installment.TransactionID != nil {
+			pbInstallments[i].TransactionId = *installment.TransactionID
+		}
+
+		if installment.PaidAt != nil {
+			pbInstallments[i].PaidAt = timestamppb.New(*installment.PaidAt)
+		}
+	}
+
+	return pbInstallments
+}
+
+func convertPocket(pocket models.Pocket) *pb.Pocket {
+	pbPocket := &pb.Pocket{
+		Id:            pocket.ID,
+		AccountNumber: pocket.AccountNumber,
+		Name:          pocket.Name,
+		TargetAmount:  pocket.TargetAmount,
+		Balance:       pocket.Balance,
+		Rules:         make([]*pb.PocketRule, len(pocket.Rules)),
+		CreatedAt:     timestamppb.New(pocket.CreatedAt),
+	}
+
+	if pocket.TargetDate != nil {
+		pbPocket.TargetDate = pocket.TargetDate.Format(time.DateOnly)
+	}
+
+	for i, rule := range pocket.Rules {
+		pbPocket.Rules[i] = convertPocketRule(rule)
+	}
+
+	if pocket.ClosedAt != nil {
+		pbPocket.ClosedAt = timestamppb.New(*pocket.ClosedAt)
+	}
+
+	return pbPocket
+}
+
+func convertPocketRule(rule models.PocketRule) *pb.PocketRule {
+	return &pb.PocketRule{
+		Id:        rule.ID,
+		PocketId:  rule.PocketID,
+		Kind:      rule.Kind,
+		Percent:   rule.Percent,
+		CreatedAt: timestamppb.New(rule.CreatedAt),
+	}
+}
+
+func convertPocketEntry(entry models.PocketEntry) *pb.PocketEntry {
+	pbEntry := &pb.PocketEntry{
+		Id:        entry.ID,
+		PocketId:  entry.PocketID,
+		Amount:    entry.Amount,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+	}
+
+	if entry.RuleID != nil {
+		pbEntry.RuleId = *entry.RuleID
+	}
+
+	if entry.TransactionID != nil {
+		pbEntry.TransactionId = *entry.TransactionID
+	}
+
+	return pbEntry
+}
+
+func convertTransaction(transaction models.Transaction) *pb.Transaction {
+	return &pb.Transaction{
+		Id:                transaction.ID,
+		FromAccountNumber: transaction.FromAccountNumber,
+		ToAccountNumber:   transaction.ToAccountNumber,
+		Currency:          transaction.Currency,
+		Description:       transaction.Description,
+		Amount:            transaction.Amount,
+		Fee:               transaction.Fee,
+		CreatedAt:         timestamppb.New(transaction.CreatedAt),
+	}
+}
+
+func convertTransactionNote(note models.TransactionNote) *pb.TransactionNote {
+	pbNote := &pb.TransactionNote{
+		TransactionId: note.TransactionID,
+		Note:          note.Note,
+		Tags:          note.Tags,
+	}
+
+	// a note that was never saved has no update time
+	if !note.UpdatedAt.IsZero() {
+		pbNote.UpdatedAt = timestamppb.New(note.UpdatedAt)
+	}
+
+	return pbNote
+}
+
+func convertTransactionAttachment(attachment models.TransactionAttachment) *pb.TransactionAttachment {
+	return &pb.TransactionAttachment{
+		Id:            attachment.ID,
+		TransactionId: attachment.TransactionID,
+		FileName:      attachment.FileName,
+		ContentType:   attachment.ContentType,
+		Size:          attachment.Size,
+		CreatedAt:     timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+func convertDispute(dispute models.Dispute) *pb.Dispute {
+	pbDispute := &pb.Dispute{
+		Id:                    dispute.ID,
+		TransactionId:         dispute.TransactionID,
+		AccountNumber:         dispute.AccountNumber,
+		ReceiverAccountNumber: dispute.ReceiverAccountNumber,
+		Amount:                dispute.Amount,
+		Currency:              dispute.Currency,
+		Reason:                dispute.Reason,
+		Evidence:              dispute.Evidence,
+		Status:                dispute.Status,
+		OpenedBy:              dispute.OpenedBy,
+		UpdatedAt:             timestamppb.New(dispute.UpdatedAt),
+		CreatedAt:             timestamppb.New(dispute.CreatedAt),
+	}
+
+	if dispute.HoldID != nil {
+		pbDispute.HoldId = *dispute.HoldID
+	}
+
+	if dispute.RefundTransactionID != nil {
+		pbDispute.RefundTransactionId = *dispute.RefundTransactionID
+	}
+
+	if dispute.RefundProposedBy != nil {
+		pbDispute.RefundProposedBy = *dispute.RefundProposedBy
+	}
+
+	if dispute.DecidedBy != nil {
+		pbDispute.DecidedBy = *dispute.DecidedBy
+	}
+
+	if dispute.Comment != nil {
+		pbDispute.Comment = *dispute.Comment
+	}
+
+	if dispute.ResolvedAt != nil {
+		pbDispute.ResolvedAt = timestamppb.New(*dispute.ResolvedAt)
+	}
+
+	return pbDispute
+}
+
+func convertGLExport(export models.GLExport) *pb.GLExport {
+	pbExport := &pb.GLExport{
+		Id:        export.ID,
+		RunId:     export.RunID,
+		StartDate: export.StartDate.Format(time.DateOnly),
+		EndDate:   export.EndDate.Format(time.DateOnly),
+		Format:    export.Format,
+		LineCount: export.LineCount,
+		Totals:    make([]*pb.GLControlTotal, len(export.Totals)),
+		CreatedAt: timestamppb.New(export.CreatedAt),
+	}
+
+	for i, total := range export.Totals {
+		pbExport.Totals[i] = &pb.GLControlTotal{
+			Currency:     total.Currency,
+			LineCount:    total.LineCount,
+			TotalDebits:  total.TotalDebits,
+			TotalCredits: total.TotalCredits,
+		}
+	}
+
+	if export.CreatedBy != nil {
+		pbExport.CreatedBy = *export.CreatedBy
+	}
+
+	return pbExport
+}
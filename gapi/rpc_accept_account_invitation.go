@@ -0,0 +1,50 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) AcceptAccountInvitation(ctx context.Context, req *pb.AcceptAccountInvitationRequest) (*pb.AcceptAccountInvitationResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateAcceptAccountInvitationRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	// only the invited user can accept, so the invitation itself is the authorization
+	holder, err := server.service.AcceptAccountInvitation(ctx, account.ID, authPayload.UserName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "no pending invitation to account %s", req.GetAccountNumber())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to accept invitation: %s", err)
+	}
+
+	return &pb.AcceptAccountInvitationResponse{
+		Holder: convertAccountHolder(holder),
+	}, nil
+}
+
+func validateAcceptAccountInvitationRequest(req *pb.AcceptAccountInvitationRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	return violations
+}
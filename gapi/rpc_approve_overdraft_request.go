@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ApproveOverdraftRequest(ctx context.Context, req *pb.ApproveOverdraftRequestRequest) (*pb.ApproveOverdraftRequestResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateApproveOverdraftRequestRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	overdraft, err := server.service.DecideOverdraftRequest(ctx, models.DecideOverdraftRequestParams{
+		ID:        req.GetId(),
+		DecidedBy: authPayload.UserName,
+		Approve:   true,
+		Comment:   req.GetComment(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "overdraft request %d not found", req.GetId())
+		}
+		if errors.Is(err, models.ErrApproverIsInitiator) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s", err)
+		}
+		if errors.Is(err, models.ErrOperationNotPending) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to approve overdraft request: %s", err)
+	}
+
+	return &pb.ApproveOverdraftRequestResponse{
+		OverdraftRequest: convertOverdraftRequest(overdraft),
+	}, nil
+}
+
+func validateApproveOverdraftRequestRequest(req *pb.ApproveOverdraftRequestRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := val.ValidateComment(req.GetComment()); err != nil {
+		violations = append(violations, fieldViolation("comment", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ApprovePendingTransfer(ctx context.Context, req *pb.ApprovePendingTransferRequest) (*pb.ApprovePendingTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleTeller, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateApprovePendingTransferRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.service.DecidePendingTransfer(ctx, models.DecidePendingTransferParams{
+		ID:        req.GetId(),
+		DecidedBy: authPayload.UserName,
+		Approve:   true,
+		Comment:   req.GetComment(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "pending transfer %d not found", req.GetId())
+		}
+		if errors.Is(err, models.ErrApproverIsInitiator) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s", err)
+		}
+		if errors.Is(err, models.ErrOperationNotPending) || errors.Is(err, models.ErrPendingTransferExpired) ||
+			errors.Is(err, models.ErrHoldNotActive) || errors.Is(err, models.ErrAccountFrozen) ||
+			errors.Is(err, models.ErrAccountClosing) || errors.Is(err, models.ErrAccountClosed) ||
+			errors.Is(err, models.ErrScreeningOpen) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to approve pending transfer: %s", err)
+	}
+
+	return &pb.ApprovePendingTransferResponse{
+		PendingTransfer: convertPendingTransfer(result.PendingTransfer),
+	}, nil
+}
+
+func validateApprovePendingTransferRequest(req *pb.ApprovePendingTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := val.ValidateComment(req.GetComment()); err != nil {
+		violations = append(violations, fieldViolation("comment", err))
+	}
+
+	return violations
+}
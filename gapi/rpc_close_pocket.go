@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ClosePocket(ctx context.Context, req *pb.ClosePocketRequest) (*pb.ClosePocketResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateClosePocketRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	pocket, err := server.authorizePocket(ctx, authPayload, req.GetPocketId(), models.PermissionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	pocket, err = server.service.ClosePocket(ctx, pocket.ID)
+	if err != nil {
+		if errors.Is(err, models.ErrPocketClosed) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to close pocket: %s", err)
+	}
+
+	return &pb.ClosePocketResponse{
+		Pocket: convertPocket(pocket),
+	}, nil
+}
+
+func validateClosePocketRequest(req *pb.ClosePocketRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetPocketId()); err != nil {
+		violations = append(violations, fieldViolation("pocket_id", err))
+	}
+
+	return violations
+}
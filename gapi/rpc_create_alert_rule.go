@@ -0,0 +1,78 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateAlertRule(ctx context.Context, req *pb.CreateAlertRuleRequest) (*pb.CreateAlertRuleResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateCreateAlertRuleRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.authorizeAccount(ctx, authPayload, account.ID, account.AccountNumber, models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	channel := models.AlertChannelEmail
+	if req.Channel != nil {
+		channel = req.GetChannel()
+	}
+
+	rule, err := server.service.CreateAlertRule(ctx, models.AlertRule{
+		AccountID: account.ID,
+		Owner:     authPayload.UserName,
+		Kind:      req.GetKind(),
+		Threshold: req.GetThreshold(),
+		Channel:   channel,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrAlertRuleExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create alert rule: %s", err)
+	}
+
+	return &pb.CreateAlertRuleResponse{
+		Rule: convertAlertRule(rule),
+	}, nil
+}
+
+func validateCreateAlertRuleRequest(req *pb.CreateAlertRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if err := val.ValidateAlertKind(req.GetKind()); err != nil {
+		violations = append(violations, fieldViolation("kind", err))
+	}
+
+	if req.GetThreshold() <= 0 {
+		violations = append(violations, fieldViolation("threshold", errors.New("must be greater than zero")))
+	}
+
+	if req.Channel != nil {
+		if err := val.ValidateAlertChannel(req.GetChannel()); err != nil {
+			violations = append(violations, fieldViolation("channel", err))
+		}
+	}
+
+	return violations
+}
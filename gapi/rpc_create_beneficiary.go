@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateBeneficiary(ctx context.Context, req *pb.CreateBeneficiaryRequest) (*pb.CreateBeneficiaryResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateCreateBeneficiaryRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	beneficiary, err := server.service.CreateBeneficiary(ctx, models.Beneficiary{
+		Owner:         authPayload.UserName,
+		Nickname:      req.GetNickname(),
+		AccountID:     account.ID,
+		Currency:      req.GetCurrency(),
+		TransferLimit: req.TransferLimit,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, status.Errorf(codes.NotFound, "account %s not found", req.GetAccountNumber())
+		case errors.Is(err, models.ErrBeneficiaryExists):
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		case errors.Is(err, models.ErrCurrencyMismatch), errors.Is(err, models.ErrAccountClosed):
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create beneficiary: %s", err)
+	}
+
+	return &pb.CreateBeneficiaryResponse{
+		Beneficiary: convertBeneficiary(beneficiary),
+	}, nil
+}
+
+func validateCreateBeneficiaryRequest(req *pb.CreateBeneficiaryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateNickname(req.GetNickname()); err != nil {
+		violations = append(violations, fieldViolation("nickname", err))
+	}
+
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if req.TransferLimit != nil && req.GetTransferLimit() <= 0 {
+		violations = append(violations, fieldViolation("transfer_limit", errors.New("must be greater than zero")))
+	}
+
+	return violations
+}
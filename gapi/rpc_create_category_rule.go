@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateCategoryRule(ctx context.Context, req *pb.CreateCategoryRuleRequest) (*pb.CreateCategoryRuleResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateCreateCategoryRuleRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rule, err := server.service.CreateCategoryRule(ctx, models.CategoryRule{
+		Owner:    &authPayload.UserName,
+		Kind:     req.GetKind(),
+		Pattern:  req.GetPattern(),
+		Category: req.GetCategory(),
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrCategoryRuleExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create category rule: %s", err)
+	}
+
+	return &pb.CreateCategoryRuleResponse{
+		Rule: convertCategoryRule(rule),
+	}, nil
+}
+
+func validateCreateCategoryRuleRequest(req *pb.CreateCategoryRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCategoryRuleKind(req.GetKind()); err != nil {
+		violations = append(violations, fieldViolation("kind", err))
+	}
+
+	if err := val.ValidateCategoryPattern(req.GetPattern()); err != nil {
+		violations = append(violations, fieldViolation("pattern", err))
+	}
+
+	if err := val.ValidateCategory(req.GetCategory()); err != nil {
+		violations = append(violations, fieldViolation("category", err))
+	}
+
+	return violations
+}
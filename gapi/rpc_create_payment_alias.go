@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreatePaymentAlias(ctx context.Context, req *pb.CreatePaymentAliasRequest) (*pb.CreatePaymentAliasResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateCreatePaymentAliasRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	alias, err := server.service.CreatePaymentAlias(ctx, models.PaymentAlias{
+		Alias:    req.GetPhone(),
+		UserName: authPayload.UserName,
+		Kind:     models.AliasKindPhone,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrAliasTaken) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create payment alias: %s", err)
+	}
+
+	return &pb.CreatePaymentAliasResponse{
+		Alias: convertPaymentAlias(alias),
+	}, nil
+}
+
+func validateCreatePaymentAliasRequest(req *pb.CreatePaymentAliasRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePhone(req.GetPhone()); err != nil {
+		violations = append(violations, fieldViolation("phone", err))
+	}
+
+	return violations
+}
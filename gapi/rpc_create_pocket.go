@@ -0,0 +1,79 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreatePocket(ctx context.Context, req *pb.CreatePocketRequest) (*pb.CreatePocketResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateCreatePocketRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.authorizeAccount(ctx, authPayload, account.ID, account.AccountNumber, models.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	arg := models.Pocket{
+		AccountID:    account.ID,
+		Name:         req.GetName(),
+		TargetAmount: req.GetTargetAmount(),
+	}
+
+	if req.TargetDate != nil {
+		date, _ := time.Parse(time.DateOnly, req.GetTargetDate())
+		arg.TargetDate = &date
+	}
+
+	pocket, err := server.service.CreatePocket(ctx, arg)
+	if err != nil {
+		if errors.Is(err, models.ErrPocketExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create pocket: %s", err)
+	}
+
+	return &pb.CreatePocketResponse{
+		Pocket: convertPocket(pocket),
+	}, nil
+}
+
+func validateCreatePocketRequest(req *pb.CreatePocketRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if err := val.ValidatePocketName(req.GetName()); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+
+	if err := val.ValidatePocketAmount(req.GetTargetAmount()); err != nil {
+		violations = append(violations, fieldViolation("target_amount", err))
+	}
+
+	if req.TargetDate != nil {
+		if err := val.ValidatePocketTargetDate(req.GetTargetDate()); err != nil {
+			violations = append(violations, fieldViolation("target_date", err))
+		}
+	}
+
+	return violations
+}
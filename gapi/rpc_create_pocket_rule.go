@@ -0,0 +1,65 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreatePocketRule(ctx context.Context, req *pb.CreatePocketRuleRequest) (*pb.CreatePocketRuleResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateCreatePocketRuleRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	pocket, err := server.authorizePocket(ctx, authPayload, req.GetPocketId(), models.PermissionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if pocket.ClosedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", models.ErrPocketClosed)
+	}
+
+	rule, err := server.service.CreatePocketRule(ctx, models.PocketRule{
+		PocketID: pocket.ID,
+		Kind:     req.GetKind(),
+		Percent:  req.GetPercent(),
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrPocketRuleExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create pocket rule: %s", err)
+	}
+
+	return &pb.CreatePocketRuleResponse{
+		Rule: convertPocketRule(rule),
+	}, nil
+}
+
+func validateCreatePocketRuleRequest(req *pb.CreatePocketRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetPocketId()); err != nil {
+		violations = append(violations, fieldViolation("pocket_id", err))
+	}
+
+	if err := val.ValidatePocketRuleKind(req.GetKind()); err != nil {
+		violations = append(violations, fieldViolation("kind", err))
+	}
+
+	if err := val.ValidatePocketRulePercent(req.GetPercent()); err != nil {
+		violations = append(violations, fieldViolation("percent", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeleteAlertRule(ctx context.Context, req *pb.DeleteAlertRuleRequest) (*pb.DeleteAlertRuleResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateDeleteAlertRuleRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if err := server.service.DeleteAlertRule(ctx, req.GetId(), authPayload.UserName); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "alert rule %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete alert rule: %s", err)
+	}
+
+	return &pb.DeleteAlertRuleResponse{}, nil
+}
+
+func validateDeleteAlertRuleRequest(req *pb.DeleteAlertRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeletePaymentAlias(ctx context.Context, req *pb.DeletePaymentAliasRequest) (*pb.DeletePaymentAliasResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateDeletePaymentAliasRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if err := server.service.DeletePaymentAlias(ctx, req.GetAlias(), authPayload.UserName); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "payment alias %s not found", req.GetAlias())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete payment alias: %s", err)
+	}
+
+	return &pb.DeletePaymentAliasResponse{}, nil
+}
+
+func validateDeletePaymentAliasRequest(req *pb.DeletePaymentAliasRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(req.GetAlias(), 1, 200); err != nil {
+		violations = append(violations, fieldViolation("alias", err))
+	}
+
+	return violations
+}
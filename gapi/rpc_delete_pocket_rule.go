@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeletePocketRule(ctx context.Context, req *pb.DeletePocketRuleRequest) (*pb.DeletePocketRuleResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateDeletePocketRuleRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rule, err := server.service.GetPocketRule(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "pocket rule %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get pocket rule: %s", err)
+	}
+
+	if _, err := server.authorizePocket(ctx, authPayload, rule.PocketID, models.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	if err := server.service.DeletePocketRule(ctx, rule.ID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "pocket rule %d not found", rule.ID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete pocket rule: %s", err)
+	}
+
+	return &pb.DeletePocketRuleResponse{}, nil
+}
+
+func validateDeletePocketRuleRequest(req *pb.DeletePocketRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
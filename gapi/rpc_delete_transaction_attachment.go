@@ -0,0 +1,42 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeleteTransactionAttachment(ctx context.Context, req *pb.DeleteTransactionAttachmentRequest) (*pb.DeleteTransactionAttachmentResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateDeleteTransactionAttachmentRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// only the uploader's own attachments are found, which is all the authorization it needs
+	if err := server.service.DeleteTransactionAttachment(ctx, req.GetId(), authPayload.UserName); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "attachment %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete attachment: %s", err)
+	}
+
+	return &pb.DeleteTransactionAttachmentResponse{}, nil
+}
+
+func validateDeleteTransactionAttachmentRequest(req *pb.DeleteTransactionAttachmentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,81 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DisburseLoan(ctx context.Context, req *pb.DisburseLoanRequest) (*pb.DisburseLoanResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleTeller, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateDisburseLoanRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := server.service.DisburseLoan(ctx, models.Loan{
+		AccountID:    account.ID,
+		Principal:    req.GetPrincipal(),
+		InterestRate: req.GetInterestRate(),
+		TermMonths:   req.GetTermMonths(),
+		Method:       req.GetMethod(),
+		DisbursedBy:  authPayload.UserName,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "account %s not found", req.GetAccountNumber())
+		}
+		if errors.Is(err, models.ErrAccountClosed) || errors.Is(err, models.ErrCurrencyMismatch) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to disburse loan: %s", err)
+	}
+
+	return &pb.DisburseLoanResponse{
+		Loan:         convertLoan(schedule.Loan),
+		Installments: convertLoanInstallments(schedule.Installments),
+	}, nil
+}
+
+func validateDisburseLoanRequest(req *pb.DisburseLoanRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if err := val.ValidateLoanPrincipal(req.GetPrincipal()); err != nil {
+		violations = append(violations, fieldViolation("principal", err))
+	}
+
+	if err := val.ValidateLoanInterestRate(req.GetInterestRate()); err != nil {
+		violations = append(violations, fieldViolation("interest_rate", err))
+	}
+
+	if err := val.ValidateLoanTerm(req.GetTermMonths()); err != nil {
+		violations = append(violations, fieldViolation("term_months", err))
+	}
+
+	if err := val.ValidateLoanMethod(req.GetMethod()); err != nil {
+		violations = append(violations, fieldViolation("method", err))
+	}
+
+	return violations
+}
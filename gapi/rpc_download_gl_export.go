@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DownloadGLExport writes the journal file exactly as the run stored it
+func (server *Server) DownloadGLExport(ctx context.Context, req *pb.DownloadGLExportRequest) (*httpbody.HttpBody, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateDownloadGLExportRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	export, content, err := server.service.GetGLExportFile(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "general ledger export %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get general ledger export: %s", err)
+	}
+
+	contentType := "text/plain"
+	if export.Format == models.GLFormatCSV {
+		contentType = "text/csv"
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: contentType,
+		Data:        content,
+	}, nil
+}
+
+func validateDownloadGLExportRequest(req *pb.DownloadGLExportRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DownloadTransactionAttachment writes the file as it was uploaded. Attachments are private, so one uploaded
+// by someone else is not found.
+func (server *Server) DownloadTransactionAttachment(ctx context.Context, req *pb.DownloadTransactionAttachmentRequest) (*httpbody.HttpBody, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateDownloadTransactionAttachmentRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	attachment, content, err := server.service.GetTransactionAttachment(ctx, req.GetId(), authPayload.UserName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "attachment %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get attachment: %s", err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: attachment.ContentType,
+		Data:        content,
+	}, nil
+}
+
+func validateDownloadTransactionAttachmentRequest(req *pb.DownloadTransactionAttachmentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,79 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strconv"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DownloadTrialBalance writes a closed business day's trial balances as csv, one row per account followed by
+// a total row for each currency
+func (server *Server) DownloadTrialBalance(ctx context.Context, req *pb.DownloadTrialBalanceRequest) (*httpbody.HttpBody, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateDownloadTrialBalanceRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	trialBalances, err := server.getTrialBalances(ctx, req.GetBusinessDate())
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"business_date", "currency", "account_id", "owner", "internal_code", "debits", "credits", "balance"})
+
+	for _, tb := range trialBalances {
+		for _, line := range tb.Lines {
+			var code string
+			if line.InternalCode != nil {
+				code = *line.InternalCode
+			}
+
+			w.Write([]string{req.GetBusinessDate(), tb.Currency, strconv.FormatInt(line.AccountID, 10), line.Owner, code,
+				formatAmount(line.Debits), formatAmount(line.Credits), formatAmount(line.Balance)})
+		}
+
+		w.Write([]string{req.GetBusinessDate(), tb.Currency, "", "total", "", formatAmount(tb.TotalDebits),
+			formatAmount(tb.TotalCredits), formatAmount(tb.TotalBalance)})
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write trial balance: %s", err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "text/csv",
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+func validateDownloadTrialBalanceRequest(req *pb.DownloadTrialBalanceRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateBusinessDate(req.GetBusinessDate()); err != nil {
+		violations = append(violations, fieldViolation("business_date", err))
+	}
+
+	return violations
+}
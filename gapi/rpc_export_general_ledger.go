@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ExportGeneralLedger(ctx context.Context, req *pb.ExportGeneralLedgerRequest) (*pb.ExportGeneralLedgerResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateExportGeneralLedgerRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	start, _ := time.Parse(time.DateOnly, req.GetStartDate())
+	end, _ := time.Parse(time.DateOnly, req.GetEndDate())
+
+	export, err := server.service.ExportGeneralLedger(ctx, models.ExportGLParams{
+		StartDate: start,
+		EndDate:   end,
+		Format:    req.GetFormat(),
+		CreatedBy: &authPayload.UserName,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrGLPeriodOpen) || errors.Is(err, models.ErrGLAccountUnmapped) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to export general ledger: %s", err)
+	}
+
+	return &pb.ExportGeneralLedgerResponse{
+		Export: convertGLExport(export),
+	}, nil
+}
+
+func validateExportGeneralLedgerRequest(req *pb.ExportGeneralLedgerRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateBusinessDate(req.GetStartDate()); err != nil {
+		violations = append(violations, fieldViolation("start_date", err))
+	}
+
+	if err := val.ValidateBusinessDate(req.GetEndDate()); err != nil {
+		violations = append(violations, fieldViolation("end_date", err))
+	} else if req.GetEndDate() < req.GetStartDate() {
+		violations = append(violations, fieldViolation("end_date", fmt.Errorf("must not be before the start date")))
+	}
+
+	if err := val.ValidateGLExportFormat(req.GetFormat()); err != nil {
+		violations = append(violations, fieldViolation("format", err))
+	}
+
+	return violations
+}
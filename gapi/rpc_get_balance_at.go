@@ -0,0 +1,71 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) GetBalanceAt(ctx context.Context, req *pb.GetBalanceAtRequest) (*pb.GetBalanceAtResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateGetBalanceAtRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.authorizeAccount(ctx, authPayload, account.ID, account.AccountNumber, models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	balance, err := server.service.GetBalanceAt(ctx, account.ID, req.GetAt().AsTime())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "account %s not found", req.GetAccountNumber())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get balance: %s", err)
+	}
+
+	rsp := &pb.GetBalanceAtResponse{
+		AccountNumber: account.AccountNumber,
+		Currency:      balance.Currency,
+		At:            timestamppb.New(balance.At),
+		Balance:       balance.Balance,
+	}
+
+	if balance.SnapshotDate != nil {
+		rsp.SnapshotDate = timestamppb.New(*balance.SnapshotDate)
+	}
+
+	return rsp, nil
+}
+
+func validateGetBalanceAtRequest(req *pb.GetBalanceAtRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if req.GetAt() == nil {
+		violations = append(violations, fieldViolation("at", fmt.Errorf("must be set")))
+	} else if err := req.GetAt().CheckValid(); err != nil {
+		violations = append(violations, fieldViolation("at", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetDispute(ctx context.Context, req *pb.GetDisputeRequest) (*pb.GetDisputeResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateGetDisputeRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	dispute, err := server.service.GetDispute(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "dispute %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get dispute: %s", err)
+	}
+
+	// staff see every dispute, customers only the ones on accounts they hold
+	if server.authorizeRole(authPayload, models.RoleTeller, models.RoleAdmin) != nil {
+		if err := server.authorizeAccount(ctx, authPayload, dispute.AccountID, dispute.AccountNumber, models.PermissionView); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.GetDisputeResponse{
+		Dispute: convertDispute(dispute),
+	}, nil
+}
+
+func validateGetDisputeRequest(req *pb.GetDisputeRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetLoanSchedule(ctx context.Context, req *pb.GetLoanScheduleRequest) (*pb.GetLoanScheduleResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateGetLoanScheduleRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	loan, err := server.service.GetLoan(ctx, req.GetLoanId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "loan %d not found", req.GetLoanId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get loan: %s", err)
+	}
+
+	if err := server.authorizeAccount(ctx, authPayload, loan.AccountID, loan.AccountNumber, models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	schedule, err := server.service.GetLoanSchedule(ctx, loan.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get loan schedule: %s", err)
+	}
+
+	return &pb.GetLoanScheduleResponse{
+		Loan:         convertLoan(schedule.Loan),
+		Installments: convertLoanInstallments(schedule.Installments),
+		PayoffAmount: schedule.PayoffAmount,
+	}, nil
+}
+
+func validateGetLoanScheduleRequest(req *pb.GetLoanScheduleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetLoanId()); err != nil {
+		violations = append(violations, fieldViolation("loan_id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,60 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetPendingTransfer(ctx context.Context, req *pb.GetPendingTransferRequest) (*pb.GetPendingTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleTeller, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateGetPendingTransferRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	pending, err := server.service.GetPendingTransfer(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "pending transfer %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get pending transfer: %s", err)
+	}
+
+	events, err := server.service.ListPendingTransferEvents(ctx, pending.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list pending transfer events: %s", err)
+	}
+
+	rsp := &pb.GetPendingTransferResponse{
+		PendingTransfer: convertPendingTransfer(pending),
+		Events:          make([]*pb.PendingTransferEvent, len(events)),
+	}
+	for i, event := range events {
+		rsp.Events[i] = convertPendingTransferEvent(event)
+	}
+
+	return rsp, nil
+}
+
+func validateGetPendingTransferRequest(req *pb.GetPendingTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
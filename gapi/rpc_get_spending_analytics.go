@@ -0,0 +1,110 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxAnalyticsPeriod bounds how far the analytics aggregates have to scan
+const maxAnalyticsPeriod = 366 * 24 * time.Hour
+
+func (server *Server) GetSpendingAnalytics(ctx context.Context, req *pb.GetSpendingAnalyticsRequest) (*pb.GetSpendingAnalyticsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateGetSpendingAnalyticsRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.authorizeAccount(ctx, authPayload, account.ID, account.AccountNumber, models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	analytics, err := server.service.GetSpendingAnalytics(ctx, models.SpendingAnalyticsParams{
+		AccountID: account.ID,
+		From:      req.GetFrom().AsTime(),
+		To:        req.GetTo().AsTime(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "account %s not found", req.GetAccountNumber())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get spending analytics: %s", err)
+	}
+
+	rsp := &pb.GetSpendingAnalyticsResponse{
+		AccountNumber: account.AccountNumber,
+		From:          timestamppb.New(analytics.From),
+		To:            timestamppb.New(analytics.To),
+		Inflows:       analytics.Inflows,
+		Outflows:      analytics.Outflows,
+		Categories:    make([]*pb.CategorySpend, len(analytics.Categories)),
+		Months:        make([]*pb.MonthlyTrend, len(analytics.Months)),
+	}
+	for i, spend := range analytics.Categories {
+		rsp.Categories[i] = &pb.CategorySpend{
+			Category: spend.Category,
+			Amount:   spend.Amount,
+			Count:    spend.Count,
+		}
+	}
+	for i, trend := range analytics.Months {
+		rsp.Months[i] = &pb.MonthlyTrend{
+			Month:          timestamppb.New(trend.Month),
+			Inflows:        trend.Inflows,
+			Outflows:       trend.Outflows,
+			OutflowsChange: trend.OutflowsChange,
+		}
+	}
+
+	return rsp, nil
+}
+
+func validateGetSpendingAnalyticsRequest(req *pb.GetSpendingAnalyticsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if req.GetFrom() == nil {
+		violations = append(violations, fieldViolation("from", fmt.Errorf("must be set")))
+	} else if err := req.GetFrom().CheckValid(); err != nil {
+		violations = append(violations, fieldViolation("from", err))
+	}
+
+	if req.GetTo() == nil {
+		violations = append(violations, fieldViolation("to", fmt.Errorf("must be set")))
+	} else if err := req.GetTo().CheckValid(); err != nil {
+		violations = append(violations, fieldViolation("to", err))
+	}
+
+	if violations != nil {
+		return violations
+	}
+
+	period := req.GetTo().AsTime().Sub(req.GetFrom().AsTime())
+	if period <= 0 {
+		violations = append(violations, fieldViolation("to", fmt.Errorf("must be after from")))
+	} else if period > maxAnalyticsPeriod {
+		violations = append(violations, fieldViolation("to", fmt.Errorf("period must not be longer than a year")))
+	}
+
+	return violations
+}
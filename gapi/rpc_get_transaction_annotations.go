@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetTransactionAnnotations(ctx context.Context, req *pb.GetTransactionAnnotationsRequest) (*pb.GetTransactionAnnotationsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateGetTransactionAnnotationsRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if _, err := server.authorizeTransaction(ctx, authPayload, req.GetAccountNumber(), req.GetTransactionId(), models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	annotations, err := server.service.GetTransactionAnnotations(ctx, req.GetTransactionId(), authPayload.UserName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get transaction annotations: %s", err)
+	}
+
+	rsp := &pb.GetTransactionAnnotationsResponse{
+		Note:        convertTransactionNote(annotations.Note),
+		Attachments: make([]*pb.TransactionAttachment, len(annotations.Attachments)),
+	}
+	for i, attachment := range annotations.Attachments {
+		rsp.Attachments[i] = convertTransactionAttachment(attachment)
+	}
+
+	return rsp, nil
+}
+
+func validateGetTransactionAnnotationsRequest(req *pb.GetTransactionAnnotationsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if err := val.ValidateAccountID(req.GetTransactionId()); err != nil {
+		violations = append(violations, fieldViolation("transaction_id", err))
+	}
+
+	return violations
+}
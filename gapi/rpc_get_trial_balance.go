@@ -0,0 +1,66 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetTrialBalance(ctx context.Context, req *pb.GetTrialBalanceRequest) (*pb.GetTrialBalanceResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateGetTrialBalanceRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	trialBalances, err := server.getTrialBalances(ctx, req.GetBusinessDate())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetTrialBalanceResponse{
+		TrialBalances: make([]*pb.TrialBalance, len(trialBalances)),
+	}
+	for i, tb := range trialBalances {
+		rsp.TrialBalances[i] = convertTrialBalance(tb)
+	}
+
+	return rsp, nil
+}
+
+// getTrialBalances loads a closed business day's trial balances, a day that was never closed has none
+func (server *Server) getTrialBalances(ctx context.Context, businessDate string) ([]models.TrialBalance, error) {
+	day, _ := time.Parse(time.DateOnly, businessDate)
+
+	trialBalances, err := server.service.GetTrialBalances(ctx, day)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get trial balance: %s", err)
+	}
+
+	if len(trialBalances) == 0 {
+		return nil, status.Errorf(codes.NotFound, "business day %s is not closed", businessDate)
+	}
+
+	return trialBalances, nil
+}
+
+func validateGetTrialBalanceRequest(req *pb.GetTrialBalanceRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateBusinessDate(req.GetBusinessDate()); err != nil {
+		violations = append(violations, fieldViolation("business_date", err))
+	}
+
+	return violations
+}
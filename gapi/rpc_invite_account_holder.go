@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) InviteAccountHolder(ctx context.Context, req *pb.InviteAccountHolderRequest) (*pb.InviteAccountHolderResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateInviteAccountHolderRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.authorizeAccount(ctx, authPayload, account.ID, account.AccountNumber, models.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	holder, err := server.service.InviteAccountHolder(ctx, models.InviteAccountHolderParams{
+		AccountID: account.ID,
+		UserName:  req.GetUsername(),
+		Role:      req.GetRole(),
+		InvitedBy: authPayload.UserName,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "user %s not found", req.GetUsername())
+		}
+		if errors.Is(err, models.ErrAlreadyAccountHolder) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to invite account holder: %s", err)
+	}
+
+	return &pb.InviteAccountHolderResponse{
+		Holder: convertAccountHolder(holder),
+	}, nil
+}
+
+func validateInviteAccountHolderRequest(req *pb.InviteAccountHolderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	if err := val.ValidateInvitedRole(req.GetRole()); err != nil {
+		violations = append(violations, fieldViolation("role", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAccountHolders(ctx context.Context, req *pb.ListAccountHoldersRequest) (*pb.ListAccountHoldersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateListAccountHoldersRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.authorizeAccount(ctx, authPayload, account.ID, account.AccountNumber, models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	holders, err := server.service.ListAccountHolders(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list account holders: %s", err)
+	}
+
+	rsp := &pb.ListAccountHoldersResponse{
+		Holders: make([]*pb.AccountHolder, len(holders)),
+	}
+	for i, holder := range holders {
+		rsp.Holders[i] = convertAccountHolder(holder)
+	}
+
+	return rsp, nil
+}
+
+func validateListAccountHoldersRequest(req *pb.ListAccountHoldersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAlertRules(ctx context.Context, req *pb.ListAlertRulesRequest) (*pb.ListAlertRulesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateListAlertRulesRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.authorizeAccount(ctx, authPayload, account.ID, account.AccountNumber, models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	rules, err := server.service.ListAlertRules(ctx, account.ID, authPayload.UserName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list alert rules: %s", err)
+	}
+
+	rsp := &pb.ListAlertRulesResponse{
+		Rules: make([]*pb.AlertRule, len(rules)),
+	}
+	for i, rule := range rules {
+		rsp.Rules[i] = convertAlertRule(rule)
+	}
+
+	return rsp, nil
+}
+
+func validateListAlertRulesRequest(req *pb.ListAlertRulesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,30 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListBeneficiaries(ctx context.Context, req *pb.ListBeneficiariesRequest) (*pb.ListBeneficiariesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	beneficiaries, err := server.service.ListBeneficiaries(ctx, authPayload.UserName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list beneficiaries: %s", err)
+	}
+
+	rsp := &pb.ListBeneficiariesResponse{
+		Beneficiaries: make([]*pb.Beneficiary, len(beneficiaries)),
+	}
+	for i, beneficiary := range beneficiaries {
+		rsp.Beneficiaries[i] = convertBeneficiary(beneficiary)
+	}
+
+	return rsp, nil
+}
@@ -0,0 +1,30 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListCategoryRules(ctx context.Context, req *pb.ListCategoryRulesRequest) (*pb.ListCategoryRulesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	rules, err := server.service.ListCategoryRules(ctx, authPayload.UserName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list category rules: %s", err)
+	}
+
+	rsp := &pb.ListCategoryRulesResponse{
+		Rules: make([]*pb.CategoryRule, len(rules)),
+	}
+	for i, rule := range rules {
+		rsp.Rules[i] = convertCategoryRule(rule)
+	}
+
+	return rsp, nil
+}
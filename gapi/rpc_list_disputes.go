@@ -0,0 +1,79 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListDisputes(ctx context.Context, req *pb.ListDisputesRequest) (*pb.ListDisputesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateListDisputesRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// without an account number the whole dispute queue is listed, which only staff work through
+	var accountID int64
+	if req.GetAccountNumber() == "" {
+		if err := server.authorizeRole(authPayload, models.RoleTeller, models.RoleAdmin); err != nil {
+			return nil, err
+		}
+	} else {
+		account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+		if err != nil {
+			return nil, err
+		}
+
+		if server.authorizeRole(authPayload, models.RoleTeller, models.RoleAdmin) != nil {
+			if err := server.authorizeAccount(ctx, authPayload, account.ID, account.AccountNumber, models.PermissionView); err != nil {
+				return nil, err
+			}
+		}
+		accountID = account.ID
+	}
+
+	disputes, err := server.service.ListDisputes(ctx, accountID, req.GetStatus(), req.GetPageSize(), (req.GetPageId()-1)*req.GetPageSize())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list disputes: %s", err)
+	}
+
+	rsp := &pb.ListDisputesResponse{
+		Disputes: make([]*pb.Dispute, len(disputes)),
+	}
+	for i, dispute := range disputes {
+		rsp.Disputes[i] = convertDispute(dispute)
+	}
+
+	return rsp, nil
+}
+
+func validateListDisputesRequest(req *pb.ListDisputesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetAccountNumber() != "" {
+		if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+			violations = append(violations, fieldViolation("account_number", err))
+		}
+	}
+
+	if err := val.ValidateDisputeStatus(req.GetStatus()); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
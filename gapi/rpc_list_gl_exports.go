@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListGLExports(ctx context.Context, req *pb.ListGLExportsRequest) (*pb.ListGLExportsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateListGLExportsRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	exports, err := server.service.ListGLExports(ctx, req.GetPageSize(), (req.GetPageId()-1)*req.GetPageSize())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list general ledger exports: %s", err)
+	}
+
+	rsp := &pb.ListGLExportsResponse{
+		Exports: make([]*pb.GLExport, len(exports)),
+	}
+	for i, export := range exports {
+		rsp.Exports[i] = convertGLExport(export)
+	}
+
+	return rsp, nil
+}
+
+func validateListGLExportsRequest(req *pb.ListGLExportsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListOverdraftRequests(ctx context.Context, req *pb.ListOverdraftRequestsRequest) (*pb.ListOverdraftRequestsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateListOverdraftRequestsRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	requests, err := server.service.ListOverdraftRequests(ctx, req.GetStatus(), req.GetPageSize(), (req.GetPageId()-1)*req.GetPageSize())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list overdraft requests: %s", err)
+	}
+
+	rsp := &pb.ListOverdraftRequestsResponse{
+		OverdraftRequests: make([]*pb.OverdraftRequest, len(requests)),
+	}
+	for i, overdraft := range requests {
+		rsp.OverdraftRequests[i] = convertOverdraftRequest(overdraft)
+	}
+
+	return rsp, nil
+}
+
+func validateListOverdraftRequestsRequest(req *pb.ListOverdraftRequestsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateOverdraftRequestStatus(req.GetStatus()); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,39 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListPaymentAliases(ctx context.Context, req *pb.ListPaymentAliasesRequest) (*pb.ListPaymentAliasesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	aliases, err := server.service.ListPaymentAliases(ctx, authPayload.UserName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list payment aliases: %s", err)
+	}
+
+	defaults, err := server.service.ListDefaultAccounts(ctx, authPayload.UserName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list default accounts: %s", err)
+	}
+
+	rsp := &pb.ListPaymentAliasesResponse{
+		Aliases:         make([]*pb.PaymentAlias, len(aliases)),
+		DefaultAccounts: make([]*pb.DefaultAccount, len(defaults)),
+	}
+	for i, alias := range aliases {
+		rsp.Aliases[i] = convertPaymentAlias(alias)
+	}
+	for i, defaultAccount := range defaults {
+		rsp.DefaultAccounts[i] = convertDefaultAccount(defaultAccount)
+	}
+
+	return rsp, nil
+}
@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListPendingTransfers(ctx context.Context, req *pb.ListPendingTransfersRequest) (*pb.ListPendingTransfersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleTeller, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateListPendingTransfersRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	transfers, err := server.service.ListPendingTransfers(ctx, req.GetStatus(), req.GetPageSize(), (req.GetPageId()-1)*req.GetPageSize())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list pending transfers: %s", err)
+	}
+
+	rsp := &pb.ListPendingTransfersResponse{
+		PendingTransfers: make([]*pb.PendingTransfer, len(transfers)),
+	}
+	for i, pending := range transfers {
+		rsp.PendingTransfers[i] = convertPendingTransfer(pending)
+	}
+
+	return rsp, nil
+}
+
+func validateListPendingTransfersRequest(req *pb.ListPendingTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePendingTransferStatus(req.GetStatus()); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
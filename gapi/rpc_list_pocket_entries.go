@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListPocketEntries(ctx context.Context, req *pb.ListPocketEntriesRequest) (*pb.ListPocketEntriesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateListPocketEntriesRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	pocket, err := server.authorizePocket(ctx, authPayload, req.GetPocketId(), models.PermissionView)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := server.service.ListPocketEntries(ctx, pocket.ID, req.GetPageSize(), (req.GetPageId()-1)*req.GetPageSize())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list pocket entries: %s", err)
+	}
+
+	rsp := &pb.ListPocketEntriesResponse{
+		Entries: make([]*pb.PocketEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		rsp.Entries[i] = convertPocketEntry(entry)
+	}
+
+	return rsp, nil
+}
+
+func validateListPocketEntriesRequest(req *pb.ListPocketEntriesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetPocketId()); err != nil {
+		violations = append(violations, fieldViolation("pocket_id", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
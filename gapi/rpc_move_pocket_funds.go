@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) MovePocketFunds(ctx context.Context, req *pb.MovePocketFundsRequest) (*pb.MovePocketFundsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateMovePocketFundsRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	pocket, err := server.authorizePocket(ctx, authPayload, req.GetPocketId(), models.PermissionTransact)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := req.GetAmount()
+	if req.GetWithdraw() {
+		amount = -amount
+	}
+
+	result, err := server.service.MovePocketFunds(ctx, models.MovePocketFundsParams{
+		PocketID: pocket.ID,
+		Amount:   amount,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrPocketClosed) || errors.Is(err, models.ErrInsufficientFunds) ||
+			errors.Is(err, models.ErrInsufficientPocketFunds) || errors.Is(err, models.ErrAccountFrozen) ||
+			errors.Is(err, models.ErrAccountClosing) || errors.Is(err, models.ErrAccountClosed) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to move pocket funds: %s", err)
+	}
+
+	return &pb.MovePocketFundsResponse{
+		Pocket:           convertPocket(result.Pocket),
+		Entry:            convertPocketEntry(result.Entry),
+		AvailableBalance: result.Account.AvailableBalance,
+	}, nil
+}
+
+func validateMovePocketFundsRequest(req *pb.MovePocketFundsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetPocketId()); err != nil {
+		violations = append(violations, fieldViolation("pocket_id", err))
+	}
+
+	if err := val.ValidatePocketAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	} else if req.GetAmount() == 0 {
+		violations = append(violations, fieldViolation("amount", errors.New("must be greater than zero")))
+	}
+
+	return violations
+}
@@ -0,0 +1,77 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) OpenDispute(ctx context.Context, req *pb.OpenDisputeRequest) (*pb.OpenDisputeResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateOpenDisputeRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeTransaction(ctx, authPayload, req.GetAccountNumber(), req.GetTransactionId(), models.PermissionTransact)
+	if err != nil {
+		return nil, err
+	}
+
+	dispute, err := server.service.OpenDispute(ctx, models.OpenDisputeParams{
+		TransactionID: req.GetTransactionId(),
+		AccountID:     account.ID,
+		Amount:        req.GetAmount(),
+		Reason:        req.GetReason(),
+		Evidence:      req.GetEvidence(),
+		HoldFunds:     req.GetHoldFunds(),
+		OpenedBy:      authPayload.UserName,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrDisputeExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
+		if errors.Is(err, models.ErrDisputeNotSender) || errors.Is(err, models.ErrDisputeExceedsAmount) ||
+			errors.Is(err, models.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to open dispute: %s", err)
+	}
+
+	return &pb.OpenDisputeResponse{
+		Dispute: convertDispute(dispute),
+	}, nil
+}
+
+func validateOpenDisputeRequest(req *pb.OpenDisputeRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if err := val.ValidateAccountID(req.GetTransactionId()); err != nil {
+		violations = append(violations, fieldViolation("transaction_id", err))
+	}
+
+	if err := val.ValidateDisputeReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	if err := val.ValidateDisputeEvidence(req.GetEvidence()); err != nil {
+		violations = append(violations, fieldViolation("evidence", err))
+	}
+
+	if err := val.ValidateDisputeAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,60 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) PayOffLoan(ctx context.Context, req *pb.PayOffLoanRequest) (*pb.PayOffLoanResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validatePayOffLoanRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	loan, err := server.service.GetLoan(ctx, req.GetLoanId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "loan %d not found", req.GetLoanId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get loan: %s", err)
+	}
+
+	if err := server.authorizeAccount(ctx, authPayload, loan.AccountID, loan.AccountNumber, models.PermissionTransact); err != nil {
+		return nil, err
+	}
+
+	schedule, err := server.service.PayOffLoan(ctx, loan.ID)
+	if err != nil {
+		if errors.Is(err, models.ErrLoanPaidOff) || errors.Is(err, models.ErrInsufficientFunds) ||
+			errors.Is(err, models.ErrAccountFrozen) || errors.Is(err, models.ErrAccountClosing) ||
+			errors.Is(err, models.ErrAccountClosed) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to pay off loan: %s", err)
+	}
+
+	return &pb.PayOffLoanResponse{
+		Loan:         convertLoan(schedule.Loan),
+		Installments: convertLoanInstallments(schedule.Installments),
+	}, nil
+}
+
+func validatePayOffLoanRequest(req *pb.PayOffLoanRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetLoanId()); err != nil {
+		violations = append(violations, fieldViolation("loan_id", err))
+	}
+
+	return violations
+}
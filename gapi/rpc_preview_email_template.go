@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zde37/Swift_Bank/mail/templates"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) PreviewEmailTemplate(ctx context.Context, req *pb.PreviewEmailTemplateRequest) (*pb.PreviewEmailTemplateResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validatePreviewEmailTemplateRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	email, err := server.templates.Preview(req.GetName(), req.GetLocale())
+	if err != nil {
+		if errors.Is(err, templates.ErrUnknownTemplate) {
+			return nil, status.Errorf(codes.NotFound, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to render email template: %s", err)
+	}
+
+	return &pb.PreviewEmailTemplateResponse{
+		Locale:  email.Locale,
+		Subject: email.Subject,
+		Html:    email.HTML,
+		Text:    email.Text,
+	}, nil
+}
+
+func validatePreviewEmailTemplateRequest(req *pb.PreviewEmailTemplateRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(req.GetName(), 1, 64); err != nil {
+		violations = append(violations, fieldViolation("name", err))
+	}
+
+	if req.GetLocale() != "" {
+		if err := val.ValidateLocale(req.GetLocale()); err != nil {
+			violations = append(violations, fieldViolation("locale", err))
+		}
+	}
+
+	return violations
+}
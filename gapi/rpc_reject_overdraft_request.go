@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) RejectOverdraftRequest(ctx context.Context, req *pb.RejectOverdraftRequestRequest) (*pb.RejectOverdraftRequestResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateRejectOverdraftRequestRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	overdraft, err := server.service.DecideOverdraftRequest(ctx, models.DecideOverdraftRequestParams{
+		ID:        req.GetId(),
+		DecidedBy: authPayload.UserName,
+		Approve:   false,
+		Comment:   req.GetComment(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "overdraft request %d not found", req.GetId())
+		}
+		if errors.Is(err, models.ErrApproverIsInitiator) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s", err)
+		}
+		if errors.Is(err, models.ErrOperationNotPending) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reject overdraft request: %s", err)
+	}
+
+	return &pb.RejectOverdraftRequestResponse{
+		OverdraftRequest: convertOverdraftRequest(overdraft),
+	}, nil
+}
+
+func validateRejectOverdraftRequestRequest(req *pb.RejectOverdraftRequestRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	// the customer should learn why they didn't get the overdraft
+	if req.GetComment() == "" {
+		violations = append(violations, fieldViolation("comment", errors.New("a reason is required when rejecting")))
+	} else if err := val.ValidateComment(req.GetComment()); err != nil {
+		violations = append(violations, fieldViolation("comment", err))
+	}
+
+	return violations
+}
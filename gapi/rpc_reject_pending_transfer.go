@@ -0,0 +1,69 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) RejectPendingTransfer(ctx context.Context, req *pb.RejectPendingTransferRequest) (*pb.RejectPendingTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleTeller, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateRejectPendingTransferRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.service.DecidePendingTransfer(ctx, models.DecidePendingTransferParams{
+		ID:        req.GetId(),
+		DecidedBy: authPayload.UserName,
+		Approve:   false,
+		Comment:   req.GetComment(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "pending transfer %d not found", req.GetId())
+		}
+		if errors.Is(err, models.ErrApproverIsInitiator) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s", err)
+		}
+		if errors.Is(err, models.ErrOperationNotPending) || errors.Is(err, models.ErrPendingTransferExpired) ||
+			errors.Is(err, models.ErrHoldNotActive) || errors.Is(err, models.ErrAccountFrozen) ||
+			errors.Is(err, models.ErrAccountClosing) || errors.Is(err, models.ErrAccountClosed) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reject pending transfer: %s", err)
+	}
+
+	return &pb.RejectPendingTransferResponse{
+		PendingTransfer: convertPendingTransfer(result.PendingTransfer),
+	}, nil
+}
+
+func validateRejectPendingTransferRequest(req *pb.RejectPendingTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	// the initiator should learn why the transfer was stopped
+	if req.GetComment() == "" {
+		violations = append(violations, fieldViolation("comment", errors.New("a reason is required when rejecting")))
+	} else if err := val.ValidateComment(req.GetComment()); err != nil {
+		violations = append(violations, fieldViolation("comment", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) RequestOverdraft(ctx context.Context, req *pb.RequestOverdraftRequest) (*pb.RequestOverdraftResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateRequestOverdraftRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.authorizeAccount(ctx, authPayload, account.ID, account.AccountNumber, models.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	overdraft, err := server.service.CreateOverdraftRequest(ctx, models.OverdraftRequest{
+		AccountID:      account.ID,
+		RequestedLimit: req.GetLimit(),
+		RequestedBy:    authPayload.UserName,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrOverdraftRequestExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to request overdraft: %s", err)
+	}
+
+	return &pb.RequestOverdraftResponse{
+		OverdraftRequest: convertOverdraftRequest(overdraft),
+	}, nil
+}
+
+func validateRequestOverdraftRequest(req *pb.RequestOverdraftRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if err := val.ValidateOverdraftLimit(req.GetLimit()); err != nil {
+		violations = append(violations, fieldViolation("limit", err))
+	}
+
+	return violations
+}
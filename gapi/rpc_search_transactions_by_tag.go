@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SearchTransactionsByTag(ctx context.Context, req *pb.SearchTransactionsByTagRequest) (*pb.SearchTransactionsByTagResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateSearchTransactionsByTagRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.authorizeAccount(ctx, authPayload, account.ID, account.AccountNumber, models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	transactions, err := server.service.SearchTransactionsByTag(ctx, models.SearchTransactionsByTagParams{
+		AccountID: account.ID,
+		Owner:     authPayload.UserName,
+		Tag:       req.GetTag(),
+		Limit:     req.GetPageSize(),
+		Offset:    (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search transactions: %s", err)
+	}
+
+	rsp := &pb.SearchTransactionsByTagResponse{
+		Transactions: make([]*pb.TaggedTransaction, len(transactions)),
+	}
+	for i, tagged := range transactions {
+		rsp.Transactions[i] = &pb.TaggedTransaction{
+			Transaction: convertTransaction(tagged.Transaction),
+			Note:        convertTransactionNote(tagged.Note),
+		}
+	}
+
+	return rsp, nil
+}
+
+func validateSearchTransactionsByTagRequest(req *pb.SearchTransactionsByTagRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if err := val.ValidateTransactionTag(req.GetTag()); err != nil {
+		violations = append(violations, fieldViolation("tag", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
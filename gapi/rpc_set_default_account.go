@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetDefaultAccount(ctx context.Context, req *pb.SetDefaultAccountRequest) (*pb.SetDefaultAccountResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateSetDefaultAccountRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	defaultAccount, err := server.service.SetDefaultAccount(ctx, authPayload.UserName, account.ID)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidDefaultAccount) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set default account: %s", err)
+	}
+
+	return &pb.SetDefaultAccountResponse{
+		DefaultAccount: convertDefaultAccount(defaultAccount),
+	}, nil
+}
+
+func validateSetDefaultAccountRequest(req *pb.SetDefaultAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	return violations
+}
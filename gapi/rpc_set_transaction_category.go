@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetTransactionCategory(ctx context.Context, req *pb.SetTransactionCategoryRequest) (*pb.SetTransactionCategoryResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateSetTransactionCategoryRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.getAccountByNumber(ctx, req.GetAccountNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	// recategorizing changes what every holder sees in the analytics, viewers may only read them
+	if err := server.authorizeAccount(ctx, authPayload, account.ID, account.AccountNumber, models.PermissionTransact); err != nil {
+		return nil, err
+	}
+
+	category, err := server.service.SetTransactionCategory(ctx, models.TransactionCategory{
+		TransactionID: req.GetTransactionId(),
+		AccountID:     account.ID,
+		Category:      req.GetCategory(),
+		SetBy:         authPayload.UserName,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, status.Errorf(codes.NotFound, "transaction %d not found", req.GetTransactionId())
+		case errors.Is(err, models.ErrTransactionNotOnAccount):
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set transaction category: %s", err)
+	}
+
+	return &pb.SetTransactionCategoryResponse{
+		AccountNumber: account.AccountNumber,
+		TransactionId: category.TransactionID,
+		Category:      category.Category,
+	}, nil
+}
+
+func validateSetTransactionCategoryRequest(req *pb.SetTransactionCategoryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if err := val.ValidateAccountID(req.GetTransactionId()); err != nil {
+		violations = append(violations, fieldViolation("transaction_id", err))
+	}
+
+	if err := val.ValidateCategory(req.GetCategory()); err != nil {
+		violations = append(violations, fieldViolation("category", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,66 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetTransactionNote(ctx context.Context, req *pb.SetTransactionNoteRequest) (*pb.SetTransactionNoteResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateSetTransactionNoteRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if _, err := server.authorizeTransaction(ctx, authPayload, req.GetAccountNumber(), req.GetTransactionId(), models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	tags := req.GetTags()
+	if tags == nil {
+		tags = []string{}
+	}
+
+	note, err := server.service.SetTransactionNote(ctx, models.TransactionNote{
+		TransactionID: req.GetTransactionId(),
+		Owner:         authPayload.UserName,
+		Note:          req.GetNote(),
+		Tags:          tags,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set transaction note: %s", err)
+	}
+
+	return &pb.SetTransactionNoteResponse{
+		Note: convertTransactionNote(note),
+	}, nil
+}
+
+func validateSetTransactionNoteRequest(req *pb.SetTransactionNoteRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if err := val.ValidateAccountID(req.GetTransactionId()); err != nil {
+		violations = append(violations, fieldViolation("transaction_id", err))
+	}
+
+	if err := val.ValidateTransactionNote(req.GetNote()); err != nil {
+		violations = append(violations, fieldViolation("note", err))
+	}
+
+	if err := val.ValidateTransactionTags(req.GetTags()); err != nil {
+		violations = append(violations, fieldViolation("tags", err))
+	}
+
+	return violations
+}
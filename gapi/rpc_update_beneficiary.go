@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateBeneficiary(ctx context.Context, req *pb.UpdateBeneficiaryRequest) (*pb.UpdateBeneficiaryResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateUpdateBeneficiaryRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	beneficiary, err := server.service.UpdateBeneficiary(ctx, models.UpdateBeneficiaryParams{
+		ID:    req.GetId(),
+		Owner: authPayload.UserName,
+		Nickname: sql.NullString{
+			String: req.GetNickname(),
+			Valid:  req.Nickname != nil,
+		},
+		TransferLimit: sql.NullFloat64{
+			Float64: req.GetTransferLimit(),
+			Valid:   req.TransferLimit != nil,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "beneficiary %d not found", req.GetId())
+		}
+		if errors.Is(err, models.ErrBeneficiaryExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update beneficiary: %s", err)
+	}
+
+	return &pb.UpdateBeneficiaryResponse{
+		Beneficiary: convertBeneficiary(beneficiary),
+	}, nil
+}
+
+func validateUpdateBeneficiaryRequest(req *pb.UpdateBeneficiaryRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if req.Nickname != nil {
+		if err := val.ValidateNickname(req.GetNickname()); err != nil {
+			violations = append(violations, fieldViolation("nickname", err))
+		}
+	}
+
+	if req.TransferLimit != nil && req.GetTransferLimit() < 0 {
+		violations = append(violations, fieldViolation("transfer_limit", errors.New("must not be negative")))
+	}
+
+	return violations
+}
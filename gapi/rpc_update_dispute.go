@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateDispute(ctx context.Context, req *pb.UpdateDisputeRequest) (*pb.UpdateDisputeResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.authorizeRole(authPayload, models.RoleTeller, models.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if violations := validateUpdateDisputeRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	dispute, err := server.service.UpdateDispute(ctx, models.UpdateDisputeParams{
+		ID:        req.GetId(),
+		Status:    req.GetStatus(),
+		Comment:   req.GetComment(),
+		DecidedBy: authPayload.UserName,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "dispute %d not found", req.GetId())
+		}
+		if errors.Is(err, models.ErrApproverIsInitiator) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s", err)
+		}
+		if errors.Is(err, models.ErrDisputeTransition) || errors.Is(err, models.ErrInsufficientFunds) ||
+			errors.Is(err, models.ErrHoldNotActive) || errors.Is(err, models.ErrAccountFrozen) ||
+			errors.Is(err, models.ErrAccountClosing) || errors.Is(err, models.ErrAccountClosed) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update dispute: %s", err)
+	}
+
+	return &pb.UpdateDisputeResponse{
+		Dispute: convertDispute(dispute),
+	}, nil
+}
+
+func validateUpdateDisputeRequest(req *pb.UpdateDisputeRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := val.ValidateDisputeTransition(req.GetStatus()); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+
+	if err := val.ValidateComment(req.GetComment()); err != nil {
+		violations = append(violations, fieldViolation("comment", err))
+	}
+
+	return violations
+}
@@ -40,6 +40,14 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 			String: req.GetEmail(),
 			Valid:  req.Email != nil,
 		},
+		StatementsOptOut: sql.NullBool{
+			Bool:  req.GetStatementsOptOut(),
+			Valid: req.StatementsOptOut != nil,
+		},
+		Locale: sql.NullString{
+			String: req.GetLocale(),
+			Valid:  req.Locale != nil,
+		},
 	}
 
 	if req.Password != nil {
@@ -96,5 +104,11 @@ func validateUpdateUserRequest(req *pb.UpdateUserRequest) (violations []*errdeta
 		}
 	}
 
+	if req.Locale != nil {
+		if err := val.ValidateLocale(req.GetLocale()); err != nil {
+			violations = append(violations, fieldViolation("locale", err))
+		}
+	}
+
 	return violations
 }
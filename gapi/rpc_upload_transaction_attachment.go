@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UploadTransactionAttachment(ctx context.Context, req *pb.UploadTransactionAttachmentRequest) (*pb.UploadTransactionAttachmentResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateUploadTransactionAttachmentRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if _, err := server.authorizeTransaction(ctx, authPayload, req.GetAccountNumber(), req.GetTransactionId(), models.PermissionView); err != nil {
+		return nil, err
+	}
+
+	attachment, err := server.service.UploadTransactionAttachment(ctx, models.TransactionAttachment{
+		TransactionID: req.GetTransactionId(),
+		Owner:         authPayload.UserName,
+		FileName:      req.GetFileName(),
+		ContentType:   req.GetContentType(),
+	}, req.GetContent())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upload attachment: %s", err)
+	}
+
+	return &pb.UploadTransactionAttachmentResponse{
+		Attachment: convertTransactionAttachment(attachment),
+	}, nil
+}
+
+func validateUploadTransactionAttachmentRequest(req *pb.UploadTransactionAttachmentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountNumber(req.GetAccountNumber()); err != nil {
+		violations = append(violations, fieldViolation("account_number", err))
+	}
+
+	if err := val.ValidateAccountID(req.GetTransactionId()); err != nil {
+		violations = append(violations, fieldViolation("transaction_id", err))
+	}
+
+	if err := val.ValidateAttachmentFileName(req.GetFileName()); err != nil {
+		violations = append(violations, fieldViolation("file_name", err))
+	}
+
+	if err := val.ValidateAttachment(req.GetContentType(), req.GetContent()); err != nil {
+		violations = append(violations, fieldViolation("content", err))
+	}
+
+	return violations
+}
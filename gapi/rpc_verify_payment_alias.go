@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/pb"
+	"github.com/zde37/Swift_Bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) VerifyPaymentAlias(ctx context.Context, req *pb.VerifyPaymentAliasRequest) (*pb.VerifyPaymentAliasResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if violations := validateVerifyPaymentAliasRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	alias, err := server.service.VerifyPaymentAlias(ctx, models.VerifyPaymentAliasParams{
+		Alias:    req.GetPhone(),
+		UserName: authPayload.UserName,
+		Code:     req.GetCode(),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "payment alias %s not found", req.GetPhone())
+		}
+		if errors.Is(err, models.ErrAliasCodeInvalid) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to verify payment alias: %s", err)
+	}
+
+	return &pb.VerifyPaymentAliasResponse{
+		Alias: convertPaymentAlias(alias),
+	}, nil
+}
+
+func validateVerifyPaymentAliasRequest(req *pb.VerifyPaymentAliasRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePhone(req.GetPhone()); err != nil {
+		violations = append(violations, fieldViolation("phone", err))
+	}
+
+	if err := val.ValidateAliasCode(req.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+
+	return violations
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/zde37/Swift_Bank/config"
+	"github.com/zde37/Swift_Bank/mail/templates"
 	"github.com/zde37/Swift_Bank/pb"
 	"github.com/zde37/Swift_Bank/service"
 	"github.com/zde37/Swift_Bank/token"
@@ -17,10 +18,12 @@ type Server struct {
 	tokenMaker      token.Maker
 	config          config.Config
 	taskDistributor worker.TaskDistributor
+	templates       *templates.Renderer
 }
 
 // NewServer creates a new gRPC server
-func NewServer(c config.Config, s service.ServiceProvider, taskDistributor worker.TaskDistributor) (*Server, error) {
+func NewServer(c config.Config, s service.ServiceProvider, taskDistributor worker.TaskDistributor,
+	templates *templates.Renderer) (*Server, error) {
 	tokenMaker, err := token.NewPastoMaker(c.TokenSymmetricKey)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
@@ -31,6 +34,7 @@ func NewServer(c config.Config, s service.ServiceProvider, taskDistributor worke
 		config:          c,
 		tokenMaker:      tokenMaker,
 		taskDistributor: taskDistributor,
+		templates:       templates,
 	}
 
 	return server, nil
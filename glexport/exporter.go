@@ -0,0 +1,164 @@
+package glexport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+	"github.com/zde37/Swift_Bank/blob"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/repository"
+)
+
+// Exporter writes general ledger journal files to the blob store and records each run. Both the API and the
+// daily export job go through it so that a period is only ever exported once per format.
+type Exporter struct {
+	repo   repository.RepositoryProvider
+	blobs  blob.Store
+	format string
+	layout string
+}
+
+// NewExporter creates an exporter that writes the format when a run doesn't ask for one. The fixed width
+// layout is parsed on every fixed width run, check it with ParseLayout at startup.
+func NewExporter(repo repository.RepositoryProvider, blobs blob.Store, format, layout string) *Exporter {
+	return &Exporter{
+		repo:   repo,
+		blobs:  blobs,
+		format: format,
+		layout: layout,
+	}
+}
+
+// Export exports the closed business days from the start to the end date. A period that was already exported
+// in the format returns the earlier run instead of writing a new file.
+func (e *Exporter) Export(ctx context.Context, arg models.ExportGLParams) (models.GLExport, error) {
+	format := arg.Format
+	if format == "" {
+		format = e.format
+	}
+
+	lastClosed, err := e.repo.GetLastClosedBusinessDate(ctx)
+	if err != nil {
+		return models.GLExport{}, err
+	}
+
+	if lastClosed == nil || arg.EndDate.After(*lastClosed) {
+		return models.GLExport{}, models.ErrGLPeriodOpen
+	}
+
+	existing, err := e.repo.GetGLExportByPeriod(ctx, arg.StartDate, arg.EndDate, format)
+	if err == nil || !errors.Is(err, pgx.ErrNoRows) {
+		return existing, err
+	}
+
+	var layout Layout
+	if format == models.GLFormatFixedWidth {
+		if layout, err = ParseLayout(e.layout); err != nil {
+			return models.GLExport{}, fmt.Errorf("invalid fixed width layout: %w", err)
+		}
+	}
+
+	lines, err := e.repo.GetJournal(ctx, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return models.GLExport{}, err
+	}
+
+	export := models.GLExport{
+		RunID:     uuid.NewString(),
+		StartDate: arg.StartDate,
+		EndDate:   arg.EndDate,
+		Format:    format,
+		LineCount: int64(len(lines)),
+		Totals:    ControlTotals(lines),
+		CreatedBy: arg.CreatedBy,
+	}
+	export.StorageKey = path.Join("gl", fmt.Sprintf("%s_%s_%s.%s", arg.StartDate.Format("20060102"),
+		arg.EndDate.Format("20060102"), export.RunID, extension(format)))
+
+	var buf bytes.Buffer
+	if err := WriteJournal(&buf, format, layout, export, lines); err != nil {
+		return models.GLExport{}, err
+	}
+
+	if err := e.blobs.Put(ctx, export.StorageKey, &buf); err != nil {
+		return models.GLExport{}, err
+	}
+
+	created, err := e.repo.CreateGLExport(ctx, export)
+	if err != nil {
+		if err := e.blobs.Delete(ctx, export.StorageKey); err != nil {
+			log.Error().Err(err).Str("key", export.StorageKey).Msg("failed to delete unrecorded journal file")
+		}
+
+		// a concurrent run recorded the period first
+		if errors.Is(err, models.ErrGLExportExists) {
+			return e.repo.GetGLExportByPeriod(ctx, arg.StartDate, arg.EndDate, format)
+		}
+		return created, err
+	}
+
+	return created, nil
+}
+
+// ExportClosedDays exports every business day closed since the last day it exported in the default format, one
+// run per day, and returns the runs. Without an earlier daily run it starts from the last closed day.
+func (e *Exporter) ExportClosedDays(ctx context.Context) ([]models.GLExport, error) {
+	lastClosed, err := e.repo.GetLastClosedBusinessDate(ctx)
+	if err != nil || lastClosed == nil {
+		return nil, err
+	}
+
+	lastExported, err := e.repo.GetLastGLExportDate(ctx, e.format)
+	if err != nil {
+		return nil, err
+	}
+
+	day := lastClosed.UTC()
+	if lastExported != nil {
+		day = lastExported.UTC().AddDate(0, 0, 1)
+	}
+
+	var exports []models.GLExport
+	for ; !day.After(lastClosed.UTC()); day = day.AddDate(0, 0, 1) {
+		export, err := e.Export(ctx, models.ExportGLParams{StartDate: day, EndDate: day})
+		if err != nil {
+			return exports, fmt.Errorf("failed to export %s: %w", day.Format(time.DateOnly), err)
+		}
+		exports = append(exports, export)
+	}
+
+	return exports, nil
+}
+
+// Open returns the run with its journal file, which the caller has to close
+func (e *Exporter) Open(ctx context.Context, id int64) (models.GLExport, io.ReadCloser, error) {
+	export, err := e.repo.GetGLExport(ctx, id)
+	if err != nil {
+		return export, nil, err
+	}
+
+	file, err := e.blobs.Get(ctx, export.StorageKey)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			err = models.ErrGLExportMissing
+		}
+		return export, nil, err
+	}
+
+	return export, file, nil
+}
+
+func extension(format string) string {
+	if format == models.GLFormatCSV {
+		return "csv"
+	}
+	return "txt"
+}
@@ -0,0 +1,146 @@
+package glexport_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/blob"
+	"github.com/zde37/Swift_Bank/glexport"
+	mockedproviders "github.com/zde37/Swift_Bank/mock"
+	"github.com/zde37/Swift_Bank/models"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blobs, err := blob.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	exporter := glexport.NewExporter(repo, blobs, models.GLFormatCSV, "")
+
+	day := time.Date(2024, time.May, 31, 0, 0, 0, 0, time.UTC)
+	arg := models.ExportGLParams{StartDate: day, EndDate: day}
+	lines := []models.JournalLine{
+		{BusinessDate: day, Currency: "EUR", GLCode: "1000", Debits: 250, EntryCount: 1},
+		{BusinessDate: day, Currency: "EUR", GLCode: "2000", Credits: 250, EntryCount: 1},
+		{BusinessDate: day, Currency: "USD", GLCode: "2000", Debits: 10.5, Credits: 10.5, EntryCount: 2},
+	}
+
+	repo.EXPECT().GetLastClosedBusinessDate(gomock.Any()).AnyTimes().Return(&day, nil)
+
+	// days that are still open can't be exported
+	_, err = exporter.Export(context.Background(), models.ExportGLParams{StartDate: day, EndDate: day.AddDate(0, 0, 1)})
+	require.ErrorIs(t, err, models.ErrGLPeriodOpen)
+
+	var recorded models.GLExport
+	gomock.InOrder(
+		repo.EXPECT().
+			GetGLExportByPeriod(gomock.Any(), day, day, models.GLFormatCSV).
+			Times(1).
+			Return(models.GLExport{}, pgx.ErrNoRows),
+		repo.EXPECT().
+			GetJournal(gomock.Any(), day, day).
+			Times(1).
+			Return(lines, nil),
+		repo.EXPECT().
+			CreateGLExport(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, export models.GLExport) (models.GLExport, error) {
+				recorded = export
+				export.ID = 1
+				return export, nil
+			}),
+		repo.EXPECT().
+			GetGLExportByPeriod(gomock.Any(), day, day, models.GLFormatCSV).
+			Times(1).
+			DoAndReturn(func(context.Context, time.Time, time.Time, string) (models.GLExport, error) {
+				return recorded, nil
+			}),
+	)
+
+	export, err := exporter.Export(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), export.ID)
+	require.Equal(t, int64(3), export.LineCount)
+	require.Len(t, export.Totals, 2)
+
+	file, err := blobs.Get(context.Background(), export.StorageKey)
+	require.NoError(t, err)
+	content, err := io.ReadAll(file)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	require.Contains(t, string(content), "D,"+export.RunID+",2024-05-31,EUR,1000,250.00,0.00,1\n")
+
+	// exporting the period again returns the first run
+	again, err := exporter.Export(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, recorded.RunID, again.RunID)
+}
+
+func TestExportClosedDaysAfterAdHocExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blobs, err := blob.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	exporter := glexport.NewExporter(repo, blobs, models.GLFormatCSV, "")
+
+	lastDaily := time.Date(2024, time.May, 28, 0, 0, 0, 0, time.UTC)
+	lastClosed := lastDaily.AddDate(0, 0, 3)
+	admin := "admin"
+
+	// the runs are recorded like the repository does, the daily job only resumes from its own runs
+	var recorded []models.GLExport
+	repo.EXPECT().GetLastClosedBusinessDate(gomock.Any()).AnyTimes().Return(&lastClosed, nil)
+	repo.EXPECT().
+		GetGLExportByPeriod(gomock.Any(), gomock.Any(), gomock.Any(), models.GLFormatCSV).
+		AnyTimes().
+		Return(models.GLExport{}, pgx.ErrNoRows)
+	repo.EXPECT().GetJournal(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+	repo.EXPECT().
+		CreateGLExport(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(_ context.Context, export models.GLExport) (models.GLExport, error) {
+			recorded = append(recorded, export)
+			return export, nil
+		})
+	repo.EXPECT().
+		GetLastGLExportDate(gomock.Any(), models.GLFormatCSV).
+		Times(1).
+		DoAndReturn(func(context.Context, string) (*time.Time, error) {
+			last := &lastDaily
+			for _, export := range recorded {
+				if export.CreatedBy == nil && export.EndDate.After(*last) {
+					last = &export.EndDate
+				}
+			}
+			return last, nil
+		})
+
+	// an admin exports the whole closed period before the daily job runs
+	_, err = exporter.Export(context.Background(), models.ExportGLParams{
+		StartDate: lastDaily.AddDate(0, 0, 1),
+		EndDate:   lastClosed,
+		CreatedBy: &admin,
+	})
+	require.NoError(t, err)
+
+	exports, err := exporter.ExportClosedDays(context.Background())
+	require.NoError(t, err)
+	require.Len(t, exports, 3)
+	for i, export := range exports {
+		day := lastDaily.AddDate(0, 0, i+1)
+		require.Equal(t, day, export.StartDate)
+		require.Equal(t, day, export.EndDate)
+		require.Nil(t, export.CreatedBy)
+	}
+}
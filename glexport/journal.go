@@ -0,0 +1,133 @@
+package glexport
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const (
+	recordLine  = "D"
+	recordTotal = "T"
+)
+
+// ControlTotals sums the journal lines of each currency, in the order the currencies first appear
+func ControlTotals(lines []models.JournalLine) []models.GLControlTotal {
+	totals := []models.GLControlTotal{}
+	index := map[string]int{}
+	for _, line := range lines {
+		i, ok := index[line.Currency]
+		if !ok {
+			i = len(totals)
+			index[line.Currency] = i
+			totals = append(totals, models.GLControlTotal{Currency: line.Currency})
+		}
+
+		totals[i].LineCount++
+		totals[i].TotalDebits = helpers.RoundMoney(totals[i].TotalDebits + line.Debits)
+		totals[i].TotalCredits = helpers.RoundMoney(totals[i].TotalCredits + line.Credits)
+	}
+
+	return totals
+}
+
+// WriteJournal writes the run's journal lines followed by a control total record for each currency. The csv
+// format has a header row and every field, the fixed width format writes the fields of the layout.
+func WriteJournal(w io.Writer, format string, layout Layout, export models.GLExport, lines []models.JournalLine) error {
+	records := make([]map[string]string, 0, len(lines)+len(export.Totals))
+	for _, line := range lines {
+		records = append(records, map[string]string{
+			FieldRecordType:   recordLine,
+			FieldRunID:        export.RunID,
+			FieldBusinessDate: line.BusinessDate.Format(time.DateOnly),
+			FieldCurrency:     line.Currency,
+			FieldGLCode:       line.GLCode,
+			FieldDebits:       formatAmount(line.Debits),
+			FieldCredits:      formatAmount(line.Credits),
+			FieldCount:        strconv.FormatInt(line.EntryCount, 10),
+		})
+	}
+
+	for _, total := range export.Totals {
+		records = append(records, map[string]string{
+			FieldRecordType: recordTotal,
+			FieldRunID:      export.RunID,
+			FieldCurrency:   total.Currency,
+			FieldDebits:     formatAmount(total.TotalDebits),
+			FieldCredits:    formatAmount(total.TotalCredits),
+			FieldCount:      strconv.FormatInt(total.LineCount, 10),
+		})
+	}
+
+	switch format {
+	case models.GLFormatCSV:
+		return writeCSV(w, records)
+	case models.GLFormatFixedWidth:
+		return writeFixedWidth(w, layout, records)
+	}
+
+	return fmt.Errorf("unsupported journal format %q", format)
+}
+
+func writeCSV(w io.Writer, records []map[string]string) error {
+	cw := csv.NewWriter(w)
+	cw.Write(fields)
+
+	row := make([]string, len(fields))
+	for _, record := range records {
+		for i, field := range fields {
+			row[i] = record[field]
+		}
+		cw.Write(row)
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeFixedWidth(w io.Writer, layout Layout, records []map[string]string) error {
+	var sb strings.Builder
+	for _, record := range records {
+		sb.Reset()
+		for _, field := range layout {
+			value := record[field.Name]
+			switch field.Name {
+			case FieldBusinessDate:
+				value = strings.ReplaceAll(value, "-", "")
+			case FieldDebits, FieldCredits:
+				value = strings.Replace(value, ".", "", 1)
+			}
+
+			if len(value) > field.Width {
+				return fmt.Errorf("%s %q does not fit in %d characters", field.Name, value, field.Width)
+			}
+
+			if slices.Contains(numericFields, field.Name) {
+				sb.WriteString(strings.Repeat("0", field.Width-len(value)))
+				sb.WriteString(value)
+			} else {
+				sb.WriteString(value)
+				sb.WriteString(strings.Repeat(" ", field.Width-len(value)))
+			}
+		}
+		sb.WriteByte('\n')
+
+		if _, err := io.WriteString(w, sb.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatAmount writes an amount with exactly two decimals, the fixed width format drops the point to get cents
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
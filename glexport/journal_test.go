@@ -0,0 +1,67 @@
+package glexport
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+var testLines = []models.JournalLine{
+	{BusinessDate: time.Date(2024, time.May, 31, 0, 0, 0, 0, time.UTC), Currency: "EUR", GLCode: "1000", Debits: 250, EntryCount: 1},
+	{BusinessDate: time.Date(2024, time.May, 31, 0, 0, 0, 0, time.UTC), Currency: "EUR", GLCode: "2000", Credits: 250, EntryCount: 1},
+	{BusinessDate: time.Date(2024, time.May, 31, 0, 0, 0, 0, time.UTC), Currency: "USD", GLCode: "2000", Debits: 10.5, Credits: 10.5, EntryCount: 2},
+}
+
+func TestControlTotals(t *testing.T) {
+	require.Equal(t, []models.GLControlTotal{
+		{Currency: "EUR", LineCount: 2, TotalDebits: 250, TotalCredits: 250},
+		{Currency: "USD", LineCount: 1, TotalDebits: 10.5, TotalCredits: 10.5},
+	}, ControlTotals(testLines))
+}
+
+func TestWriteJournalCSV(t *testing.T) {
+	export := models.GLExport{RunID: "run-1", Totals: ControlTotals(testLines)}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteJournal(&buf, models.GLFormatCSV, nil, export, testLines))
+	require.Equal(t, `record_type,run_id,business_date,currency,gl_code,debits,credits,count
+D,run-1,2024-05-31,EUR,1000,250.00,0.00,1
+D,run-1,2024-05-31,EUR,2000,0.00,250.00,1
+D,run-1,2024-05-31,USD,2000,10.50,10.50,2
+T,run-1,,EUR,,250.00,250.00,2
+T,run-1,,USD,,10.50,10.50,1
+`, buf.String())
+}
+
+func TestWriteJournalFixedWidth(t *testing.T) {
+	layout, err := ParseLayout("record_type:1,business_date:8,currency:3,gl_code:6,debits:10,credits:10,count:4")
+	require.NoError(t, err)
+
+	export := models.GLExport{RunID: "run-1", Totals: ControlTotals(testLines[:2])}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteJournal(&buf, models.GLFormatFixedWidth, layout, export, testLines[:2]))
+	require.Equal(t, ""+
+		"D20240531EUR1000  000002500000000000000001\n"+
+		"D20240531EUR2000  000000000000000250000001\n"+
+		"T        EUR      000002500000000250000002\n", buf.String())
+
+	// values never get cut off
+	layout[5].Width = 4
+	err = WriteJournal(&buf, models.GLFormatFixedWidth, layout, export, testLines[:2])
+	require.ErrorContains(t, err, "does not fit")
+}
+
+func TestParseLayout(t *testing.T) {
+	layout, err := ParseLayout("gl_code:6, debits:15")
+	require.NoError(t, err)
+	require.Equal(t, Layout{{Name: FieldGLCode, Width: 6}, {Name: FieldDebits, Width: 15}}, layout)
+
+	for _, spec := range []string{"", "gl_code", "gl_code:0", "gl_code:x", "account:5", "gl_code:6,gl_code:6"} {
+		_, err := ParseLayout(spec)
+		require.Error(t, err, spec)
+	}
+}
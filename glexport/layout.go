@@ -0,0 +1,63 @@
+package glexport
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Fields a journal line can carry, in the order of the csv columns
+const (
+	FieldRecordType   = "record_type" // D for a journal line, T for a currency's control totals
+	FieldRunID        = "run_id"
+	FieldBusinessDate = "business_date"
+	FieldCurrency     = "currency"
+	FieldGLCode       = "gl_code"
+	FieldDebits       = "debits"
+	FieldCredits      = "credits"
+	FieldCount        = "count" // entries behind a journal line, journal lines behind the control totals
+)
+
+var fields = []string{FieldRecordType, FieldRunID, FieldBusinessDate, FieldCurrency, FieldGLCode, FieldDebits,
+	FieldCredits, FieldCount}
+
+// numericFields are zero padded on the left in the fixed width format, amounts are written in cents
+var numericFields = []string{FieldDebits, FieldCredits, FieldCount}
+
+type Field struct {
+	Name  string
+	Width int
+}
+
+// Layout lists the fields of a fixed width record in order
+type Layout []Field
+
+// ParseLayout reads a fixed width layout such as "record_type:1,gl_code:6,debits:15". Fields can be left out
+// or reordered but not repeated.
+func ParseLayout(spec string) (Layout, error) {
+	var layout Layout
+	for _, part := range strings.Split(spec, ",") {
+		name, width, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok {
+			return nil, fmt.Errorf("field %q must be written as name:width", part)
+		}
+
+		if !slices.Contains(fields, name) {
+			return nil, fmt.Errorf("unknown field %q, must be one of %s", name, strings.Join(fields, ", "))
+		}
+
+		if slices.ContainsFunc(layout, func(f Field) bool { return f.Name == name }) {
+			return nil, fmt.Errorf("field %q appears more than once", name)
+		}
+
+		n, err := strconv.Atoi(width)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("width of field %q must be a positive number", name)
+		}
+
+		layout = append(layout, Field{Name: name, Width: n})
+	}
+
+	return layout, nil
+}
@@ -0,0 +1,46 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountNumberCheckDigits(t *testing.T) {
+	// worked out by hand: 000000000001281100 mod 97 is 21, 98 - 21 = 77
+	require.Equal(t, "77", AccountNumberCheckDigits("000000000001"))
+	require.True(t, IsValidAccountNumber("SB77000000000001"))
+}
+
+func TestIsValidAccountNumber(t *testing.T) {
+	number := RandomAccountNumber()
+	require.True(t, IsValidAccountNumber(number))
+
+	testCases := []struct {
+		name   string
+		number string
+	}{
+		{"EMPTY", ""},
+		{"TOO SHORT", number[:len(number)-1]},
+		{"TOO LONG", number + "0"},
+		{"WRONG PREFIX", "XX" + number[2:]},
+		{"NOT DIGITS", number[:len(number)-1] + "A"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.False(t, IsValidAccountNumber(tc.number))
+		})
+	}
+
+	// every single digit typo is caught
+	for i := len(AccountNumberPrefix); i < len(number); i++ {
+		for d := byte('0'); d <= '9'; d++ {
+			if number[i] == d {
+				continue
+			}
+			typo := number[:i] + string(d) + number[i+1:]
+			require.False(t, IsValidAccountNumber(typo), typo)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package helpers
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Day count conventions supported for interest accrual
+const (
+	DayCountActual365    = "ACT/365"
+	DayCountActual360    = "ACT/360"
+	DayCountActualActual = "ACT/ACT"
+	DayCount30360        = "30/360"
+)
+
+func IsSupportedDayCount(convention string) bool {
+	switch convention {
+	case DayCountActual365, DayCountActual360, DayCountActualActual, DayCount30360:
+		return true
+	}
+	return false
+}
+
+// DayCountFraction returns the fraction of a year between from and to under the given convention.
+// Only the calendar dates of from and to are used.
+func DayCountFraction(convention string, from, to time.Time) (float64, error) {
+	from = truncateToDate(from)
+	to = truncateToDate(to)
+
+	switch convention {
+	case DayCountActual365:
+		return actualDays(from, to) / 365, nil
+	case DayCountActual360:
+		return actualDays(from, to) / 360, nil
+	case DayCountActualActual:
+		// split the period at year boundaries so every day is divided by the length of its own year
+		fraction := 0.0
+		for from.Before(to) {
+			yearEnd := time.Date(from.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+			end := to
+			if yearEnd.Before(to) {
+				end = yearEnd
+			}
+			fraction += actualDays(from, end) / daysInYear(from.Year())
+			from = end
+		}
+		return fraction, nil
+	case DayCount30360:
+		// US (bond basis) 30/360
+		d1, d2 := from.Day(), to.Day()
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 && d1 == 30 {
+			d2 = 30
+		}
+		days := 360*(to.Year()-from.Year()) + 30*(int(to.Month())-int(from.Month())) + (d2 - d1)
+		return float64(days) / 360, nil
+	}
+
+	return 0, fmt.Errorf("unsupported day count convention: %s", convention)
+}
+
+// DailyInterest returns the interest earned by balance at the annual rate for the single day starting at day
+func DailyInterest(convention string, balance, annualRate float64, day time.Time) (float64, error) {
+	day = truncateToDate(day)
+	fraction, err := DayCountFraction(convention, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return 0, err
+	}
+
+	return balance * annualRate * fraction, nil
+}
+
+// RoundMoney rounds an amount to whole cents
+func RoundMoney(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func actualDays(from, to time.Time) float64 {
+	return math.Round(to.Sub(from).Hours() / 24)
+}
+
+func daysInYear(year int) float64 {
+	return float64(time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC).Sub(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)).Hours() / 24)
+}
@@ -0,0 +1,64 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestDayCountFraction(t *testing.T) {
+	testCases := []struct {
+		name       string
+		convention string
+		from       time.Time
+		to         time.Time
+		want       float64
+	}{
+		{"ACT/365 one day", DayCountActual365, date(2024, 3, 1), date(2024, 3, 2), 1.0 / 365},
+		{"ACT/365 leap year", DayCountActual365, date(2024, 1, 1), date(2025, 1, 1), 366.0 / 365},
+		{"ACT/360 one month", DayCountActual360, date(2024, 1, 1), date(2024, 2, 1), 31.0 / 360},
+		{"ACT/ACT leap year", DayCountActualActual, date(2024, 1, 1), date(2025, 1, 1), 1},
+		{"ACT/ACT across years", DayCountActualActual, date(2024, 12, 31), date(2025, 1, 2), 1.0/366 + 1.0/365},
+		{"30/360 one month", DayCount30360, date(2024, 1, 31), date(2024, 2, 29), 29.0 / 360},
+		{"30/360 day 31 is free", DayCount30360, date(2024, 1, 30), date(2024, 1, 31), 0},
+		{"30/360 end of february", DayCount30360, date(2023, 2, 28), date(2023, 3, 1), 3.0 / 360},
+		{"30/360 one year", DayCount30360, date(2023, 5, 15), date(2024, 5, 15), 1},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := DayCountFraction(tc.convention, tc.from, tc.to)
+			require.NoError(t, err)
+			require.InDelta(t, tc.want, got, 1e-12)
+		})
+	}
+
+	_, err := DayCountFraction("ACT/999", date(2024, 1, 1), date(2024, 1, 2))
+	require.Error(t, err)
+}
+
+func TestDailyInterestSumsToAnnualRate(t *testing.T) {
+	// accruing every day of a year must add up to the full annual interest, whatever the convention
+	for _, convention := range []string{DayCountActualActual, DayCount30360} {
+		total := 0.0
+		for day := date(2024, 1, 1); day.Before(date(2025, 1, 1)); day = day.AddDate(0, 0, 1) {
+			interest, err := DailyInterest(convention, 1000, 0.05, day)
+			require.NoError(t, err)
+			total += interest
+		}
+		require.InDelta(t, 50, total, 1e-9, convention)
+	}
+}
+
+func TestRoundMoney(t *testing.T) {
+	require.Equal(t, 1.23, RoundMoney(1.234))
+	require.Equal(t, 1.24, RoundMoney(1.235001))
+	require.Equal(t, float64(0), RoundMoney(0.004))
+}
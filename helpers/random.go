@@ -47,4 +47,10 @@ func RandomCurrency() string  {
 	n := len(currencies)
 
 	return currencies[rand.Intn(n)]
-}
\ No newline at end of file
+}
+
+// RandomAccountNumber generates a valid account number with random digits
+func RandomAccountNumber() string {
+	digits := fmt.Sprintf("%012d", RandomInt(0, 999999999999))
+	return AccountNumberPrefix + AccountNumberCheckDigits(digits) + digits
+}
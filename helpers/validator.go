@@ -9,3 +9,10 @@ var ValidCurrency validator.Func = func(fieldLevel validator.FieldLevel) bool {
 	}
 	return false
 }
+
+var ValidAccountNumber validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if number, ok := fieldLevel.Field().Interface().(string); ok {
+		return IsValidAccountNumber(number)
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// MboxSender appends every message to an mbox file instead of sending it, so development and CI environments
+// can read what would have gone out with any mail client
+type MboxSender struct {
+	name             string
+	fromEmailAddress string
+	path             string
+	mu               sync.Mutex
+}
+
+func NewMboxSender(name, fromEmailAddress, path string) (EmailSender, error) {
+	if path == "" {
+		return nil, fmt.Errorf("mbox path is required")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mbox directory: %w", err)
+	}
+
+	return &MboxSender{
+		name:             name,
+		fromEmailAddress: fromEmailAddress,
+		path:             path,
+	}, nil
+}
+
+func (m *MboxSender) SendEmail(
+	subject string,
+	content string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	return m.SendEmailWithText(subject, content, "", to, cc, bcc, attachFiles)
+}
+
+func (m *MboxSender) SendEmailWithText(
+	subject string,
+	content string,
+	text string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	e, err := newEmail(m.name, m.fromEmailAddress, subject, content, text, to, cc, bcc, attachFiles)
+	if err != nil {
+		return err
+	}
+
+	raw, err := e.Bytes()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From %s %s\n", m.fromEmailAddress, time.Now().UTC().Format(time.ANSIC))
+	for _, line := range bytes.SplitAfter(bytes.ReplaceAll(raw, []byte("\r\n"), []byte("\n")), []byte("\n")) {
+		// a body line that looks like a message separator is quoted
+		if bytes.HasPrefix(bytes.TrimLeft(line, ">"), []byte("From ")) {
+			buf.WriteByte('>')
+		}
+		buf.Write(line)
+	}
+	if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open mbox: %w", err)
+	}
+
+	_, err = f.Write(buf.Bytes())
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
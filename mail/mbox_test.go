@@ -0,0 +1,39 @@
+package mail
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMboxSender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail", "captured.mbox")
+
+	sender, err := NewMboxSender("Swift Bank", "bank@example.com", path)
+	require.NoError(t, err)
+
+	require.NoError(t, sender.SendEmail("First", "From the bank", []string{"a@example.com"}, nil, nil, nil))
+	require.NoError(t, sender.SendEmailWithText("Second", "<p>Hi</p>", "Hi in plain text", []string{"b@example.com"}, nil, nil, nil))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	mbox := string(content)
+	var separators int
+	for _, line := range strings.Split(mbox, "\n") {
+		if strings.HasPrefix(line, "From ") {
+			require.True(t, strings.HasPrefix(line, "From bank@example.com "), line)
+			separators++
+		}
+	}
+	require.Equal(t, 2, separators)
+	require.Contains(t, mbox, ">From the bank")
+	require.Contains(t, mbox, "Subject: First")
+	require.Contains(t, mbox, "Subject: Second")
+	require.Contains(t, mbox, "multipart/alternative")
+	require.Contains(t, mbox, "Hi in plain text")
+	require.NotContains(t, mbox, "\r\n")
+}
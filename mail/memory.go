@@ -0,0 +1,70 @@
+package mail
+
+import (
+	"slices"
+	"sync"
+)
+
+// Message is an email the MemorySender recorded
+type Message struct {
+	Subject     string
+	Content     string
+	Text        string
+	To          []string
+	Cc          []string
+	Bcc         []string
+	AttachFiles []string
+}
+
+// MemorySender records messages instead of sending them, for tests that check what was sent
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemorySender() *MemorySender {
+	return &MemorySender{}
+}
+
+func (m *MemorySender) SendEmail(
+	subject string,
+	content string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	return m.SendEmailWithText(subject, content, "", to, cc, bcc, attachFiles)
+}
+
+func (m *MemorySender) SendEmailWithText(
+	subject string,
+	content string,
+	text string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.messages = append(m.messages, Message{
+		Subject:     subject,
+		Content:     content,
+		Text:        text,
+		To:          slices.Clone(to),
+		Cc:          slices.Clone(cc),
+		Bcc:         slices.Clone(bcc),
+		AttachFiles: slices.Clone(attachFiles),
+	})
+	return nil
+}
+
+// Messages returns the messages recorded so far, oldest first
+func (m *MemorySender) Messages() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.messages)
+}
@@ -5,6 +5,7 @@ import (
 	"net/smtp"
 
 	"github.com/jordan-wright/email"
+	"github.com/zde37/Swift_Bank/config"
 )
 
 const (
@@ -12,6 +13,13 @@ const (
 	smtpServerAddress = "smtp.gmail.com:587"
 )
 
+// Transports a sender can be picked by with EMAIL_TRANSPORT
+const (
+	TransportGmail = "gmail"
+	TransportSMTP  = "smtp"
+	TransportMbox  = "mbox"
+)
+
 type EmailSender interface {
 	SendEmail(
 		subject string,
@@ -21,6 +29,36 @@ type EmailSender interface {
 		bcc []string,
 		attachFiles []string,
 	) error
+	// SendEmailWithText sends content as html with text as its plain text alternative
+	SendEmailWithText(
+		subject string,
+		content string,
+		text string,
+		to []string,
+		cc []string,
+		bcc []string,
+		attachFiles []string,
+	) error
+}
+
+// NewSenderFromConfig creates the sender for the configured transport, gmail when none is set
+func NewSenderFromConfig(c config.Config) (EmailSender, error) {
+	switch c.EmailTransport {
+	case "", TransportGmail:
+		return NewGmailSender(c.EmailSender, c.EmailAddress, c.EmailPassword), nil
+	case TransportSMTP:
+		return NewSMTPSender(c.EmailSender, c.EmailAddress, SMTPConfig{
+			Host:     c.SMTPHost,
+			Port:     c.SMTPPort,
+			TLSMode:  c.SMTPTLSMode,
+			Username: c.SMTPUsername,
+			Password: c.SMTPPassword,
+		})
+	case TransportMbox:
+		return NewMboxSender(c.EmailSender, c.EmailAddress, c.EmailMboxPath)
+	}
+
+	return nil, fmt.Errorf("unsupported email transport %q", c.EmailTransport)
 }
 
 type GmailSender struct {
@@ -45,10 +83,36 @@ func (g *GmailSender) SendEmail(
 	bcc []string,
 	attachFiles []string,
 ) error {
+	return g.SendEmailWithText(subject, content, "", to, cc, bcc, attachFiles)
+}
+
+func (g *GmailSender) SendEmailWithText(
+	subject string,
+	content string,
+	text string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	e, err := newEmail(g.name, g.fromEmailAddress, subject, content, text, to, cc, bcc, attachFiles)
+	if err != nil {
+		return err
+	}
+
+	smtpAuth := smtp.PlainAuth("", g.fromEmailAddress, g.fromEmailPassword, smtpAuthAddress)
+	return e.Send(smtpServerAddress, smtpAuth)
+}
+
+// newEmail builds the html message every sender sends or captures, with a plain text part when text is set
+func newEmail(name, from, subject, content, text string, to, cc, bcc, attachFiles []string) (*email.Email, error) {
 	e := email.NewEmail()
-	e.From = fmt.Sprintf("%s <%s>", g.name, g.fromEmailAddress)
+	e.From = fmt.Sprintf("%s <%s>", name, from)
 	e.Subject = subject
 	e.HTML = []byte(content)
+	if text != "" {
+		e.Text = []byte(text)
+	}
 	e.To = to
 	e.Cc = cc
 	e.Bcc = bcc
@@ -56,10 +120,9 @@ func (g *GmailSender) SendEmail(
 	for _, f := range attachFiles {
 		_, err := e.AttachFile(f)
 		if err != nil {
-			return fmt.Errorf("failed to attach file %s: %w", f, err)
+			return nil, fmt.Errorf("failed to attach file %s: %w", f, err)
 		}
 	}
 
-	smtpAuth := smtp.PlainAuth("", g.fromEmailAddress, g.fromEmailPassword, smtpAuthAddress)
-	return e.Send(smtpServerAddress, smtpAuth)
+	return e, nil
 }
@@ -13,6 +13,9 @@ func TestSendEmail(t *testing.T) {
 	}
 	config, err := config.LoadConfig("..")
 	require.NoError(t, err)
+	if config.EmailAddress == "" || config.EmailPassword == "" {
+		t.Skip("no gmail account configured")
+	}
 
 	sender := NewGmailSender(config.EmailSender, config.EmailAddress, config.EmailPassword)
 
@@ -27,3 +30,35 @@ func TestSendEmail(t *testing.T) {
 	err = sender.SendEmail(subject, content, to, nil, nil, attachFiles)
 	require.NoError(t, err)
 }
+
+func TestNewSenderFromConfig(t *testing.T) {
+	sender, err := NewSenderFromConfig(config.Config{EmailTransport: TransportMbox, EmailMboxPath: t.TempDir() + "/mail.mbox"})
+	require.NoError(t, err)
+	require.IsType(t, &MboxSender{}, sender)
+
+	sender, err = NewSenderFromConfig(config.Config{EmailTransport: TransportSMTP, SMTPHost: "localhost", SMTPPort: 1025, SMTPTLSMode: TLSModeNone})
+	require.NoError(t, err)
+	require.IsType(t, &SMTPSender{}, sender)
+
+	sender, err = NewSenderFromConfig(config.Config{})
+	require.NoError(t, err)
+	require.IsType(t, &GmailSender{}, sender)
+
+	_, err = NewSenderFromConfig(config.Config{EmailTransport: "pigeon"})
+	require.Error(t, err)
+}
+
+func TestMemorySender(t *testing.T) {
+	sender := NewMemorySender()
+
+	to := []string{"a@example.com"}
+	require.NoError(t, sender.SendEmail("Hello", "<p>Hi</p>", to, nil, nil, nil))
+	to[0] = "changed@example.com"
+
+	require.NoError(t, sender.SendEmailWithText("Again", "<p>Hi</p>", "Hi", to, nil, nil, nil))
+
+	require.Equal(t, []Message{
+		{Subject: "Hello", Content: "<p>Hi</p>", To: []string{"a@example.com"}},
+		{Subject: "Again", Content: "<p>Hi</p>", Text: "Hi", To: []string{"changed@example.com"}},
+	}, sender.Messages())
+}
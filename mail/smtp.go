@@ -0,0 +1,146 @@
+package mail
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+
+	"github.com/jordan-wright/email"
+)
+
+// TLS modes of the SMTP sender
+const (
+	TLSModeStartTLS = "starttls" // upgrade the plain connection, the server must support it
+	TLSModeTLS      = "tls"      // implicit tls, usually on port 465
+	TLSModeNone     = "none"     // plain text, only meant for local relays and mail catchers
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	TLSMode  string
+	Username string // no authentication when empty
+	Password string
+}
+
+// SMTPSender sends through any SMTP server
+type SMTPSender struct {
+	name             string
+	fromEmailAddress string
+	config           SMTPConfig
+}
+
+func NewSMTPSender(name, fromEmailAddress string, config SMTPConfig) (EmailSender, error) {
+	if config.Host == "" {
+		return nil, fmt.Errorf("smtp host is required")
+	}
+
+	if config.Port < 1 || config.Port > 65535 {
+		return nil, fmt.Errorf("smtp port %d is out of range", config.Port)
+	}
+
+	switch config.TLSMode {
+	case TLSModeStartTLS, TLSModeTLS, TLSModeNone:
+	default:
+		return nil, fmt.Errorf("unsupported smtp tls mode %q", config.TLSMode)
+	}
+
+	return &SMTPSender{
+		name:             name,
+		fromEmailAddress: fromEmailAddress,
+		config:           config,
+	}, nil
+}
+
+func (s *SMTPSender) SendEmail(
+	subject string,
+	content string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	return s.SendEmailWithText(subject, content, "", to, cc, bcc, attachFiles)
+}
+
+func (s *SMTPSender) SendEmailWithText(
+	subject string,
+	content string,
+	text string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	e, err := newEmail(s.name, s.fromEmailAddress, subject, content, text, to, cc, bcc, attachFiles)
+	if err != nil {
+		return err
+	}
+
+	address := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+
+	var auth smtp.Auth
+	if s.config.Username != "" {
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+	}
+
+	tlsConfig := &tls.Config{ServerName: s.config.Host}
+	switch s.config.TLSMode {
+	case TLSModeTLS:
+		return e.SendWithTLS(address, auth, tlsConfig)
+	case TLSModeStartTLS:
+		return e.SendWithStartTLS(address, auth, tlsConfig)
+	}
+
+	return sendPlain(address, auth, s.fromEmailAddress, e)
+}
+
+// sendPlain delivers the message without ever upgrading the connection, smtp.SendMail would switch to tls
+// whenever the server offers it
+func sendPlain(address string, auth smtp.Auth, from string, e *email.Email) error {
+	raw, err := e.Bytes()
+	if err != nil {
+		return err
+	}
+
+	c, err := smtp.Dial(address)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if auth != nil {
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+
+	for _, recipients := range [][]string{e.To, e.Cc, e.Bcc} {
+		for _, rcpt := range recipients {
+			if err := c.Rcpt(rcpt); err != nil {
+				return err
+			}
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(raw); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
@@ -0,0 +1,100 @@
+package mail
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer accepts a single session and returns what the client sent
+func fakeSMTPServer(t *testing.T) (port int, session <-chan []string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	lines := make(chan []string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var received []string
+		r := bufio.NewReader(conn)
+		reply := func(s string) { conn.Write([]byte(s + "\r\n")) }
+
+		reply("220 localhost ready")
+		inData := false
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				break
+			}
+			line = strings.TrimRight(line, "\r\n")
+			received = append(received, line)
+
+			switch {
+			case inData && line == ".":
+				inData = false
+				reply("250 queued")
+			case inData:
+			case strings.HasPrefix(line, "EHLO"):
+				reply("250-localhost")
+				reply("250 AUTH PLAIN")
+			case strings.HasPrefix(line, "AUTH"):
+				reply("235 authenticated")
+			case line == "DATA":
+				inData = true
+				reply("354 go ahead")
+			case line == "QUIT":
+				reply("221 bye")
+				lines <- received
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+		lines <- received
+	}()
+
+	return listener.Addr().(*net.TCPAddr).Port, lines
+}
+
+func TestSMTPSender(t *testing.T) {
+	port, session := fakeSMTPServer(t)
+
+	sender, err := NewSMTPSender("Swift Bank", "bank@example.com", SMTPConfig{
+		Host:     "127.0.0.1",
+		Port:     port,
+		TLSMode:  TLSModeNone,
+		Username: "bank",
+		Password: "secret",
+	})
+	require.NoError(t, err)
+
+	err = sender.SendEmail("Hello", "<p>Hi</p>", []string{"a@example.com"}, nil, []string{"b@example.com"}, nil)
+	require.NoError(t, err)
+
+	received := strings.Join(<-session, "\n")
+	require.Contains(t, received, "AUTH PLAIN")
+	require.Contains(t, received, "MAIL FROM:<bank@example.com>")
+	require.Contains(t, received, "RCPT TO:<a@example.com>")
+	require.Contains(t, received, "RCPT TO:<b@example.com>")
+	require.Contains(t, received, "Subject: Hello")
+	require.NotContains(t, received, "STARTTLS")
+}
+
+func TestNewSMTPSenderValidatesConfig(t *testing.T) {
+	for _, config := range []SMTPConfig{
+		{Port: 25, TLSMode: TLSModeNone},
+		{Host: "localhost", TLSMode: TLSModeNone},
+		{Host: "localhost", Port: 25, TLSMode: "ssl"},
+	} {
+		_, err := NewSMTPSender("Swift Bank", "bank@example.com", config)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,143 @@
+package templates
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// VerifyEmailData is rendered by the verify_email template
+type VerifyEmailData struct {
+	FullName   string
+	EmailID    int64
+	SecretCode string
+}
+
+// StatementData is rendered by the statement template
+type StatementData struct {
+	FullName      string
+	AccountNumber string
+	Period        time.Time
+}
+
+// AlertData is rendered by the alert template, Kind is one of the models.AlertKind values
+type AlertData struct {
+	FullName      string
+	Kind          string
+	AccountNumber string
+	TransactionID int64
+	Amount        float64
+	Balance       float64
+	Threshold     float64
+	Currency      string
+	Incoming      bool
+}
+
+// DisputeUpdateData is rendered by the dispute_update template, Status is one of the models.DisputeStatus values
+type DisputeUpdateData struct {
+	FullName      string
+	DisputeID     int64
+	TransactionID int64
+	Status        string
+	Amount        float64
+	Currency      string
+	Comment       string
+}
+
+// TransferNoticeData is rendered by the transfer_notice template, Kind is debit or credit
+type TransferNoticeData struct {
+	FullName      string
+	Kind          string
+	AccountNumber string
+	TransactionID int64
+	Amount        float64
+	Fee           float64
+	Currency      string
+	Description   string
+	Balance       float64
+}
+
+// samples is the data each template is previewed with
+var samples = map[string]any{
+	VerifyEmail: VerifyEmailData{
+		FullName:   "Jane Doe",
+		EmailID:    1,
+		SecretCode: "preview-secret-code",
+	},
+	Statement: StatementData{
+		FullName:      "Jane Doe",
+		AccountNumber: "1000000000000001",
+		Period:        time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+	},
+	Alert: AlertData{
+		FullName:      "Jane Doe",
+		Kind:          "large_transaction",
+		AccountNumber: "1000000000000001",
+		TransactionID: 42,
+		Amount:        2500,
+		Balance:       1200.5,
+		Threshold:     1000,
+		Currency:      "USD",
+		Incoming:      true,
+	},
+	DisputeUpdate: DisputeUpdateData{
+		FullName:      "Jane Doe",
+		DisputeID:     7,
+		TransactionID: 42,
+		Status:        "open",
+		Amount:        25,
+		Currency:      "USD",
+		Comment:       "We have asked the merchant for a receipt.",
+	},
+	TransferNotice: TransferNoticeData{
+		FullName:      "Jane Doe",
+		Kind:          "debit",
+		AccountNumber: "1000000000000001",
+		TransactionID: 42,
+		Amount:        150,
+		Fee:           1.5,
+		Currency:      "USD",
+		Description:   "Rent for May",
+		Balance:       848.5,
+	},
+}
+
+// Names lists the templates that can be previewed
+func Names() []string {
+	names := make([]string, 0, len(samples))
+	for name := range samples {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+// Preview renders the named template with sample data
+func (r *Renderer) Preview(name, locale string) (Rendered, error) {
+	data, ok := samples[name]
+	if !ok {
+		return Rendered{}, fmt.Errorf("%w %q", ErrUnknownTemplate, name)
+	}
+
+	return r.Render(name, locale, data)
+}
+
+// Check previews every template in every locale, so a broken template is caught at startup rather than when
+// the first email goes out
+func (r *Renderer) Check() error {
+	locales, err := r.Locales()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range Names() {
+		for _, locale := range locales {
+			if _, err := r.Preview(name, locale); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package templates
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Names of the emails the worker sends
+const (
+	VerifyEmail    = "verify_email"
+	Statement      = "statement"
+	Alert          = "alert"
+	DisputeUpdate  = "dispute_update"
+	TransferNotice = "transfer_notice"
+)
+
+// DefaultLocale is used when neither the user's locale nor the configured default has the template
+const DefaultLocale = "en"
+
+var ErrUnknownTemplate = errors.New("unknown email template")
+
+//go:embed files
+var embedded embed.FS
+
+// Rendered is an email ready to be sent, Locale is the locale the template was found in
+type Rendered struct {
+	Locale  string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Renderer renders the emails from <locale>/<name>.html.tmpl and <locale>/<name>.txt.tmpl, where the text
+// template also defines the "subject". Templates in the override directory take precedence over the embedded
+// ones and are read on every render, so they can be edited without a restart.
+type Renderer struct {
+	sources       []fs.FS
+	baseURL       string
+	defaultLocale string
+}
+
+// NewRenderer creates a renderer that builds links from baseURL. dir may be empty to use the embedded
+// templates only.
+func NewRenderer(dir, baseURL, defaultLocale string) (*Renderer, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid base url %q", baseURL)
+	}
+
+	files, err := fs.Sub(embedded, "files")
+	if err != nil {
+		return nil, err
+	}
+
+	sources := []fs.FS{files}
+	if dir != "" {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open template directory: %w", err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("template directory %s is not a directory", dir)
+		}
+		sources = append([]fs.FS{os.DirFS(dir)}, sources...)
+	}
+
+	if defaultLocale == "" {
+		defaultLocale = DefaultLocale
+	}
+
+	return &Renderer{
+		sources:       sources,
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		defaultLocale: normalizeLocale(defaultLocale),
+	}, nil
+}
+
+// Render renders the named email in the closest locale that has it, fr-CA falls back to fr, then to the
+// default locale and then to en
+func (r *Renderer) Render(name, locale string, data any) (Rendered, error) {
+	for _, l := range r.localeChain(locale) {
+		htmlSource, ok, err := r.read(l, name+".html.tmpl")
+		if err != nil {
+			return Rendered{}, err
+		}
+		if !ok {
+			continue
+		}
+
+		textSource, ok, err := r.read(l, name+".txt.tmpl")
+		if err != nil {
+			return Rendered{}, err
+		}
+		if !ok {
+			return Rendered{}, fmt.Errorf("template %s/%s has no plain text version", l, name)
+		}
+
+		return r.render(name, l, htmlSource, textSource, data)
+	}
+
+	return Rendered{}, fmt.Errorf("%w %q", ErrUnknownTemplate, name)
+}
+
+// Locales lists the locales that have at least one template
+func (r *Renderer) Locales() ([]string, error) {
+	var locales []string
+	for _, source := range r.sources {
+		entries, err := fs.ReadDir(source, ".")
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() && !slices.Contains(locales, entry.Name()) {
+				locales = append(locales, entry.Name())
+			}
+		}
+	}
+
+	slices.Sort(locales)
+	return locales, nil
+}
+
+func (r *Renderer) render(name, locale, htmlSource, textSource string, data any) (Rendered, error) {
+	funcs := map[string]any{
+		"url":   r.url,
+		"money": money,
+	}
+
+	text, err := texttemplate.New(name).Option("missingkey=error").Funcs(funcs).Parse(textSource)
+	if err != nil {
+		return Rendered{}, fmt.Errorf("failed to parse template %s/%s: %w", locale, name, err)
+	}
+	if text.Lookup("subject") == nil {
+		return Rendered{}, fmt.Errorf("template %s/%s doesn't define a subject", locale, name)
+	}
+
+	html, err := htmltemplate.New(name).Option("missingkey=error").Funcs(funcs).Parse(htmlSource)
+	if err != nil {
+		return Rendered{}, fmt.Errorf("failed to parse template %s/%s: %w", locale, name, err)
+	}
+
+	var subject, textBody, htmlBody bytes.Buffer
+	if err = text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Rendered{}, fmt.Errorf("failed to render subject of %s/%s: %w", locale, name, err)
+	}
+	if err = text.Execute(&textBody, data); err != nil {
+		return Rendered{}, fmt.Errorf("failed to render template %s/%s: %w", locale, name, err)
+	}
+	if err = html.Execute(&htmlBody, data); err != nil {
+		return Rendered{}, fmt.Errorf("failed to render template %s/%s: %w", locale, name, err)
+	}
+
+	return Rendered{
+		Locale:  locale,
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		HTML:    strings.TrimSpace(htmlBody.String()) + "\n",
+		Text:    strings.TrimSpace(textBody.String()) + "\n",
+	}, nil
+}
+
+// read returns the file from the first source that has it
+func (r *Renderer) read(locale, file string) (string, bool, error) {
+	for _, source := range r.sources {
+		content, err := fs.ReadFile(source, path.Join(locale, file))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", false, fmt.Errorf("failed to read template %s/%s: %w", locale, file, err)
+		}
+		return string(content), true, nil
+	}
+
+	return "", false, nil
+}
+
+func (r *Renderer) localeChain(locale string) []string {
+	var chain []string
+	add := func(l string) {
+		for l != "" {
+			if !slices.Contains(chain, l) {
+				chain = append(chain, l)
+			}
+			i := strings.LastIndex(l, "-")
+			if i < 0 {
+				break
+			}
+			l = l[:i]
+		}
+	}
+
+	add(normalizeLocale(locale))
+	add(r.defaultLocale)
+	add(DefaultLocale)
+	return chain
+}
+
+// url builds an absolute link to path on the configured base url, followed by query key and value pairs
+func (r *Renderer) url(p string, query ...any) (string, error) {
+	if len(query)%2 != 0 {
+		return "", fmt.Errorf("url %s needs a value for every query key", p)
+	}
+
+	values := url.Values{}
+	for i := 0; i < len(query); i += 2 {
+		values.Add(fmt.Sprint(query[i]), fmt.Sprint(query[i+1]))
+	}
+
+	link := r.baseURL + "/" + strings.TrimLeft(p, "/")
+	if len(values) > 0 {
+		link += "?" + values.Encode()
+	}
+	return link, nil
+}
+
+func money(amount float64, currency string) string {
+	return fmt.Sprintf("%.2f %s", amount, currency)
+}
+
+// normalizeLocale turns fr_CA and FR-ca into fr-ca, the form the template directories use
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
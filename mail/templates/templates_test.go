@@ -0,0 +1,190 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	r, err := NewRenderer("", "https://bank.example.com/", "")
+	require.NoError(t, err)
+
+	data := VerifyEmailData{FullName: "Jane <Doe>", EmailID: 12, SecretCode: "a&b"}
+
+	email, err := r.Render(VerifyEmail, "en", data)
+	require.NoError(t, err)
+	require.Equal(t, "en", email.Locale)
+	require.Equal(t, "Welcome to Swift Bank", email.Subject)
+	require.Contains(t, email.HTML, "Hello Jane &lt;Doe&gt;")
+	require.Contains(t, email.HTML, `href="https://bank.example.com/sb/api/v1/verify_email?email_id=12&amp;secret_code=a%26b"`)
+	require.Contains(t, email.Text, "Hello Jane <Doe>")
+	require.Contains(t, email.Text, "https://bank.example.com/sb/api/v1/verify_email?email_id=12&secret_code=a%26b")
+	require.NotContains(t, email.Text, "subject")
+
+	// regional variants fall back to the language, unknown locales to the default
+	email, err = r.Render(VerifyEmail, "fr_CA", data)
+	require.NoError(t, err)
+	require.Equal(t, "fr", email.Locale)
+	require.Equal(t, "Bienvenue chez Swift Bank", email.Subject)
+
+	email, err = r.Render(VerifyEmail, "de", data)
+	require.NoError(t, err)
+	require.Equal(t, "en", email.Locale)
+
+	r, err = NewRenderer("", "https://bank.example.com", "fr")
+	require.NoError(t, err)
+	email, err = r.Render(VerifyEmail, "", data)
+	require.NoError(t, err)
+	require.Equal(t, "fr", email.Locale)
+
+	_, err = r.Render("missing", "en", data)
+	require.ErrorIs(t, err, ErrUnknownTemplate)
+
+	_, err = r.Render(VerifyEmail, "en", StatementData{})
+	require.Error(t, err)
+
+	_, err = NewRenderer("", "localhost:8080", "")
+	require.Error(t, err)
+	_, err = NewRenderer(filepath.Join(t.TempDir(), "missing"), "https://bank.example.com", "")
+	require.Error(t, err)
+}
+
+func TestRenderOverride(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "en"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "de"), 0o755))
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	write("en/statement.html.tmpl", `<p>Statement {{.AccountNumber}}</p>`)
+	write("de/statement.html.tmpl", `<p>Kontoauszug {{.AccountNumber}}</p>`)
+	write("de/statement.txt.tmpl", `{{define "subject"}}Kontoauszug{{end}}Kontoauszug {{.AccountNumber}}`)
+
+	r, err := NewRenderer(dir, "https://bank.example.com", "")
+	require.NoError(t, err)
+
+	data := StatementData{FullName: "Jane Doe", AccountNumber: "1000000000000003"}
+
+	// the override replaces the html and the embedded plain text version is kept
+	email, err := r.Render(Statement, "en", data)
+	require.NoError(t, err)
+	require.Equal(t, "<p>Statement 1000000000000003</p>\n", email.HTML)
+	require.Contains(t, email.Text, "Your statement for account 1000000000000003")
+
+	email, err = r.Render(Statement, "de", data)
+	require.NoError(t, err)
+	require.Equal(t, "de", email.Locale)
+	require.Equal(t, "Kontoauszug", email.Subject)
+
+	// the override is read on every render
+	write("de/statement.txt.tmpl", `{{define "subject"}}Ihr Kontoauszug{{end}}Kontoauszug {{.AccountNumber}}`)
+	email, err = r.Render(Statement, "de", data)
+	require.NoError(t, err)
+	require.Equal(t, "Ihr Kontoauszug", email.Subject)
+
+	locales, err := r.Locales()
+	require.NoError(t, err)
+	require.Equal(t, []string{"de", "en", "fr"}, locales)
+	require.NoError(t, r.Check())
+
+	write("de/statement.txt.tmpl", `Kontoauszug {{.AccountNumber}}`)
+	_, err = r.Render(Statement, "de", data)
+	require.Error(t, err)
+	require.Error(t, r.Check())
+}
+
+func TestPreview(t *testing.T) {
+	r, err := NewRenderer("", "https://bank.example.com", "")
+	require.NoError(t, err)
+	require.NoError(t, r.Check())
+
+	require.Equal(t, []string{Alert, DisputeUpdate, Statement, TransferNotice, VerifyEmail}, Names())
+
+	for _, name := range Names() {
+		for _, locale := range []string{"en", "fr"} {
+			email, err := r.Preview(name, locale)
+			require.NoError(t, err)
+			require.Equal(t, locale, email.Locale)
+			require.NotEmpty(t, email.Subject)
+			require.NotEmpty(t, email.HTML)
+			require.NotEmpty(t, email.Text)
+		}
+	}
+
+	email, err := r.Preview(DisputeUpdate, "en")
+	require.NoError(t, err)
+	require.Equal(t, "Dispute 7 opened", email.Subject)
+	require.Contains(t, email.Text, "Comment: We have asked the merchant for a receipt.")
+
+	_, err = r.Preview("missing", "en")
+	require.ErrorIs(t, err, ErrUnknownTemplate)
+}
+
+func TestRenderAlert(t *testing.T) {
+	r, err := NewRenderer("", "https://bank.example.com", "")
+	require.NoError(t, err)
+
+	email, err := r.Render(Alert, "en", AlertData{
+		FullName:      "Jane Doe",
+		Kind:          "low_balance",
+		AccountNumber: "1000000000000001",
+		Balance:       20,
+		Threshold:     50,
+		Currency:      "USD",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Low balance on account 1000000000000001", email.Subject)
+	require.Contains(t, email.Text, "is now 20.00 USD, below your alert threshold of 50.00 USD.")
+
+	email, err = r.Render(Alert, "en", AlertData{
+		FullName:      "Jane Doe",
+		Kind:          "large_transaction",
+		AccountNumber: "1000000000000001",
+		TransactionID: 9,
+		Amount:        5000,
+		Balance:       100,
+		Currency:      "EUR",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Large transfer on account 1000000000000001", email.Subject)
+	require.Contains(t, email.HTML, "A transfer of 5000.00 EUR just left account 1000000000000001 (transaction 9).")
+}
+
+func TestRenderTransferNotice(t *testing.T) {
+	r, err := NewRenderer("", "https://bank.example.com", "")
+	require.NoError(t, err)
+
+	data := TransferNoticeData{
+		FullName:      "Jane Doe",
+		Kind:          "credit",
+		AccountNumber: "1000000000000002",
+		TransactionID: 9,
+		Amount:        75,
+		Fee:           1,
+		Currency:      "EUR",
+		Balance:       175,
+	}
+
+	email, err := r.Render(TransferNotice, "en", data)
+	require.NoError(t, err)
+	require.Equal(t, "75.00 EUR received in account 1000000000000002", email.Subject)
+	require.Contains(t, email.Text, "arrived in account 1000000000000002 (transaction 9)")
+	require.Contains(t, email.Text, "The balance is now 175.00 EUR.")
+	require.NotContains(t, email.Text, "fee")
+	require.NotContains(t, email.Text, "Description")
+
+	data.Kind = "debit"
+	data.AccountNumber = "1000000000000001"
+	data.Balance = 24
+	data.Description = "Dinner"
+	email, err = r.Render(TransferNotice, "en", data)
+	require.NoError(t, err)
+	require.Equal(t, "75.00 EUR sent from account 1000000000000001", email.Subject)
+	require.Contains(t, email.HTML, "A fee of 1.00 EUR was charged for it.")
+	require.Contains(t, email.HTML, "<p>Description: Dinner</p>")
+	require.Contains(t, email.HTML, "The balance is now 24.00 EUR.")
+}
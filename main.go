@@ -16,14 +16,18 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/zde37/Swift_Bank/api"
+	"github.com/zde37/Swift_Bank/blob"
 	"github.com/zde37/Swift_Bank/config"
 	"github.com/zde37/Swift_Bank/database"
 	_ "github.com/zde37/Swift_Bank/doc/statik"
 	"github.com/zde37/Swift_Bank/gapi"
+	"github.com/zde37/Swift_Bank/glexport"
 	"github.com/zde37/Swift_Bank/mail"
+	"github.com/zde37/Swift_Bank/mail/templates"
 	"github.com/zde37/Swift_Bank/pb"
 	"github.com/zde37/Swift_Bank/repository"
 	"github.com/zde37/Swift_Bank/service"
+	"github.com/zde37/Swift_Bank/sms"
 	"github.com/zde37/Swift_Bank/worker"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -57,20 +61,39 @@ func main() {
 
 	runDBMigration(config.MigrationURL, config.Dsn)
 
-	repository := repository.NewRepository(pool)
-	service := service.NewService(repository.R)
-
 	redisOpt := asynq.RedisClientOpt{
 		Addr: config.RedisAddress,
 	}
 
 	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
 
-	go runTaskProcessor(redisOpt, repository.R, config)
-	go runGatewayServer(config, service.S, taskDistributor)
-	runGrpcServer(config, service.S, taskDistributor)
+	blobs, err := blob.NewLocalStore(config.BlobStoragePath)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create blob store")
+	}
+
+	if _, err := glexport.ParseLayout(config.GLFixedWidthLayout); err != nil {
+		log.Fatal().Err(err).Msg("invalid general ledger fixed width layout")
+	}
+
+	emailTemplates, err := templates.NewRenderer(config.EmailTemplateDir, config.AppBaseURL, config.EmailDefaultLocale)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load email templates")
+	}
+
+	if err = emailTemplates.Check(); err != nil {
+		log.Fatal().Err(err).Msg("invalid email template")
+	}
+
+	repository := repository.NewRepository(pool)
+	service := service.NewService(repository.R, taskDistributor, config, blobs)
+
+	go runTaskProcessor(redisOpt, repository.R, config, blobs, emailTemplates)
+	go runTaskScheduler(redisOpt)
+	go runGatewayServer(config, service.S, taskDistributor, emailTemplates)
+	runGrpcServer(config, service.S, taskDistributor, emailTemplates)
 
-	// runGinServer(config, pool)
+	// runGinServer(config, pool, taskDistributor, blobs)
 }
 
 func runDBMigration(migrationURL, dbSource string) {
@@ -86,17 +109,37 @@ func runDBMigration(migrationURL, dbSource string) {
 	log.Info().Msg("DB migrated successfully")
 }
 
-func runTaskProcessor(redisOpt asynq.RedisClientOpt, repo repository.RepositoryProvider, config config.Config)  {
-	mailer := mail.NewGmailSender(config.EmailSender, config.EmailAddress, config.EmailPassword)
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, repo, mailer)
+func runTaskProcessor(redisOpt asynq.RedisClientOpt, repo repository.RepositoryProvider, config config.Config, blobs blob.Store,
+	emailTemplates *templates.Renderer) {
+	mailer, err := mail.NewSenderFromConfig(config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create email sender")
+	}
+
+	texter, err := sms.NewSenderFromConfig(config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create sms sender")
+	}
+
+	gl := glexport.NewExporter(repo, blobs, config.GLExportFormat, config.GLFixedWidthLayout)
+	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, repo, mailer, texter, emailTemplates, gl)
 	log.Info().Msg("start task processor")
 	if err := taskProcessor.Start(); err != nil {
 		log.Fatal().Err(err).Msg("failed to start task processor")
 	}
 }
 
-func runGrpcServer(config config.Config, service service.ServiceProvider, taskDistributor worker.TaskDistributor) {
-	server, err := gapi.NewServer(config, service, taskDistributor)
+func runTaskScheduler(redisOpt asynq.RedisClientOpt) {
+	taskScheduler := worker.NewRedisTaskScheduler(redisOpt)
+	log.Info().Msg("start task scheduler")
+	if err := taskScheduler.Start(); err != nil {
+		log.Fatal().Err(err).Msg("failed to start task scheduler")
+	}
+}
+
+func runGrpcServer(config config.Config, service service.ServiceProvider, taskDistributor worker.TaskDistributor,
+	emailTemplates *templates.Renderer) {
+	server, err := gapi.NewServer(config, service, taskDistributor, emailTemplates)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create server")
 	}
@@ -118,19 +161,22 @@ func runGrpcServer(config config.Config, service service.ServiceProvider, taskDi
 	}
 }
 
-func runGatewayServer(config config.Config, service service.ServiceProvider, taskDistributor worker.TaskDistributor) {
-	server, err := gapi.NewServer(config, service, taskDistributor)
+func runGatewayServer(config config.Config, service service.ServiceProvider, taskDistributor worker.TaskDistributor,
+	emailTemplates *templates.Renderer) {
+	server, err := gapi.NewServer(config, service, taskDistributor, emailTemplates)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create server")
 	}
 
-	// set json response to use snake case
-	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-		MarshalOptions: protojson.MarshalOptions{
-			UseProtoNames: true,
-		},
-		UnmarshalOptions: protojson.UnmarshalOptions{
-			DiscardUnknown: true,
+	// set json response to use snake case, HttpBody responses such as csv downloads are written as is
+	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
+		Marshaler: &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
 		},
 	})
 	grpcMux := runtime.NewServeMux(jsonOption)
@@ -168,9 +214,9 @@ func runGatewayServer(config config.Config, service service.ServiceProvider, tas
 	}
 }
 
-func runGinServer(config config.Config, pool *pgxpool.Pool) {
+func runGinServer(config config.Config, pool *pgxpool.Pool, taskDistributor worker.TaskDistributor, blobs blob.Store) {
 	repository := repository.NewRepository(pool)
-	service := service.NewService(repository.R)
+	service := service.NewService(repository.R, taskDistributor, config, blobs)
 	handler, err := api.NewHandler(config, service.S)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to load handler")
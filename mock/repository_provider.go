@@ -12,6 +12,7 @@ package mockedproviders
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	models "github.com/zde37/Swift_Bank/models"
@@ -41,19 +42,109 @@ func (m *MockRepositoryProvider) EXPECT() *MockRepositoryProviderMockRecorder {
 	return m.recorder
 }
 
-// AddAccountBalance mocks base method.
-func (m *MockRepositoryProvider) AddAccountBalance(arg0 context.Context, arg1 int64, arg2 float64) (models.Account, error) {
+// AcceptAccountInvitation mocks base method.
+func (m *MockRepositoryProvider) AcceptAccountInvitation(arg0 context.Context, arg1 int64, arg2 string) (models.AccountHolder, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddAccountBalance", arg0, arg1, arg2)
-	ret0, _ := ret[0].(models.Account)
+	ret := m.ctrl.Call(m, "AcceptAccountInvitation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptAccountInvitation indicates an expected call of AcceptAccountInvitation.
+func (mr *MockRepositoryProviderMockRecorder) AcceptAccountInvitation(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptAccountInvitation", reflect.TypeOf((*MockRepositoryProvider)(nil).AcceptAccountInvitation), arg0, arg1, arg2)
+}
+
+// BatchTransferTx mocks base method.
+func (m *MockRepositoryProvider) BatchTransferTx(arg0 context.Context, arg1 models.BatchTransferTxParams) (models.BatchTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(models.BatchTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchTransferTx indicates an expected call of BatchTransferTx.
+func (mr *MockRepositoryProviderMockRecorder) BatchTransferTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchTransferTx", reflect.TypeOf((*MockRepositoryProvider)(nil).BatchTransferTx), arg0, arg1)
+}
+
+// CaptureHoldTx mocks base method.
+func (m *MockRepositoryProvider) CaptureHoldTx(arg0 context.Context, arg1 models.CaptureHoldParams) (models.CaptureHoldResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHoldTx", arg0, arg1)
+	ret0, _ := ret[0].(models.CaptureHoldResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHoldTx indicates an expected call of CaptureHoldTx.
+func (mr *MockRepositoryProviderMockRecorder) CaptureHoldTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHoldTx", reflect.TypeOf((*MockRepositoryProvider)(nil).CaptureHoldTx), arg0, arg1)
+}
+
+// ChargeOverdraftTx mocks base method.
+func (m *MockRepositoryProvider) ChargeOverdraftTx(arg0 context.Context, arg1 models.OverdraftCharge) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChargeOverdraftTx", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChargeOverdraftTx indicates an expected call of ChargeOverdraftTx.
+func (mr *MockRepositoryProviderMockRecorder) ChargeOverdraftTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChargeOverdraftTx", reflect.TypeOf((*MockRepositoryProvider)(nil).ChargeOverdraftTx), arg0, arg1)
+}
+
+// CloseAccountTx mocks base method.
+func (m *MockRepositoryProvider) CloseAccountTx(arg0 context.Context, arg1 models.CloseAccountTxParams) (models.CloseAccountTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAccountTx", arg0, arg1)
+	ret0, _ := ret[0].(models.CloseAccountTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAccountTx indicates an expected call of CloseAccountTx.
+func (mr *MockRepositoryProviderMockRecorder) CloseAccountTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccountTx", reflect.TypeOf((*MockRepositoryProvider)(nil).CloseAccountTx), arg0, arg1)
+}
+
+// CloseBusinessDayTx mocks base method.
+func (m *MockRepositoryProvider) CloseBusinessDayTx(arg0 context.Context, arg1 time.Time) ([]models.TrialBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseBusinessDayTx", arg0, arg1)
+	ret0, _ := ret[0].([]models.TrialBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseBusinessDayTx indicates an expected call of CloseBusinessDayTx.
+func (mr *MockRepositoryProviderMockRecorder) CloseBusinessDayTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseBusinessDayTx", reflect.TypeOf((*MockRepositoryProvider)(nil).CloseBusinessDayTx), arg0, arg1)
+}
+
+// ClosePocketTx mocks base method.
+func (m *MockRepositoryProvider) ClosePocketTx(arg0 context.Context, arg1 int64) (models.Pocket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosePocketTx", arg0, arg1)
+	ret0, _ := ret[0].(models.Pocket)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddAccountBalance indicates an expected call of AddAccountBalance.
-func (mr *MockRepositoryProviderMockRecorder) AddAccountBalance(arg0, arg1, arg2 any) *gomock.Call {
+// ClosePocketTx indicates an expected call of ClosePocketTx.
+func (mr *MockRepositoryProviderMockRecorder) ClosePocketTx(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockRepositoryProvider)(nil).AddAccountBalance), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePocketTx", reflect.TypeOf((*MockRepositoryProvider)(nil).ClosePocketTx), arg0, arg1)
 }
 
 // CreateAccount mocks base method.
@@ -71,228 +162,1454 @@ func (mr *MockRepositoryProviderMockRecorder) CreateAccount(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateAccount), arg0, arg1)
 }
 
-// CreateEntry mocks base method.
-func (m *MockRepositoryProvider) CreateEntry(arg0 context.Context, arg1 models.Entry) (models.Entry, error) {
+// CreateAlertRule mocks base method.
+func (m *MockRepositoryProvider) CreateAlertRule(arg0 context.Context, arg1 models.AlertRule) (models.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertRule", arg0, arg1)
+	ret0, _ := ret[0].(models.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlertRule indicates an expected call of CreateAlertRule.
+func (mr *MockRepositoryProviderMockRecorder) CreateAlertRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertRule", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateAlertRule), arg0, arg1)
+}
+
+// CreateBalanceSnapshots mocks base method.
+func (m *MockRepositoryProvider) CreateBalanceSnapshots(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBalanceSnapshots", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBalanceSnapshots indicates an expected call of CreateBalanceSnapshots.
+func (mr *MockRepositoryProviderMockRecorder) CreateBalanceSnapshots(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBalanceSnapshots", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateBalanceSnapshots), arg0, arg1)
+}
+
+// CreateBeneficiary mocks base method.
+func (m *MockRepositoryProvider) CreateBeneficiary(arg0 context.Context, arg1 models.Beneficiary) (models.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBeneficiary", arg0, arg1)
+	ret0, _ := ret[0].(models.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBeneficiary indicates an expected call of CreateBeneficiary.
+func (mr *MockRepositoryProviderMockRecorder) CreateBeneficiary(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBeneficiary", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateBeneficiary), arg0, arg1)
+}
+
+// CreateCategoryRule mocks base method.
+func (m *MockRepositoryProvider) CreateCategoryRule(arg0 context.Context, arg1 models.CategoryRule) (models.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategoryRule", arg0, arg1)
+	ret0, _ := ret[0].(models.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategoryRule indicates an expected call of CreateCategoryRule.
+func (mr *MockRepositoryProviderMockRecorder) CreateCategoryRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategoryRule", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateCategoryRule), arg0, arg1)
+}
+
+// CreateEntry mocks base method.
+func (m *MockRepositoryProvider) CreateEntry(arg0 context.Context, arg1 models.Entry) (models.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", arg0, arg1)
+	ret0, _ := ret[0].(models.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockRepositoryProviderMockRecorder) CreateEntry(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateEntry), arg0, arg1)
+}
+
+// CreateFraudScreening mocks base method.
+func (m *MockRepositoryProvider) CreateFraudScreening(arg0 context.Context, arg1 models.FraudScreening) (models.FraudScreening, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFraudScreening", arg0, arg1)
+	ret0, _ := ret[0].(models.FraudScreening)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFraudScreening indicates an expected call of CreateFraudScreening.
+func (mr *MockRepositoryProviderMockRecorder) CreateFraudScreening(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFraudScreening", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateFraudScreening), arg0, arg1)
+}
+
+// CreateGLExport mocks base method.
+func (m *MockRepositoryProvider) CreateGLExport(arg0 context.Context, arg1 models.GLExport) (models.GLExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGLExport", arg0, arg1)
+	ret0, _ := ret[0].(models.GLExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGLExport indicates an expected call of CreateGLExport.
+func (mr *MockRepositoryProviderMockRecorder) CreateGLExport(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGLExport", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateGLExport), arg0, arg1)
+}
+
+// CreateHoldTx mocks base method.
+func (m *MockRepositoryProvider) CreateHoldTx(arg0 context.Context, arg1 models.CreateHoldParams) (models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHoldTx", arg0, arg1)
+	ret0, _ := ret[0].(models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHoldTx indicates an expected call of CreateHoldTx.
+func (mr *MockRepositoryProviderMockRecorder) CreateHoldTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHoldTx", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateHoldTx), arg0, arg1)
+}
+
+// CreateInterestAccrual mocks base method.
+func (m *MockRepositoryProvider) CreateInterestAccrual(arg0 context.Context, arg1 models.InterestAccrual) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterestAccrual", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterestAccrual indicates an expected call of CreateInterestAccrual.
+func (mr *MockRepositoryProviderMockRecorder) CreateInterestAccrual(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterestAccrual", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateInterestAccrual), arg0, arg1)
+}
+
+// CreateOverdraftRequest mocks base method.
+func (m *MockRepositoryProvider) CreateOverdraftRequest(arg0 context.Context, arg1 models.OverdraftRequest) (models.OverdraftRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOverdraftRequest", arg0, arg1)
+	ret0, _ := ret[0].(models.OverdraftRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOverdraftRequest indicates an expected call of CreateOverdraftRequest.
+func (mr *MockRepositoryProviderMockRecorder) CreateOverdraftRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOverdraftRequest", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateOverdraftRequest), arg0, arg1)
+}
+
+// CreatePaymentAlias mocks base method.
+func (m *MockRepositoryProvider) CreatePaymentAlias(arg0 context.Context, arg1 models.PaymentAlias) (models.PaymentAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentAlias", arg0, arg1)
+	ret0, _ := ret[0].(models.PaymentAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentAlias indicates an expected call of CreatePaymentAlias.
+func (mr *MockRepositoryProviderMockRecorder) CreatePaymentAlias(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentAlias", reflect.TypeOf((*MockRepositoryProvider)(nil).CreatePaymentAlias), arg0, arg1)
+}
+
+// CreatePendingTransferTx mocks base method.
+func (m *MockRepositoryProvider) CreatePendingTransferTx(arg0 context.Context, arg1 models.CreatePendingTransferParams) (models.PendingTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePendingTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(models.PendingTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePendingTransferTx indicates an expected call of CreatePendingTransferTx.
+func (mr *MockRepositoryProviderMockRecorder) CreatePendingTransferTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePendingTransferTx", reflect.TypeOf((*MockRepositoryProvider)(nil).CreatePendingTransferTx), arg0, arg1)
+}
+
+// CreatePocket mocks base method.
+func (m *MockRepositoryProvider) CreatePocket(arg0 context.Context, arg1 models.Pocket) (models.Pocket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePocket", arg0, arg1)
+	ret0, _ := ret[0].(models.Pocket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePocket indicates an expected call of CreatePocket.
+func (mr *MockRepositoryProviderMockRecorder) CreatePocket(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePocket", reflect.TypeOf((*MockRepositoryProvider)(nil).CreatePocket), arg0, arg1)
+}
+
+// CreatePocketRule mocks base method.
+func (m *MockRepositoryProvider) CreatePocketRule(arg0 context.Context, arg1 models.PocketRule) (models.PocketRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePocketRule", arg0, arg1)
+	ret0, _ := ret[0].(models.PocketRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePocketRule indicates an expected call of CreatePocketRule.
+func (mr *MockRepositoryProviderMockRecorder) CreatePocketRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePocketRule", reflect.TypeOf((*MockRepositoryProvider)(nil).CreatePocketRule), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockRepositoryProvider) CreateSession(arg0 context.Context, arg1 models.Session) (models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockRepositoryProviderMockRecorder) CreateSession(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateSession), arg0, arg1)
+}
+
+// CreateStatementDelivery mocks base method.
+func (m *MockRepositoryProvider) CreateStatementDelivery(arg0 context.Context, arg1 models.StatementDelivery) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStatementDelivery", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStatementDelivery indicates an expected call of CreateStatementDelivery.
+func (mr *MockRepositoryProviderMockRecorder) CreateStatementDelivery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStatementDelivery", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateStatementDelivery), arg0, arg1)
+}
+
+// CreateTellerOperationTx mocks base method.
+func (m *MockRepositoryProvider) CreateTellerOperationTx(arg0 context.Context, arg1 models.CreateTellerOperationParams) (models.TellerOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTellerOperationTx", arg0, arg1)
+	ret0, _ := ret[0].(models.TellerOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTellerOperationTx indicates an expected call of CreateTellerOperationTx.
+func (mr *MockRepositoryProviderMockRecorder) CreateTellerOperationTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTellerOperationTx", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateTellerOperationTx), arg0, arg1)
+}
+
+// CreateTransaction mocks base method.
+func (m *MockRepositoryProvider) CreateTransaction(arg0 context.Context, arg1 models.Transaction) (models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransaction", arg0, arg1)
+	ret0, _ := ret[0].(models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransaction indicates an expected call of CreateTransaction.
+func (mr *MockRepositoryProviderMockRecorder) CreateTransaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateTransaction), arg0, arg1)
+}
+
+// CreateTransactionAttachment mocks base method.
+func (m *MockRepositoryProvider) CreateTransactionAttachment(arg0 context.Context, arg1 models.TransactionAttachment) (models.TransactionAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionAttachment", arg0, arg1)
+	ret0, _ := ret[0].(models.TransactionAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransactionAttachment indicates an expected call of CreateTransactionAttachment.
+func (mr *MockRepositoryProviderMockRecorder) CreateTransactionAttachment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionAttachment", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateTransactionAttachment), arg0, arg1)
+}
+
+// CreateUser mocks base method.
+func (m *MockRepositoryProvider) CreateUser(arg0 context.Context, arg1 models.User) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockRepositoryProviderMockRecorder) CreateUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateUser), arg0, arg1)
+}
+
+// CreateUserTx mocks base method.
+func (m *MockRepositoryProvider) CreateUserTx(arg0 context.Context, arg1 models.CreateUserTxParams) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserTx", arg0, arg1)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserTx indicates an expected call of CreateUserTx.
+func (mr *MockRepositoryProviderMockRecorder) CreateUserTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateUserTx), arg0, arg1)
+}
+
+// CreateVerifyEmail mocks base method.
+func (m *MockRepositoryProvider) CreateVerifyEmail(arg0 context.Context, arg1 models.VerifyEmails) (models.VerifyEmails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(models.VerifyEmails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVerifyEmail indicates an expected call of CreateVerifyEmail.
+func (mr *MockRepositoryProviderMockRecorder) CreateVerifyEmail(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerifyEmail", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateVerifyEmail), arg0, arg1)
+}
+
+// DecideOverdraftRequestTx mocks base method.
+func (m *MockRepositoryProvider) DecideOverdraftRequestTx(arg0 context.Context, arg1 models.DecideOverdraftRequestParams) (models.OverdraftRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideOverdraftRequestTx", arg0, arg1)
+	ret0, _ := ret[0].(models.OverdraftRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideOverdraftRequestTx indicates an expected call of DecideOverdraftRequestTx.
+func (mr *MockRepositoryProviderMockRecorder) DecideOverdraftRequestTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideOverdraftRequestTx", reflect.TypeOf((*MockRepositoryProvider)(nil).DecideOverdraftRequestTx), arg0, arg1)
+}
+
+// DecidePendingTransferTx mocks base method.
+func (m *MockRepositoryProvider) DecidePendingTransferTx(arg0 context.Context, arg1 models.DecidePendingTransferParams) (models.DecidePendingTransferResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecidePendingTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(models.DecidePendingTransferResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecidePendingTransferTx indicates an expected call of DecidePendingTransferTx.
+func (mr *MockRepositoryProviderMockRecorder) DecidePendingTransferTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecidePendingTransferTx", reflect.TypeOf((*MockRepositoryProvider)(nil).DecidePendingTransferTx), arg0, arg1)
+}
+
+// DecideTellerOperationTx mocks base method.
+func (m *MockRepositoryProvider) DecideTellerOperationTx(arg0 context.Context, arg1 models.DecideTellerOperationParams) (models.TellerOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideTellerOperationTx", arg0, arg1)
+	ret0, _ := ret[0].(models.TellerOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideTellerOperationTx indicates an expected call of DecideTellerOperationTx.
+func (mr *MockRepositoryProviderMockRecorder) DecideTellerOperationTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideTellerOperationTx", reflect.TypeOf((*MockRepositoryProvider)(nil).DecideTellerOperationTx), arg0, arg1)
+}
+
+// DeleteAlertRule mocks base method.
+func (m *MockRepositoryProvider) DeleteAlertRule(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertRule indicates an expected call of DeleteAlertRule.
+func (mr *MockRepositoryProviderMockRecorder) DeleteAlertRule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertRule", reflect.TypeOf((*MockRepositoryProvider)(nil).DeleteAlertRule), arg0, arg1, arg2)
+}
+
+// DeleteBeneficiary mocks base method.
+func (m *MockRepositoryProvider) DeleteBeneficiary(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBeneficiary", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBeneficiary indicates an expected call of DeleteBeneficiary.
+func (mr *MockRepositoryProviderMockRecorder) DeleteBeneficiary(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBeneficiary", reflect.TypeOf((*MockRepositoryProvider)(nil).DeleteBeneficiary), arg0, arg1, arg2)
+}
+
+// DeleteCategoryRule mocks base method.
+func (m *MockRepositoryProvider) DeleteCategoryRule(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategoryRule indicates an expected call of DeleteCategoryRule.
+func (mr *MockRepositoryProviderMockRecorder) DeleteCategoryRule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryRule", reflect.TypeOf((*MockRepositoryProvider)(nil).DeleteCategoryRule), arg0, arg1, arg2)
+}
+
+// DeletePaymentAlias mocks base method.
+func (m *MockRepositoryProvider) DeletePaymentAlias(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePaymentAlias", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePaymentAlias indicates an expected call of DeletePaymentAlias.
+func (mr *MockRepositoryProviderMockRecorder) DeletePaymentAlias(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePaymentAlias", reflect.TypeOf((*MockRepositoryProvider)(nil).DeletePaymentAlias), arg0, arg1, arg2)
+}
+
+// DeletePocketRule mocks base method.
+func (m *MockRepositoryProvider) DeletePocketRule(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePocketRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePocketRule indicates an expected call of DeletePocketRule.
+func (mr *MockRepositoryProviderMockRecorder) DeletePocketRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePocketRule", reflect.TypeOf((*MockRepositoryProvider)(nil).DeletePocketRule), arg0, arg1)
+}
+
+// DeleteTransactionAttachment mocks base method.
+func (m *MockRepositoryProvider) DeleteTransactionAttachment(arg0 context.Context, arg1 int64, arg2 string) (models.TransactionAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransactionAttachment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.TransactionAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTransactionAttachment indicates an expected call of DeleteTransactionAttachment.
+func (mr *MockRepositoryProviderMockRecorder) DeleteTransactionAttachment(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransactionAttachment", reflect.TypeOf((*MockRepositoryProvider)(nil).DeleteTransactionAttachment), arg0, arg1, arg2)
+}
+
+// DisburseLoanTx mocks base method.
+func (m *MockRepositoryProvider) DisburseLoanTx(arg0 context.Context, arg1 models.DisburseLoanParams) (models.LoanSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisburseLoanTx", arg0, arg1)
+	ret0, _ := ret[0].(models.LoanSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisburseLoanTx indicates an expected call of DisburseLoanTx.
+func (mr *MockRepositoryProviderMockRecorder) DisburseLoanTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisburseLoanTx", reflect.TypeOf((*MockRepositoryProvider)(nil).DisburseLoanTx), arg0, arg1)
+}
+
+// ExpirePendingTransfers mocks base method.
+func (m *MockRepositoryProvider) ExpirePendingTransfers(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePendingTransfers", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePendingTransfers indicates an expected call of ExpirePendingTransfers.
+func (mr *MockRepositoryProviderMockRecorder) ExpirePendingTransfers(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePendingTransfers", reflect.TypeOf((*MockRepositoryProvider)(nil).ExpirePendingTransfers), arg0)
+}
+
+// GetAccount mocks base method.
+func (m *MockRepositoryProvider) GetAccount(arg0 context.Context, arg1 int64) (models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
+	ret0, _ := ret[0].(models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockRepositoryProviderMockRecorder) GetAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockRepositoryProvider)(nil).GetAccount), arg0, arg1)
+}
+
+// GetAccountByNumber mocks base method.
+func (m *MockRepositoryProvider) GetAccountByNumber(arg0 context.Context, arg1 string) (models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountByNumber", arg0, arg1)
+	ret0, _ := ret[0].(models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountByNumber indicates an expected call of GetAccountByNumber.
+func (mr *MockRepositoryProviderMockRecorder) GetAccountByNumber(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByNumber", reflect.TypeOf((*MockRepositoryProvider)(nil).GetAccountByNumber), arg0, arg1)
+}
+
+// GetAccountForUpdate mocks base method.
+func (m *MockRepositoryProvider) GetAccountForUpdate(arg0 context.Context, arg1 int64) (models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
+func (mr *MockRepositoryProviderMockRecorder) GetAccountForUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockRepositoryProvider)(nil).GetAccountForUpdate), arg0, arg1)
+}
+
+// GetAccountHolder mocks base method.
+func (m *MockRepositoryProvider) GetAccountHolder(arg0 context.Context, arg1 int64, arg2 string) (models.AccountHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountHolder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountHolder indicates an expected call of GetAccountHolder.
+func (mr *MockRepositoryProviderMockRecorder) GetAccountHolder(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountHolder", reflect.TypeOf((*MockRepositoryProvider)(nil).GetAccountHolder), arg0, arg1, arg2)
+}
+
+// GetAccountProduct mocks base method.
+func (m *MockRepositoryProvider) GetAccountProduct(arg0 context.Context, arg1 string) (models.AccountProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountProduct", arg0, arg1)
+	ret0, _ := ret[0].(models.AccountProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountProduct indicates an expected call of GetAccountProduct.
+func (mr *MockRepositoryProviderMockRecorder) GetAccountProduct(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountProduct", reflect.TypeOf((*MockRepositoryProvider)(nil).GetAccountProduct), arg0, arg1)
+}
+
+// GetBalanceAt mocks base method.
+func (m *MockRepositoryProvider) GetBalanceAt(arg0 context.Context, arg1 int64, arg2 time.Time) (models.BalanceAt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceAt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.BalanceAt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceAt indicates an expected call of GetBalanceAt.
+func (mr *MockRepositoryProviderMockRecorder) GetBalanceAt(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceAt", reflect.TypeOf((*MockRepositoryProvider)(nil).GetBalanceAt), arg0, arg1, arg2)
+}
+
+// GetBeneficiary mocks base method.
+func (m *MockRepositoryProvider) GetBeneficiary(arg0 context.Context, arg1 int64, arg2 string) (models.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBeneficiary", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBeneficiary indicates an expected call of GetBeneficiary.
+func (mr *MockRepositoryProviderMockRecorder) GetBeneficiary(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeneficiary", reflect.TypeOf((*MockRepositoryProvider)(nil).GetBeneficiary), arg0, arg1, arg2)
+}
+
+// GetBeneficiaryByAccount mocks base method.
+func (m *MockRepositoryProvider) GetBeneficiaryByAccount(arg0 context.Context, arg1 string, arg2 int64) (models.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBeneficiaryByAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBeneficiaryByAccount indicates an expected call of GetBeneficiaryByAccount.
+func (mr *MockRepositoryProviderMockRecorder) GetBeneficiaryByAccount(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeneficiaryByAccount", reflect.TypeOf((*MockRepositoryProvider)(nil).GetBeneficiaryByAccount), arg0, arg1, arg2)
+}
+
+// GetDispute mocks base method.
+func (m *MockRepositoryProvider) GetDispute(arg0 context.Context, arg1 int64) (models.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDispute", arg0, arg1)
+	ret0, _ := ret[0].(models.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDispute indicates an expected call of GetDispute.
+func (mr *MockRepositoryProviderMockRecorder) GetDispute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDispute", reflect.TypeOf((*MockRepositoryProvider)(nil).GetDispute), arg0, arg1)
+}
+
+// GetEntry mocks base method.
+func (m *MockRepositoryProvider) GetEntry(arg0 context.Context, arg1 int64) (models.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", arg0, arg1)
+	ret0, _ := ret[0].(models.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockRepositoryProviderMockRecorder) GetEntry(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockRepositoryProvider)(nil).GetEntry), arg0, arg1)
+}
+
+// GetFraudScreening mocks base method.
+func (m *MockRepositoryProvider) GetFraudScreening(arg0 context.Context, arg1 int64) (models.FraudScreening, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFraudScreening", arg0, arg1)
+	ret0, _ := ret[0].(models.FraudScreening)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFraudScreening indicates an expected call of GetFraudScreening.
+func (mr *MockRepositoryProviderMockRecorder) GetFraudScreening(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFraudScreening", reflect.TypeOf((*MockRepositoryProvider)(nil).GetFraudScreening), arg0, arg1)
+}
+
+// GetGLExport mocks base method.
+func (m *MockRepositoryProvider) GetGLExport(arg0 context.Context, arg1 int64) (models.GLExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGLExport", arg0, arg1)
+	ret0, _ := ret[0].(models.GLExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGLExport indicates an expected call of GetGLExport.
+func (mr *MockRepositoryProviderMockRecorder) GetGLExport(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGLExport", reflect.TypeOf((*MockRepositoryProvider)(nil).GetGLExport), arg0, arg1)
+}
+
+// GetGLExportByPeriod mocks base method.
+func (m *MockRepositoryProvider) GetGLExportByPeriod(arg0 context.Context, arg1, arg2 time.Time, arg3 string) (models.GLExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGLExportByPeriod", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(models.GLExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGLExportByPeriod indicates an expected call of GetGLExportByPeriod.
+func (mr *MockRepositoryProviderMockRecorder) GetGLExportByPeriod(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGLExportByPeriod", reflect.TypeOf((*MockRepositoryProvider)(nil).GetGLExportByPeriod), arg0, arg1, arg2, arg3)
+}
+
+// GetHold mocks base method.
+func (m *MockRepositoryProvider) GetHold(arg0 context.Context, arg1 int64) (models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", arg0, arg1)
+	ret0, _ := ret[0].(models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockRepositoryProviderMockRecorder) GetHold(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockRepositoryProvider)(nil).GetHold), arg0, arg1)
+}
+
+// GetJournal mocks base method.
+func (m *MockRepositoryProvider) GetJournal(arg0 context.Context, arg1, arg2 time.Time) ([]models.JournalLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJournal", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.JournalLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJournal indicates an expected call of GetJournal.
+func (mr *MockRepositoryProviderMockRecorder) GetJournal(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJournal", reflect.TypeOf((*MockRepositoryProvider)(nil).GetJournal), arg0, arg1, arg2)
+}
+
+// GetLastClosedBusinessDate mocks base method.
+func (m *MockRepositoryProvider) GetLastClosedBusinessDate(arg0 context.Context) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastClosedBusinessDate", arg0)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastClosedBusinessDate indicates an expected call of GetLastClosedBusinessDate.
+func (mr *MockRepositoryProviderMockRecorder) GetLastClosedBusinessDate(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastClosedBusinessDate", reflect.TypeOf((*MockRepositoryProvider)(nil).GetLastClosedBusinessDate), arg0)
+}
+
+// GetLastGLExportDate mocks base method.
+func (m *MockRepositoryProvider) GetLastGLExportDate(arg0 context.Context, arg1 string) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastGLExportDate", arg0, arg1)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastGLExportDate indicates an expected call of GetLastGLExportDate.
+func (mr *MockRepositoryProviderMockRecorder) GetLastGLExportDate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastGLExportDate", reflect.TypeOf((*MockRepositoryProvider)(nil).GetLastGLExportDate), arg0, arg1)
+}
+
+// GetLoan mocks base method.
+func (m *MockRepositoryProvider) GetLoan(arg0 context.Context, arg1 int64) (models.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoan", arg0, arg1)
+	ret0, _ := ret[0].(models.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoan indicates an expected call of GetLoan.
+func (mr *MockRepositoryProviderMockRecorder) GetLoan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoan", reflect.TypeOf((*MockRepositoryProvider)(nil).GetLoan), arg0, arg1)
+}
+
+// GetLoanSchedule mocks base method.
+func (m *MockRepositoryProvider) GetLoanSchedule(arg0 context.Context, arg1 int64, arg2 time.Time) (models.LoanSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoanSchedule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.LoanSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoanSchedule indicates an expected call of GetLoanSchedule.
+func (mr *MockRepositoryProviderMockRecorder) GetLoanSchedule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanSchedule", reflect.TypeOf((*MockRepositoryProvider)(nil).GetLoanSchedule), arg0, arg1, arg2)
+}
+
+// GetPaymentAlias mocks base method.
+func (m *MockRepositoryProvider) GetPaymentAlias(arg0 context.Context, arg1 string) (models.PaymentAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentAlias", arg0, arg1)
+	ret0, _ := ret[0].(models.PaymentAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentAlias indicates an expected call of GetPaymentAlias.
+func (mr *MockRepositoryProviderMockRecorder) GetPaymentAlias(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentAlias", reflect.TypeOf((*MockRepositoryProvider)(nil).GetPaymentAlias), arg0, arg1)
+}
+
+// GetPendingTransfer mocks base method.
+func (m *MockRepositoryProvider) GetPendingTransfer(arg0 context.Context, arg1 int64) (models.PendingTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingTransfer", arg0, arg1)
+	ret0, _ := ret[0].(models.PendingTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingTransfer indicates an expected call of GetPendingTransfer.
+func (mr *MockRepositoryProviderMockRecorder) GetPendingTransfer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTransfer", reflect.TypeOf((*MockRepositoryProvider)(nil).GetPendingTransfer), arg0, arg1)
+}
+
+// GetPocket mocks base method.
+func (m *MockRepositoryProvider) GetPocket(arg0 context.Context, arg1 int64) (models.Pocket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPocket", arg0, arg1)
+	ret0, _ := ret[0].(models.Pocket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPocket indicates an expected call of GetPocket.
+func (mr *MockRepositoryProviderMockRecorder) GetPocket(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPocket", reflect.TypeOf((*MockRepositoryProvider)(nil).GetPocket), arg0, arg1)
+}
+
+// GetPocketRule mocks base method.
+func (m *MockRepositoryProvider) GetPocketRule(arg0 context.Context, arg1 int64) (models.PocketRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPocketRule", arg0, arg1)
+	ret0, _ := ret[0].(models.PocketRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPocketRule indicates an expected call of GetPocketRule.
+func (mr *MockRepositoryProviderMockRecorder) GetPocketRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPocketRule", reflect.TypeOf((*MockRepositoryProvider)(nil).GetPocketRule), arg0, arg1)
+}
+
+// GetSession mocks base method.
+func (m *MockRepositoryProvider) GetSession(arg0 context.Context, arg1 uuid.UUID) (models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockRepositoryProviderMockRecorder) GetSession(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockRepositoryProvider)(nil).GetSession), arg0, arg1)
+}
+
+// GetSpendingAnalytics mocks base method.
+func (m *MockRepositoryProvider) GetSpendingAnalytics(arg0 context.Context, arg1 models.SpendingAnalyticsParams) (models.SpendingAnalytics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingAnalytics", arg0, arg1)
+	ret0, _ := ret[0].(models.SpendingAnalytics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingAnalytics indicates an expected call of GetSpendingAnalytics.
+func (mr *MockRepositoryProviderMockRecorder) GetSpendingAnalytics(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingAnalytics", reflect.TypeOf((*MockRepositoryProvider)(nil).GetSpendingAnalytics), arg0, arg1)
+}
+
+// GetStatement mocks base method.
+func (m *MockRepositoryProvider) GetStatement(arg0 context.Context, arg1 int64, arg2 time.Time) (models.Statement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatement", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Statement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatement indicates an expected call of GetStatement.
+func (mr *MockRepositoryProviderMockRecorder) GetStatement(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatement", reflect.TypeOf((*MockRepositoryProvider)(nil).GetStatement), arg0, arg1, arg2)
+}
+
+// GetTellerOperation mocks base method.
+func (m *MockRepositoryProvider) GetTellerOperation(arg0 context.Context, arg1 int64) (models.TellerOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTellerOperation", arg0, arg1)
+	ret0, _ := ret[0].(models.TellerOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTellerOperation indicates an expected call of GetTellerOperation.
+func (mr *MockRepositoryProviderMockRecorder) GetTellerOperation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTellerOperation", reflect.TypeOf((*MockRepositoryProvider)(nil).GetTellerOperation), arg0, arg1)
+}
+
+// GetTransaction mocks base method.
+func (m *MockRepositoryProvider) GetTransaction(arg0 context.Context, arg1 int64) (models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransaction", arg0, arg1)
+	ret0, _ := ret[0].(models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransaction indicates an expected call of GetTransaction.
+func (mr *MockRepositoryProviderMockRecorder) GetTransaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockRepositoryProvider)(nil).GetTransaction), arg0, arg1)
+}
+
+// GetTransactionAttachment mocks base method.
+func (m *MockRepositoryProvider) GetTransactionAttachment(arg0 context.Context, arg1 int64, arg2 string) (models.TransactionAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionAttachment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.TransactionAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionAttachment indicates an expected call of GetTransactionAttachment.
+func (mr *MockRepositoryProviderMockRecorder) GetTransactionAttachment(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionAttachment", reflect.TypeOf((*MockRepositoryProvider)(nil).GetTransactionAttachment), arg0, arg1, arg2)
+}
+
+// GetTransactionNote mocks base method.
+func (m *MockRepositoryProvider) GetTransactionNote(arg0 context.Context, arg1 int64, arg2 string) (models.TransactionNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionNote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.TransactionNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionNote indicates an expected call of GetTransactionNote.
+func (mr *MockRepositoryProviderMockRecorder) GetTransactionNote(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionNote", reflect.TypeOf((*MockRepositoryProvider)(nil).GetTransactionNote), arg0, arg1, arg2)
+}
+
+// GetTransferSignals mocks base method.
+func (m *MockRepositoryProvider) GetTransferSignals(arg0 context.Context, arg1 models.TransferSignalsParams) (models.TransferSignals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferSignals", arg0, arg1)
+	ret0, _ := ret[0].(models.TransferSignals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferSignals indicates an expected call of GetTransferSignals.
+func (mr *MockRepositoryProviderMockRecorder) GetTransferSignals(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferSignals", reflect.TypeOf((*MockRepositoryProvider)(nil).GetTransferSignals), arg0, arg1)
+}
+
+// GetTrialBalances mocks base method.
+func (m *MockRepositoryProvider) GetTrialBalances(arg0 context.Context, arg1 time.Time) ([]models.TrialBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrialBalances", arg0, arg1)
+	ret0, _ := ret[0].([]models.TrialBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrialBalances indicates an expected call of GetTrialBalances.
+func (mr *MockRepositoryProviderMockRecorder) GetTrialBalances(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrialBalances", reflect.TypeOf((*MockRepositoryProvider)(nil).GetTrialBalances), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockRepositoryProvider) GetUser(arg0 context.Context, arg1 string) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockRepositoryProviderMockRecorder) GetUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepositoryProvider)(nil).GetUser), arg0, arg1)
+}
+
+// InviteAccountHolder mocks base method.
+func (m *MockRepositoryProvider) InviteAccountHolder(arg0 context.Context, arg1 models.InviteAccountHolderParams) (models.AccountHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteAccountHolder", arg0, arg1)
+	ret0, _ := ret[0].(models.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteAccountHolder indicates an expected call of InviteAccountHolder.
+func (mr *MockRepositoryProviderMockRecorder) InviteAccountHolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteAccountHolder", reflect.TypeOf((*MockRepositoryProvider)(nil).InviteAccountHolder), arg0, arg1)
+}
+
+// ListAccountHolders mocks base method.
+func (m *MockRepositoryProvider) ListAccountHolders(arg0 context.Context, arg1 int64) ([]models.AccountHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountHolders", arg0, arg1)
+	ret0, _ := ret[0].([]models.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountHolders indicates an expected call of ListAccountHolders.
+func (mr *MockRepositoryProviderMockRecorder) ListAccountHolders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountHolders", reflect.TypeOf((*MockRepositoryProvider)(nil).ListAccountHolders), arg0, arg1)
+}
+
+// ListAccountIDsByNumber mocks base method.
+func (m *MockRepositoryProvider) ListAccountIDsByNumber(arg0 context.Context, arg1 []string) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountIDsByNumber", arg0, arg1)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountIDsByNumber indicates an expected call of ListAccountIDsByNumber.
+func (mr *MockRepositoryProviderMockRecorder) ListAccountIDsByNumber(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountIDsByNumber", reflect.TypeOf((*MockRepositoryProvider)(nil).ListAccountIDsByNumber), arg0, arg1)
+}
+
+// ListAccountProducts mocks base method.
+func (m *MockRepositoryProvider) ListAccountProducts(arg0 context.Context) ([]models.AccountProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountProducts", arg0)
+	ret0, _ := ret[0].([]models.AccountProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountProducts indicates an expected call of ListAccountProducts.
+func (mr *MockRepositoryProviderMockRecorder) ListAccountProducts(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountProducts", reflect.TypeOf((*MockRepositoryProvider)(nil).ListAccountProducts), arg0)
+}
+
+// ListAccounts mocks base method.
+func (m *MockRepositoryProvider) ListAccounts(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockRepositoryProviderMockRecorder) ListAccounts(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockRepositoryProvider)(nil).ListAccounts), arg0, arg1, arg2, arg3)
+}
+
+// ListAccountsWithUnpaidInterest mocks base method.
+func (m *MockRepositoryProvider) ListAccountsWithUnpaidInterest(arg0 context.Context, arg1 time.Time) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsWithUnpaidInterest", arg0, arg1)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsWithUnpaidInterest indicates an expected call of ListAccountsWithUnpaidInterest.
+func (mr *MockRepositoryProviderMockRecorder) ListAccountsWithUnpaidInterest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsWithUnpaidInterest", reflect.TypeOf((*MockRepositoryProvider)(nil).ListAccountsWithUnpaidInterest), arg0, arg1)
+}
+
+// ListAlertRules mocks base method.
+func (m *MockRepositoryProvider) ListAlertRules(arg0 context.Context, arg1 int64, arg2 string) ([]models.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertRules", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertRules indicates an expected call of ListAlertRules.
+func (mr *MockRepositoryProviderMockRecorder) ListAlertRules(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertRules", reflect.TypeOf((*MockRepositoryProvider)(nil).ListAlertRules), arg0, arg1, arg2)
+}
+
+// ListBeneficiaries mocks base method.
+func (m *MockRepositoryProvider) ListBeneficiaries(arg0 context.Context, arg1 string) ([]models.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBeneficiaries", arg0, arg1)
+	ret0, _ := ret[0].([]models.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBeneficiaries indicates an expected call of ListBeneficiaries.
+func (mr *MockRepositoryProviderMockRecorder) ListBeneficiaries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBeneficiaries", reflect.TypeOf((*MockRepositoryProvider)(nil).ListBeneficiaries), arg0, arg1)
+}
+
+// ListCategoryRules mocks base method.
+func (m *MockRepositoryProvider) ListCategoryRules(arg0 context.Context, arg1 string) ([]models.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategoryRules", arg0, arg1)
+	ret0, _ := ret[0].([]models.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategoryRules indicates an expected call of ListCategoryRules.
+func (mr *MockRepositoryProviderMockRecorder) ListCategoryRules(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryRules", reflect.TypeOf((*MockRepositoryProvider)(nil).ListCategoryRules), arg0, arg1)
+}
+
+// ListDefaultAccounts mocks base method.
+func (m *MockRepositoryProvider) ListDefaultAccounts(arg0 context.Context, arg1 string) ([]models.DefaultAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDefaultAccounts", arg0, arg1)
+	ret0, _ := ret[0].([]models.DefaultAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDefaultAccounts indicates an expected call of ListDefaultAccounts.
+func (mr *MockRepositoryProviderMockRecorder) ListDefaultAccounts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDefaultAccounts", reflect.TypeOf((*MockRepositoryProvider)(nil).ListDefaultAccounts), arg0, arg1)
+}
+
+// ListDisputes mocks base method.
+func (m *MockRepositoryProvider) ListDisputes(arg0 context.Context, arg1 int64, arg2 string, arg3, arg4 int32) ([]models.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisputes", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisputes indicates an expected call of ListDisputes.
+func (mr *MockRepositoryProviderMockRecorder) ListDisputes(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisputes", reflect.TypeOf((*MockRepositoryProvider)(nil).ListDisputes), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ListDueLoanInstallments mocks base method.
+func (m *MockRepositoryProvider) ListDueLoanInstallments(arg0 context.Context, arg1 time.Time) ([]models.LoanInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueLoanInstallments", arg0, arg1)
+	ret0, _ := ret[0].([]models.LoanInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueLoanInstallments indicates an expected call of ListDueLoanInstallments.
+func (mr *MockRepositoryProviderMockRecorder) ListDueLoanInstallments(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueLoanInstallments", reflect.TypeOf((*MockRepositoryProvider)(nil).ListDueLoanInstallments), arg0, arg1)
+}
+
+// ListEntries mocks base method.
+func (m *MockRepositoryProvider) ListEntries(arg0 context.Context, arg1, arg2, arg3 int64) ([]models.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockRepositoryProviderMockRecorder) ListEntries(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockRepositoryProvider)(nil).ListEntries), arg0, arg1, arg2, arg3)
+}
+
+// ListFraudScreenings mocks base method.
+func (m *MockRepositoryProvider) ListFraudScreenings(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]models.FraudScreening, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFraudScreenings", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.FraudScreening)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFraudScreenings indicates an expected call of ListFraudScreenings.
+func (mr *MockRepositoryProviderMockRecorder) ListFraudScreenings(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFraudScreenings", reflect.TypeOf((*MockRepositoryProvider)(nil).ListFraudScreenings), arg0, arg1, arg2, arg3)
+}
+
+// ListGLExports mocks base method.
+func (m *MockRepositoryProvider) ListGLExports(arg0 context.Context, arg1, arg2 int32) ([]models.GLExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGLExports", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.GLExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGLExports indicates an expected call of ListGLExports.
+func (mr *MockRepositoryProviderMockRecorder) ListGLExports(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGLExports", reflect.TypeOf((*MockRepositoryProvider)(nil).ListGLExports), arg0, arg1, arg2)
+}
+
+// ListHolds mocks base method.
+func (m *MockRepositoryProvider) ListHolds(arg0 context.Context, arg1 int64, arg2, arg3 int32) ([]models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHolds", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHolds indicates an expected call of ListHolds.
+func (mr *MockRepositoryProviderMockRecorder) ListHolds(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHolds", reflect.TypeOf((*MockRepositoryProvider)(nil).ListHolds), arg0, arg1, arg2, arg3)
+}
+
+// ListInterestAccruals mocks base method.
+func (m *MockRepositoryProvider) ListInterestAccruals(arg0 context.Context, arg1 int64, arg2, arg3 int32) ([]models.InterestAccrual, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEntry", arg0, arg1)
-	ret0, _ := ret[0].(models.Entry)
+	ret := m.ctrl.Call(m, "ListInterestAccruals", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.InterestAccrual)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEntry indicates an expected call of CreateEntry.
-func (mr *MockRepositoryProviderMockRecorder) CreateEntry(arg0, arg1 any) *gomock.Call {
+// ListInterestAccruals indicates an expected call of ListInterestAccruals.
+func (mr *MockRepositoryProviderMockRecorder) ListInterestAccruals(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterestAccruals", reflect.TypeOf((*MockRepositoryProvider)(nil).ListInterestAccruals), arg0, arg1, arg2, arg3)
 }
 
-// CreateSession mocks base method.
-func (m *MockRepositoryProvider) CreateSession(arg0 context.Context, arg1 models.Session) (models.Session, error) {
+// ListInterestBearingAccounts mocks base method.
+func (m *MockRepositoryProvider) ListInterestBearingAccounts(arg0 context.Context, arg1 time.Time, arg2 int64, arg3 int32) ([]models.InterestBearingAccount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
-	ret0, _ := ret[0].(models.Session)
+	ret := m.ctrl.Call(m, "ListInterestBearingAccounts", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.InterestBearingAccount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockRepositoryProviderMockRecorder) CreateSession(arg0, arg1 any) *gomock.Call {
+// ListInterestBearingAccounts indicates an expected call of ListInterestBearingAccounts.
+func (mr *MockRepositoryProviderMockRecorder) ListInterestBearingAccounts(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterestBearingAccounts", reflect.TypeOf((*MockRepositoryProvider)(nil).ListInterestBearingAccounts), arg0, arg1, arg2, arg3)
 }
 
-// CreateTransaction mocks base method.
-func (m *MockRepositoryProvider) CreateTransaction(arg0 context.Context, arg1 models.Transaction) (models.Transaction, error) {
+// ListLoans mocks base method.
+func (m *MockRepositoryProvider) ListLoans(arg0 context.Context, arg1 int64) ([]models.Loan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransaction", arg0, arg1)
-	ret0, _ := ret[0].(models.Transaction)
+	ret := m.ctrl.Call(m, "ListLoans", arg0, arg1)
+	ret0, _ := ret[0].([]models.Loan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransaction indicates an expected call of CreateTransaction.
-func (mr *MockRepositoryProviderMockRecorder) CreateTransaction(arg0, arg1 any) *gomock.Call {
+// ListLoans indicates an expected call of ListLoans.
+func (mr *MockRepositoryProviderMockRecorder) ListLoans(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateTransaction), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoans", reflect.TypeOf((*MockRepositoryProvider)(nil).ListLoans), arg0, arg1)
 }
 
-// CreateUser mocks base method.
-func (m *MockRepositoryProvider) CreateUser(arg0 context.Context, arg1 models.User) (models.User, error) {
+// ListOverdraftCharges mocks base method.
+func (m *MockRepositoryProvider) ListOverdraftCharges(arg0 context.Context, arg1 int64, arg2, arg3 int32) ([]models.OverdraftCharge, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
-	ret0, _ := ret[0].(models.User)
+	ret := m.ctrl.Call(m, "ListOverdraftCharges", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.OverdraftCharge)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockRepositoryProviderMockRecorder) CreateUser(arg0, arg1 any) *gomock.Call {
+// ListOverdraftCharges indicates an expected call of ListOverdraftCharges.
+func (mr *MockRepositoryProviderMockRecorder) ListOverdraftCharges(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdraftCharges", reflect.TypeOf((*MockRepositoryProvider)(nil).ListOverdraftCharges), arg0, arg1, arg2, arg3)
 }
 
-// CreateUserTx mocks base method.
-func (m *MockRepositoryProvider) CreateUserTx(arg0 context.Context, arg1 models.CreateUserTxParams) (models.User, error) {
+// ListOverdraftRequests mocks base method.
+func (m *MockRepositoryProvider) ListOverdraftRequests(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]models.OverdraftRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserTx", arg0, arg1)
-	ret0, _ := ret[0].(models.User)
+	ret := m.ctrl.Call(m, "ListOverdraftRequests", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.OverdraftRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUserTx indicates an expected call of CreateUserTx.
-func (mr *MockRepositoryProviderMockRecorder) CreateUserTx(arg0, arg1 any) *gomock.Call {
+// ListOverdraftRequests indicates an expected call of ListOverdraftRequests.
+func (mr *MockRepositoryProviderMockRecorder) ListOverdraftRequests(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateUserTx), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdraftRequests", reflect.TypeOf((*MockRepositoryProvider)(nil).ListOverdraftRequests), arg0, arg1, arg2, arg3)
 }
 
-// CreateVerifyEmail mocks base method.
-func (m *MockRepositoryProvider) CreateVerifyEmail(arg0 context.Context, arg1 models.VerifyEmails) (models.VerifyEmails, error) {
+// ListOverdrawnAccounts mocks base method.
+func (m *MockRepositoryProvider) ListOverdrawnAccounts(arg0 context.Context, arg1 time.Time, arg2 int64, arg3 int32) ([]models.OverdrawnAccount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVerifyEmail", arg0, arg1)
-	ret0, _ := ret[0].(models.VerifyEmails)
+	ret := m.ctrl.Call(m, "ListOverdrawnAccounts", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.OverdrawnAccount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateVerifyEmail indicates an expected call of CreateVerifyEmail.
-func (mr *MockRepositoryProviderMockRecorder) CreateVerifyEmail(arg0, arg1 any) *gomock.Call {
+// ListOverdrawnAccounts indicates an expected call of ListOverdrawnAccounts.
+func (mr *MockRepositoryProviderMockRecorder) ListOverdrawnAccounts(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerifyEmail", reflect.TypeOf((*MockRepositoryProvider)(nil).CreateVerifyEmail), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdrawnAccounts", reflect.TypeOf((*MockRepositoryProvider)(nil).ListOverdrawnAccounts), arg0, arg1, arg2, arg3)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockRepositoryProvider) DeleteAccount(arg0 context.Context, arg1 int64) error {
+// ListPaymentAliases mocks base method.
+func (m *MockRepositoryProvider) ListPaymentAliases(arg0 context.Context, arg1 string) ([]models.PaymentAlias, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListPaymentAliases", arg0, arg1)
+	ret0, _ := ret[0].([]models.PaymentAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockRepositoryProviderMockRecorder) DeleteAccount(arg0, arg1 any) *gomock.Call {
+// ListPaymentAliases indicates an expected call of ListPaymentAliases.
+func (mr *MockRepositoryProviderMockRecorder) ListPaymentAliases(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockRepositoryProvider)(nil).DeleteAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentAliases", reflect.TypeOf((*MockRepositoryProvider)(nil).ListPaymentAliases), arg0, arg1)
 }
 
-// GetAccount mocks base method.
-func (m *MockRepositoryProvider) GetAccount(arg0 context.Context, arg1 int64) (models.Account, error) {
+// ListPendingTransferEvents mocks base method.
+func (m *MockRepositoryProvider) ListPendingTransferEvents(arg0 context.Context, arg1 int64) ([]models.PendingTransferEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
-	ret0, _ := ret[0].(models.Account)
+	ret := m.ctrl.Call(m, "ListPendingTransferEvents", arg0, arg1)
+	ret0, _ := ret[0].([]models.PendingTransferEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockRepositoryProviderMockRecorder) GetAccount(arg0, arg1 any) *gomock.Call {
+// ListPendingTransferEvents indicates an expected call of ListPendingTransferEvents.
+func (mr *MockRepositoryProviderMockRecorder) ListPendingTransferEvents(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockRepositoryProvider)(nil).GetAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingTransferEvents", reflect.TypeOf((*MockRepositoryProvider)(nil).ListPendingTransferEvents), arg0, arg1)
 }
 
-// GetAccountForUpdate mocks base method.
-func (m *MockRepositoryProvider) GetAccountForUpdate(arg0 context.Context, arg1 int64) (models.Account, error) {
+// ListPendingTransfers mocks base method.
+func (m *MockRepositoryProvider) ListPendingTransfers(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]models.PendingTransfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountForUpdate", arg0, arg1)
-	ret0, _ := ret[0].(models.Account)
+	ret := m.ctrl.Call(m, "ListPendingTransfers", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.PendingTransfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
-func (mr *MockRepositoryProviderMockRecorder) GetAccountForUpdate(arg0, arg1 any) *gomock.Call {
+// ListPendingTransfers indicates an expected call of ListPendingTransfers.
+func (mr *MockRepositoryProviderMockRecorder) ListPendingTransfers(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockRepositoryProvider)(nil).GetAccountForUpdate), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingTransfers", reflect.TypeOf((*MockRepositoryProvider)(nil).ListPendingTransfers), arg0, arg1, arg2, arg3)
 }
 
-// GetEntry mocks base method.
-func (m *MockRepositoryProvider) GetEntry(arg0 context.Context, arg1 int64) (models.Entry, error) {
+// ListPocketEntries mocks base method.
+func (m *MockRepositoryProvider) ListPocketEntries(arg0 context.Context, arg1 int64, arg2, arg3 int32) ([]models.PocketEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEntry", arg0, arg1)
-	ret0, _ := ret[0].(models.Entry)
+	ret := m.ctrl.Call(m, "ListPocketEntries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.PocketEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEntry indicates an expected call of GetEntry.
-func (mr *MockRepositoryProviderMockRecorder) GetEntry(arg0, arg1 any) *gomock.Call {
+// ListPocketEntries indicates an expected call of ListPocketEntries.
+func (mr *MockRepositoryProviderMockRecorder) ListPocketEntries(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockRepositoryProvider)(nil).GetEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPocketEntries", reflect.TypeOf((*MockRepositoryProvider)(nil).ListPocketEntries), arg0, arg1, arg2, arg3)
 }
 
-// GetSession mocks base method.
-func (m *MockRepositoryProvider) GetSession(arg0 context.Context, arg1 uuid.UUID) (models.Session, error) {
+// ListPockets mocks base method.
+func (m *MockRepositoryProvider) ListPockets(arg0 context.Context, arg1 int64) ([]models.Pocket, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
-	ret0, _ := ret[0].(models.Session)
+	ret := m.ctrl.Call(m, "ListPockets", arg0, arg1)
+	ret0, _ := ret[0].([]models.Pocket)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockRepositoryProviderMockRecorder) GetSession(arg0, arg1 any) *gomock.Call {
+// ListPockets indicates an expected call of ListPockets.
+func (mr *MockRepositoryProviderMockRecorder) ListPockets(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockRepositoryProvider)(nil).GetSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPockets", reflect.TypeOf((*MockRepositoryProvider)(nil).ListPockets), arg0, arg1)
 }
 
-// GetTransaction mocks base method.
-func (m *MockRepositoryProvider) GetTransaction(arg0 context.Context, arg1 int64) (models.Transaction, error) {
+// ListStatementRecipients mocks base method.
+func (m *MockRepositoryProvider) ListStatementRecipients(arg0 context.Context, arg1 time.Time) ([]models.StatementRecipient, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransaction", arg0, arg1)
-	ret0, _ := ret[0].(models.Transaction)
+	ret := m.ctrl.Call(m, "ListStatementRecipients", arg0, arg1)
+	ret0, _ := ret[0].([]models.StatementRecipient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransaction indicates an expected call of GetTransaction.
-func (mr *MockRepositoryProviderMockRecorder) GetTransaction(arg0, arg1 any) *gomock.Call {
+// ListStatementRecipients indicates an expected call of ListStatementRecipients.
+func (mr *MockRepositoryProviderMockRecorder) ListStatementRecipients(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockRepositoryProvider)(nil).GetTransaction), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatementRecipients", reflect.TypeOf((*MockRepositoryProvider)(nil).ListStatementRecipients), arg0, arg1)
 }
 
-// GetUser mocks base method.
-func (m *MockRepositoryProvider) GetUser(arg0 context.Context, arg1 string) (models.User, error) {
+// ListTellerOperations mocks base method.
+func (m *MockRepositoryProvider) ListTellerOperations(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]models.TellerOperation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
-	ret0, _ := ret[0].(models.User)
+	ret := m.ctrl.Call(m, "ListTellerOperations", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.TellerOperation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockRepositoryProviderMockRecorder) GetUser(arg0, arg1 any) *gomock.Call {
+// ListTellerOperations indicates an expected call of ListTellerOperations.
+func (mr *MockRepositoryProviderMockRecorder) ListTellerOperations(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepositoryProvider)(nil).GetUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTellerOperations", reflect.TypeOf((*MockRepositoryProvider)(nil).ListTellerOperations), arg0, arg1, arg2, arg3)
 }
 
-// ListAccounts mocks base method.
-func (m *MockRepositoryProvider) ListAccounts(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]models.Account, error) {
+// ListTellerReasonCodes mocks base method.
+func (m *MockRepositoryProvider) ListTellerReasonCodes(arg0 context.Context) ([]models.TellerReasonCode, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAccounts", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]models.Account)
+	ret := m.ctrl.Call(m, "ListTellerReasonCodes", arg0)
+	ret0, _ := ret[0].([]models.TellerReasonCode)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAccounts indicates an expected call of ListAccounts.
-func (mr *MockRepositoryProviderMockRecorder) ListAccounts(arg0, arg1, arg2, arg3 any) *gomock.Call {
+// ListTellerReasonCodes indicates an expected call of ListTellerReasonCodes.
+func (mr *MockRepositoryProviderMockRecorder) ListTellerReasonCodes(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockRepositoryProvider)(nil).ListAccounts), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTellerReasonCodes", reflect.TypeOf((*MockRepositoryProvider)(nil).ListTellerReasonCodes), arg0)
 }
 
-// ListEntries mocks base method.
-func (m *MockRepositoryProvider) ListEntries(arg0 context.Context, arg1, arg2, arg3 int64) ([]models.Entry, error) {
+// ListTransactionAttachments mocks base method.
+func (m *MockRepositoryProvider) ListTransactionAttachments(arg0 context.Context, arg1 int64, arg2 string) ([]models.TransactionAttachment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEntries", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]models.Entry)
+	ret := m.ctrl.Call(m, "ListTransactionAttachments", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.TransactionAttachment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntries indicates an expected call of ListEntries.
-func (mr *MockRepositoryProviderMockRecorder) ListEntries(arg0, arg1, arg2, arg3 any) *gomock.Call {
+// ListTransactionAttachments indicates an expected call of ListTransactionAttachments.
+func (mr *MockRepositoryProviderMockRecorder) ListTransactionAttachments(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockRepositoryProvider)(nil).ListEntries), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactionAttachments", reflect.TypeOf((*MockRepositoryProvider)(nil).ListTransactionAttachments), arg0, arg1, arg2)
 }
 
 // ListTransactions mocks base method.
@@ -310,6 +1627,21 @@ func (mr *MockRepositoryProviderMockRecorder) ListTransactions(arg0, arg1, arg2,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockRepositoryProvider)(nil).ListTransactions), arg0, arg1, arg2, arg3, arg4)
 }
 
+// ListUnchargedOverdrafts mocks base method.
+func (m *MockRepositoryProvider) ListUnchargedOverdrafts(arg0 context.Context) ([]models.UnchargedOverdraft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnchargedOverdrafts", arg0)
+	ret0, _ := ret[0].([]models.UnchargedOverdraft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnchargedOverdrafts indicates an expected call of ListUnchargedOverdrafts.
+func (mr *MockRepositoryProviderMockRecorder) ListUnchargedOverdrafts(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnchargedOverdrafts", reflect.TypeOf((*MockRepositoryProvider)(nil).ListUnchargedOverdrafts), arg0)
+}
+
 // ListUsers mocks base method.
 func (m *MockRepositoryProvider) ListUsers(arg0 context.Context, arg1, arg2 int32) ([]models.User, error) {
 	m.ctrl.T.Helper()
@@ -325,6 +1657,200 @@ func (mr *MockRepositoryProviderMockRecorder) ListUsers(arg0, arg1, arg2 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockRepositoryProvider)(nil).ListUsers), arg0, arg1, arg2)
 }
 
+// MovePocketFundsTx mocks base method.
+func (m *MockRepositoryProvider) MovePocketFundsTx(arg0 context.Context, arg1 models.MovePocketFundsParams) (models.MovePocketFundsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovePocketFundsTx", arg0, arg1)
+	ret0, _ := ret[0].(models.MovePocketFundsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovePocketFundsTx indicates an expected call of MovePocketFundsTx.
+func (mr *MockRepositoryProviderMockRecorder) MovePocketFundsTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePocketFundsTx", reflect.TypeOf((*MockRepositoryProvider)(nil).MovePocketFundsTx), arg0, arg1)
+}
+
+// OpenDisputeTx mocks base method.
+func (m *MockRepositoryProvider) OpenDisputeTx(arg0 context.Context, arg1 models.OpenDisputeParams) (models.DisputeUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDisputeTx", arg0, arg1)
+	ret0, _ := ret[0].(models.DisputeUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenDisputeTx indicates an expected call of OpenDisputeTx.
+func (mr *MockRepositoryProviderMockRecorder) OpenDisputeTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDisputeTx", reflect.TypeOf((*MockRepositoryProvider)(nil).OpenDisputeTx), arg0, arg1)
+}
+
+// PayInterestTx mocks base method.
+func (m *MockRepositoryProvider) PayInterestTx(arg0 context.Context, arg1 models.PayInterestTxParams) (models.PayInterestTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayInterestTx", arg0, arg1)
+	ret0, _ := ret[0].(models.PayInterestTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayInterestTx indicates an expected call of PayInterestTx.
+func (mr *MockRepositoryProviderMockRecorder) PayInterestTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayInterestTx", reflect.TypeOf((*MockRepositoryProvider)(nil).PayInterestTx), arg0, arg1)
+}
+
+// PayOffLoanTx mocks base method.
+func (m *MockRepositoryProvider) PayOffLoanTx(arg0 context.Context, arg1 int64, arg2 time.Time) (models.LoanSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayOffLoanTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.LoanSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayOffLoanTx indicates an expected call of PayOffLoanTx.
+func (mr *MockRepositoryProviderMockRecorder) PayOffLoanTx(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayOffLoanTx", reflect.TypeOf((*MockRepositoryProvider)(nil).PayOffLoanTx), arg0, arg1, arg2)
+}
+
+// ReleaseExpiredHolds mocks base method.
+func (m *MockRepositoryProvider) ReleaseExpiredHolds(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseExpiredHolds", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseExpiredHolds indicates an expected call of ReleaseExpiredHolds.
+func (mr *MockRepositoryProviderMockRecorder) ReleaseExpiredHolds(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExpiredHolds", reflect.TypeOf((*MockRepositoryProvider)(nil).ReleaseExpiredHolds), arg0)
+}
+
+// RepayLoanInstallmentTx mocks base method.
+func (m *MockRepositoryProvider) RepayLoanInstallmentTx(arg0 context.Context, arg1 int64, arg2 time.Time) (models.LoanRepaymentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepayLoanInstallmentTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.LoanRepaymentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepayLoanInstallmentTx indicates an expected call of RepayLoanInstallmentTx.
+func (mr *MockRepositoryProviderMockRecorder) RepayLoanInstallmentTx(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepayLoanInstallmentTx", reflect.TypeOf((*MockRepositoryProvider)(nil).RepayLoanInstallmentTx), arg0, arg1, arg2)
+}
+
+// ResolveFraudScreeningTx mocks base method.
+func (m *MockRepositoryProvider) ResolveFraudScreeningTx(arg0 context.Context, arg1 models.ResolveFraudScreeningParams) (models.ResolveFraudScreeningResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveFraudScreeningTx", arg0, arg1)
+	ret0, _ := ret[0].(models.ResolveFraudScreeningResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveFraudScreeningTx indicates an expected call of ResolveFraudScreeningTx.
+func (mr *MockRepositoryProviderMockRecorder) ResolveFraudScreeningTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFraudScreeningTx", reflect.TypeOf((*MockRepositoryProvider)(nil).ResolveFraudScreeningTx), arg0, arg1)
+}
+
+// ResolvePaymentAlias mocks base method.
+func (m *MockRepositoryProvider) ResolvePaymentAlias(arg0 context.Context, arg1, arg2 string) (models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePaymentAlias", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePaymentAlias indicates an expected call of ResolvePaymentAlias.
+func (mr *MockRepositoryProviderMockRecorder) ResolvePaymentAlias(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePaymentAlias", reflect.TypeOf((*MockRepositoryProvider)(nil).ResolvePaymentAlias), arg0, arg1, arg2)
+}
+
+// SearchTransactionsByTag mocks base method.
+func (m *MockRepositoryProvider) SearchTransactionsByTag(arg0 context.Context, arg1 models.SearchTransactionsByTagParams) ([]models.TaggedTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTransactionsByTag", arg0, arg1)
+	ret0, _ := ret[0].([]models.TaggedTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTransactionsByTag indicates an expected call of SearchTransactionsByTag.
+func (mr *MockRepositoryProviderMockRecorder) SearchTransactionsByTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTransactionsByTag", reflect.TypeOf((*MockRepositoryProvider)(nil).SearchTransactionsByTag), arg0, arg1)
+}
+
+// SetDefaultAccount mocks base method.
+func (m *MockRepositoryProvider) SetDefaultAccount(arg0 context.Context, arg1 string, arg2 int64) (models.DefaultAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.DefaultAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDefaultAccount indicates an expected call of SetDefaultAccount.
+func (mr *MockRepositoryProviderMockRecorder) SetDefaultAccount(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultAccount", reflect.TypeOf((*MockRepositoryProvider)(nil).SetDefaultAccount), arg0, arg1, arg2)
+}
+
+// SetPaymentAliasCode mocks base method.
+func (m *MockRepositoryProvider) SetPaymentAliasCode(arg0 context.Context, arg1, arg2, arg3 string, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPaymentAliasCode", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPaymentAliasCode indicates an expected call of SetPaymentAliasCode.
+func (mr *MockRepositoryProviderMockRecorder) SetPaymentAliasCode(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPaymentAliasCode", reflect.TypeOf((*MockRepositoryProvider)(nil).SetPaymentAliasCode), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SetTransactionCategory mocks base method.
+func (m *MockRepositoryProvider) SetTransactionCategory(arg0 context.Context, arg1 models.TransactionCategory) (models.TransactionCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransactionCategory", arg0, arg1)
+	ret0, _ := ret[0].(models.TransactionCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTransactionCategory indicates an expected call of SetTransactionCategory.
+func (mr *MockRepositoryProviderMockRecorder) SetTransactionCategory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransactionCategory", reflect.TypeOf((*MockRepositoryProvider)(nil).SetTransactionCategory), arg0, arg1)
+}
+
+// SetTransactionNote mocks base method.
+func (m *MockRepositoryProvider) SetTransactionNote(arg0 context.Context, arg1 models.TransactionNote) (models.TransactionNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransactionNote", arg0, arg1)
+	ret0, _ := ret[0].(models.TransactionNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTransactionNote indicates an expected call of SetTransactionNote.
+func (mr *MockRepositoryProviderMockRecorder) SetTransactionNote(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransactionNote", reflect.TypeOf((*MockRepositoryProvider)(nil).SetTransactionNote), arg0, arg1)
+}
+
 // TransferTx mocks base method.
 func (m *MockRepositoryProvider) TransferTx(arg0 context.Context, arg1 models.TransferTxParams) (models.TransferTxResult, error) {
 	m.ctrl.T.Helper()
@@ -355,6 +1881,51 @@ func (mr *MockRepositoryProviderMockRecorder) UpdateAccount(arg0, arg1, arg2 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockRepositoryProvider)(nil).UpdateAccount), arg0, arg1, arg2)
 }
 
+// UpdateAccountStatusTx mocks base method.
+func (m *MockRepositoryProvider) UpdateAccountStatusTx(arg0 context.Context, arg1 models.UpdateAccountStatusParams) (models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountStatusTx", arg0, arg1)
+	ret0, _ := ret[0].(models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountStatusTx indicates an expected call of UpdateAccountStatusTx.
+func (mr *MockRepositoryProviderMockRecorder) UpdateAccountStatusTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountStatusTx", reflect.TypeOf((*MockRepositoryProvider)(nil).UpdateAccountStatusTx), arg0, arg1)
+}
+
+// UpdateBeneficiary mocks base method.
+func (m *MockRepositoryProvider) UpdateBeneficiary(arg0 context.Context, arg1 models.UpdateBeneficiaryParams) (models.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBeneficiary", arg0, arg1)
+	ret0, _ := ret[0].(models.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBeneficiary indicates an expected call of UpdateBeneficiary.
+func (mr *MockRepositoryProviderMockRecorder) UpdateBeneficiary(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBeneficiary", reflect.TypeOf((*MockRepositoryProvider)(nil).UpdateBeneficiary), arg0, arg1)
+}
+
+// UpdateDisputeTx mocks base method.
+func (m *MockRepositoryProvider) UpdateDisputeTx(arg0 context.Context, arg1 models.UpdateDisputeParams) (models.DisputeUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDisputeTx", arg0, arg1)
+	ret0, _ := ret[0].(models.DisputeUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDisputeTx indicates an expected call of UpdateDisputeTx.
+func (mr *MockRepositoryProviderMockRecorder) UpdateDisputeTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDisputeTx", reflect.TypeOf((*MockRepositoryProvider)(nil).UpdateDisputeTx), arg0, arg1)
+}
+
 // UpdateUser mocks base method.
 func (m *MockRepositoryProvider) UpdateUser(arg0 context.Context, arg1 models.UpdateUserParams) (models.User, error) {
 	m.ctrl.T.Helper()
@@ -384,3 +1955,33 @@ func (mr *MockRepositoryProviderMockRecorder) VerifyEmailTx(arg0, arg1 any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmailTx", reflect.TypeOf((*MockRepositoryProvider)(nil).VerifyEmailTx), arg0, arg1)
 }
+
+// VerifyPaymentAliasTx mocks base method.
+func (m *MockRepositoryProvider) VerifyPaymentAliasTx(arg0 context.Context, arg1 models.VerifyPaymentAliasParams) (models.PaymentAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPaymentAliasTx", arg0, arg1)
+	ret0, _ := ret[0].(models.PaymentAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyPaymentAliasTx indicates an expected call of VerifyPaymentAliasTx.
+func (mr *MockRepositoryProviderMockRecorder) VerifyPaymentAliasTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPaymentAliasTx", reflect.TypeOf((*MockRepositoryProvider)(nil).VerifyPaymentAliasTx), arg0, arg1)
+}
+
+// VoidHoldTx mocks base method.
+func (m *MockRepositoryProvider) VoidHoldTx(arg0 context.Context, arg1 int64) (models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidHoldTx", arg0, arg1)
+	ret0, _ := ret[0].(models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoidHoldTx indicates an expected call of VoidHoldTx.
+func (mr *MockRepositoryProviderMockRecorder) VoidHoldTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidHoldTx", reflect.TypeOf((*MockRepositoryProvider)(nil).VoidHoldTx), arg0, arg1)
+}
@@ -12,6 +12,7 @@ package mockedproviders
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	models "github.com/zde37/Swift_Bank/models"
@@ -41,243 +42,1213 @@ func (m *MockServiceProvider) EXPECT() *MockServiceProviderMockRecorder {
 	return m.recorder
 }
 
-// AddAccountBalance mocks base method.
-func (m *MockServiceProvider) AddAccountBalance(arg0 context.Context, arg1 int64, arg2 float64) (models.Account, error) {
+// AcceptAccountInvitation mocks base method.
+func (m *MockServiceProvider) AcceptAccountInvitation(arg0 context.Context, arg1 int64, arg2 string) (models.AccountHolder, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddAccountBalance", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "AcceptAccountInvitation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptAccountInvitation indicates an expected call of AcceptAccountInvitation.
+func (mr *MockServiceProviderMockRecorder) AcceptAccountInvitation(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptAccountInvitation", reflect.TypeOf((*MockServiceProvider)(nil).AcceptAccountInvitation), arg0, arg1, arg2)
+}
+
+// BatchTransferTx mocks base method.
+func (m *MockServiceProvider) BatchTransferTx(arg0 context.Context, arg1 models.BatchTransferTxParams) (models.BatchTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(models.BatchTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchTransferTx indicates an expected call of BatchTransferTx.
+func (mr *MockServiceProviderMockRecorder) BatchTransferTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchTransferTx", reflect.TypeOf((*MockServiceProvider)(nil).BatchTransferTx), arg0, arg1)
+}
+
+// CaptureHold mocks base method.
+func (m *MockServiceProvider) CaptureHold(arg0 context.Context, arg1 models.CaptureHoldParams) (models.CaptureHoldResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", arg0, arg1)
+	ret0, _ := ret[0].(models.CaptureHoldResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockServiceProviderMockRecorder) CaptureHold(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockServiceProvider)(nil).CaptureHold), arg0, arg1)
+}
+
+// CheckBeneficiaryTransfer mocks base method.
+func (m *MockServiceProvider) CheckBeneficiaryTransfer(arg0 models.Beneficiary, arg1 float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBeneficiaryTransfer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckBeneficiaryTransfer indicates an expected call of CheckBeneficiaryTransfer.
+func (mr *MockServiceProviderMockRecorder) CheckBeneficiaryTransfer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBeneficiaryTransfer", reflect.TypeOf((*MockServiceProvider)(nil).CheckBeneficiaryTransfer), arg0, arg1)
+}
+
+// CheckPayeeTransfer mocks base method.
+func (m *MockServiceProvider) CheckPayeeTransfer(arg0 context.Context, arg1 string, arg2 int64, arg3 float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPayeeTransfer", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckPayeeTransfer indicates an expected call of CheckPayeeTransfer.
+func (mr *MockServiceProviderMockRecorder) CheckPayeeTransfer(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPayeeTransfer", reflect.TypeOf((*MockServiceProvider)(nil).CheckPayeeTransfer), arg0, arg1, arg2, arg3)
+}
+
+// CloseAccount mocks base method.
+func (m *MockServiceProvider) CloseAccount(arg0 context.Context, arg1 models.CloseAccountTxParams) (models.CloseAccountTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAccount", arg0, arg1)
+	ret0, _ := ret[0].(models.CloseAccountTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAccount indicates an expected call of CloseAccount.
+func (mr *MockServiceProviderMockRecorder) CloseAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccount", reflect.TypeOf((*MockServiceProvider)(nil).CloseAccount), arg0, arg1)
+}
+
+// ClosePocket mocks base method.
+func (m *MockServiceProvider) ClosePocket(arg0 context.Context, arg1 int64) (models.Pocket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosePocket", arg0, arg1)
+	ret0, _ := ret[0].(models.Pocket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClosePocket indicates an expected call of ClosePocket.
+func (mr *MockServiceProviderMockRecorder) ClosePocket(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePocket", reflect.TypeOf((*MockServiceProvider)(nil).ClosePocket), arg0, arg1)
+}
+
+// CreateAccount mocks base method.
+func (m *MockServiceProvider) CreateAccount(arg0 context.Context, arg1 models.CreateAccountRequest, arg2 string) (models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockServiceProviderMockRecorder) CreateAccount(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockServiceProvider)(nil).CreateAccount), arg0, arg1, arg2)
+}
+
+// CreateAlertRule mocks base method.
+func (m *MockServiceProvider) CreateAlertRule(arg0 context.Context, arg1 models.AlertRule) (models.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertRule", arg0, arg1)
+	ret0, _ := ret[0].(models.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlertRule indicates an expected call of CreateAlertRule.
+func (mr *MockServiceProviderMockRecorder) CreateAlertRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertRule", reflect.TypeOf((*MockServiceProvider)(nil).CreateAlertRule), arg0, arg1)
+}
+
+// CreateBeneficiary mocks base method.
+func (m *MockServiceProvider) CreateBeneficiary(arg0 context.Context, arg1 models.Beneficiary) (models.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBeneficiary", arg0, arg1)
+	ret0, _ := ret[0].(models.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBeneficiary indicates an expected call of CreateBeneficiary.
+func (mr *MockServiceProviderMockRecorder) CreateBeneficiary(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBeneficiary", reflect.TypeOf((*MockServiceProvider)(nil).CreateBeneficiary), arg0, arg1)
+}
+
+// CreateCategoryRule mocks base method.
+func (m *MockServiceProvider) CreateCategoryRule(arg0 context.Context, arg1 models.CategoryRule) (models.CategoryRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategoryRule", arg0, arg1)
+	ret0, _ := ret[0].(models.CategoryRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategoryRule indicates an expected call of CreateCategoryRule.
+func (mr *MockServiceProviderMockRecorder) CreateCategoryRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategoryRule", reflect.TypeOf((*MockServiceProvider)(nil).CreateCategoryRule), arg0, arg1)
+}
+
+// CreateEntry mocks base method.
+func (m *MockServiceProvider) CreateEntry(arg0 context.Context, arg1 models.Entry) (models.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", arg0, arg1)
+	ret0, _ := ret[0].(models.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockServiceProviderMockRecorder) CreateEntry(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockServiceProvider)(nil).CreateEntry), arg0, arg1)
+}
+
+// CreateHold mocks base method.
+func (m *MockServiceProvider) CreateHold(arg0 context.Context, arg1 models.CreateHoldRequest) (models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", arg0, arg1)
+	ret0, _ := ret[0].(models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHold indicates an expected call of CreateHold.
+func (mr *MockServiceProviderMockRecorder) CreateHold(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*MockServiceProvider)(nil).CreateHold), arg0, arg1)
+}
+
+// CreateOverdraftRequest mocks base method.
+func (m *MockServiceProvider) CreateOverdraftRequest(arg0 context.Context, arg1 models.OverdraftRequest) (models.OverdraftRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOverdraftRequest", arg0, arg1)
+	ret0, _ := ret[0].(models.OverdraftRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOverdraftRequest indicates an expected call of CreateOverdraftRequest.
+func (mr *MockServiceProviderMockRecorder) CreateOverdraftRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOverdraftRequest", reflect.TypeOf((*MockServiceProvider)(nil).CreateOverdraftRequest), arg0, arg1)
+}
+
+// CreatePaymentAlias mocks base method.
+func (m *MockServiceProvider) CreatePaymentAlias(arg0 context.Context, arg1 models.PaymentAlias) (models.PaymentAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentAlias", arg0, arg1)
+	ret0, _ := ret[0].(models.PaymentAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentAlias indicates an expected call of CreatePaymentAlias.
+func (mr *MockServiceProviderMockRecorder) CreatePaymentAlias(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentAlias", reflect.TypeOf((*MockServiceProvider)(nil).CreatePaymentAlias), arg0, arg1)
+}
+
+// CreatePocket mocks base method.
+func (m *MockServiceProvider) CreatePocket(arg0 context.Context, arg1 models.Pocket) (models.Pocket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePocket", arg0, arg1)
+	ret0, _ := ret[0].(models.Pocket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePocket indicates an expected call of CreatePocket.
+func (mr *MockServiceProviderMockRecorder) CreatePocket(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePocket", reflect.TypeOf((*MockServiceProvider)(nil).CreatePocket), arg0, arg1)
+}
+
+// CreatePocketRule mocks base method.
+func (m *MockServiceProvider) CreatePocketRule(arg0 context.Context, arg1 models.PocketRule) (models.PocketRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePocketRule", arg0, arg1)
+	ret0, _ := ret[0].(models.PocketRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePocketRule indicates an expected call of CreatePocketRule.
+func (mr *MockServiceProviderMockRecorder) CreatePocketRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePocketRule", reflect.TypeOf((*MockServiceProvider)(nil).CreatePocketRule), arg0, arg1)
+}
+
+// CreateTellerOperation mocks base method.
+func (m *MockServiceProvider) CreateTellerOperation(arg0 context.Context, arg1 models.CreateTellerOperationParams) (models.TellerOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTellerOperation", arg0, arg1)
+	ret0, _ := ret[0].(models.TellerOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTellerOperation indicates an expected call of CreateTellerOperation.
+func (mr *MockServiceProviderMockRecorder) CreateTellerOperation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTellerOperation", reflect.TypeOf((*MockServiceProvider)(nil).CreateTellerOperation), arg0, arg1)
+}
+
+// CreateTransaction mocks base method.
+func (m *MockServiceProvider) CreateTransaction(arg0 context.Context, arg1 models.Transaction) (models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransaction", arg0, arg1)
+	ret0, _ := ret[0].(models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransaction indicates an expected call of CreateTransaction.
+func (mr *MockServiceProviderMockRecorder) CreateTransaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockServiceProvider)(nil).CreateTransaction), arg0, arg1)
+}
+
+// CreateUser mocks base method.
+func (m *MockServiceProvider) CreateUser(arg0 context.Context, arg1 models.CreateUserRequest) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockServiceProviderMockRecorder) CreateUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockServiceProvider)(nil).CreateUser), arg0, arg1)
+}
+
+// CreateUserTx mocks base method.
+func (m *MockServiceProvider) CreateUserTx(arg0 context.Context, arg1 models.CreateUserTxParams) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserTx", arg0, arg1)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserTx indicates an expected call of CreateUserTx.
+func (mr *MockServiceProviderMockRecorder) CreateUserTx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockServiceProvider)(nil).CreateUserTx), arg0, arg1)
+}
+
+// CreateVerifyEmail mocks base method.
+func (m *MockServiceProvider) CreateVerifyEmail(arg0 context.Context, arg1 models.VerifyEmails) (models.VerifyEmails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(models.VerifyEmails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVerifyEmail indicates an expected call of CreateVerifyEmail.
+func (mr *MockServiceProviderMockRecorder) CreateVerifyEmail(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerifyEmail", reflect.TypeOf((*MockServiceProvider)(nil).CreateVerifyEmail), arg0, arg1)
+}
+
+// DecideOverdraftRequest mocks base method.
+func (m *MockServiceProvider) DecideOverdraftRequest(arg0 context.Context, arg1 models.DecideOverdraftRequestParams) (models.OverdraftRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideOverdraftRequest", arg0, arg1)
+	ret0, _ := ret[0].(models.OverdraftRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideOverdraftRequest indicates an expected call of DecideOverdraftRequest.
+func (mr *MockServiceProviderMockRecorder) DecideOverdraftRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideOverdraftRequest", reflect.TypeOf((*MockServiceProvider)(nil).DecideOverdraftRequest), arg0, arg1)
+}
+
+// DecidePendingTransfer mocks base method.
+func (m *MockServiceProvider) DecidePendingTransfer(arg0 context.Context, arg1 models.DecidePendingTransferParams) (models.DecidePendingTransferResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecidePendingTransfer", arg0, arg1)
+	ret0, _ := ret[0].(models.DecidePendingTransferResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecidePendingTransfer indicates an expected call of DecidePendingTransfer.
+func (mr *MockServiceProviderMockRecorder) DecidePendingTransfer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecidePendingTransfer", reflect.TypeOf((*MockServiceProvider)(nil).DecidePendingTransfer), arg0, arg1)
+}
+
+// DecideTellerOperation mocks base method.
+func (m *MockServiceProvider) DecideTellerOperation(arg0 context.Context, arg1 models.DecideTellerOperationParams) (models.TellerOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideTellerOperation", arg0, arg1)
+	ret0, _ := ret[0].(models.TellerOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideTellerOperation indicates an expected call of DecideTellerOperation.
+func (mr *MockServiceProviderMockRecorder) DecideTellerOperation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideTellerOperation", reflect.TypeOf((*MockServiceProvider)(nil).DecideTellerOperation), arg0, arg1)
+}
+
+// DeleteAlertRule mocks base method.
+func (m *MockServiceProvider) DeleteAlertRule(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertRule indicates an expected call of DeleteAlertRule.
+func (mr *MockServiceProviderMockRecorder) DeleteAlertRule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertRule", reflect.TypeOf((*MockServiceProvider)(nil).DeleteAlertRule), arg0, arg1, arg2)
+}
+
+// DeleteBeneficiary mocks base method.
+func (m *MockServiceProvider) DeleteBeneficiary(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBeneficiary", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBeneficiary indicates an expected call of DeleteBeneficiary.
+func (mr *MockServiceProviderMockRecorder) DeleteBeneficiary(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBeneficiary", reflect.TypeOf((*MockServiceProvider)(nil).DeleteBeneficiary), arg0, arg1, arg2)
+}
+
+// DeleteCategoryRule mocks base method.
+func (m *MockServiceProvider) DeleteCategoryRule(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategoryRule indicates an expected call of DeleteCategoryRule.
+func (mr *MockServiceProviderMockRecorder) DeleteCategoryRule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryRule", reflect.TypeOf((*MockServiceProvider)(nil).DeleteCategoryRule), arg0, arg1, arg2)
+}
+
+// DeletePaymentAlias mocks base method.
+func (m *MockServiceProvider) DeletePaymentAlias(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePaymentAlias", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePaymentAlias indicates an expected call of DeletePaymentAlias.
+func (mr *MockServiceProviderMockRecorder) DeletePaymentAlias(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePaymentAlias", reflect.TypeOf((*MockServiceProvider)(nil).DeletePaymentAlias), arg0, arg1, arg2)
+}
+
+// DeletePocketRule mocks base method.
+func (m *MockServiceProvider) DeletePocketRule(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePocketRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePocketRule indicates an expected call of DeletePocketRule.
+func (mr *MockServiceProviderMockRecorder) DeletePocketRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePocketRule", reflect.TypeOf((*MockServiceProvider)(nil).DeletePocketRule), arg0, arg1)
+}
+
+// DeleteTransactionAttachment mocks base method.
+func (m *MockServiceProvider) DeleteTransactionAttachment(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransactionAttachment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransactionAttachment indicates an expected call of DeleteTransactionAttachment.
+func (mr *MockServiceProviderMockRecorder) DeleteTransactionAttachment(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransactionAttachment", reflect.TypeOf((*MockServiceProvider)(nil).DeleteTransactionAttachment), arg0, arg1, arg2)
+}
+
+// DisburseLoan mocks base method.
+func (m *MockServiceProvider) DisburseLoan(arg0 context.Context, arg1 models.Loan) (models.LoanSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisburseLoan", arg0, arg1)
+	ret0, _ := ret[0].(models.LoanSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisburseLoan indicates an expected call of DisburseLoan.
+func (mr *MockServiceProviderMockRecorder) DisburseLoan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisburseLoan", reflect.TypeOf((*MockServiceProvider)(nil).DisburseLoan), arg0, arg1)
+}
+
+// ExportGeneralLedger mocks base method.
+func (m *MockServiceProvider) ExportGeneralLedger(arg0 context.Context, arg1 models.ExportGLParams) (models.GLExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportGeneralLedger", arg0, arg1)
+	ret0, _ := ret[0].(models.GLExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportGeneralLedger indicates an expected call of ExportGeneralLedger.
+func (mr *MockServiceProviderMockRecorder) ExportGeneralLedger(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportGeneralLedger", reflect.TypeOf((*MockServiceProvider)(nil).ExportGeneralLedger), arg0, arg1)
+}
+
+// FetchSession mocks base method.
+func (m *MockServiceProvider) FetchSession(arg0 context.Context, arg1 uuid.UUID) (models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchSession", arg0, arg1)
+	ret0, _ := ret[0].(models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchSession indicates an expected call of FetchSession.
+func (mr *MockServiceProviderMockRecorder) FetchSession(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSession", reflect.TypeOf((*MockServiceProvider)(nil).FetchSession), arg0, arg1)
+}
+
+// GetAccount mocks base method.
+func (m *MockServiceProvider) GetAccount(arg0 context.Context, arg1 int64) (models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
+	ret0, _ := ret[0].(models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockServiceProviderMockRecorder) GetAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockServiceProvider)(nil).GetAccount), arg0, arg1)
+}
+
+// GetAccountByNumber mocks base method.
+func (m *MockServiceProvider) GetAccountByNumber(arg0 context.Context, arg1 string) (models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountByNumber", arg0, arg1)
+	ret0, _ := ret[0].(models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountByNumber indicates an expected call of GetAccountByNumber.
+func (mr *MockServiceProviderMockRecorder) GetAccountByNumber(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByNumber", reflect.TypeOf((*MockServiceProvider)(nil).GetAccountByNumber), arg0, arg1)
+}
+
+// GetAccountForUpdate mocks base method.
+func (m *MockServiceProvider) GetAccountForUpdate(arg0 context.Context, arg1 int64) (models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountForUpdate", arg0, arg1)
 	ret0, _ := ret[0].(models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddAccountBalance indicates an expected call of AddAccountBalance.
-func (mr *MockServiceProviderMockRecorder) AddAccountBalance(arg0, arg1, arg2 any) *gomock.Call {
+// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
+func (mr *MockServiceProviderMockRecorder) GetAccountForUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockServiceProvider)(nil).GetAccountForUpdate), arg0, arg1)
+}
+
+// GetAccountHolder mocks base method.
+func (m *MockServiceProvider) GetAccountHolder(arg0 context.Context, arg1 int64, arg2 string) (models.AccountHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountHolder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountHolder indicates an expected call of GetAccountHolder.
+func (mr *MockServiceProviderMockRecorder) GetAccountHolder(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountHolder", reflect.TypeOf((*MockServiceProvider)(nil).GetAccountHolder), arg0, arg1, arg2)
+}
+
+// GetBalanceAt mocks base method.
+func (m *MockServiceProvider) GetBalanceAt(arg0 context.Context, arg1 int64, arg2 time.Time) (models.BalanceAt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceAt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.BalanceAt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceAt indicates an expected call of GetBalanceAt.
+func (mr *MockServiceProviderMockRecorder) GetBalanceAt(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceAt", reflect.TypeOf((*MockServiceProvider)(nil).GetBalanceAt), arg0, arg1, arg2)
+}
+
+// GetBeneficiary mocks base method.
+func (m *MockServiceProvider) GetBeneficiary(arg0 context.Context, arg1 int64, arg2 string) (models.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBeneficiary", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBeneficiary indicates an expected call of GetBeneficiary.
+func (mr *MockServiceProviderMockRecorder) GetBeneficiary(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeneficiary", reflect.TypeOf((*MockServiceProvider)(nil).GetBeneficiary), arg0, arg1, arg2)
+}
+
+// GetDispute mocks base method.
+func (m *MockServiceProvider) GetDispute(arg0 context.Context, arg1 int64) (models.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDispute", arg0, arg1)
+	ret0, _ := ret[0].(models.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDispute indicates an expected call of GetDispute.
+func (mr *MockServiceProviderMockRecorder) GetDispute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDispute", reflect.TypeOf((*MockServiceProvider)(nil).GetDispute), arg0, arg1)
+}
+
+// GetEntry mocks base method.
+func (m *MockServiceProvider) GetEntry(arg0 context.Context, arg1 int64) (models.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", arg0, arg1)
+	ret0, _ := ret[0].(models.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockServiceProviderMockRecorder) GetEntry(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockServiceProvider)(nil).GetEntry), arg0, arg1)
+}
+
+// GetFraudScreening mocks base method.
+func (m *MockServiceProvider) GetFraudScreening(arg0 context.Context, arg1 int64) (models.FraudScreening, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFraudScreening", arg0, arg1)
+	ret0, _ := ret[0].(models.FraudScreening)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFraudScreening indicates an expected call of GetFraudScreening.
+func (mr *MockServiceProviderMockRecorder) GetFraudScreening(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFraudScreening", reflect.TypeOf((*MockServiceProvider)(nil).GetFraudScreening), arg0, arg1)
+}
+
+// GetGLExportFile mocks base method.
+func (m *MockServiceProvider) GetGLExportFile(arg0 context.Context, arg1 int64) (models.GLExport, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGLExportFile", arg0, arg1)
+	ret0, _ := ret[0].(models.GLExport)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGLExportFile indicates an expected call of GetGLExportFile.
+func (mr *MockServiceProviderMockRecorder) GetGLExportFile(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGLExportFile", reflect.TypeOf((*MockServiceProvider)(nil).GetGLExportFile), arg0, arg1)
+}
+
+// GetHold mocks base method.
+func (m *MockServiceProvider) GetHold(arg0 context.Context, arg1 int64) (models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", arg0, arg1)
+	ret0, _ := ret[0].(models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockServiceProviderMockRecorder) GetHold(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockServiceProvider)(nil).GetHold), arg0, arg1)
+}
+
+// GetLoan mocks base method.
+func (m *MockServiceProvider) GetLoan(arg0 context.Context, arg1 int64) (models.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoan", arg0, arg1)
+	ret0, _ := ret[0].(models.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoan indicates an expected call of GetLoan.
+func (mr *MockServiceProviderMockRecorder) GetLoan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoan", reflect.TypeOf((*MockServiceProvider)(nil).GetLoan), arg0, arg1)
+}
+
+// GetLoanSchedule mocks base method.
+func (m *MockServiceProvider) GetLoanSchedule(arg0 context.Context, arg1 int64) (models.LoanSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoanSchedule", arg0, arg1)
+	ret0, _ := ret[0].(models.LoanSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoanSchedule indicates an expected call of GetLoanSchedule.
+func (mr *MockServiceProviderMockRecorder) GetLoanSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanSchedule", reflect.TypeOf((*MockServiceProvider)(nil).GetLoanSchedule), arg0, arg1)
+}
+
+// GetPendingTransfer mocks base method.
+func (m *MockServiceProvider) GetPendingTransfer(arg0 context.Context, arg1 int64) (models.PendingTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingTransfer", arg0, arg1)
+	ret0, _ := ret[0].(models.PendingTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingTransfer indicates an expected call of GetPendingTransfer.
+func (mr *MockServiceProviderMockRecorder) GetPendingTransfer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTransfer", reflect.TypeOf((*MockServiceProvider)(nil).GetPendingTransfer), arg0, arg1)
+}
+
+// GetPocket mocks base method.
+func (m *MockServiceProvider) GetPocket(arg0 context.Context, arg1 int64) (models.Pocket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPocket", arg0, arg1)
+	ret0, _ := ret[0].(models.Pocket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPocket indicates an expected call of GetPocket.
+func (mr *MockServiceProviderMockRecorder) GetPocket(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPocket", reflect.TypeOf((*MockServiceProvider)(nil).GetPocket), arg0, arg1)
+}
+
+// GetPocketRule mocks base method.
+func (m *MockServiceProvider) GetPocketRule(arg0 context.Context, arg1 int64) (models.PocketRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPocketRule", arg0, arg1)
+	ret0, _ := ret[0].(models.PocketRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPocketRule indicates an expected call of GetPocketRule.
+func (mr *MockServiceProviderMockRecorder) GetPocketRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPocketRule", reflect.TypeOf((*MockServiceProvider)(nil).GetPocketRule), arg0, arg1)
+}
+
+// GetSpendingAnalytics mocks base method.
+func (m *MockServiceProvider) GetSpendingAnalytics(arg0 context.Context, arg1 models.SpendingAnalyticsParams) (models.SpendingAnalytics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingAnalytics", arg0, arg1)
+	ret0, _ := ret[0].(models.SpendingAnalytics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingAnalytics indicates an expected call of GetSpendingAnalytics.
+func (mr *MockServiceProviderMockRecorder) GetSpendingAnalytics(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingAnalytics", reflect.TypeOf((*MockServiceProvider)(nil).GetSpendingAnalytics), arg0, arg1)
+}
+
+// GetTellerOperation mocks base method.
+func (m *MockServiceProvider) GetTellerOperation(arg0 context.Context, arg1 int64) (models.TellerOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTellerOperation", arg0, arg1)
+	ret0, _ := ret[0].(models.TellerOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTellerOperation indicates an expected call of GetTellerOperation.
+func (mr *MockServiceProviderMockRecorder) GetTellerOperation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTellerOperation", reflect.TypeOf((*MockServiceProvider)(nil).GetTellerOperation), arg0, arg1)
+}
+
+// GetTransaction mocks base method.
+func (m *MockServiceProvider) GetTransaction(arg0 context.Context, arg1 int64) (models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransaction", arg0, arg1)
+	ret0, _ := ret[0].(models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransaction indicates an expected call of GetTransaction.
+func (mr *MockServiceProviderMockRecorder) GetTransaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockServiceProvider)(nil).GetTransaction), arg0, arg1)
+}
+
+// GetTransactionAnnotations mocks base method.
+func (m *MockServiceProvider) GetTransactionAnnotations(arg0 context.Context, arg1 int64, arg2 string) (models.TransactionAnnotations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionAnnotations", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.TransactionAnnotations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionAnnotations indicates an expected call of GetTransactionAnnotations.
+func (mr *MockServiceProviderMockRecorder) GetTransactionAnnotations(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionAnnotations", reflect.TypeOf((*MockServiceProvider)(nil).GetTransactionAnnotations), arg0, arg1, arg2)
+}
+
+// GetTransactionAttachment mocks base method.
+func (m *MockServiceProvider) GetTransactionAttachment(arg0 context.Context, arg1 int64, arg2 string) (models.TransactionAttachment, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionAttachment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.TransactionAttachment)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTransactionAttachment indicates an expected call of GetTransactionAttachment.
+func (mr *MockServiceProviderMockRecorder) GetTransactionAttachment(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionAttachment", reflect.TypeOf((*MockServiceProvider)(nil).GetTransactionAttachment), arg0, arg1, arg2)
+}
+
+// GetTrialBalances mocks base method.
+func (m *MockServiceProvider) GetTrialBalances(arg0 context.Context, arg1 time.Time) ([]models.TrialBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrialBalances", arg0, arg1)
+	ret0, _ := ret[0].([]models.TrialBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrialBalances indicates an expected call of GetTrialBalances.
+func (mr *MockServiceProviderMockRecorder) GetTrialBalances(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrialBalances", reflect.TypeOf((*MockServiceProvider)(nil).GetTrialBalances), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockServiceProvider) GetUser(arg0 context.Context, arg1 string) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockServiceProviderMockRecorder) GetUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockServiceProvider)(nil).GetUser), arg0, arg1)
+}
+
+// InviteAccountHolder mocks base method.
+func (m *MockServiceProvider) InviteAccountHolder(arg0 context.Context, arg1 models.InviteAccountHolderParams) (models.AccountHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteAccountHolder", arg0, arg1)
+	ret0, _ := ret[0].(models.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteAccountHolder indicates an expected call of InviteAccountHolder.
+func (mr *MockServiceProviderMockRecorder) InviteAccountHolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteAccountHolder", reflect.TypeOf((*MockServiceProvider)(nil).InviteAccountHolder), arg0, arg1)
+}
+
+// ListAccountHolders mocks base method.
+func (m *MockServiceProvider) ListAccountHolders(arg0 context.Context, arg1 int64) ([]models.AccountHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountHolders", arg0, arg1)
+	ret0, _ := ret[0].([]models.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountHolders indicates an expected call of ListAccountHolders.
+func (mr *MockServiceProviderMockRecorder) ListAccountHolders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountHolders", reflect.TypeOf((*MockServiceProvider)(nil).ListAccountHolders), arg0, arg1)
+}
+
+// ListAccountIDsByNumber mocks base method.
+func (m *MockServiceProvider) ListAccountIDsByNumber(arg0 context.Context, arg1 []string) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountIDsByNumber", arg0, arg1)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountIDsByNumber indicates an expected call of ListAccountIDsByNumber.
+func (mr *MockServiceProviderMockRecorder) ListAccountIDsByNumber(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountIDsByNumber", reflect.TypeOf((*MockServiceProvider)(nil).ListAccountIDsByNumber), arg0, arg1)
+}
+
+// ListAccountProducts mocks base method.
+func (m *MockServiceProvider) ListAccountProducts(arg0 context.Context) ([]models.AccountProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountProducts", arg0)
+	ret0, _ := ret[0].([]models.AccountProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountProducts indicates an expected call of ListAccountProducts.
+func (mr *MockServiceProviderMockRecorder) ListAccountProducts(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountProducts", reflect.TypeOf((*MockServiceProvider)(nil).ListAccountProducts), arg0)
+}
+
+// ListAccounts mocks base method.
+func (m *MockServiceProvider) ListAccounts(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockServiceProviderMockRecorder) ListAccounts(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockServiceProvider)(nil).ListAccounts), arg0, arg1, arg2, arg3)
+}
+
+// ListAlertRules mocks base method.
+func (m *MockServiceProvider) ListAlertRules(arg0 context.Context, arg1 int64, arg2 string) ([]models.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertRules", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertRules indicates an expected call of ListAlertRules.
+func (mr *MockServiceProviderMockRecorder) ListAlertRules(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockServiceProvider)(nil).AddAccountBalance), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertRules", reflect.TypeOf((*MockServiceProvider)(nil).ListAlertRules), arg0, arg1, arg2)
 }
 
-// CreateAccount mocks base method.
-func (m *MockServiceProvider) CreateAccount(arg0 context.Context, arg1 models.CreateAccountRequest, arg2 string) (models.Account, error) {
+// ListBeneficiaries mocks base method.
+func (m *MockServiceProvider) ListBeneficiaries(arg0 context.Context, arg1 string) ([]models.Beneficiary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", arg0, arg1, arg2)
-	ret0, _ := ret[0].(models.Account)
+	ret := m.ctrl.Call(m, "ListBeneficiaries", arg0, arg1)
+	ret0, _ := ret[0].([]models.Beneficiary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockServiceProviderMockRecorder) CreateAccount(arg0, arg1, arg2 any) *gomock.Call {
+// ListBeneficiaries indicates an expected call of ListBeneficiaries.
+func (mr *MockServiceProviderMockRecorder) ListBeneficiaries(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockServiceProvider)(nil).CreateAccount), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBeneficiaries", reflect.TypeOf((*MockServiceProvider)(nil).ListBeneficiaries), arg0, arg1)
 }
 
-// CreateEntry mocks base method.
-func (m *MockServiceProvider) CreateEntry(arg0 context.Context, arg1 models.Entry) (models.Entry, error) {
+// ListCategoryRules mocks base method.
+func (m *MockServiceProvider) ListCategoryRules(arg0 context.Context, arg1 string) ([]models.CategoryRule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEntry", arg0, arg1)
-	ret0, _ := ret[0].(models.Entry)
+	ret := m.ctrl.Call(m, "ListCategoryRules", arg0, arg1)
+	ret0, _ := ret[0].([]models.CategoryRule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEntry indicates an expected call of CreateEntry.
-func (mr *MockServiceProviderMockRecorder) CreateEntry(arg0, arg1 any) *gomock.Call {
+// ListCategoryRules indicates an expected call of ListCategoryRules.
+func (mr *MockServiceProviderMockRecorder) ListCategoryRules(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockServiceProvider)(nil).CreateEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryRules", reflect.TypeOf((*MockServiceProvider)(nil).ListCategoryRules), arg0, arg1)
 }
 
-// CreateTransaction mocks base method.
-func (m *MockServiceProvider) CreateTransaction(arg0 context.Context, arg1 models.Transaction) (models.Transaction, error) {
+// ListDefaultAccounts mocks base method.
+func (m *MockServiceProvider) ListDefaultAccounts(arg0 context.Context, arg1 string) ([]models.DefaultAccount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransaction", arg0, arg1)
-	ret0, _ := ret[0].(models.Transaction)
+	ret := m.ctrl.Call(m, "ListDefaultAccounts", arg0, arg1)
+	ret0, _ := ret[0].([]models.DefaultAccount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransaction indicates an expected call of CreateTransaction.
-func (mr *MockServiceProviderMockRecorder) CreateTransaction(arg0, arg1 any) *gomock.Call {
+// ListDefaultAccounts indicates an expected call of ListDefaultAccounts.
+func (mr *MockServiceProviderMockRecorder) ListDefaultAccounts(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockServiceProvider)(nil).CreateTransaction), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDefaultAccounts", reflect.TypeOf((*MockServiceProvider)(nil).ListDefaultAccounts), arg0, arg1)
 }
 
-// CreateUser mocks base method.
-func (m *MockServiceProvider) CreateUser(arg0 context.Context, arg1 models.CreateUserRequest) (models.User, error) {
+// ListDisputes mocks base method.
+func (m *MockServiceProvider) ListDisputes(arg0 context.Context, arg1 int64, arg2 string, arg3, arg4 int32) ([]models.Dispute, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
-	ret0, _ := ret[0].(models.User)
+	ret := m.ctrl.Call(m, "ListDisputes", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Dispute)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockServiceProviderMockRecorder) CreateUser(arg0, arg1 any) *gomock.Call {
+// ListDisputes indicates an expected call of ListDisputes.
+func (mr *MockServiceProviderMockRecorder) ListDisputes(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockServiceProvider)(nil).CreateUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisputes", reflect.TypeOf((*MockServiceProvider)(nil).ListDisputes), arg0, arg1, arg2, arg3, arg4)
 }
 
-// CreateUserTx mocks base method.
-func (m *MockServiceProvider) CreateUserTx(arg0 context.Context, arg1 models.CreateUserTxParams) (models.User, error) {
+// ListEntries mocks base method.
+func (m *MockServiceProvider) ListEntries(arg0 context.Context, arg1, arg2, arg3 int64) ([]models.Entry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserTx", arg0, arg1)
-	ret0, _ := ret[0].(models.User)
+	ret := m.ctrl.Call(m, "ListEntries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Entry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUserTx indicates an expected call of CreateUserTx.
-func (mr *MockServiceProviderMockRecorder) CreateUserTx(arg0, arg1 any) *gomock.Call {
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockServiceProviderMockRecorder) ListEntries(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockServiceProvider)(nil).CreateUserTx), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockServiceProvider)(nil).ListEntries), arg0, arg1, arg2, arg3)
 }
 
-// CreateVerifyEmail mocks base method.
-func (m *MockServiceProvider) CreateVerifyEmail(arg0 context.Context, arg1 models.VerifyEmails) (models.VerifyEmails, error) {
+// ListFraudScreenings mocks base method.
+func (m *MockServiceProvider) ListFraudScreenings(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]models.FraudScreening, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVerifyEmail", arg0, arg1)
-	ret0, _ := ret[0].(models.VerifyEmails)
+	ret := m.ctrl.Call(m, "ListFraudScreenings", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.FraudScreening)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateVerifyEmail indicates an expected call of CreateVerifyEmail.
-func (mr *MockServiceProviderMockRecorder) CreateVerifyEmail(arg0, arg1 any) *gomock.Call {
+// ListFraudScreenings indicates an expected call of ListFraudScreenings.
+func (mr *MockServiceProviderMockRecorder) ListFraudScreenings(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerifyEmail", reflect.TypeOf((*MockServiceProvider)(nil).CreateVerifyEmail), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFraudScreenings", reflect.TypeOf((*MockServiceProvider)(nil).ListFraudScreenings), arg0, arg1, arg2, arg3)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockServiceProvider) DeleteAccount(arg0 context.Context, arg1 int64) error {
+// ListGLExports mocks base method.
+func (m *MockServiceProvider) ListGLExports(arg0 context.Context, arg1, arg2 int32) ([]models.GLExport, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListGLExports", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.GLExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockServiceProviderMockRecorder) DeleteAccount(arg0, arg1 any) *gomock.Call {
+// ListGLExports indicates an expected call of ListGLExports.
+func (mr *MockServiceProviderMockRecorder) ListGLExports(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockServiceProvider)(nil).DeleteAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGLExports", reflect.TypeOf((*MockServiceProvider)(nil).ListGLExports), arg0, arg1, arg2)
 }
 
-// FetchSession mocks base method.
-func (m *MockServiceProvider) FetchSession(arg0 context.Context, arg1 uuid.UUID) (models.Session, error) {
+// ListHolds mocks base method.
+func (m *MockServiceProvider) ListHolds(arg0 context.Context, arg1 int64, arg2, arg3 int32) ([]models.Hold, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchSession", arg0, arg1)
-	ret0, _ := ret[0].(models.Session)
+	ret := m.ctrl.Call(m, "ListHolds", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Hold)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FetchSession indicates an expected call of FetchSession.
-func (mr *MockServiceProviderMockRecorder) FetchSession(arg0, arg1 any) *gomock.Call {
+// ListHolds indicates an expected call of ListHolds.
+func (mr *MockServiceProviderMockRecorder) ListHolds(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSession", reflect.TypeOf((*MockServiceProvider)(nil).FetchSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHolds", reflect.TypeOf((*MockServiceProvider)(nil).ListHolds), arg0, arg1, arg2, arg3)
 }
 
-// GetAccount mocks base method.
-func (m *MockServiceProvider) GetAccount(arg0 context.Context, arg1 int64) (models.Account, error) {
+// ListInterestAccruals mocks base method.
+func (m *MockServiceProvider) ListInterestAccruals(arg0 context.Context, arg1 int64, arg2, arg3 int32) ([]models.InterestAccrual, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
-	ret0, _ := ret[0].(models.Account)
+	ret := m.ctrl.Call(m, "ListInterestAccruals", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.InterestAccrual)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockServiceProviderMockRecorder) GetAccount(arg0, arg1 any) *gomock.Call {
+// ListInterestAccruals indicates an expected call of ListInterestAccruals.
+func (mr *MockServiceProviderMockRecorder) ListInterestAccruals(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockServiceProvider)(nil).GetAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInterestAccruals", reflect.TypeOf((*MockServiceProvider)(nil).ListInterestAccruals), arg0, arg1, arg2, arg3)
 }
 
-// GetAccountForUpdate mocks base method.
-func (m *MockServiceProvider) GetAccountForUpdate(arg0 context.Context, arg1 int64) (models.Account, error) {
+// ListLoans mocks base method.
+func (m *MockServiceProvider) ListLoans(arg0 context.Context, arg1 int64) ([]models.Loan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountForUpdate", arg0, arg1)
-	ret0, _ := ret[0].(models.Account)
+	ret := m.ctrl.Call(m, "ListLoans", arg0, arg1)
+	ret0, _ := ret[0].([]models.Loan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
-func (mr *MockServiceProviderMockRecorder) GetAccountForUpdate(arg0, arg1 any) *gomock.Call {
+// ListLoans indicates an expected call of ListLoans.
+func (mr *MockServiceProviderMockRecorder) ListLoans(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockServiceProvider)(nil).GetAccountForUpdate), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoans", reflect.TypeOf((*MockServiceProvider)(nil).ListLoans), arg0, arg1)
 }
 
-// GetEntry mocks base method.
-func (m *MockServiceProvider) GetEntry(arg0 context.Context, arg1 int64) (models.Entry, error) {
+// ListOverdraftRequests mocks base method.
+func (m *MockServiceProvider) ListOverdraftRequests(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]models.OverdraftRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEntry", arg0, arg1)
-	ret0, _ := ret[0].(models.Entry)
+	ret := m.ctrl.Call(m, "ListOverdraftRequests", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.OverdraftRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEntry indicates an expected call of GetEntry.
-func (mr *MockServiceProviderMockRecorder) GetEntry(arg0, arg1 any) *gomock.Call {
+// ListOverdraftRequests indicates an expected call of ListOverdraftRequests.
+func (mr *MockServiceProviderMockRecorder) ListOverdraftRequests(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockServiceProvider)(nil).GetEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdraftRequests", reflect.TypeOf((*MockServiceProvider)(nil).ListOverdraftRequests), arg0, arg1, arg2, arg3)
 }
 
-// GetTransaction mocks base method.
-func (m *MockServiceProvider) GetTransaction(arg0 context.Context, arg1 int64) (models.Transaction, error) {
+// ListPaymentAliases mocks base method.
+func (m *MockServiceProvider) ListPaymentAliases(arg0 context.Context, arg1 string) ([]models.PaymentAlias, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransaction", arg0, arg1)
-	ret0, _ := ret[0].(models.Transaction)
+	ret := m.ctrl.Call(m, "ListPaymentAliases", arg0, arg1)
+	ret0, _ := ret[0].([]models.PaymentAlias)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransaction indicates an expected call of GetTransaction.
-func (mr *MockServiceProviderMockRecorder) GetTransaction(arg0, arg1 any) *gomock.Call {
+// ListPaymentAliases indicates an expected call of ListPaymentAliases.
+func (mr *MockServiceProviderMockRecorder) ListPaymentAliases(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockServiceProvider)(nil).GetTransaction), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentAliases", reflect.TypeOf((*MockServiceProvider)(nil).ListPaymentAliases), arg0, arg1)
 }
 
-// GetUser mocks base method.
-func (m *MockServiceProvider) GetUser(arg0 context.Context, arg1 string) (models.User, error) {
+// ListPendingTransferEvents mocks base method.
+func (m *MockServiceProvider) ListPendingTransferEvents(arg0 context.Context, arg1 int64) ([]models.PendingTransferEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
-	ret0, _ := ret[0].(models.User)
+	ret := m.ctrl.Call(m, "ListPendingTransferEvents", arg0, arg1)
+	ret0, _ := ret[0].([]models.PendingTransferEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockServiceProviderMockRecorder) GetUser(arg0, arg1 any) *gomock.Call {
+// ListPendingTransferEvents indicates an expected call of ListPendingTransferEvents.
+func (mr *MockServiceProviderMockRecorder) ListPendingTransferEvents(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockServiceProvider)(nil).GetUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingTransferEvents", reflect.TypeOf((*MockServiceProvider)(nil).ListPendingTransferEvents), arg0, arg1)
 }
 
-// ListAccounts mocks base method.
-func (m *MockServiceProvider) ListAccounts(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]models.Account, error) {
+// ListPendingTransfers mocks base method.
+func (m *MockServiceProvider) ListPendingTransfers(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]models.PendingTransfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAccounts", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]models.Account)
+	ret := m.ctrl.Call(m, "ListPendingTransfers", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.PendingTransfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAccounts indicates an expected call of ListAccounts.
-func (mr *MockServiceProviderMockRecorder) ListAccounts(arg0, arg1, arg2, arg3 any) *gomock.Call {
+// ListPendingTransfers indicates an expected call of ListPendingTransfers.
+func (mr *MockServiceProviderMockRecorder) ListPendingTransfers(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockServiceProvider)(nil).ListAccounts), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingTransfers", reflect.TypeOf((*MockServiceProvider)(nil).ListPendingTransfers), arg0, arg1, arg2, arg3)
 }
 
-// ListEntries mocks base method.
-func (m *MockServiceProvider) ListEntries(arg0 context.Context, arg1, arg2, arg3 int64) ([]models.Entry, error) {
+// ListPocketEntries mocks base method.
+func (m *MockServiceProvider) ListPocketEntries(arg0 context.Context, arg1 int64, arg2, arg3 int32) ([]models.PocketEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEntries", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]models.Entry)
+	ret := m.ctrl.Call(m, "ListPocketEntries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.PocketEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntries indicates an expected call of ListEntries.
-func (mr *MockServiceProviderMockRecorder) ListEntries(arg0, arg1, arg2, arg3 any) *gomock.Call {
+// ListPocketEntries indicates an expected call of ListPocketEntries.
+func (mr *MockServiceProviderMockRecorder) ListPocketEntries(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockServiceProvider)(nil).ListEntries), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPocketEntries", reflect.TypeOf((*MockServiceProvider)(nil).ListPocketEntries), arg0, arg1, arg2, arg3)
+}
+
+// ListPockets mocks base method.
+func (m *MockServiceProvider) ListPockets(arg0 context.Context, arg1 int64) ([]models.Pocket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPockets", arg0, arg1)
+	ret0, _ := ret[0].([]models.Pocket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPockets indicates an expected call of ListPockets.
+func (mr *MockServiceProviderMockRecorder) ListPockets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPockets", reflect.TypeOf((*MockServiceProvider)(nil).ListPockets), arg0, arg1)
+}
+
+// ListTellerOperations mocks base method.
+func (m *MockServiceProvider) ListTellerOperations(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]models.TellerOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTellerOperations", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.TellerOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTellerOperations indicates an expected call of ListTellerOperations.
+func (mr *MockServiceProviderMockRecorder) ListTellerOperations(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTellerOperations", reflect.TypeOf((*MockServiceProvider)(nil).ListTellerOperations), arg0, arg1, arg2, arg3)
+}
+
+// ListTellerReasonCodes mocks base method.
+func (m *MockServiceProvider) ListTellerReasonCodes(arg0 context.Context) ([]models.TellerReasonCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTellerReasonCodes", arg0)
+	ret0, _ := ret[0].([]models.TellerReasonCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTellerReasonCodes indicates an expected call of ListTellerReasonCodes.
+func (mr *MockServiceProviderMockRecorder) ListTellerReasonCodes(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTellerReasonCodes", reflect.TypeOf((*MockServiceProvider)(nil).ListTellerReasonCodes), arg0)
 }
 
 // ListTransactions mocks base method.
@@ -325,6 +1296,21 @@ func (mr *MockServiceProviderMockRecorder) LoginUser(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginUser", reflect.TypeOf((*MockServiceProvider)(nil).LoginUser), arg0, arg1)
 }
 
+// MovePocketFunds mocks base method.
+func (m *MockServiceProvider) MovePocketFunds(arg0 context.Context, arg1 models.MovePocketFundsParams) (models.MovePocketFundsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovePocketFunds", arg0, arg1)
+	ret0, _ := ret[0].(models.MovePocketFundsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovePocketFunds indicates an expected call of MovePocketFunds.
+func (mr *MockServiceProviderMockRecorder) MovePocketFunds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePocketFunds", reflect.TypeOf((*MockServiceProvider)(nil).MovePocketFunds), arg0, arg1)
+}
+
 // NewSession mocks base method.
 func (m *MockServiceProvider) NewSession(arg0 context.Context, arg1 models.Session) (models.Session, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +1326,126 @@ func (mr *MockServiceProviderMockRecorder) NewSession(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSession", reflect.TypeOf((*MockServiceProvider)(nil).NewSession), arg0, arg1)
 }
 
+// OpenDispute mocks base method.
+func (m *MockServiceProvider) OpenDispute(arg0 context.Context, arg1 models.OpenDisputeParams) (models.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDispute", arg0, arg1)
+	ret0, _ := ret[0].(models.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenDispute indicates an expected call of OpenDispute.
+func (mr *MockServiceProviderMockRecorder) OpenDispute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDispute", reflect.TypeOf((*MockServiceProvider)(nil).OpenDispute), arg0, arg1)
+}
+
+// PayOffLoan mocks base method.
+func (m *MockServiceProvider) PayOffLoan(arg0 context.Context, arg1 int64) (models.LoanSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayOffLoan", arg0, arg1)
+	ret0, _ := ret[0].(models.LoanSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayOffLoan indicates an expected call of PayOffLoan.
+func (mr *MockServiceProviderMockRecorder) PayOffLoan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayOffLoan", reflect.TypeOf((*MockServiceProvider)(nil).PayOffLoan), arg0, arg1)
+}
+
+// ResolveFraudScreening mocks base method.
+func (m *MockServiceProvider) ResolveFraudScreening(arg0 context.Context, arg1 models.ResolveFraudScreeningParams) (models.FraudScreening, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveFraudScreening", arg0, arg1)
+	ret0, _ := ret[0].(models.FraudScreening)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveFraudScreening indicates an expected call of ResolveFraudScreening.
+func (mr *MockServiceProviderMockRecorder) ResolveFraudScreening(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveFraudScreening", reflect.TypeOf((*MockServiceProvider)(nil).ResolveFraudScreening), arg0, arg1)
+}
+
+// ResolvePaymentAlias mocks base method.
+func (m *MockServiceProvider) ResolvePaymentAlias(arg0 context.Context, arg1, arg2 string) (models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePaymentAlias", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePaymentAlias indicates an expected call of ResolvePaymentAlias.
+func (mr *MockServiceProviderMockRecorder) ResolvePaymentAlias(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePaymentAlias", reflect.TypeOf((*MockServiceProvider)(nil).ResolvePaymentAlias), arg0, arg1, arg2)
+}
+
+// SearchTransactionsByTag mocks base method.
+func (m *MockServiceProvider) SearchTransactionsByTag(arg0 context.Context, arg1 models.SearchTransactionsByTagParams) ([]models.TaggedTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTransactionsByTag", arg0, arg1)
+	ret0, _ := ret[0].([]models.TaggedTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTransactionsByTag indicates an expected call of SearchTransactionsByTag.
+func (mr *MockServiceProviderMockRecorder) SearchTransactionsByTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTransactionsByTag", reflect.TypeOf((*MockServiceProvider)(nil).SearchTransactionsByTag), arg0, arg1)
+}
+
+// SetDefaultAccount mocks base method.
+func (m *MockServiceProvider) SetDefaultAccount(arg0 context.Context, arg1 string, arg2 int64) (models.DefaultAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.DefaultAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDefaultAccount indicates an expected call of SetDefaultAccount.
+func (mr *MockServiceProviderMockRecorder) SetDefaultAccount(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultAccount", reflect.TypeOf((*MockServiceProvider)(nil).SetDefaultAccount), arg0, arg1, arg2)
+}
+
+// SetTransactionCategory mocks base method.
+func (m *MockServiceProvider) SetTransactionCategory(arg0 context.Context, arg1 models.TransactionCategory) (models.TransactionCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransactionCategory", arg0, arg1)
+	ret0, _ := ret[0].(models.TransactionCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTransactionCategory indicates an expected call of SetTransactionCategory.
+func (mr *MockServiceProviderMockRecorder) SetTransactionCategory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransactionCategory", reflect.TypeOf((*MockServiceProvider)(nil).SetTransactionCategory), arg0, arg1)
+}
+
+// SetTransactionNote mocks base method.
+func (m *MockServiceProvider) SetTransactionNote(arg0 context.Context, arg1 models.TransactionNote) (models.TransactionNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransactionNote", arg0, arg1)
+	ret0, _ := ret[0].(models.TransactionNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTransactionNote indicates an expected call of SetTransactionNote.
+func (mr *MockServiceProviderMockRecorder) SetTransactionNote(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransactionNote", reflect.TypeOf((*MockServiceProvider)(nil).SetTransactionNote), arg0, arg1)
+}
+
 // TransferTx mocks base method.
 func (m *MockServiceProvider) TransferTx(arg0 context.Context, arg1 models.TransferTxParams) (models.TransferTxResult, error) {
 	m.ctrl.T.Helper()
@@ -355,19 +1461,49 @@ func (mr *MockServiceProviderMockRecorder) TransferTx(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferTx", reflect.TypeOf((*MockServiceProvider)(nil).TransferTx), arg0, arg1)
 }
 
-// UpdateAccount mocks base method.
-func (m *MockServiceProvider) UpdateAccount(arg0 context.Context, arg1 int64, arg2 float64) (models.Account, error) {
+// UpdateAccountStatus mocks base method.
+func (m *MockServiceProvider) UpdateAccountStatus(arg0 context.Context, arg1 models.UpdateAccountStatusParams) (models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAccount", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdateAccountStatus", arg0, arg1)
 	ret0, _ := ret[0].(models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateAccount indicates an expected call of UpdateAccount.
-func (mr *MockServiceProviderMockRecorder) UpdateAccount(arg0, arg1, arg2 any) *gomock.Call {
+// UpdateAccountStatus indicates an expected call of UpdateAccountStatus.
+func (mr *MockServiceProviderMockRecorder) UpdateAccountStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountStatus", reflect.TypeOf((*MockServiceProvider)(nil).UpdateAccountStatus), arg0, arg1)
+}
+
+// UpdateBeneficiary mocks base method.
+func (m *MockServiceProvider) UpdateBeneficiary(arg0 context.Context, arg1 models.UpdateBeneficiaryParams) (models.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBeneficiary", arg0, arg1)
+	ret0, _ := ret[0].(models.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBeneficiary indicates an expected call of UpdateBeneficiary.
+func (mr *MockServiceProviderMockRecorder) UpdateBeneficiary(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockServiceProvider)(nil).UpdateAccount), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBeneficiary", reflect.TypeOf((*MockServiceProvider)(nil).UpdateBeneficiary), arg0, arg1)
+}
+
+// UpdateDispute mocks base method.
+func (m *MockServiceProvider) UpdateDispute(arg0 context.Context, arg1 models.UpdateDisputeParams) (models.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDispute", arg0, arg1)
+	ret0, _ := ret[0].(models.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDispute indicates an expected call of UpdateDispute.
+func (mr *MockServiceProviderMockRecorder) UpdateDispute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDispute", reflect.TypeOf((*MockServiceProvider)(nil).UpdateDispute), arg0, arg1)
 }
 
 // UpdateUser mocks base method.
@@ -385,6 +1521,21 @@ func (mr *MockServiceProviderMockRecorder) UpdateUser(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockServiceProvider)(nil).UpdateUser), arg0, arg1)
 }
 
+// UploadTransactionAttachment mocks base method.
+func (m *MockServiceProvider) UploadTransactionAttachment(arg0 context.Context, arg1 models.TransactionAttachment, arg2 []byte) (models.TransactionAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadTransactionAttachment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.TransactionAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadTransactionAttachment indicates an expected call of UploadTransactionAttachment.
+func (mr *MockServiceProviderMockRecorder) UploadTransactionAttachment(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTransactionAttachment", reflect.TypeOf((*MockServiceProvider)(nil).UploadTransactionAttachment), arg0, arg1, arg2)
+}
+
 // VerifyEmailTx mocks base method.
 func (m *MockServiceProvider) VerifyEmailTx(arg0 context.Context, arg1 models.VerifyEmailTxParams) (models.VerifyEmailTxResult, error) {
 	m.ctrl.T.Helper()
@@ -399,3 +1550,33 @@ func (mr *MockServiceProviderMockRecorder) VerifyEmailTx(arg0, arg1 any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmailTx", reflect.TypeOf((*MockServiceProvider)(nil).VerifyEmailTx), arg0, arg1)
 }
+
+// VerifyPaymentAlias mocks base method.
+func (m *MockServiceProvider) VerifyPaymentAlias(arg0 context.Context, arg1 models.VerifyPaymentAliasParams) (models.PaymentAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPaymentAlias", arg0, arg1)
+	ret0, _ := ret[0].(models.PaymentAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyPaymentAlias indicates an expected call of VerifyPaymentAlias.
+func (mr *MockServiceProviderMockRecorder) VerifyPaymentAlias(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPaymentAlias", reflect.TypeOf((*MockServiceProvider)(nil).VerifyPaymentAlias), arg0, arg1)
+}
+
+// VoidHold mocks base method.
+func (m *MockServiceProvider) VoidHold(arg0 context.Context, arg1 int64) (models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidHold", arg0, arg1)
+	ret0, _ := ret[0].(models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoidHold indicates an expected call of VoidHold.
+func (mr *MockServiceProviderMockRecorder) VoidHold(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidHold", reflect.TypeOf((*MockServiceProvider)(nil).VoidHold), arg0, arg1)
+}
@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/zde37/Swift_Bank/worker (interfaces: TaskDistributor)
+//
+// Generated by this command:
+//
+//	mockgen -package mockedproviders -destination mock/task_distributor.go github.com/zde37/Swift_Bank/worker TaskDistributor
+//
+
+// Package mockedproviders is a generated GoMock package.
+package mockedproviders
+
+import (
+	context "context"
+	reflect "reflect"
+
+	asynq "github.com/hibiken/asynq"
+	worker "github.com/zde37/Swift_Bank/worker"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskDistributor is a mock of TaskDistributor interface.
+type MockTaskDistributor struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskDistributorMockRecorder
+}
+
+// MockTaskDistributorMockRecorder is the mock recorder for MockTaskDistributor.
+type MockTaskDistributorMockRecorder struct {
+	mock *MockTaskDistributor
+}
+
+// NewMockTaskDistributor creates a new mock instance.
+func NewMockTaskDistributor(ctrl *gomock.Controller) *MockTaskDistributor {
+	mock := &MockTaskDistributor{ctrl: ctrl}
+	mock.recorder = &MockTaskDistributorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskDistributor) EXPECT() *MockTaskDistributorMockRecorder {
+	return m.recorder
+}
+
+// DistributeTaskSendAlert mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendAlert(arg0 context.Context, arg1 *worker.PayloadSendAlert, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendAlert", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendAlert indicates an expected call of DistributeTaskSendAlert.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendAlert(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendAlert", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendAlert), varargs...)
+}
+
+// DistributeTaskSendAliasCode mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendAliasCode(arg0 context.Context, arg1 *worker.PayloadSendAliasCode, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendAliasCode", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendAliasCode indicates an expected call of DistributeTaskSendAliasCode.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendAliasCode(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendAliasCode", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendAliasCode), varargs...)
+}
+
+// DistributeTaskSendDisputeUpdate mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendDisputeUpdate(arg0 context.Context, arg1 *worker.PayloadSendDisputeUpdate, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendDisputeUpdate", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendDisputeUpdate indicates an expected call of DistributeTaskSendDisputeUpdate.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendDisputeUpdate(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendDisputeUpdate", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendDisputeUpdate), varargs...)
+}
+
+// DistributeTaskSendTransferNotice mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendTransferNotice(arg0 context.Context, arg1 *worker.PayloadSendTransferNotice, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendTransferNotice", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendTransferNotice indicates an expected call of DistributeTaskSendTransferNotice.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendTransferNotice(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendTransferNotice", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendTransferNotice), varargs...)
+}
+
+// DistributeTaskSendVerifyEmail mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendVerifyEmail(arg0 context.Context, arg1 *worker.PayloadSendVerifyEmail, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendVerifyEmail", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendVerifyEmail indicates an expected call of DistributeTaskSendVerifyEmail.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendVerifyEmail(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendVerifyEmail", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendVerifyEmail), varargs...)
+}
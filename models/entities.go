@@ -2,24 +2,34 @@ package models
 
 import (
 	"database/sql"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Account struct {
-	ID        int64     `json:"id"`
-	Owner     string    `json:"owner"`
-	Balance   float64   `json:"balance"`
-	Currency  string    `json:"currency"`
-	CreatedAt time.Time `json:"created_at"`
+	ID               int64      `json:"-"`              // internal, customers only ever see the account number
+	AccountNumber    string     `json:"account_number"` // what customers see and type, with mod-97 check digits
+	Owner            string     `json:"owner"`
+	Balance          float64    `json:"balance"`           // ledger balance
+	AvailableBalance float64    `json:"available_balance"` // ledger balance minus active holds and money set aside in pockets
+	Currency         string     `json:"currency"`
+	ProductCode      string     `json:"product_code"`
+	MaturesAt        *time.Time `json:"matures_at,omitempty"` // fixed term accounts only
+	Status           string     `json:"status"`
+	ClosedAt         *time.Time `json:"closed_at,omitempty"`
+	OverdraftLimit   float64    `json:"overdraft_limit"`           // how far below zero the available balance may go
+	OverdrawnSince   *time.Time `json:"overdrawn_since,omitempty"` // set while the ledger balance is negative
+	CreatedAt        time.Time  `json:"created_at"`
 }
 
 type Entry struct {
-	ID        int64     `json:"id"`
-	AccountID int64     `json:"account_id"`
-	Amount    float64   `json:"amount"` // can be negative or positive
-	CreatedAt time.Time `json:"created_at"`
+	ID            int64     `json:"id"`
+	AccountID     int64     `json:"-"`
+	AccountNumber string    `json:"account_number"`
+	Amount        float64   `json:"amount"` // can be negative or positive
+	CreatedAt     time.Time `json:"created_at"`
 }
 
 type VerifyEmails struct {
@@ -33,14 +43,16 @@ type VerifyEmails struct {
 }
 
 type Transaction struct {
-	ID            int64     `json:"id"`
-	FromAccountID int64     `json:"from_account_id"`
-	ToAccountID   int64     `json:"to_account_id"`
-	Currency      string    `json:"currency"`
-	Description   string    `json:"description"`
-	Amount        float64   `json:"amount"` // must be positive
-	Fee           float64   `json:"fee"`    // must be positive
-	CreatedAt     time.Time `json:"created_at"`
+	ID                int64     `json:"id"`
+	FromAccountID     int64     `json:"-"`
+	FromAccountNumber string    `json:"from_account_number"`
+	ToAccountID       int64     `json:"-"`
+	ToAccountNumber   string    `json:"to_account_number"`
+	Currency          string    `json:"currency"`
+	Description       string    `json:"description"`
+	Amount            float64   `json:"amount"` // must be positive
+	Fee               float64   `json:"fee"`    // must be positive
+	CreatedAt         time.Time `json:"created_at"`
 }
 
 type User struct {
@@ -49,10 +61,19 @@ type User struct {
 	FullName          string    `json:"full_name"`
 	Email             string    `json:"email"`
 	IsEmailVerified   bool      `json:"is_email_verified"`
+	Role              string    `json:"role"`
+	StatementsOptOut  bool      `json:"statements_opt_out"`
+	Locale            string    `json:"locale"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
 }
 
+const (
+	RoleCustomer = "customer"
+	RoleTeller   = "teller"
+	RoleAdmin    = "admin"
+)
+
 type CreateUserTxParams struct {
 	User
 	AfterCreate func(user User) error
@@ -75,6 +96,9 @@ type UpdateUserParams struct {
 	Email             sql.NullString `json:"email"`
 	IsEmailVerified   sql.NullBool   `json:"is_email_verified"`
 	PasswordChangedAt sql.NullTime   `json:"password_changed_at"`
+	Role              sql.NullString `json:"role"`
+	StatementsOptOut  sql.NullBool   `json:"statements_opt_out"`
+	Locale            sql.NullString `json:"locale"`
 }
 
 type Session struct {
@@ -95,12 +119,1058 @@ type TransferTxParams struct {
 	Currency      string  `json:"currency"`
 	Description   string  `json:"description"`
 	Fee           float64 `json:"fee"`
+	InitiatedBy   string  `json:"initiated_by"` // only needed when the transfer may wait for approval
+	UserAgent     string  `json:"-"`            // identifies the device for fraud screening
 }
 
 type TransferTxResult struct {
-	Transaction Transaction `json:"transaction"`
-	ToAccount   Account     `json:"to_account"`
-	FromAccount Account     `json:"from_account"`
-	ToEntry     Entry       `json:"to_entry"`
-	FromEntry   Entry       `json:"from_entry"`
+	Transaction Transaction      `json:"transaction"`
+	ToAccount   Account          `json:"to_account"`
+	FromAccount Account          `json:"from_account"`
+	ToEntry     Entry            `json:"to_entry"`
+	FromEntry   Entry            `json:"from_entry"`
+	Pending     *PendingTransfer `json:"pending_transfer,omitempty"` // set instead of the rest when the transfer waits for approval
+	Alerts      []TriggeredAlert `json:"-"`                          // alert rules the transfer set off
+	Overdrafts  []OverdraftEvent `json:"-"`                          // accounts the transfer moved into or out of overdraft
+	Pockets     []PocketEntry    `json:"-"`                          // money pocket rules set aside because of the transfer
+	Notices     []TransferNotice `json:"-"`                          // debit and credit notices to send once committed
+}
+
+const (
+	HoldStatusActive   = "active"
+	HoldStatusCaptured = "captured"
+	HoldStatusVoided   = "voided"
+	HoldStatusExpired  = "expired"
+)
+
+type Hold struct {
+	ID              int64      `json:"id"`
+	AccountID       int64      `json:"-"`
+	AccountNumber   string     `json:"account_number"`
+	ToAccountID     int64      `json:"-"`
+	ToAccountNumber string     `json:"to_account_number"`
+	Amount          float64    `json:"amount"`
+	CapturedAmount  float64    `json:"captured_amount"`
+	Currency        string     `json:"currency"`
+	Description     string     `json:"description"`
+	Status          string     `json:"status"`
+	TransactionID   *int64     `json:"transaction_id,omitempty"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	ReleasedAt      *time.Time `json:"released_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+type CreateHoldParams struct {
+	AccountID   int64     `json:"account_id"`
+	ToAccountID int64     `json:"to_account_id"`
+	Amount      float64   `json:"amount"`
+	Currency    string    `json:"currency"`
+	Description string    `json:"description"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+type CaptureHoldParams struct {
+	HoldID int64   `json:"hold_id"`
+	Amount float64 `json:"amount"` // may be less than the held amount, the rest is released
+}
+
+type CaptureHoldResult struct {
+	Hold     Hold             `json:"hold"`
+	Transfer TransferTxResult `json:"transfer"`
+}
+
+const (
+	AccountTypeChecking  = "checking"
+	AccountTypeSavings   = "savings"
+	AccountTypeFixedTerm = "fixed_term"
+)
+
+// DefaultProductCode is used when an account is opened without choosing a product
+const DefaultProductCode = "checking"
+
+// Codes of the bank's own accounts, there is one internal account per code and currency
+const (
+	InternalAccountInterestExpense   = "interest_expense"
+	InternalAccountCash              = "cash"
+	InternalAccountSuspense          = "suspense"
+	InternalAccountOverdraftInterest = "overdraft_interest_income"
+	InternalAccountOverdraftFees     = "overdraft_fee_income"
+	InternalAccountLoanPrincipal     = "loan_principal"
+	InternalAccountLoanInterest      = "loan_interest_income"
+	InternalAccountLoanFees          = "loan_fee_income"
+)
+
+type AccountProduct struct {
+	Code               string    `json:"code"`
+	Name               string    `json:"name"`
+	AccountType        string    `json:"account_type"`
+	InterestRate       float64   `json:"interest_rate"` // annual, 0.02 is 2%
+	DayCountConvention string    `json:"day_count_convention"`
+	TermMonths         int32     `json:"term_months"` // fixed term accounts only
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// InterestBearingAccount is an account together with the product parameters needed to accrue its interest
+type InterestBearingAccount struct {
+	AccountID          int64   `json:"account_id"`
+	Balance            float64 `json:"balance"`
+	InterestRate       float64 `json:"interest_rate"`
+	DayCountConvention string  `json:"day_count_convention"`
+}
+
+type InterestAccrual struct {
+	ID                 int64     `json:"id"`
+	AccountID          int64     `json:"-"`
+	AccountNumber      string    `json:"account_number"`
+	AccrualDate        time.Time `json:"accrual_date"`
+	Balance            float64   `json:"balance"`
+	InterestRate       float64   `json:"interest_rate"`
+	DayCountConvention string    `json:"day_count_convention"`
+	Amount             float64   `json:"amount"`
+	TransactionID      *int64    `json:"transaction_id,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+type PayInterestTxParams struct {
+	AccountID   int64     `json:"account_id"`
+	Before      time.Time `json:"before"` // accruals dated before this are paid
+	Description string    `json:"description"`
+}
+
+type PayInterestTxResult struct {
+	Accruals int64             `json:"accruals"`
+	Transfer *TransferTxResult `json:"transfer,omitempty"` // nil when the accrued amount rounds to zero
+}
+
+const (
+	AccountStatusActive  = "active"
+	AccountStatusFrozen  = "frozen"  // credits only
+	AccountStatusClosing = "closing" // credits only, waiting to be closed
+	AccountStatusClosed  = "closed"  // no postings, kept for history and statements
+)
+
+// accountStatusTransitions lists the statuses an account may move to from each status
+var accountStatusTransitions = map[string][]string{
+	AccountStatusActive:  {AccountStatusFrozen, AccountStatusClosing, AccountStatusClosed},
+	AccountStatusFrozen:  {AccountStatusActive},
+	AccountStatusClosing: {AccountStatusActive, AccountStatusFrozen, AccountStatusClosed},
+}
+
+func CanTransitionAccountStatus(from, to string) bool {
+	for _, status := range accountStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+type UpdateAccountStatusParams struct {
+	AccountID int64  `json:"account_id"`
+	Status    string `json:"status"`
+	Reason    string `json:"reason"`
+	ChangedBy string `json:"changed_by"`
+	ByStaff   bool   `json:"by_staff"` // only staff may move an account out of frozen
+}
+
+type CloseAccountTxParams struct {
+	AccountID        int64  `json:"account_id"`
+	SweepToAccountID int64  `json:"sweep_to_account_id"` // 0 when the balance must already be zero
+	ChangedBy        string `json:"changed_by"`
+}
+
+type CloseAccountTxResult struct {
+	Account  Account              `json:"account"`
+	Interest *PayInterestTxResult `json:"interest,omitempty"` // interest still owed, paid before closing
+	Sweep    *TransferTxResult    `json:"sweep,omitempty"`
+}
+
+const (
+	TellerOperationDeposit    = "deposit"
+	TellerOperationWithdrawal = "withdrawal"
+	TellerOperationAdjustment = "adjustment"
+)
+
+const (
+	TellerOperationPendingApproval = "pending_approval"
+	TellerOperationCompleted       = "completed"
+	TellerOperationRejected        = "rejected"
+)
+
+type TellerReasonCode struct {
+	Code        string `json:"code"`
+	Kind        string `json:"kind"`
+	Description string `json:"description"`
+}
+
+type TellerOperation struct {
+	ID            int64      `json:"id"`
+	Kind          string     `json:"kind"`
+	AccountID     int64      `json:"-"`
+	AccountNumber string     `json:"account_number"`
+	Amount        float64    `json:"amount"` // negative for debits to the customer account
+	Currency      string     `json:"currency"`
+	ReasonCode    string     `json:"reason_code"`
+	Note          string     `json:"note"`
+	Status        string     `json:"status"`
+	InitiatedBy   string     `json:"initiated_by"`
+	DecidedBy     *string    `json:"decided_by,omitempty"`
+	TransactionID *int64     `json:"transaction_id,omitempty"`
+	DecidedAt     *time.Time `json:"decided_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type CreateTellerOperationParams struct {
+	Kind             string  `json:"kind"`
+	AccountID        int64   `json:"account_id"`
+	Amount           float64 `json:"amount"` // negative for debits to the customer account
+	Currency         string  `json:"currency"`
+	ReasonCode       string  `json:"reason_code"`
+	Note             string  `json:"note"`
+	InitiatedBy      string  `json:"initiated_by"`
+	RequiresApproval bool    `json:"requires_approval"`
+}
+
+type DecideTellerOperationParams struct {
+	OperationID int64  `json:"operation_id"`
+	DecidedBy   string `json:"decided_by"`
+	Approve     bool   `json:"approve"`
+}
+
+const (
+	BatchModeAllOrNothing = "all_or_nothing"
+	BatchModeBestEffort   = "best_effort"
+)
+
+const (
+	BatchLineCompleted = "completed"
+	BatchLineFailed    = "failed"
+	BatchLineSkipped   = "skipped"          // valid, but not run because the batch was rejected
+	BatchLinePending   = "pending_approval" // the funds are reserved until someone approves the line
+)
+
+type BatchTransferLine struct {
+	ToAccountID int64   `json:"to_account_id"`
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description"`
+	Pending     bool    `json:"-"` // set by the service when the line has to be approved like a single large transfer
+
+	Screening *FraudScreening `json:"-"` // set by the service when fraud rules flagged the line, blocks fail the line
+	Refused   error           `json:"-"` // set by the handler when a beneficiary limit refuses the line, it fails with it
+}
+
+type BatchTransferTxParams struct {
+	FromAccountID    int64               `json:"from_account_id"`
+	Currency         string              `json:"currency"`
+	Mode             string              `json:"mode"`
+	Lines            []BatchTransferLine `json:"lines"`
+	InitiatedBy      string              `json:"initiated_by"` // recorded on the lines that wait for approval
+	UserAgent        string              `json:"-"`            // the device the batch came from, for fraud screening
+	PendingExpiresAt time.Time           `json:"-"`            // when the pending lines expire if nobody decides on them
+}
+
+type BatchTransferLineResult struct {
+	Line            int              `json:"line"` // 1 based position in the request
+	ToAccountID     int64            `json:"-"`
+	ToAccountNumber string           `json:"to_account_number"` // as given in the request, filled in by the handler
+	Amount          float64          `json:"amount"`
+	Status          string           `json:"status"`
+	Error           string           `json:"error,omitempty"`
+	Transaction     *Transaction     `json:"transaction,omitempty"`
+	PendingTransfer *PendingTransfer `json:"pending_transfer,omitempty"`
+}
+
+type BatchTransferTxResult struct {
+	FromAccount Account                   `json:"from_account"`
+	Completed   int                       `json:"completed"`
+	Pending     int                       `json:"pending"`
+	Failed      int                       `json:"failed"`
+	Lines       []BatchTransferLineResult `json:"lines"`
+	Alerts      []TriggeredAlert          `json:"-"` // alert rules the completed lines set off
+	Notices     []TransferNotice          `json:"-"` // debit and credit notices of the completed lines
+}
+
+const (
+	HolderRoleOwner            = "owner" // the account's primary owner, set when the account is opened
+	HolderRoleCoOwner          = "co_owner"
+	HolderRoleViewer           = "viewer"
+	HolderRoleAuthorizedSigner = "authorized_signer"
+)
+
+const (
+	HolderStatusInvited = "invited"
+	HolderStatusActive  = "active"
+)
+
+const (
+	PermissionView     = "view"     // read the account, its holds and history
+	PermissionTransact = "transact" // move money out of the account
+	PermissionManage   = "manage"   // change the status, close the account and invite holders
+)
+
+// holderPermissions lists what each holder role may do with an account
+var holderPermissions = map[string][]string{
+	HolderRoleOwner:            {PermissionView, PermissionTransact, PermissionManage},
+	HolderRoleCoOwner:          {PermissionView, PermissionTransact, PermissionManage},
+	HolderRoleAuthorizedSigner: {PermissionView, PermissionTransact},
+	HolderRoleViewer:           {PermissionView},
+}
+
+type AccountHolder struct {
+	AccountID     int64      `json:"-"`
+	AccountNumber string     `json:"account_number"`
+	UserName      string     `json:"username"`
+	Role          string     `json:"role"`
+	Status        string     `json:"status"`
+	InvitedBy     *string    `json:"invited_by,omitempty"` // nil for the primary owner
+	AcceptedAt    *time.Time `json:"accepted_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// Can reports whether the holder may use the permission, invitations grant nothing until accepted
+func (h AccountHolder) Can(permission string) bool {
+	if h.Status != HolderStatusActive {
+		return false
+	}
+	for _, p := range holderPermissions[h.Role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+type InviteAccountHolderParams struct {
+	AccountID int64  `json:"account_id"`
+	UserName  string `json:"username"`
+	Role      string `json:"role"`
+	InvitedBy string `json:"invited_by"`
+}
+
+type Beneficiary struct {
+	ID            int64     `json:"id"`
+	Owner         string    `json:"owner"`
+	Nickname      string    `json:"nickname"`
+	AccountID     int64     `json:"-"`
+	AccountNumber string    `json:"account_number"`
+	Currency      string    `json:"currency"`
+	TransferLimit *float64  `json:"transfer_limit,omitempty"` // nil when any amount may be sent
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// UpdateBeneficiaryParams leaves the target account alone, pointing a payee somewhere else is a new
+// beneficiary with its own cooling-off period
+type UpdateBeneficiaryParams struct {
+	ID            int64           `json:"id"`
+	Owner         string          `json:"owner"`
+	Nickname      sql.NullString  `json:"nickname"`
+	TransferLimit sql.NullFloat64 `json:"transfer_limit"` // a valid value of zero or less removes the limit
+}
+
+const AliasKindPhone = "phone"
+
+const (
+	// AliasCodeTTL is how long the code sent to a phone stays valid, an unverified alias can be claimed by
+	// someone else once it has passed
+	AliasCodeTTL = 15 * time.Minute
+	// AliasCodeAttempts is how many wrong codes are accepted before a new one has to be requested
+	AliasCodeAttempts = 5
+)
+
+// PaymentAlias is a registered name that payments can be sent to, usernames and verified emails
+// work without registering. A phone alias receives payments only once the code sent to it was confirmed.
+type PaymentAlias struct {
+	Alias      string     `json:"alias"`
+	UserName   string     `json:"username"`
+	Kind       string     `json:"kind"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type VerifyPaymentAliasParams struct {
+	Alias    string `json:"alias"`
+	UserName string `json:"username"`
+	Code     string `json:"code"`
+}
+
+type DefaultAccount struct {
+	UserName      string    `json:"username"`
+	Currency      string    `json:"currency"`
+	AccountID     int64     `json:"-"`
+	AccountNumber string    `json:"account_number"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+const (
+	PendingTransferPendingApproval = "pending_approval"
+	PendingTransferApproved        = "approved"
+	PendingTransferRejected        = "rejected"
+	PendingTransferExpired         = "expired"
+)
+
+// PendingTransfer is a transfer above the approval threshold, its funds are reserved by a hold until a
+// second user approves or rejects it or it expires
+type PendingTransfer struct {
+	ID                int64      `json:"id"`
+	FromAccountID     int64      `json:"-"`
+	FromAccountNumber string     `json:"from_account_number"`
+	ToAccountID       int64      `json:"-"`
+	ToAccountNumber   string     `json:"to_account_number"`
+	Amount            float64    `json:"amount"`
+	Fee               float64    `json:"fee"`
+	Currency          string     `json:"currency"`
+	Description       string     `json:"description"`
+	Status            string     `json:"status"`
+	InitiatedBy       string     `json:"initiated_by"`
+	DecidedBy         *string    `json:"decided_by,omitempty"`
+	Comment           string     `json:"comment"`
+	HoldID            int64      `json:"hold_id"`
+	TransactionID     *int64     `json:"transaction_id,omitempty"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	DecidedAt         *time.Time `json:"decided_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+type PendingTransferEvent struct {
+	ID                int64     `json:"id"`
+	PendingTransferID int64     `json:"pending_transfer_id"`
+	FromStatus        string    `json:"from_status"` // empty for the event that created the transfer
+	ToStatus          string    `json:"to_status"`
+	Actor             *string   `json:"actor,omitempty"` // nil when the system expired the transfer
+	Comment           string    `json:"comment"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+type CreatePendingTransferParams struct {
+	Transfer  TransferTxParams `json:"transfer"`
+	ExpiresAt time.Time        `json:"expires_at"`
+	Screening *FraudScreening  `json:"screening,omitempty"` // recorded with the transfer when fraud rules sent it for review
+}
+
+type DecidePendingTransferParams struct {
+	ID        int64  `json:"id"`
+	DecidedBy string `json:"decided_by"`
+	Approve   bool   `json:"approve"`
+	Comment   string `json:"comment"`
+}
+
+type DecidePendingTransferResult struct {
+	PendingTransfer PendingTransfer   `json:"pending_transfer"`
+	Transfer        *TransferTxResult `json:"transfer,omitempty"` // set when the transfer was approved
+}
+
+const (
+	FraudDecisionAllow  = "allow"
+	FraudDecisionReview = "review" // the transfer waits for approval like a large one
+	FraudDecisionBlock  = "block"
+)
+
+const (
+	FraudScreeningOpen       = "open"
+	FraudScreeningLegitimate = "legitimate"
+	FraudScreeningFraudulent = "fraudulent"
+)
+
+// TransferSignals is what the fraud rules know about a transfer and the history behind it
+type TransferSignals struct {
+	Amount          float64 `json:"amount"`
+	NewDevice       bool    `json:"new_device"`
+	NewPayee        bool    `json:"new_payee"`
+	RecentTransfers int64   `json:"recent_transfers"` // within the velocity window
+	HistoryCount    int64   `json:"history_count"`    // transfers the average is taken over
+	AverageAmount   float64 `json:"average_amount"`
+}
+
+type TransferSignalsParams struct {
+	UserName         string    `json:"username"`
+	UserAgent        string    `json:"user_agent"`
+	FromAccountID    int64     `json:"from_account_id"`
+	ToAccountID      int64     `json:"to_account_id"`
+	DeviceSeenBefore time.Time `json:"device_seen_before"` // a device first seen after this is new
+	VelocitySince    time.Time `json:"velocity_since"`
+	HistorySince     time.Time `json:"history_since"`
+}
+
+// FraudScreening records a transfer that one or more fraud rules flagged, open screenings are the admins' review queue
+type FraudScreening struct {
+	ID                int64      `json:"id"`
+	FromAccountID     int64      `json:"-"`
+	FromAccountNumber string     `json:"from_account_number"`
+	ToAccountID       int64      `json:"-"`
+	ToAccountNumber   string     `json:"to_account_number"`
+	Amount            float64    `json:"amount"`
+	Currency          string     `json:"currency"`
+	InitiatedBy       string     `json:"initiated_by"`
+	Decision          string     `json:"decision"`
+	Reasons           []string   `json:"reasons"`
+	Status            string     `json:"status"`
+	PendingTransferID *int64     `json:"pending_transfer_id,omitempty"` // review decisions only
+	ReviewedBy        *string    `json:"reviewed_by,omitempty"`
+	ReviewNote        string     `json:"review_note"`
+	ReviewedAt        *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+type ResolveFraudScreeningParams struct {
+	ID         int64  `json:"id"`
+	ReviewedBy string `json:"reviewed_by"`
+	Resolution string `json:"resolution"`
+	Note       string `json:"note"`
+}
+
+type ResolveFraudScreeningResult struct {
+	Screening FraudScreening    `json:"screening"`
+	Transfer  *TransferTxResult `json:"transfer,omitempty"` // set when clearing the screening released the held transfer
+}
+
+// BalanceAt is an account's ledger balance at a point in time, worked out from the latest snapshot before it
+type BalanceAt struct {
+	AccountID    int64      `json:"account_id"`
+	Currency     string     `json:"currency"`
+	At           time.Time  `json:"at"`
+	Balance      float64    `json:"balance"`
+	SnapshotDate *time.Time `json:"snapshot_date,omitempty"` // nil when every entry had to be replayed
+}
+
+// StatementRecipient is an account whose monthly statement is emailed to its owner
+type StatementRecipient struct {
+	AccountID int64  `json:"account_id"`
+	UserName  string `json:"username"`
+	FullName  string `json:"full_name"`
+	Email     string `json:"email"`
+	Locale    string `json:"locale"`
+}
+
+// Statement lists an account's entries for one month between its opening and closing balance
+type Statement struct {
+	Account        Account   `json:"account"`
+	PeriodStart    time.Time `json:"period_start"`
+	PeriodEnd      time.Time `json:"period_end"` // exclusive
+	OpeningBalance float64   `json:"opening_balance"`
+	ClosingBalance float64   `json:"closing_balance"`
+	Entries        []Entry   `json:"entries"`
+}
+
+// StatementDelivery records that an account's statement for a month was emailed
+type StatementDelivery struct {
+	AccountID int64     `json:"account_id"`
+	UserName  string    `json:"username"`
+	Period    time.Time `json:"period"` // first day of the month
+	Email     string    `json:"email"`
+	SentAt    time.Time `json:"sent_at"`
+}
+
+// TrialBalance totals one currency's ledger at the close of a business day, TotalBalance must be zero
+type TrialBalance struct {
+	ID           int64              `json:"id"`
+	BusinessDate time.Time          `json:"business_date"`
+	Currency     string             `json:"currency"`
+	TotalDebits  float64            `json:"total_debits"`  // of the day's entries
+	TotalCredits float64            `json:"total_credits"` // of the day's entries
+	TotalBalance float64            `json:"total_balance"` // of every closing balance
+	EntryCount   int64              `json:"entry_count"`
+	Lines        []TrialBalanceLine `json:"lines,omitempty"`
+	CreatedAt    time.Time          `json:"created_at"`
+}
+
+type TrialBalanceLine struct {
+	AccountID    int64   `json:"account_id"`
+	Owner        string  `json:"owner"`
+	InternalCode *string `json:"internal_code,omitempty"` // set for the bank's own accounts
+	Debits       float64 `json:"debits"`
+	Credits      float64 `json:"credits"`
+	Balance      float64 `json:"balance"`
+}
+
+const (
+	CategoryGroceries     = "groceries"
+	CategoryDining        = "dining"
+	CategoryTransport     = "transport"
+	CategoryUtilities     = "utilities"
+	CategoryShopping      = "shopping"
+	CategoryEntertainment = "entertainment"
+	CategoryHealth        = "health"
+	CategoryIncome        = "income"
+	CategoryTransfers     = "transfers"
+	CategoryFees          = "fees"
+	CategoryUncategorized = "uncategorized" // when no rule matches
+)
+
+// Categories lists every spending category a transaction can be put in
+var Categories = []string{CategoryGroceries, CategoryDining, CategoryTransport, CategoryUtilities, CategoryShopping,
+	CategoryEntertainment, CategoryHealth, CategoryIncome, CategoryTransfers, CategoryFees, CategoryUncategorized}
+
+const (
+	CategoryRuleKeyword  = "keyword"  // the pattern is looked for in the transaction's description
+	CategoryRuleMerchant = "merchant" // the pattern is the username owning the other side of the transaction
+)
+
+// CategoryRule puts matching transactions in a category. Rules without an owner apply to everyone, a user's own
+// rules win over them and among several matches the longest pattern wins.
+type CategoryRule struct {
+	ID        int64     `json:"id"`
+	Owner     *string   `json:"owner,omitempty"`
+	Kind      string    `json:"kind"`
+	Pattern   string    `json:"pattern"`
+	Category  string    `json:"category"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TransactionCategory overrides the category the rules give a transaction, for one account's side of it
+type TransactionCategory struct {
+	TransactionID int64     `json:"transaction_id"`
+	AccountID     int64     `json:"account_id"`
+	Category      string    `json:"category"`
+	SetBy         string    `json:"set_by"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type SpendingAnalyticsParams struct {
+	AccountID int64
+	From      time.Time
+	To        time.Time // exclusive
+}
+
+// SpendingAnalytics summarizes an account's transactions over a period. Fees are not part of the amounts.
+type SpendingAnalytics struct {
+	AccountID  int64           `json:"account_id"`
+	From       time.Time       `json:"from"`
+	To         time.Time       `json:"to"`
+	Inflows    float64         `json:"inflows"`
+	Outflows   float64         `json:"outflows"`
+	Categories []CategorySpend `json:"categories"`
+	Months     []MonthlyTrend  `json:"months"`
+}
+
+// CategorySpend is what went out of the account in one category, largest first
+type CategorySpend struct {
+	Category string  `json:"category"`
+	Amount   float64 `json:"amount"`
+	Count    int64   `json:"count"`
+}
+
+type MonthlyTrend struct {
+	Month          time.Time `json:"month"`
+	Inflows        float64   `json:"inflows"`
+	Outflows       float64   `json:"outflows"`
+	OutflowsChange *float64  `json:"outflows_change,omitempty"` // compared to the month before, nil for the first month
+}
+
+const (
+	AlertKindLowBalance       = "low_balance"       // the available balance dropped below the threshold
+	AlertKindLargeTransaction = "large_transaction" // a transfer of at least the threshold left or arrived
+	AlertKindOverdraftEntered = "overdraft_entered" // sent without a rule when the balance goes below zero
+	AlertKindOverdraftLeft    = "overdraft_left"    // sent without a rule when the balance is back at zero or above
+)
+
+const (
+	AlertChannelEmail = "email"
+)
+
+// AlertRule notifies its owner about activity on an account. A low balance rule fires once when the balance
+// drops below its threshold and again only after the balance has recovered.
+type AlertRule struct {
+	ID            int64     `json:"id"`
+	AccountID     int64     `json:"-"`
+	AccountNumber string    `json:"account_number"`
+	Owner         string    `json:"owner"`
+	Kind          string    `json:"kind"`
+	Threshold     float64   `json:"threshold"`
+	Channel       string    `json:"channel"`
+	Triggered     bool      `json:"triggered"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// TriggeredAlert is an alert rule a transfer set off, to be sent once the transfer has committed
+type TriggeredAlert struct {
+	Rule          AlertRule
+	TransactionID int64
+	Amount        float64
+	Currency      string
+	Incoming      bool    // whether the transfer credited the account
+	Balance       float64 // available balance after the transfer
+}
+
+const (
+	TransferNoticeDebit  = "debit"
+	TransferNoticeCredit = "credit"
+)
+
+// TransferNotice tells a user that a transfer took money out of or brought money into one of their accounts
+type TransferNotice struct {
+	Username      string
+	Kind          string // debit for the sender, credit for each holder of the receiving account
+	AccountNumber string
+	TransactionID int64
+	Amount        float64
+	Fee           float64
+	Currency      string
+	Description   string
+	Balance       float64 // the account's balance after the transfer
+}
+
+const (
+	OverdraftRequestPendingApproval = "pending_approval"
+	OverdraftRequestApproved        = "approved"
+	OverdraftRequestRejected        = "rejected"
+)
+
+// OverdraftRequest asks for an account's overdraft limit to be set, an admin approves or rejects it
+type OverdraftRequest struct {
+	ID             int64      `json:"id"`
+	AccountID      int64      `json:"-"`
+	AccountNumber  string     `json:"account_number"`
+	RequestedLimit float64    `json:"requested_limit"` // 0 removes the overdraft
+	Status         string     `json:"status"`
+	RequestedBy    string     `json:"requested_by"`
+	DecidedBy      *string    `json:"decided_by,omitempty"`
+	Comment        string     `json:"comment"`
+	DecidedAt      *time.Time `json:"decided_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+type DecideOverdraftRequestParams struct {
+	ID           int64   `json:"id"`
+	DecidedBy    string  `json:"decided_by"`
+	Approve      bool    `json:"approve"`
+	Comment      string  `json:"comment"`
+	InterestRate float64 `json:"interest_rate"` // terms the account gets when the request is approved
+	Fee          float64 `json:"fee"`
+}
+
+const (
+	OverdraftEventEntered = "entered"
+	OverdraftEventLeft    = "left"
+)
+
+// OverdraftEvent records a transfer taking an account's ledger balance below zero or back to zero or above
+type OverdraftEvent struct {
+	ID            int64     `json:"id"`
+	AccountID     int64     `json:"account_id"`
+	Kind          string    `json:"kind"`
+	Balance       float64   `json:"balance"`
+	TransactionID int64     `json:"transaction_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+const (
+	OverdraftChargeInterest = "interest"
+	OverdraftChargeFee      = "fee"
+)
+
+// OverdraftCharge is interest for one day on a negative balance, or the fee for one time the account went
+// overdrawn. Charges are unique per account and day for interest and per event for fees.
+type OverdraftCharge struct {
+	ID            int64     `json:"id"`
+	AccountID     int64     `json:"account_id"`
+	Kind          string    `json:"kind"`
+	ChargeDate    time.Time `json:"charge_date"`
+	Balance       float64   `json:"balance"`
+	Amount        float64   `json:"amount"`
+	EventID       *int64    `json:"event_id,omitempty"` // fees only
+	TransactionID *int64    `json:"transaction_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// OverdrawnAccount is an account with a negative balance together with the terms of its overdraft
+type OverdrawnAccount struct {
+	AccountID    int64   `json:"account_id"`
+	Balance      float64 `json:"balance"`
+	InterestRate float64 `json:"interest_rate"`
+}
+
+// UnchargedOverdraft is a time an account went overdrawn that its fee has not been charged for yet
+type UnchargedOverdraft struct {
+	Event OverdraftEvent `json:"event"`
+	Fee   float64        `json:"fee"`
+}
+
+const (
+	LoanMethodAnnuity = "annuity" // equal installments
+	LoanMethodFlat    = "flat"    // equal principal, interest on the original principal
+)
+
+const (
+	LoanStatusActive     = "active"
+	LoanStatusDelinquent = "delinquent" // an installment could not be collected when due
+	LoanStatusDefaulted  = "defaulted"  // an installment has been overdue for LoanDefaultAfterDays
+	LoanStatusPaidOff    = "paid_off"
+)
+
+// LoanDefaultAfterDays is how long an installment may stay overdue before the loan is in default
+const LoanDefaultAfterDays = 90
+
+const (
+	LoanInstallmentScheduled = "scheduled"
+	LoanInstallmentOverdue   = "overdue"
+	LoanInstallmentPaid      = "paid"
+	LoanInstallmentCancelled = "cancelled" // no longer owed after an early payoff
+)
+
+type Loan struct {
+	ID                   int64      `json:"id"`
+	AccountID            int64      `json:"-"`
+	AccountNumber        string     `json:"account_number"`
+	Principal            float64    `json:"principal"`
+	InterestRate         float64    `json:"interest_rate"` // annual, 0.08 is 8%
+	TermMonths           int32      `json:"term_months"`
+	Method               string     `json:"method"`
+	Currency             string     `json:"currency"`
+	LateFee              float64    `json:"late_fee"`
+	OutstandingPrincipal float64    `json:"outstanding_principal"`
+	Status               string     `json:"status"`
+	DisbursedBy          string     `json:"disbursed_by"`
+	TransactionID        int64      `json:"transaction_id"`
+	PaidOffAt            *time.Time `json:"paid_off_at,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+}
+
+type LoanInstallment struct {
+	ID            int64      `json:"id"`
+	LoanID        int64      `json:"loan_id"`
+	Number        int32      `json:"number"`
+	DueDate       time.Time  `json:"due_date"`
+	Principal     float64    `json:"principal"`
+	Interest      float64    `json:"interest"`
+	Amount        float64    `json:"amount"`   // principal plus interest
+	LateFee       float64    `json:"late_fee"` // owed on top of the amount once the installment is overdue
+	Status        string     `json:"status"`
+	TransactionID *int64     `json:"transaction_id,omitempty"`
+	PaidAt        *time.Time `json:"paid_at,omitempty"`
+}
+
+type DisburseLoanParams struct {
+	Loan         Loan              `json:"loan"`
+	Installments []LoanInstallment `json:"installments"`
+}
+
+// LoanSchedule is a loan with all of its installments and what it would take to pay it off today
+type LoanSchedule struct {
+	Loan         Loan              `json:"loan"`
+	Installments []LoanInstallment `json:"installments"`
+	PayoffAmount float64           `json:"payoff_amount"`
+}
+
+type LoanRepaymentResult struct {
+	Loan        Loan            `json:"loan"`
+	Installment LoanInstallment `json:"installment"`
+	Paid        bool            `json:"paid"` // false when the account could not cover the installment
+}
+
+const (
+	PocketRulePercentOfIncoming = "percent_of_incoming" // sets aside a percentage of every transfer into the account
+)
+
+// Pocket sets money aside inside an account. Its balance stays part of the account's ledger balance but is
+// taken out of the available balance until it is moved back.
+type Pocket struct {
+	ID            int64        `json:"id"`
+	AccountID     int64        `json:"-"`
+	AccountNumber string       `json:"account_number"`
+	Name          string       `json:"name"`
+	TargetAmount  float64      `json:"target_amount"` // 0 when the pocket has no target
+	TargetDate    *time.Time   `json:"target_date,omitempty"`
+	Balance       float64      `json:"balance"`
+	Rules         []PocketRule `json:"rules"`
+	ClosedAt      *time.Time   `json:"closed_at,omitempty"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+type PocketRule struct {
+	ID        int64     `json:"id"`
+	PocketID  int64     `json:"pocket_id"`
+	Kind      string    `json:"kind"`
+	Percent   float64   `json:"percent"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PocketEntry is a move between an account and one of its pockets
+type PocketEntry struct {
+	ID            int64     `json:"id"`
+	PocketID      int64     `json:"pocket_id"`
+	Amount        float64   `json:"amount"`                   // positive into the pocket, negative back to the account
+	RuleID        *int64    `json:"rule_id,omitempty"`        // set when a rule made the move
+	TransactionID *int64    `json:"transaction_id,omitempty"` // the transfer that set off the rule
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type MovePocketFundsParams struct {
+	PocketID int64   `json:"pocket_id"`
+	Amount   float64 `json:"amount"` // positive into the pocket, negative back to the account
+}
+
+type MovePocketFundsResult struct {
+	Pocket        Pocket      `json:"pocket"`
+	Account       Account     `json:"account"`
+	Entry         PocketEntry `json:"entry"`
+	LedgerEntries []Entry     `json:"ledger_entries"` // the account's side of the move first, then the pocket's
+}
+
+const (
+	MaxAttachmentSize  = 3 << 20 // stays below gRPC's default 4MB message limit
+	MaxTransactionTags = 10
+)
+
+// AttachmentContentTypes are the receipt formats that can be attached to a transaction
+var AttachmentContentTypes = []string{"image/jpeg", "image/png", "application/pdf"}
+
+// TransactionNote is a user's private note and tags on a transaction. Other parties to the transaction,
+// including other holders of the same account, never see it.
+type TransactionNote struct {
+	TransactionID int64     `json:"transaction_id"`
+	Owner         string    `json:"owner"`
+	Note          string    `json:"note"`
+	Tags          []string  `json:"tags"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// TransactionAttachment is a receipt a user uploaded against a transaction, only the uploader can see it.
+// The file itself lives in the blob store under StorageKey.
+type TransactionAttachment struct {
+	ID            int64     `json:"id"`
+	TransactionID int64     `json:"transaction_id"`
+	Owner         string    `json:"owner"`
+	FileName      string    `json:"file_name"`
+	ContentType   string    `json:"content_type"`
+	Size          int64     `json:"size"`
+	StorageKey    string    `json:"-"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// TransactionAnnotations is everything one user has added to a transaction
+type TransactionAnnotations struct {
+	Note        TransactionNote         `json:"note"`
+	Attachments []TransactionAttachment `json:"attachments"`
+}
+
+type TaggedTransaction struct {
+	Transaction Transaction     `json:"transaction"`
+	Note        TransactionNote `json:"note"`
+}
+
+type SearchTransactionsByTagParams struct {
+	AccountID int64
+	Owner     string
+	Tag       string
+	Limit     int32
+	Offset    int32
+}
+
+const (
+	DisputeStatusOpen             = "open"
+	DisputeStatusInvestigating    = "investigating"
+	DisputeStatusRefundProposed   = "refund_proposed"   // a refund waits for a second agent to approve it
+	DisputeStatusResolvedCustomer = "resolved_customer" // the disputed amount went back to the customer
+	DisputeStatusRejected         = "rejected"
+)
+
+// disputeTransitions lists the statuses a dispute can move to from each status, resolved and rejected
+// disputes are final. A refund is only made once a second agent approves the one proposed.
+var disputeTransitions = map[string][]string{
+	DisputeStatusOpen:           {DisputeStatusInvestigating, DisputeStatusRefundProposed, DisputeStatusRejected},
+	DisputeStatusInvestigating:  {DisputeStatusRefundProposed, DisputeStatusRejected},
+	DisputeStatusRefundProposed: {DisputeStatusResolvedCustomer, DisputeStatusInvestigating, DisputeStatusRejected},
+}
+
+// Dispute contests a transfer the customer's account sent. While it is open the disputed amount can be held
+// at the receiving account, resolving it for the customer moves that amount back.
+type Dispute struct {
+	ID                    int64      `json:"id"`
+	TransactionID         int64      `json:"transaction_id"`
+	AccountID             int64      `json:"-"` // the account that sent the transfer
+	AccountNumber         string     `json:"account_number"`
+	ReceiverAccountID     int64      `json:"-"`
+	ReceiverAccountNumber string     `json:"receiver_account_number"`
+	Amount                float64    `json:"amount"`
+	Currency              string     `json:"currency"`
+	Reason                string     `json:"reason"`
+	Evidence              string     `json:"evidence"`
+	Status                string     `json:"status"`
+	OpenedBy              string     `json:"opened_by"`
+	HoldID                *int64     `json:"hold_id,omitempty"`
+	RefundTransactionID   *int64     `json:"refund_transaction_id,omitempty"`
+	RefundProposedBy      *string    `json:"refund_proposed_by,omitempty"`
+	DecidedBy             *string    `json:"decided_by,omitempty"`
+	Comment               *string    `json:"comment,omitempty"`
+	ResolvedAt            *time.Time `json:"resolved_at,omitempty"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+	CreatedAt             time.Time  `json:"created_at"`
+}
+
+// CanMoveTo reports whether the dispute's state machine allows the status next
+func (d Dispute) CanMoveTo(status string) bool {
+	return slices.Contains(disputeTransitions[d.Status], status)
+}
+
+type OpenDisputeParams struct {
+	TransactionID int64
+	AccountID     int64
+	Amount        float64 // 0 disputes the whole transfer
+	Reason        string
+	Evidence      string
+	HoldFunds     bool
+	HoldExpiresAt time.Time
+	OpenedBy      string
+}
+
+type UpdateDisputeParams struct {
+	ID        int64
+	Status    string
+	Comment   string
+	DecidedBy string
+}
+
+// DisputeUpdate is a dispute after it changed, with the users who should hear about it
+type DisputeUpdate struct {
+	Dispute Dispute          `json:"dispute"`
+	Notify  []string         `json:"-"`
+	Alerts  []TriggeredAlert `json:"-"` // alert rules the refund set off
+	Notices []TransferNotice `json:"-"` // debit and credit notices of the refund
+}
+
+// Journal file formats of the general ledger export
+const (
+	GLFormatCSV        = "csv"
+	GLFormatFixedWidth = "fixed_width"
+)
+
+// JournalLine totals one day's entries in one currency that post to the same general ledger account
+type JournalLine struct {
+	BusinessDate time.Time `json:"business_date"`
+	Currency     string    `json:"currency"`
+	GLCode       string    `json:"gl_code"`
+	Debits       float64   `json:"debits"`
+	Credits      float64   `json:"credits"`
+	EntryCount   int64     `json:"entry_count"`
+}
+
+// GLExport is one run of the general ledger export, the run id is written on every line of its journal file.
+// StartDate and EndDate are both included.
+type GLExport struct {
+	ID         int64            `json:"id"`
+	RunID      string           `json:"run_id"`
+	StartDate  time.Time        `json:"start_date"`
+	EndDate    time.Time        `json:"end_date"`
+	Format     string           `json:"format"`
+	StorageKey string           `json:"-"`
+	LineCount  int64            `json:"line_count"`
+	Totals     []GLControlTotal `json:"totals"`
+	CreatedBy  *string          `json:"created_by,omitempty"` // nil for the daily export
+	CreatedAt  time.Time        `json:"created_at"`
+}
+
+// GLControlTotal lets the accounting system check it imported all of a currency's journal lines
+type GLControlTotal struct {
+	Currency     string  `json:"currency"`
+	LineCount    int64   `json:"line_count"`
+	TotalDebits  float64 `json:"total_debits"`
+	TotalCredits float64 `json:"total_credits"`
+}
+
+type ExportGLParams struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Format    string  // the exporter's default format when empty
+	CreatedBy *string // nil for the daily export
 }
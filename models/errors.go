@@ -0,0 +1,73 @@
+package models
+
+import "errors"
+
+var (
+	ErrInsufficientFunds  = errors.New("insufficient available balance")
+	ErrHoldNotActive      = errors.New("hold is no longer active")
+	ErrCaptureExceedsHold = errors.New("capture amount exceeds the held amount")
+	ErrAccountNotMatured  = errors.New("fixed term account cannot be debited before it matures")
+
+	ErrAccountFrozen           = errors.New("account is frozen and cannot be debited")
+	ErrAccountClosing          = errors.New("account is being closed and cannot be debited")
+	ErrAccountClosed           = errors.New("account is closed")
+	ErrInvalidStatusTransition = errors.New("account cannot move to the requested status")
+	ErrFreezeNeedsStaff        = errors.New("a frozen account can only be unfrozen by bank staff")
+	ErrAccountNotEmpty         = errors.New("account balance must be zero or swept to another account before closing")
+	ErrAccountHasHolds         = errors.New("account has active holds")
+	ErrAccountHasLoan          = errors.New("account has a loan that is not paid off")
+	ErrSweepAccountInvalid     = errors.New("sweep account must be a different open account in the same currency")
+
+	ErrCurrencyMismatch    = errors.New("currency does not match the account currency")
+	ErrInvalidReasonCode   = errors.New("reason code is not valid for this operation")
+	ErrOperationNotPending = errors.New("operation is not waiting for approval")
+	ErrApproverIsInitiator = errors.New("operation must be approved by someone other than its initiator")
+
+	ErrPendingTransferExpired = errors.New("pending transfer has expired")
+
+	ErrBusinessDateClosed     = errors.New("business date is closed to new postings")
+	ErrTrialBalanceUnbalanced = errors.New("trial balance does not net to zero")
+	ErrLedgerMismatch         = errors.New("account balance does not match its entries")
+
+	ErrTransferBlocked  = errors.New("transfer was blocked by fraud screening")
+	ErrScreeningNotOpen = errors.New("fraud screening has already been resolved")
+	ErrScreeningOpen    = errors.New("transfer is waiting on a fraud review")
+
+	ErrBatchRejected = errors.New("batch rejected, no transfers were made")
+
+	ErrAlreadyAccountHolder = errors.New("user already holds or has been invited to this account")
+
+	ErrBeneficiaryExists        = errors.New("a beneficiary with this nickname or account already exists")
+	ErrBeneficiaryLimitExceeded = errors.New("amount exceeds the beneficiary's transfer limit")
+	ErrBeneficiaryCoolingOff    = errors.New("new beneficiary is still in its cooling-off period for large transfers")
+
+	ErrAliasTaken            = errors.New("alias is already registered")
+	ErrAliasCodeInvalid      = errors.New("verification code is wrong or has expired")
+	ErrInvalidDefaultAccount = errors.New("default account must be an open account the user owns in that currency")
+
+	ErrCategoryRuleExists      = errors.New("a rule with this pattern already exists")
+	ErrTransactionNotOnAccount = errors.New("transaction neither debits nor credits this account")
+
+	ErrAlertRuleExists = errors.New("an alert of this kind already exists for the account")
+
+	ErrOverdraftRequestExists = errors.New("the account already has an overdraft request waiting for approval")
+
+	ErrLoanPaidOff = errors.New("loan has already been paid off")
+
+	ErrPocketExists            = errors.New("the account already has a pocket with this name")
+	ErrPocketRuleExists        = errors.New("the pocket already has a rule of this kind")
+	ErrPocketClosed            = errors.New("pocket has been closed")
+	ErrInsufficientPocketFunds = errors.New("pocket balance is too low")
+
+	ErrAttachmentMissing = errors.New("attachment file is missing from the blob store")
+
+	ErrDisputeNotSender     = errors.New("only the account that sent a transfer can dispute it")
+	ErrDisputeExists        = errors.New("the transaction already has an open dispute")
+	ErrDisputeExceedsAmount = errors.New("disputed amount exceeds the transferred amount")
+	ErrDisputeTransition    = errors.New("the dispute can't move to this status")
+
+	ErrGLAccountUnmapped = errors.New("account has no general ledger code in the chart of accounts")
+	ErrGLPeriodOpen      = errors.New("the export period includes business days that are not closed yet")
+	ErrGLExportExists    = errors.New("the period has already been exported in this format")
+	ErrGLExportMissing   = errors.New("journal file is missing from the blob store")
+)
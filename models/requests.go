@@ -1,11 +1,12 @@
 package models
 
 type CreateAccountRequest struct {
-	Currency string `json:"currency" binding:"required,currency"`
+	Currency    string `json:"currency" binding:"required,currency"`
+	ProductCode string `json:"product_code"`
 }
 
 type GetAccountRequest struct {
-	ID int64 `uri:"id" binding:"required,min=1"`
+	AccountNumber string `uri:"number" binding:"required,account_number"`
 }
 
 type ListAccountRequest struct {
@@ -13,10 +14,6 @@ type ListAccountRequest struct {
 	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
 }
 
-type UpdateAccountRequest struct {
-	Balance float64 `json:"balance" binding:"required"`
-}
-
 type CreateUserRequest struct {
 	UserName string `json:"username" binding:"required,alphanum"`
 	Password string `json:"password" binding:"required,min=6"`
@@ -38,10 +35,107 @@ type GetUserRequest struct {
 }
 
 type TransferMoneyRequest struct {
-	FromAccountID int64   `json:"from_account_id" binding:"required,min=1"`
-	ToAccountID   int64   `json:"to_account_id" binding:"required,min=1"`
-	Description   string  `json:"description"`
+	FromAccountNumber string  `json:"from_account_number" binding:"required,account_number"`
+	ToAccountNumber   string  `json:"to_account_number" binding:"required_without_all=BeneficiaryID ToAlias,excluded_with=BeneficiaryID ToAlias,omitempty,account_number"`
+	BeneficiaryID     int64   `json:"beneficiary_id" binding:"excluded_with=ToAlias,gte=0"` // a saved payee, in place of to_account_number
+	ToAlias           string  `json:"to_alias" binding:"max=200"`                           // username, verified email or phone alias
+	Description       string  `json:"description"`
+	Amount            float64 `json:"amount" binding:"required,gt=0"`
+	Fee               float64 `json:"fee" binding:"required,gt=0"`
+	Currency          string  `json:"currency" binding:"required,currency"`
+}
+
+type CreateHoldRequest struct {
+	AccountNumber    string  `json:"account_number" binding:"required,account_number"`
+	ToAccountNumber  string  `json:"to_account_number" binding:"required,account_number"`
+	AccountID        int64   `json:"-"` // looked up from the account numbers
+	ToAccountID      int64   `json:"-"`
+	Amount           float64 `json:"amount" binding:"required,gt=0"`
+	Currency         string  `json:"currency" binding:"required,currency"`
+	Description      string  `json:"description"`
+	ExpiresInMinutes int32   `json:"expires_in_minutes" binding:"required,min=1,max=43200"`
+}
+
+type GetHoldRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type CaptureHoldRequest struct {
+	Amount float64 `json:"amount" binding:"required,gt=0"`
+}
+
+type UpdateAccountStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=active frozen closing"`
+	Reason string `json:"reason"`
+}
+
+type CloseAccountRequest struct {
+	SweepToAccountNumber string `form:"sweep_to_account_number" binding:"omitempty,account_number"`
+}
+
+type TellerOperationRequest struct {
+	AccountNumber string  `json:"account_number" binding:"required,account_number"`
 	Amount        float64 `json:"amount" binding:"required,gt=0"`
-	Fee           float64 `json:"fee" binding:"required,gt=0"`
 	Currency      string  `json:"currency" binding:"required,currency"`
+	ReasonCode    string  `json:"reason_code" binding:"required"`
+	Note          string  `json:"note"`
+}
+
+type AdjustmentRequest struct {
+	AccountNumber string  `json:"account_number" binding:"required,account_number"`
+	Amount        float64 `json:"amount" binding:"required,ne=0"` // negative debits the account
+	Currency      string  `json:"currency" binding:"required,currency"`
+	ReasonCode    string  `json:"reason_code" binding:"required"`
+	Note          string  `json:"note" binding:"required"`
+}
+
+type ListTellerOperationsRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=pending_approval completed rejected"`
+	PageID   int32  `form:"page_id" binding:"required,min=1"`
+	PageSize int32  `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+type GetTellerOperationRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type UpdateUserRoleRequest struct {
+	UserName string `uri:"username" binding:"required,alphanum"`
+}
+
+type UpdateUserRoleBody struct {
+	Role string `json:"role" binding:"required,oneof=customer teller admin"`
+}
+
+type BatchTransferLineRequest struct {
+	ToAccountNumber string  `json:"to_account_number" binding:"required,account_number"`
+	Amount          float64 `json:"amount" binding:"required,gt=0"`
+	Description     string  `json:"description"`
+}
+
+type BatchTransferRequest struct {
+	FromAccountNumber string                     `json:"from_account_number" form:"from_account_number" binding:"required,account_number"`
+	Currency          string                     `json:"currency" form:"currency" binding:"required,currency"`
+	Mode              string                     `json:"mode" form:"mode" binding:"required,oneof=all_or_nothing best_effort"`
+	Lines             []BatchTransferLineRequest `json:"lines" form:"-" binding:"required,min=1,max=1000,dive"`
+}
+
+type InviteAccountHolderRequest struct {
+	UserName string `json:"username" binding:"required,alphanum"`
+	Role     string `json:"role" binding:"required,oneof=co_owner viewer authorized_signer"`
+}
+
+type ListFraudScreeningsRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=open legitimate fraudulent"`
+	PageID   int32  `form:"page_id" binding:"required,min=1"`
+	PageSize int32  `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+type GetFraudScreeningRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type ResolveFraudScreeningRequest struct {
+	Resolution string `json:"resolution" binding:"required,oneof=legitimate fraudulent"`
+	Note       string `json:"note" binding:"required,max=500"`
 }
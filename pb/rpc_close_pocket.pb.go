@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_close_pocket.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ClosePocketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PocketId int64 `protobuf:"varint,1,opt,name=pocket_id,json=pocketId,proto3" json:"pocket_id,omitempty"`
+}
+
+func (x *ClosePocketRequest) Reset() {
+	*x = ClosePocketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_close_pocket_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePocketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePocketRequest) ProtoMessage() {}
+
+func (x *ClosePocketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_close_pocket_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePocketRequest.ProtoReflect.Descriptor instead.
+func (*ClosePocketRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_close_pocket_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClosePocketRequest) GetPocketId() int64 {
+	if x != nil {
+		return x.PocketId
+	}
+	return 0
+}
+
+type ClosePocketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pocket *Pocket `protobuf:"bytes,1,opt,name=pocket,proto3" json:"pocket,omitempty"`
+}
+
+func (x *ClosePocketResponse) Reset() {
+	*x = ClosePocketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_close_pocket_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePocketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePocketResponse) ProtoMessage() {}
+
+func (x *ClosePocketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_close_pocket_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePocketResponse.ProtoReflect.Descriptor instead.
+func (*ClosePocketResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_close_pocket_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClosePocketResponse) GetPocket() *Pocket {
+	if x != nil {
+		return x.Pocket
+	}
+	return nil
+}
+
+var File_rpc_close_pocket_proto protoreflect.FileDescriptor
+
+var file_rpc_close_pocket_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0c, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x31, 0x0a, 0x12, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x22, 0x39, 0x0a,
+	0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x06, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x64, 0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69,
+	0x66, 0x74, 0x5f, 0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_rpc_close_pocket_proto_rawDescOnce sync.Once
+	file_rpc_close_pocket_proto_rawDescData = file_rpc_close_pocket_proto_rawDesc
+)
+
+func file_rpc_close_pocket_proto_rawDescGZIP() []byte {
+	file_rpc_close_pocket_proto_rawDescOnce.Do(func() {
+		file_rpc_close_pocket_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_close_pocket_proto_rawDescData)
+	})
+	return file_rpc_close_pocket_proto_rawDescData
+}
+
+var file_rpc_close_pocket_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_close_pocket_proto_goTypes = []interface{}{
+	(*ClosePocketRequest)(nil),  // 0: pb.ClosePocketRequest
+	(*ClosePocketResponse)(nil), // 1: pb.ClosePocketResponse
+	(*Pocket)(nil),              // 2: pb.Pocket
+}
+var file_rpc_close_pocket_proto_depIdxs = []int32{
+	2, // 0: pb.ClosePocketResponse.pocket:type_name -> pb.Pocket
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_close_pocket_proto_init() }
+func file_rpc_close_pocket_proto_init() {
+	if File_rpc_close_pocket_proto != nil {
+		return
+	}
+	file_pocket_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_close_pocket_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePocketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_close_pocket_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePocketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_close_pocket_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_close_pocket_proto_goTypes,
+		DependencyIndexes: file_rpc_close_pocket_proto_depIdxs,
+		MessageInfos:      file_rpc_close_pocket_proto_msgTypes,
+	}.Build()
+	File_rpc_close_pocket_proto = out.File
+	file_rpc_close_pocket_proto_rawDesc = nil
+	file_rpc_close_pocket_proto_goTypes = nil
+	file_rpc_close_pocket_proto_depIdxs = nil
+}
@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_create_beneficiary.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateBeneficiaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname      string   `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AccountNumber string   `protobuf:"bytes,5,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Currency      string   `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	TransferLimit *float64 `protobuf:"fixed64,4,opt,name=transfer_limit,json=transferLimit,proto3,oneof" json:"transfer_limit,omitempty"`
+}
+
+func (x *CreateBeneficiaryRequest) Reset() {
+	*x = CreateBeneficiaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_beneficiary_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBeneficiaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBeneficiaryRequest) ProtoMessage() {}
+
+func (x *CreateBeneficiaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_beneficiary_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBeneficiaryRequest.ProtoReflect.Descriptor instead.
+func (*CreateBeneficiaryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_beneficiary_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateBeneficiaryRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *CreateBeneficiaryRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *CreateBeneficiaryRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateBeneficiaryRequest) GetTransferLimit() float64 {
+	if x != nil && x.TransferLimit != nil {
+		return *x.TransferLimit
+	}
+	return 0
+}
+
+type CreateBeneficiaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Beneficiary *Beneficiary `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+}
+
+func (x *CreateBeneficiaryResponse) Reset() {
+	*x = CreateBeneficiaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_beneficiary_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBeneficiaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBeneficiaryResponse) ProtoMessage() {}
+
+func (x *CreateBeneficiaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_beneficiary_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBeneficiaryResponse.ProtoReflect.Descriptor instead.
+func (*CreateBeneficiaryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_beneficiary_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateBeneficiaryResponse) GetBeneficiary() *Beneficiary {
+	if x != nil {
+		return x.Beneficiary
+	}
+	return nil
+}
+
+var File_rpc_create_beneficiary_proto protoreflect.FileDescriptor
+
+var file_rpc_create_beneficiary_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x65, 0x6e,
+	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x1a, 0x11, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x4e, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69,
+	0x63, 0x69, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69,
+	0x61, 0x72, 0x79, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79,
+	0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a,
+	0x64, 0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61, 0x6e, 0x6b, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_create_beneficiary_proto_rawDescOnce sync.Once
+	file_rpc_create_beneficiary_proto_rawDescData = file_rpc_create_beneficiary_proto_rawDesc
+)
+
+func file_rpc_create_beneficiary_proto_rawDescGZIP() []byte {
+	file_rpc_create_beneficiary_proto_rawDescOnce.Do(func() {
+		file_rpc_create_beneficiary_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_create_beneficiary_proto_rawDescData)
+	})
+	return file_rpc_create_beneficiary_proto_rawDescData
+}
+
+var file_rpc_create_beneficiary_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_beneficiary_proto_goTypes = []interface{}{
+	(*CreateBeneficiaryRequest)(nil),  // 0: pb.CreateBeneficiaryRequest
+	(*CreateBeneficiaryResponse)(nil), // 1: pb.CreateBeneficiaryResponse
+	(*Beneficiary)(nil),               // 2: pb.Beneficiary
+}
+var file_rpc_create_beneficiary_proto_depIdxs = []int32{
+	2, // 0: pb.CreateBeneficiaryResponse.beneficiary:type_name -> pb.Beneficiary
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_beneficiary_proto_init() }
+func file_rpc_create_beneficiary_proto_init() {
+	if File_rpc_create_beneficiary_proto != nil {
+		return
+	}
+	file_beneficiary_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_create_beneficiary_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBeneficiaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_beneficiary_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBeneficiaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpc_create_beneficiary_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_create_beneficiary_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_beneficiary_proto_goTypes,
+		DependencyIndexes: file_rpc_create_beneficiary_proto_depIdxs,
+		MessageInfos:      file_rpc_create_beneficiary_proto_msgTypes,
+	}.Build()
+	File_rpc_create_beneficiary_proto = out.File
+	file_rpc_create_beneficiary_proto_rawDesc = nil
+	file_rpc_create_beneficiary_proto_goTypes = nil
+	file_rpc_create_beneficiary_proto_depIdxs = nil
+}
@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_create_category_rule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateCategoryRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind     string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Pattern  string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *CreateCategoryRuleRequest) Reset() {
+	*x = CreateCategoryRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_category_rule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRuleRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_category_rule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_category_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateCategoryRuleRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *CreateCategoryRuleRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type CreateCategoryRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *CategoryRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *CreateCategoryRuleResponse) Reset() {
+	*x = CreateCategoryRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_category_rule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCategoryRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRuleResponse) ProtoMessage() {}
+
+func (x *CreateCategoryRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_category_rule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_category_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCategoryRuleResponse) GetRule() *CategoryRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+var File_rpc_create_category_rule_proto protoreflect.FileDescriptor
+
+var file_rpc_create_category_rule_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x1a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x65, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x42, 0x0a, 0x1a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x42,
+	0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x64,
+	0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_create_category_rule_proto_rawDescOnce sync.Once
+	file_rpc_create_category_rule_proto_rawDescData = file_rpc_create_category_rule_proto_rawDesc
+)
+
+func file_rpc_create_category_rule_proto_rawDescGZIP() []byte {
+	file_rpc_create_category_rule_proto_rawDescOnce.Do(func() {
+		file_rpc_create_category_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_create_category_rule_proto_rawDescData)
+	})
+	return file_rpc_create_category_rule_proto_rawDescData
+}
+
+var file_rpc_create_category_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_category_rule_proto_goTypes = []interface{}{
+	(*CreateCategoryRuleRequest)(nil),  // 0: pb.CreateCategoryRuleRequest
+	(*CreateCategoryRuleResponse)(nil), // 1: pb.CreateCategoryRuleResponse
+	(*CategoryRule)(nil),               // 2: pb.CategoryRule
+}
+var file_rpc_create_category_rule_proto_depIdxs = []int32{
+	2, // 0: pb.CreateCategoryRuleResponse.rule:type_name -> pb.CategoryRule
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_category_rule_proto_init() }
+func file_rpc_create_category_rule_proto_init() {
+	if File_rpc_create_category_rule_proto != nil {
+		return
+	}
+	file_category_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_create_category_rule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCategoryRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_category_rule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCategoryRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_create_category_rule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_category_rule_proto_goTypes,
+		DependencyIndexes: file_rpc_create_category_rule_proto_depIdxs,
+		MessageInfos:      file_rpc_create_category_rule_proto_msgTypes,
+	}.Build()
+	File_rpc_create_category_rule_proto = out.File
+	file_rpc_create_category_rule_proto_rawDesc = nil
+	file_rpc_create_category_rule_proto_goTypes = nil
+	file_rpc_create_category_rule_proto_depIdxs = nil
+}
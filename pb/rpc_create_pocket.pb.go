@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_create_pocket.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreatePocketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountNumber string  `protobuf:"bytes,5,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Name          string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmount  float64 `protobuf:"fixed64,3,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	TargetDate    *string `protobuf:"bytes,4,opt,name=target_date,json=targetDate,proto3,oneof" json:"target_date,omitempty"`
+}
+
+func (x *CreatePocketRequest) Reset() {
+	*x = CreatePocketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_pocket_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePocketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePocketRequest) ProtoMessage() {}
+
+func (x *CreatePocketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_pocket_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePocketRequest.ProtoReflect.Descriptor instead.
+func (*CreatePocketRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_pocket_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePocketRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *CreatePocketRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePocketRequest) GetTargetAmount() float64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *CreatePocketRequest) GetTargetDate() string {
+	if x != nil && x.TargetDate != nil {
+		return *x.TargetDate
+	}
+	return ""
+}
+
+type CreatePocketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pocket *Pocket `protobuf:"bytes,1,opt,name=pocket,proto3" json:"pocket,omitempty"`
+}
+
+func (x *CreatePocketResponse) Reset() {
+	*x = CreatePocketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_pocket_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePocketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePocketResponse) ProtoMessage() {}
+
+func (x *CreatePocketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_pocket_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePocketResponse.ProtoReflect.Descriptor instead.
+func (*CreatePocketResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_pocket_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePocketResponse) GetPocket() *Pocket {
+	if x != nil {
+		return x.Pocket
+	}
+	return nil
+}
+
+var File_rpc_create_pocket_proto protoreflect.FileDescriptor
+
+var file_rpc_create_pocket_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0c, 0x70,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x01, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x06, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x70,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x64, 0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f,
+	0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_create_pocket_proto_rawDescOnce sync.Once
+	file_rpc_create_pocket_proto_rawDescData = file_rpc_create_pocket_proto_rawDesc
+)
+
+func file_rpc_create_pocket_proto_rawDescGZIP() []byte {
+	file_rpc_create_pocket_proto_rawDescOnce.Do(func() {
+		file_rpc_create_pocket_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_create_pocket_proto_rawDescData)
+	})
+	return file_rpc_create_pocket_proto_rawDescData
+}
+
+var file_rpc_create_pocket_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_pocket_proto_goTypes = []interface{}{
+	(*CreatePocketRequest)(nil),  // 0: pb.CreatePocketRequest
+	(*CreatePocketResponse)(nil), // 1: pb.CreatePocketResponse
+	(*Pocket)(nil),               // 2: pb.Pocket
+}
+var file_rpc_create_pocket_proto_depIdxs = []int32{
+	2, // 0: pb.CreatePocketResponse.pocket:type_name -> pb.Pocket
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_pocket_proto_init() }
+func file_rpc_create_pocket_proto_init() {
+	if File_rpc_create_pocket_proto != nil {
+		return
+	}
+	file_pocket_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_create_pocket_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePocketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_pocket_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePocketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpc_create_pocket_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_create_pocket_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_pocket_proto_goTypes,
+		DependencyIndexes: file_rpc_create_pocket_proto_depIdxs,
+		MessageInfos:      file_rpc_create_pocket_proto_msgTypes,
+	}.Build()
+	File_rpc_create_pocket_proto = out.File
+	file_rpc_create_pocket_proto_rawDesc = nil
+	file_rpc_create_pocket_proto_goTypes = nil
+	file_rpc_create_pocket_proto_depIdxs = nil
+}
@@ -0,0 +1,231 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_create_pocket_rule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreatePocketRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PocketId int64   `protobuf:"varint,1,opt,name=pocket_id,json=pocketId,proto3" json:"pocket_id,omitempty"`
+	Kind     string  `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Percent  float64 `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (x *CreatePocketRuleRequest) Reset() {
+	*x = CreatePocketRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_pocket_rule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePocketRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePocketRuleRequest) ProtoMessage() {}
+
+func (x *CreatePocketRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_pocket_rule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePocketRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreatePocketRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_pocket_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePocketRuleRequest) GetPocketId() int64 {
+	if x != nil {
+		return x.PocketId
+	}
+	return 0
+}
+
+func (x *CreatePocketRuleRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreatePocketRuleRequest) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type CreatePocketRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *PocketRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *CreatePocketRuleResponse) Reset() {
+	*x = CreatePocketRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_pocket_rule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePocketRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePocketRuleResponse) ProtoMessage() {}
+
+func (x *CreatePocketRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_pocket_rule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePocketRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreatePocketRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_pocket_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePocketRuleResponse) GetRule() *PocketRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+var File_rpc_create_pocket_rule_proto protoreflect.FileDescriptor
+
+var file_rpc_create_pocket_rule_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x1a, 0x0c, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x64, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x64, 0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74,
+	0x5f, 0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_create_pocket_rule_proto_rawDescOnce sync.Once
+	file_rpc_create_pocket_rule_proto_rawDescData = file_rpc_create_pocket_rule_proto_rawDesc
+)
+
+func file_rpc_create_pocket_rule_proto_rawDescGZIP() []byte {
+	file_rpc_create_pocket_rule_proto_rawDescOnce.Do(func() {
+		file_rpc_create_pocket_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_create_pocket_rule_proto_rawDescData)
+	})
+	return file_rpc_create_pocket_rule_proto_rawDescData
+}
+
+var file_rpc_create_pocket_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_pocket_rule_proto_goTypes = []interface{}{
+	(*CreatePocketRuleRequest)(nil),  // 0: pb.CreatePocketRuleRequest
+	(*CreatePocketRuleResponse)(nil), // 1: pb.CreatePocketRuleResponse
+	(*PocketRule)(nil),               // 2: pb.PocketRule
+}
+var file_rpc_create_pocket_rule_proto_depIdxs = []int32{
+	2, // 0: pb.CreatePocketRuleResponse.rule:type_name -> pb.PocketRule
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_pocket_rule_proto_init() }
+func file_rpc_create_pocket_rule_proto_init() {
+	if File_rpc_create_pocket_rule_proto != nil {
+		return
+	}
+	file_pocket_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_create_pocket_rule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePocketRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_pocket_rule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePocketRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_create_pocket_rule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_pocket_rule_proto_goTypes,
+		DependencyIndexes: file_rpc_create_pocket_rule_proto_depIdxs,
+		MessageInfos:      file_rpc_create_pocket_rule_proto_msgTypes,
+	}.Build()
+	File_rpc_create_pocket_rule_proto = out.File
+	file_rpc_create_pocket_rule_proto_rawDesc = nil
+	file_rpc_create_pocket_rule_proto_goTypes = nil
+	file_rpc_create_pocket_rule_proto_depIdxs = nil
+}
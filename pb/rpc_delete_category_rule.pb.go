@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_delete_category_rule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteCategoryRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteCategoryRuleRequest) Reset() {
+	*x = DeleteCategoryRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_delete_category_rule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCategoryRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRuleRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_category_rule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_category_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteCategoryRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCategoryRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCategoryRuleResponse) Reset() {
+	*x = DeleteCategoryRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_delete_category_rule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCategoryRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRuleResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_category_rule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_category_rule_proto_rawDescGZIP(), []int{1}
+}
+
+var File_rpc_delete_category_rule_proto protoreflect.FileDescriptor
+
+var file_rpc_delete_category_rule_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x72, 0x70, 0x63, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x64,
+	0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_delete_category_rule_proto_rawDescOnce sync.Once
+	file_rpc_delete_category_rule_proto_rawDescData = file_rpc_delete_category_rule_proto_rawDesc
+)
+
+func file_rpc_delete_category_rule_proto_rawDescGZIP() []byte {
+	file_rpc_delete_category_rule_proto_rawDescOnce.Do(func() {
+		file_rpc_delete_category_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_delete_category_rule_proto_rawDescData)
+	})
+	return file_rpc_delete_category_rule_proto_rawDescData
+}
+
+var file_rpc_delete_category_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_delete_category_rule_proto_goTypes = []interface{}{
+	(*DeleteCategoryRuleRequest)(nil),  // 0: pb.DeleteCategoryRuleRequest
+	(*DeleteCategoryRuleResponse)(nil), // 1: pb.DeleteCategoryRuleResponse
+}
+var file_rpc_delete_category_rule_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_delete_category_rule_proto_init() }
+func file_rpc_delete_category_rule_proto_init() {
+	if File_rpc_delete_category_rule_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_delete_category_rule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCategoryRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_delete_category_rule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCategoryRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_delete_category_rule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_delete_category_rule_proto_goTypes,
+		DependencyIndexes: file_rpc_delete_category_rule_proto_depIdxs,
+		MessageInfos:      file_rpc_delete_category_rule_proto_msgTypes,
+	}.Build()
+	File_rpc_delete_category_rule_proto = out.File
+	file_rpc_delete_category_rule_proto_rawDesc = nil
+	file_rpc_delete_category_rule_proto_goTypes = nil
+	file_rpc_delete_category_rule_proto_depIdxs = nil
+}
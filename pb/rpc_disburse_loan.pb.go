@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_disburse_loan.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DisburseLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountNumber string  `protobuf:"bytes,6,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Principal     float64 `protobuf:"fixed64,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	InterestRate  float64 `protobuf:"fixed64,3,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths    int32   `protobuf:"varint,4,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	Method        string  `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *DisburseLoanRequest) Reset() {
+	*x = DisburseLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_disburse_loan_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisburseLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisburseLoanRequest) ProtoMessage() {}
+
+func (x *DisburseLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_disburse_loan_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisburseLoanRequest.ProtoReflect.Descriptor instead.
+func (*DisburseLoanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_disburse_loan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DisburseLoanRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *DisburseLoanRequest) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *DisburseLoanRequest) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *DisburseLoanRequest) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *DisburseLoanRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+type DisburseLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loan         *Loan              `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	Installments []*LoanInstallment `protobuf:"bytes,2,rep,name=installments,proto3" json:"installments,omitempty"`
+}
+
+func (x *DisburseLoanResponse) Reset() {
+	*x = DisburseLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_disburse_loan_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisburseLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisburseLoanResponse) ProtoMessage() {}
+
+func (x *DisburseLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_disburse_loan_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisburseLoanResponse.ProtoReflect.Descriptor instead.
+func (*DisburseLoanResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_disburse_loan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DisburseLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *DisburseLoanResponse) GetInstallments() []*LoanInstallment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+var File_rpc_disburse_loan_proto protoreflect.FileDescriptor
+
+var file_rpc_disburse_loan_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x72, 0x70, 0x63, 0x5f, 0x64, 0x69, 0x73, 0x62, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0a, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x01, 0x0a, 0x13, 0x44, 0x69,
+	0x73, 0x62, 0x75, 0x72, 0x73, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x65, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x22, 0x6d, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x62, 0x75, 0x72, 0x73, 0x65,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04,
+	0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x12, 0x37, 0x0a, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x7a, 0x64, 0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61,
+	0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_disburse_loan_proto_rawDescOnce sync.Once
+	file_rpc_disburse_loan_proto_rawDescData = file_rpc_disburse_loan_proto_rawDesc
+)
+
+func file_rpc_disburse_loan_proto_rawDescGZIP() []byte {
+	file_rpc_disburse_loan_proto_rawDescOnce.Do(func() {
+		file_rpc_disburse_loan_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_disburse_loan_proto_rawDescData)
+	})
+	return file_rpc_disburse_loan_proto_rawDescData
+}
+
+var file_rpc_disburse_loan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_disburse_loan_proto_goTypes = []interface{}{
+	(*DisburseLoanRequest)(nil),  // 0: pb.DisburseLoanRequest
+	(*DisburseLoanResponse)(nil), // 1: pb.DisburseLoanResponse
+	(*Loan)(nil),                 // 2: pb.Loan
+	(*LoanInstallment)(nil),      // 3: pb.LoanInstallment
+}
+var file_rpc_disburse_loan_proto_depIdxs = []int32{
+	2, // 0: pb.DisburseLoanResponse.loan:type_name -> pb.Loan
+	3, // 1: pb.DisburseLoanResponse.installments:type_name -> pb.LoanInstallment
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_disburse_loan_proto_init() }
+func file_rpc_disburse_loan_proto_init() {
+	if File_rpc_disburse_loan_proto != nil {
+		return
+	}
+	file_loan_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_disburse_loan_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisburseLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_disburse_loan_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisburseLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_disburse_loan_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_disburse_loan_proto_goTypes,
+		DependencyIndexes: file_rpc_disburse_loan_proto_depIdxs,
+		MessageInfos:      file_rpc_disburse_loan_proto_msgTypes,
+	}.Build()
+	File_rpc_disburse_loan_proto = out.File
+	file_rpc_disburse_loan_proto_rawDesc = nil
+	file_rpc_disburse_loan_proto_goTypes = nil
+	file_rpc_disburse_loan_proto_depIdxs = nil
+}
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_download_trial_balance.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DownloadTrialBalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BusinessDate string `protobuf:"bytes,1,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+}
+
+func (x *DownloadTrialBalanceRequest) Reset() {
+	*x = DownloadTrialBalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_download_trial_balance_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTrialBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTrialBalanceRequest) ProtoMessage() {}
+
+func (x *DownloadTrialBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_download_trial_balance_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTrialBalanceRequest.ProtoReflect.Descriptor instead.
+func (*DownloadTrialBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_download_trial_balance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DownloadTrialBalanceRequest) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+var File_rpc_download_trial_balance_proto protoreflect.FileDescriptor
+
+var file_rpc_download_trial_balance_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x72, 0x70, 0x63, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74,
+	0x72, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x42, 0x0a, 0x1b, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x44, 0x61, 0x74, 0x65, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x64, 0x65, 0x33, 0x37, 0x2f, 0x53,
+	0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_download_trial_balance_proto_rawDescOnce sync.Once
+	file_rpc_download_trial_balance_proto_rawDescData = file_rpc_download_trial_balance_proto_rawDesc
+)
+
+func file_rpc_download_trial_balance_proto_rawDescGZIP() []byte {
+	file_rpc_download_trial_balance_proto_rawDescOnce.Do(func() {
+		file_rpc_download_trial_balance_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_download_trial_balance_proto_rawDescData)
+	})
+	return file_rpc_download_trial_balance_proto_rawDescData
+}
+
+var file_rpc_download_trial_balance_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rpc_download_trial_balance_proto_goTypes = []interface{}{
+	(*DownloadTrialBalanceRequest)(nil), // 0: pb.DownloadTrialBalanceRequest
+}
+var file_rpc_download_trial_balance_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_download_trial_balance_proto_init() }
+func file_rpc_download_trial_balance_proto_init() {
+	if File_rpc_download_trial_balance_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_download_trial_balance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadTrialBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_download_trial_balance_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_download_trial_balance_proto_goTypes,
+		DependencyIndexes: file_rpc_download_trial_balance_proto_depIdxs,
+		MessageInfos:      file_rpc_download_trial_balance_proto_msgTypes,
+	}.Build()
+	File_rpc_download_trial_balance_proto = out.File
+	file_rpc_download_trial_balance_proto_rawDesc = nil
+	file_rpc_download_trial_balance_proto_goTypes = nil
+	file_rpc_download_trial_balance_proto_depIdxs = nil
+}
@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_export_general_ledger.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportGeneralLedgerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Format    string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *ExportGeneralLedgerRequest) Reset() {
+	*x = ExportGeneralLedgerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_export_general_ledger_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGeneralLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGeneralLedgerRequest) ProtoMessage() {}
+
+func (x *ExportGeneralLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_export_general_ledger_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGeneralLedgerRequest.ProtoReflect.Descriptor instead.
+func (*ExportGeneralLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_export_general_ledger_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportGeneralLedgerRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ExportGeneralLedgerRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ExportGeneralLedgerRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportGeneralLedgerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Export *GLExport `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+}
+
+func (x *ExportGeneralLedgerResponse) Reset() {
+	*x = ExportGeneralLedgerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_export_general_ledger_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGeneralLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGeneralLedgerResponse) ProtoMessage() {}
+
+func (x *ExportGeneralLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_export_general_ledger_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGeneralLedgerResponse.ProtoReflect.Descriptor instead.
+func (*ExportGeneralLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_export_general_ledger_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportGeneralLedgerResponse) GetExport() *GLExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+var File_rpc_export_general_ledger_proto protoreflect.FileDescriptor
+
+var file_rpc_export_general_ledger_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x72, 0x70, 0x63, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0f, 0x67, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6e, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x43, 0x0a, 0x1b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x4c, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x20, 0x5a, 0x1e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x64, 0x65, 0x33, 0x37, 0x2f,
+	0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_export_general_ledger_proto_rawDescOnce sync.Once
+	file_rpc_export_general_ledger_proto_rawDescData = file_rpc_export_general_ledger_proto_rawDesc
+)
+
+func file_rpc_export_general_ledger_proto_rawDescGZIP() []byte {
+	file_rpc_export_general_ledger_proto_rawDescOnce.Do(func() {
+		file_rpc_export_general_ledger_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_export_general_ledger_proto_rawDescData)
+	})
+	return file_rpc_export_general_ledger_proto_rawDescData
+}
+
+var file_rpc_export_general_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_export_general_ledger_proto_goTypes = []interface{}{
+	(*ExportGeneralLedgerRequest)(nil),  // 0: pb.ExportGeneralLedgerRequest
+	(*ExportGeneralLedgerResponse)(nil), // 1: pb.ExportGeneralLedgerResponse
+	(*GLExport)(nil),                    // 2: pb.GLExport
+}
+var file_rpc_export_general_ledger_proto_depIdxs = []int32{
+	2, // 0: pb.ExportGeneralLedgerResponse.export:type_name -> pb.GLExport
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_export_general_ledger_proto_init() }
+func file_rpc_export_general_ledger_proto_init() {
+	if File_rpc_export_general_ledger_proto != nil {
+		return
+	}
+	file_gl_export_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_export_general_ledger_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportGeneralLedgerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_export_general_ledger_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportGeneralLedgerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_export_general_ledger_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_export_general_ledger_proto_goTypes,
+		DependencyIndexes: file_rpc_export_general_ledger_proto_depIdxs,
+		MessageInfos:      file_rpc_export_general_ledger_proto_msgTypes,
+	}.Build()
+	File_rpc_export_general_ledger_proto = out.File
+	file_rpc_export_general_ledger_proto_rawDesc = nil
+	file_rpc_export_general_ledger_proto_goTypes = nil
+	file_rpc_export_general_ledger_proto_depIdxs = nil
+}
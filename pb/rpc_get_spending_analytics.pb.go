@@ -0,0 +1,309 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_get_spending_analytics.proto
+
+package pb
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetSpendingAnalyticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountNumber string               `protobuf:"bytes,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	From          *timestamp.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *GetSpendingAnalyticsRequest) Reset() {
+	*x = GetSpendingAnalyticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_spending_analytics_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpendingAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetSpendingAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_spending_analytics_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetSpendingAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_spending_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetSpendingAnalyticsRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *GetSpendingAnalyticsRequest) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetSpendingAnalyticsRequest) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetSpendingAnalyticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountNumber string               `protobuf:"bytes,8,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	From          *timestamp.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Inflows       float64              `protobuf:"fixed64,4,opt,name=inflows,proto3" json:"inflows,omitempty"`
+	Outflows      float64              `protobuf:"fixed64,5,opt,name=outflows,proto3" json:"outflows,omitempty"`
+	Categories    []*CategorySpend     `protobuf:"bytes,6,rep,name=categories,proto3" json:"categories,omitempty"`
+	Months        []*MonthlyTrend      `protobuf:"bytes,7,rep,name=months,proto3" json:"months,omitempty"`
+}
+
+func (x *GetSpendingAnalyticsResponse) Reset() {
+	*x = GetSpendingAnalyticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_spending_analytics_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpendingAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetSpendingAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_spending_analytics_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetSpendingAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_spending_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSpendingAnalyticsResponse) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *GetSpendingAnalyticsResponse) GetFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetSpendingAnalyticsResponse) GetTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetSpendingAnalyticsResponse) GetInflows() float64 {
+	if x != nil {
+		return x.Inflows
+	}
+	return 0
+}
+
+func (x *GetSpendingAnalyticsResponse) GetOutflows() float64 {
+	if x != nil {
+		return x.Outflows
+	}
+	return 0
+}
+
+func (x *GetSpendingAnalyticsResponse) GetCategories() []*CategorySpend {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetSpendingAnalyticsResponse) GetMonths() []*MonthlyTrend {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+var File_rpc_get_spending_analytics_proto protoreflect.FileDescriptor
+
+var file_rpc_get_spending_analytics_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xb4, 0x02, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x07, 0x69, 0x6e, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x0a, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x74, 0x68, 0x6c, 0x79, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x73, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x7a, 0x64, 0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61, 0x6e, 0x6b,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_get_spending_analytics_proto_rawDescOnce sync.Once
+	file_rpc_get_spending_analytics_proto_rawDescData = file_rpc_get_spending_analytics_proto_rawDesc
+)
+
+func file_rpc_get_spending_analytics_proto_rawDescGZIP() []byte {
+	file_rpc_get_spending_analytics_proto_rawDescOnce.Do(func() {
+		file_rpc_get_spending_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_get_spending_analytics_proto_rawDescData)
+	})
+	return file_rpc_get_spending_analytics_proto_rawDescData
+}
+
+var file_rpc_get_spending_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_spending_analytics_proto_goTypes = []interface{}{
+	(*GetSpendingAnalyticsRequest)(nil),  // 0: pb.GetSpendingAnalyticsRequest
+	(*GetSpendingAnalyticsResponse)(nil), // 1: pb.GetSpendingAnalyticsResponse
+	(*timestamp.Timestamp)(nil),          // 2: google.protobuf.Timestamp
+	(*CategorySpend)(nil),                // 3: pb.CategorySpend
+	(*MonthlyTrend)(nil),                 // 4: pb.MonthlyTrend
+}
+var file_rpc_get_spending_analytics_proto_depIdxs = []int32{
+	2, // 0: pb.GetSpendingAnalyticsRequest.from:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.GetSpendingAnalyticsRequest.to:type_name -> google.protobuf.Timestamp
+	2, // 2: pb.GetSpendingAnalyticsResponse.from:type_name -> google.protobuf.Timestamp
+	2, // 3: pb.GetSpendingAnalyticsResponse.to:type_name -> google.protobuf.Timestamp
+	3, // 4: pb.GetSpendingAnalyticsResponse.categories:type_name -> pb.CategorySpend
+	4, // 5: pb.GetSpendingAnalyticsResponse.months:type_name -> pb.MonthlyTrend
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_spending_analytics_proto_init() }
+func file_rpc_get_spending_analytics_proto_init() {
+	if File_rpc_get_spending_analytics_proto != nil {
+		return
+	}
+	file_category_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_get_spending_analytics_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpendingAnalyticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_get_spending_analytics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpendingAnalyticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_get_spending_analytics_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_spending_analytics_proto_goTypes,
+		DependencyIndexes: file_rpc_get_spending_analytics_proto_depIdxs,
+		MessageInfos:      file_rpc_get_spending_analytics_proto_msgTypes,
+	}.Build()
+	File_rpc_get_spending_analytics_proto = out.File
+	file_rpc_get_spending_analytics_proto_rawDesc = nil
+	file_rpc_get_spending_analytics_proto_goTypes = nil
+	file_rpc_get_spending_analytics_proto_depIdxs = nil
+}
@@ -0,0 +1,240 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_get_transaction_annotations.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetTransactionAnnotationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountNumber string `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	TransactionId int64  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+}
+
+func (x *GetTransactionAnnotationsRequest) Reset() {
+	*x = GetTransactionAnnotationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_transaction_annotations_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionAnnotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionAnnotationsRequest) ProtoMessage() {}
+
+func (x *GetTransactionAnnotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_transaction_annotations_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionAnnotationsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionAnnotationsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_transaction_annotations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetTransactionAnnotationsRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *GetTransactionAnnotationsRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type GetTransactionAnnotationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Note        *TransactionNote         `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	Attachments []*TransactionAttachment `protobuf:"bytes,2,rep,name=attachments,proto3" json:"attachments,omitempty"`
+}
+
+func (x *GetTransactionAnnotationsResponse) Reset() {
+	*x = GetTransactionAnnotationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_transaction_annotations_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionAnnotationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionAnnotationsResponse) ProtoMessage() {}
+
+func (x *GetTransactionAnnotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_transaction_annotations_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionAnnotationsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionAnnotationsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_transaction_annotations_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetTransactionAnnotationsResponse) GetNote() *TransactionNote {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *GetTransactionAnnotationsResponse) GetAttachments() []*TransactionAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+var File_rpc_get_transaction_annotations_proto protoreflect.FileDescriptor
+
+var file_rpc_get_transaction_annotations_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x11, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x70,
+	0x0a, 0x20, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x89, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12,
+	0x3b, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x20, 0x5a, 0x1e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x64, 0x65, 0x33, 0x37,
+	0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_get_transaction_annotations_proto_rawDescOnce sync.Once
+	file_rpc_get_transaction_annotations_proto_rawDescData = file_rpc_get_transaction_annotations_proto_rawDesc
+)
+
+func file_rpc_get_transaction_annotations_proto_rawDescGZIP() []byte {
+	file_rpc_get_transaction_annotations_proto_rawDescOnce.Do(func() {
+		file_rpc_get_transaction_annotations_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_get_transaction_annotations_proto_rawDescData)
+	})
+	return file_rpc_get_transaction_annotations_proto_rawDescData
+}
+
+var file_rpc_get_transaction_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_transaction_annotations_proto_goTypes = []interface{}{
+	(*GetTransactionAnnotationsRequest)(nil),  // 0: pb.GetTransactionAnnotationsRequest
+	(*GetTransactionAnnotationsResponse)(nil), // 1: pb.GetTransactionAnnotationsResponse
+	(*TransactionNote)(nil),                   // 2: pb.TransactionNote
+	(*TransactionAttachment)(nil),             // 3: pb.TransactionAttachment
+}
+var file_rpc_get_transaction_annotations_proto_depIdxs = []int32{
+	2, // 0: pb.GetTransactionAnnotationsResponse.note:type_name -> pb.TransactionNote
+	3, // 1: pb.GetTransactionAnnotationsResponse.attachments:type_name -> pb.TransactionAttachment
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_transaction_annotations_proto_init() }
+func file_rpc_get_transaction_annotations_proto_init() {
+	if File_rpc_get_transaction_annotations_proto != nil {
+		return
+	}
+	file_transaction_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_get_transaction_annotations_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionAnnotationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_get_transaction_annotations_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionAnnotationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_get_transaction_annotations_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_transaction_annotations_proto_goTypes,
+		DependencyIndexes: file_rpc_get_transaction_annotations_proto_depIdxs,
+		MessageInfos:      file_rpc_get_transaction_annotations_proto_msgTypes,
+	}.Build()
+	File_rpc_get_transaction_annotations_proto = out.File
+	file_rpc_get_transaction_annotations_proto_rawDesc = nil
+	file_rpc_get_transaction_annotations_proto_goTypes = nil
+	file_rpc_get_transaction_annotations_proto_depIdxs = nil
+}
@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_list_gl_exports.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListGLExportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageId   int32 `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListGLExportsRequest) Reset() {
+	*x = ListGLExportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_gl_exports_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGLExportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGLExportsRequest) ProtoMessage() {}
+
+func (x *ListGLExportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_gl_exports_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGLExportsRequest.ProtoReflect.Descriptor instead.
+func (*ListGLExportsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_gl_exports_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListGLExportsRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListGLExportsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListGLExportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exports []*GLExport `protobuf:"bytes,1,rep,name=exports,proto3" json:"exports,omitempty"`
+}
+
+func (x *ListGLExportsResponse) Reset() {
+	*x = ListGLExportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_gl_exports_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGLExportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGLExportsResponse) ProtoMessage() {}
+
+func (x *ListGLExportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_gl_exports_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGLExportsResponse.ProtoReflect.Descriptor instead.
+func (*ListGLExportsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_gl_exports_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListGLExportsResponse) GetExports() []*GLExport {
+	if x != nil {
+		return x.Exports
+	}
+	return nil
+}
+
+var File_rpc_list_gl_exports_proto protoreflect.FileDescriptor
+
+var file_rpc_list_gl_exports_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x67, 0x6c, 0x5f, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a,
+	0x0f, 0x67, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x4c, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x4c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3f,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x4c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x4c,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x42,
+	0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x64,
+	0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_gl_exports_proto_rawDescOnce sync.Once
+	file_rpc_list_gl_exports_proto_rawDescData = file_rpc_list_gl_exports_proto_rawDesc
+)
+
+func file_rpc_list_gl_exports_proto_rawDescGZIP() []byte {
+	file_rpc_list_gl_exports_proto_rawDescOnce.Do(func() {
+		file_rpc_list_gl_exports_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_gl_exports_proto_rawDescData)
+	})
+	return file_rpc_list_gl_exports_proto_rawDescData
+}
+
+var file_rpc_list_gl_exports_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_gl_exports_proto_goTypes = []interface{}{
+	(*ListGLExportsRequest)(nil),  // 0: pb.ListGLExportsRequest
+	(*ListGLExportsResponse)(nil), // 1: pb.ListGLExportsResponse
+	(*GLExport)(nil),              // 2: pb.GLExport
+}
+var file_rpc_list_gl_exports_proto_depIdxs = []int32{
+	2, // 0: pb.ListGLExportsResponse.exports:type_name -> pb.GLExport
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_gl_exports_proto_init() }
+func file_rpc_list_gl_exports_proto_init() {
+	if File_rpc_list_gl_exports_proto != nil {
+		return
+	}
+	file_gl_export_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_gl_exports_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGLExportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_gl_exports_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGLExportsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_gl_exports_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_gl_exports_proto_goTypes,
+		DependencyIndexes: file_rpc_list_gl_exports_proto_depIdxs,
+		MessageInfos:      file_rpc_list_gl_exports_proto_msgTypes,
+	}.Build()
+	File_rpc_list_gl_exports_proto = out.File
+	file_rpc_list_gl_exports_proto_rawDesc = nil
+	file_rpc_list_gl_exports_proto_goTypes = nil
+	file_rpc_list_gl_exports_proto_depIdxs = nil
+}
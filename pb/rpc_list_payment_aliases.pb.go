@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_list_payment_aliases.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListPaymentAliasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPaymentAliasesRequest) Reset() {
+	*x = ListPaymentAliasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_payment_aliases_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPaymentAliasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentAliasesRequest) ProtoMessage() {}
+
+func (x *ListPaymentAliasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_payment_aliases_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentAliasesRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentAliasesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_payment_aliases_proto_rawDescGZIP(), []int{0}
+}
+
+type ListPaymentAliasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aliases         []*PaymentAlias   `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	DefaultAccounts []*DefaultAccount `protobuf:"bytes,2,rep,name=default_accounts,json=defaultAccounts,proto3" json:"default_accounts,omitempty"`
+}
+
+func (x *ListPaymentAliasesResponse) Reset() {
+	*x = ListPaymentAliasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_payment_aliases_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPaymentAliasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentAliasesResponse) ProtoMessage() {}
+
+func (x *ListPaymentAliasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_payment_aliases_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentAliasesResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentAliasesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_payment_aliases_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPaymentAliasesResponse) GetAliases() []*PaymentAlias {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *ListPaymentAliasesResponse) GetDefaultAccounts() []*DefaultAccount {
+	if x != nil {
+		return x.DefaultAccounts
+	}
+	return nil
+}
+
+var File_rpc_list_payment_aliases_proto protoreflect.FileDescriptor
+
+var file_rpc_list_payment_aliases_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x1a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x3d, 0x0a, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62,
+	0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a,
+	0x64, 0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61, 0x6e, 0x6b, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_payment_aliases_proto_rawDescOnce sync.Once
+	file_rpc_list_payment_aliases_proto_rawDescData = file_rpc_list_payment_aliases_proto_rawDesc
+)
+
+func file_rpc_list_payment_aliases_proto_rawDescGZIP() []byte {
+	file_rpc_list_payment_aliases_proto_rawDescOnce.Do(func() {
+		file_rpc_list_payment_aliases_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_payment_aliases_proto_rawDescData)
+	})
+	return file_rpc_list_payment_aliases_proto_rawDescData
+}
+
+var file_rpc_list_payment_aliases_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_payment_aliases_proto_goTypes = []interface{}{
+	(*ListPaymentAliasesRequest)(nil),  // 0: pb.ListPaymentAliasesRequest
+	(*ListPaymentAliasesResponse)(nil), // 1: pb.ListPaymentAliasesResponse
+	(*PaymentAlias)(nil),               // 2: pb.PaymentAlias
+	(*DefaultAccount)(nil),             // 3: pb.DefaultAccount
+}
+var file_rpc_list_payment_aliases_proto_depIdxs = []int32{
+	2, // 0: pb.ListPaymentAliasesResponse.aliases:type_name -> pb.PaymentAlias
+	3, // 1: pb.ListPaymentAliasesResponse.default_accounts:type_name -> pb.DefaultAccount
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_payment_aliases_proto_init() }
+func file_rpc_list_payment_aliases_proto_init() {
+	if File_rpc_list_payment_aliases_proto != nil {
+		return
+	}
+	file_payment_alias_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_payment_aliases_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPaymentAliasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_payment_aliases_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPaymentAliasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_payment_aliases_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_payment_aliases_proto_goTypes,
+		DependencyIndexes: file_rpc_list_payment_aliases_proto_depIdxs,
+		MessageInfos:      file_rpc_list_payment_aliases_proto_msgTypes,
+	}.Build()
+	File_rpc_list_payment_aliases_proto = out.File
+	file_rpc_list_payment_aliases_proto_rawDesc = nil
+	file_rpc_list_payment_aliases_proto_goTypes = nil
+	file_rpc_list_payment_aliases_proto_depIdxs = nil
+}
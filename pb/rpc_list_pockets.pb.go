@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_list_pockets.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListPocketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountNumber string `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+}
+
+func (x *ListPocketsRequest) Reset() {
+	*x = ListPocketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_pockets_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPocketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPocketsRequest) ProtoMessage() {}
+
+func (x *ListPocketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_pockets_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPocketsRequest.ProtoReflect.Descriptor instead.
+func (*ListPocketsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_pockets_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListPocketsRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+type ListPocketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pockets []*Pocket `protobuf:"bytes,1,rep,name=pockets,proto3" json:"pockets,omitempty"`
+}
+
+func (x *ListPocketsResponse) Reset() {
+	*x = ListPocketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_pockets_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPocketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPocketsResponse) ProtoMessage() {}
+
+func (x *ListPocketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_pockets_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPocketsResponse.ProtoReflect.Descriptor instead.
+func (*ListPocketsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_pockets_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPocketsResponse) GetPockets() []*Pocket {
+	if x != nil {
+		return x.Pockets
+	}
+	return nil
+}
+
+var File_rpc_list_pockets_proto protoreflect.FileDescriptor
+
+var file_rpc_list_pockets_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0c, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3b, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x3b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x07, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x70, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x7a, 0x64, 0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42,
+	0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_pockets_proto_rawDescOnce sync.Once
+	file_rpc_list_pockets_proto_rawDescData = file_rpc_list_pockets_proto_rawDesc
+)
+
+func file_rpc_list_pockets_proto_rawDescGZIP() []byte {
+	file_rpc_list_pockets_proto_rawDescOnce.Do(func() {
+		file_rpc_list_pockets_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_pockets_proto_rawDescData)
+	})
+	return file_rpc_list_pockets_proto_rawDescData
+}
+
+var file_rpc_list_pockets_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_pockets_proto_goTypes = []interface{}{
+	(*ListPocketsRequest)(nil),  // 0: pb.ListPocketsRequest
+	(*ListPocketsResponse)(nil), // 1: pb.ListPocketsResponse
+	(*Pocket)(nil),              // 2: pb.Pocket
+}
+var file_rpc_list_pockets_proto_depIdxs = []int32{
+	2, // 0: pb.ListPocketsResponse.pockets:type_name -> pb.Pocket
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_pockets_proto_init() }
+func file_rpc_list_pockets_proto_init() {
+	if File_rpc_list_pockets_proto != nil {
+		return
+	}
+	file_pocket_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_pockets_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPocketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_pockets_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPocketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_pockets_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_pockets_proto_goTypes,
+		DependencyIndexes: file_rpc_list_pockets_proto_depIdxs,
+		MessageInfos:      file_rpc_list_pockets_proto_msgTypes,
+	}.Build()
+	File_rpc_list_pockets_proto = out.File
+	file_rpc_list_pockets_proto_rawDesc = nil
+	file_rpc_list_pockets_proto_goTypes = nil
+	file_rpc_list_pockets_proto_depIdxs = nil
+}
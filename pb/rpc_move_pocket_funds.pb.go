@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_move_pocket_funds.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MovePocketFundsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PocketId int64   `protobuf:"varint,1,opt,name=pocket_id,json=pocketId,proto3" json:"pocket_id,omitempty"`
+	Amount   float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Withdraw bool    `protobuf:"varint,3,opt,name=withdraw,proto3" json:"withdraw,omitempty"`
+}
+
+func (x *MovePocketFundsRequest) Reset() {
+	*x = MovePocketFundsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_move_pocket_funds_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MovePocketFundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovePocketFundsRequest) ProtoMessage() {}
+
+func (x *MovePocketFundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_move_pocket_funds_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovePocketFundsRequest.ProtoReflect.Descriptor instead.
+func (*MovePocketFundsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_move_pocket_funds_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MovePocketFundsRequest) GetPocketId() int64 {
+	if x != nil {
+		return x.PocketId
+	}
+	return 0
+}
+
+func (x *MovePocketFundsRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MovePocketFundsRequest) GetWithdraw() bool {
+	if x != nil {
+		return x.Withdraw
+	}
+	return false
+}
+
+type MovePocketFundsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pocket           *Pocket      `protobuf:"bytes,1,opt,name=pocket,proto3" json:"pocket,omitempty"`
+	Entry            *PocketEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	AvailableBalance float64      `protobuf:"fixed64,3,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+}
+
+func (x *MovePocketFundsResponse) Reset() {
+	*x = MovePocketFundsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_move_pocket_funds_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MovePocketFundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovePocketFundsResponse) ProtoMessage() {}
+
+func (x *MovePocketFundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_move_pocket_funds_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovePocketFundsResponse.ProtoReflect.Descriptor instead.
+func (*MovePocketFundsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_move_pocket_funds_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MovePocketFundsResponse) GetPocket() *Pocket {
+	if x != nil {
+		return x.Pocket
+	}
+	return nil
+}
+
+func (x *MovePocketFundsResponse) GetEntry() *PocketEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *MovePocketFundsResponse) GetAvailableBalance() float64 {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return 0
+}
+
+var File_rpc_move_pocket_funds_proto protoreflect.FileDescriptor
+
+var file_rpc_move_pocket_funds_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x1a, 0x0c, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x69, 0x0a, 0x16, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x75, 0x6e,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x22, 0x91, 0x01, 0x0a, 0x17, 0x4d,
+	0x6f, 0x76, 0x65, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x20,
+	0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x64, 0x65,
+	0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_move_pocket_funds_proto_rawDescOnce sync.Once
+	file_rpc_move_pocket_funds_proto_rawDescData = file_rpc_move_pocket_funds_proto_rawDesc
+)
+
+func file_rpc_move_pocket_funds_proto_rawDescGZIP() []byte {
+	file_rpc_move_pocket_funds_proto_rawDescOnce.Do(func() {
+		file_rpc_move_pocket_funds_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_move_pocket_funds_proto_rawDescData)
+	})
+	return file_rpc_move_pocket_funds_proto_rawDescData
+}
+
+var file_rpc_move_pocket_funds_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_move_pocket_funds_proto_goTypes = []interface{}{
+	(*MovePocketFundsRequest)(nil),  // 0: pb.MovePocketFundsRequest
+	(*MovePocketFundsResponse)(nil), // 1: pb.MovePocketFundsResponse
+	(*Pocket)(nil),                  // 2: pb.Pocket
+	(*PocketEntry)(nil),             // 3: pb.PocketEntry
+}
+var file_rpc_move_pocket_funds_proto_depIdxs = []int32{
+	2, // 0: pb.MovePocketFundsResponse.pocket:type_name -> pb.Pocket
+	3, // 1: pb.MovePocketFundsResponse.entry:type_name -> pb.PocketEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_move_pocket_funds_proto_init() }
+func file_rpc_move_pocket_funds_proto_init() {
+	if File_rpc_move_pocket_funds_proto != nil {
+		return
+	}
+	file_pocket_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_move_pocket_funds_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MovePocketFundsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_move_pocket_funds_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MovePocketFundsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_move_pocket_funds_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_move_pocket_funds_proto_goTypes,
+		DependencyIndexes: file_rpc_move_pocket_funds_proto_depIdxs,
+		MessageInfos:      file_rpc_move_pocket_funds_proto_msgTypes,
+	}.Build()
+	File_rpc_move_pocket_funds_proto = out.File
+	file_rpc_move_pocket_funds_proto_rawDesc = nil
+	file_rpc_move_pocket_funds_proto_goTypes = nil
+	file_rpc_move_pocket_funds_proto_depIdxs = nil
+}
@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_pay_off_loan.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PayOffLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId int64 `protobuf:"varint,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *PayOffLoanRequest) Reset() {
+	*x = PayOffLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pay_off_loan_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayOffLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayOffLoanRequest) ProtoMessage() {}
+
+func (x *PayOffLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pay_off_loan_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayOffLoanRequest.ProtoReflect.Descriptor instead.
+func (*PayOffLoanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_pay_off_loan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PayOffLoanRequest) GetLoanId() int64 {
+	if x != nil {
+		return x.LoanId
+	}
+	return 0
+}
+
+type PayOffLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loan         *Loan              `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	Installments []*LoanInstallment `protobuf:"bytes,2,rep,name=installments,proto3" json:"installments,omitempty"`
+}
+
+func (x *PayOffLoanResponse) Reset() {
+	*x = PayOffLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_pay_off_loan_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayOffLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayOffLoanResponse) ProtoMessage() {}
+
+func (x *PayOffLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_pay_off_loan_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayOffLoanResponse.ProtoReflect.Descriptor instead.
+func (*PayOffLoanResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_pay_off_loan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PayOffLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *PayOffLoanResponse) GetInstallments() []*LoanInstallment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+var File_rpc_pay_off_loan_proto protoreflect.FileDescriptor
+
+var file_rpc_pay_off_loan_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x61, 0x79, 0x5f, 0x6f, 0x66, 0x66, 0x5f, 0x6c, 0x6f,
+	0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0a, 0x6c, 0x6f,
+	0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2c, 0x0a, 0x11, 0x50, 0x61, 0x79, 0x4f,
+	0x66, 0x66, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x12, 0x50, 0x61, 0x79, 0x4f, 0x66, 0x66,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04,
+	0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x12, 0x37, 0x0a, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x7a, 0x64, 0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61,
+	0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_pay_off_loan_proto_rawDescOnce sync.Once
+	file_rpc_pay_off_loan_proto_rawDescData = file_rpc_pay_off_loan_proto_rawDesc
+)
+
+func file_rpc_pay_off_loan_proto_rawDescGZIP() []byte {
+	file_rpc_pay_off_loan_proto_rawDescOnce.Do(func() {
+		file_rpc_pay_off_loan_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_pay_off_loan_proto_rawDescData)
+	})
+	return file_rpc_pay_off_loan_proto_rawDescData
+}
+
+var file_rpc_pay_off_loan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_pay_off_loan_proto_goTypes = []interface{}{
+	(*PayOffLoanRequest)(nil),  // 0: pb.PayOffLoanRequest
+	(*PayOffLoanResponse)(nil), // 1: pb.PayOffLoanResponse
+	(*Loan)(nil),               // 2: pb.Loan
+	(*LoanInstallment)(nil),    // 3: pb.LoanInstallment
+}
+var file_rpc_pay_off_loan_proto_depIdxs = []int32{
+	2, // 0: pb.PayOffLoanResponse.loan:type_name -> pb.Loan
+	3, // 1: pb.PayOffLoanResponse.installments:type_name -> pb.LoanInstallment
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_pay_off_loan_proto_init() }
+func file_rpc_pay_off_loan_proto_init() {
+	if File_rpc_pay_off_loan_proto != nil {
+		return
+	}
+	file_loan_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_pay_off_loan_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayOffLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_pay_off_loan_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayOffLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_pay_off_loan_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_pay_off_loan_proto_goTypes,
+		DependencyIndexes: file_rpc_pay_off_loan_proto_depIdxs,
+		MessageInfos:      file_rpc_pay_off_loan_proto_msgTypes,
+	}.Build()
+	File_rpc_pay_off_loan_proto = out.File
+	file_rpc_pay_off_loan_proto_rawDesc = nil
+	file_rpc_pay_off_loan_proto_goTypes = nil
+	file_rpc_pay_off_loan_proto_depIdxs = nil
+}
@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_request_overdraft.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RequestOverdraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountNumber string  `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Limit         float64 `protobuf:"fixed64,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *RequestOverdraftRequest) Reset() {
+	*x = RequestOverdraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_request_overdraft_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestOverdraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestOverdraftRequest) ProtoMessage() {}
+
+func (x *RequestOverdraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_request_overdraft_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestOverdraftRequest.ProtoReflect.Descriptor instead.
+func (*RequestOverdraftRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_request_overdraft_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RequestOverdraftRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *RequestOverdraftRequest) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RequestOverdraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OverdraftRequest *OverdraftRequest `protobuf:"bytes,1,opt,name=overdraft_request,json=overdraftRequest,proto3" json:"overdraft_request,omitempty"`
+}
+
+func (x *RequestOverdraftResponse) Reset() {
+	*x = RequestOverdraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_request_overdraft_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestOverdraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestOverdraftResponse) ProtoMessage() {}
+
+func (x *RequestOverdraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_request_overdraft_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestOverdraftResponse.ProtoReflect.Descriptor instead.
+func (*RequestOverdraftResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_request_overdraft_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestOverdraftResponse) GetOverdraftRequest() *OverdraftRequest {
+	if x != nil {
+		return x.OverdraftRequest
+	}
+	return nil
+}
+
+var File_rpc_request_overdraft_proto protoreflect.FileDescriptor
+
+var file_rpc_request_overdraft_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x76,
+	0x65, 0x72, 0x64, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x1a, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x56, 0x0a, 0x17, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x64, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x5d, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4f, 0x76, 0x65,
+	0x72, 0x64, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x11, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
+	0x76, 0x65, 0x72, 0x64, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x10, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x7a, 0x64, 0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74, 0x5f, 0x42, 0x61, 0x6e, 0x6b,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_request_overdraft_proto_rawDescOnce sync.Once
+	file_rpc_request_overdraft_proto_rawDescData = file_rpc_request_overdraft_proto_rawDesc
+)
+
+func file_rpc_request_overdraft_proto_rawDescGZIP() []byte {
+	file_rpc_request_overdraft_proto_rawDescOnce.Do(func() {
+		file_rpc_request_overdraft_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_request_overdraft_proto_rawDescData)
+	})
+	return file_rpc_request_overdraft_proto_rawDescData
+}
+
+var file_rpc_request_overdraft_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_request_overdraft_proto_goTypes = []interface{}{
+	(*RequestOverdraftRequest)(nil),  // 0: pb.RequestOverdraftRequest
+	(*RequestOverdraftResponse)(nil), // 1: pb.RequestOverdraftResponse
+	(*OverdraftRequest)(nil),         // 2: pb.OverdraftRequest
+}
+var file_rpc_request_overdraft_proto_depIdxs = []int32{
+	2, // 0: pb.RequestOverdraftResponse.overdraft_request:type_name -> pb.OverdraftRequest
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_request_overdraft_proto_init() }
+func file_rpc_request_overdraft_proto_init() {
+	if File_rpc_request_overdraft_proto != nil {
+		return
+	}
+	file_overdraft_request_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_request_overdraft_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestOverdraftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_request_overdraft_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestOverdraftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_request_overdraft_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_request_overdraft_proto_goTypes,
+		DependencyIndexes: file_rpc_request_overdraft_proto_depIdxs,
+		MessageInfos:      file_rpc_request_overdraft_proto_msgTypes,
+	}.Build()
+	File_rpc_request_overdraft_proto = out.File
+	file_rpc_request_overdraft_proto_rawDesc = nil
+	file_rpc_request_overdraft_proto_goTypes = nil
+	file_rpc_request_overdraft_proto_depIdxs = nil
+}
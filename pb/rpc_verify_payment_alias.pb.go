@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: rpc_verify_payment_alias.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VerifyPaymentAliasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *VerifyPaymentAliasRequest) Reset() {
+	*x = VerifyPaymentAliasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_verify_payment_alias_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyPaymentAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPaymentAliasRequest) ProtoMessage() {}
+
+func (x *VerifyPaymentAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_verify_payment_alias_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPaymentAliasRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPaymentAliasRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_verify_payment_alias_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VerifyPaymentAliasRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *VerifyPaymentAliasRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyPaymentAliasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alias *PaymentAlias `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+}
+
+func (x *VerifyPaymentAliasResponse) Reset() {
+	*x = VerifyPaymentAliasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_verify_payment_alias_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyPaymentAliasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPaymentAliasResponse) ProtoMessage() {}
+
+func (x *VerifyPaymentAliasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_verify_payment_alias_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPaymentAliasResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPaymentAliasResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_verify_payment_alias_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VerifyPaymentAliasResponse) GetAlias() *PaymentAlias {
+	if x != nil {
+		return x.Alias
+	}
+	return nil
+}
+
+var File_rpc_verify_payment_alias_proto protoreflect.FileDescriptor
+
+var file_rpc_verify_payment_alias_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x72, 0x70, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x1a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x19, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x22, 0x44, 0x0a, 0x1a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x64, 0x65, 0x33, 0x37, 0x2f, 0x53, 0x77, 0x69, 0x66, 0x74,
+	0x5f, 0x42, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_verify_payment_alias_proto_rawDescOnce sync.Once
+	file_rpc_verify_payment_alias_proto_rawDescData = file_rpc_verify_payment_alias_proto_rawDesc
+)
+
+func file_rpc_verify_payment_alias_proto_rawDescGZIP() []byte {
+	file_rpc_verify_payment_alias_proto_rawDescOnce.Do(func() {
+		file_rpc_verify_payment_alias_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_verify_payment_alias_proto_rawDescData)
+	})
+	return file_rpc_verify_payment_alias_proto_rawDescData
+}
+
+var file_rpc_verify_payment_alias_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_verify_payment_alias_proto_goTypes = []interface{}{
+	(*VerifyPaymentAliasRequest)(nil),  // 0: pb.VerifyPaymentAliasRequest
+	(*VerifyPaymentAliasResponse)(nil), // 1: pb.VerifyPaymentAliasResponse
+	(*PaymentAlias)(nil),               // 2: pb.PaymentAlias
+}
+var file_rpc_verify_payment_alias_proto_depIdxs = []int32{
+	2, // 0: pb.VerifyPaymentAliasResponse.alias:type_name -> pb.PaymentAlias
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_verify_payment_alias_proto_init() }
+func file_rpc_verify_payment_alias_proto_init() {
+	if File_rpc_verify_payment_alias_proto != nil {
+		return
+	}
+	file_payment_alias_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_verify_payment_alias_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyPaymentAliasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_verify_payment_alias_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyPaymentAliasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_verify_payment_alias_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_verify_payment_alias_proto_goTypes,
+		DependencyIndexes: file_rpc_verify_payment_alias_proto_depIdxs,
+		MessageInfos:      file_rpc_verify_payment_alias_proto_msgTypes,
+	}.Build()
+	File_rpc_verify_payment_alias_proto = out.File
+	file_rpc_verify_payment_alias_proto_rawDesc = nil
+	file_rpc_verify_payment_alias_proto_goTypes = nil
+	file_rpc_verify_payment_alias_proto_depIdxs = nil
+}
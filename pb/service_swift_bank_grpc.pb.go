@@ -8,6 +8,7 @@ package pb
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,10 +20,66 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SwiftBank_CreateUser_FullMethodName  = "/pb.SwiftBank/CreateUser"
-	SwiftBank_LoginUser_FullMethodName   = "/pb.SwiftBank/LoginUser"
-	SwiftBank_VerifyEmail_FullMethodName = "/pb.SwiftBank/VerifyEmail"
-	SwiftBank_UpdateUser_FullMethodName  = "/pb.SwiftBank/UpdateUser"
+	SwiftBank_CreateUser_FullMethodName                    = "/pb.SwiftBank/CreateUser"
+	SwiftBank_LoginUser_FullMethodName                     = "/pb.SwiftBank/LoginUser"
+	SwiftBank_VerifyEmail_FullMethodName                   = "/pb.SwiftBank/VerifyEmail"
+	SwiftBank_UpdateUser_FullMethodName                    = "/pb.SwiftBank/UpdateUser"
+	SwiftBank_InviteAccountHolder_FullMethodName           = "/pb.SwiftBank/InviteAccountHolder"
+	SwiftBank_AcceptAccountInvitation_FullMethodName       = "/pb.SwiftBank/AcceptAccountInvitation"
+	SwiftBank_ListAccountHolders_FullMethodName            = "/pb.SwiftBank/ListAccountHolders"
+	SwiftBank_CreateBeneficiary_FullMethodName             = "/pb.SwiftBank/CreateBeneficiary"
+	SwiftBank_ListBeneficiaries_FullMethodName             = "/pb.SwiftBank/ListBeneficiaries"
+	SwiftBank_UpdateBeneficiary_FullMethodName             = "/pb.SwiftBank/UpdateBeneficiary"
+	SwiftBank_DeleteBeneficiary_FullMethodName             = "/pb.SwiftBank/DeleteBeneficiary"
+	SwiftBank_CreatePaymentAlias_FullMethodName            = "/pb.SwiftBank/CreatePaymentAlias"
+	SwiftBank_VerifyPaymentAlias_FullMethodName            = "/pb.SwiftBank/VerifyPaymentAlias"
+	SwiftBank_ListPaymentAliases_FullMethodName            = "/pb.SwiftBank/ListPaymentAliases"
+	SwiftBank_DeletePaymentAlias_FullMethodName            = "/pb.SwiftBank/DeletePaymentAlias"
+	SwiftBank_SetDefaultAccount_FullMethodName             = "/pb.SwiftBank/SetDefaultAccount"
+	SwiftBank_ListPendingTransfers_FullMethodName          = "/pb.SwiftBank/ListPendingTransfers"
+	SwiftBank_GetPendingTransfer_FullMethodName            = "/pb.SwiftBank/GetPendingTransfer"
+	SwiftBank_ApprovePendingTransfer_FullMethodName        = "/pb.SwiftBank/ApprovePendingTransfer"
+	SwiftBank_RejectPendingTransfer_FullMethodName         = "/pb.SwiftBank/RejectPendingTransfer"
+	SwiftBank_GetBalanceAt_FullMethodName                  = "/pb.SwiftBank/GetBalanceAt"
+	SwiftBank_GetTrialBalance_FullMethodName               = "/pb.SwiftBank/GetTrialBalance"
+	SwiftBank_DownloadTrialBalance_FullMethodName          = "/pb.SwiftBank/DownloadTrialBalance"
+	SwiftBank_CreateCategoryRule_FullMethodName            = "/pb.SwiftBank/CreateCategoryRule"
+	SwiftBank_ListCategoryRules_FullMethodName             = "/pb.SwiftBank/ListCategoryRules"
+	SwiftBank_DeleteCategoryRule_FullMethodName            = "/pb.SwiftBank/DeleteCategoryRule"
+	SwiftBank_SetTransactionCategory_FullMethodName        = "/pb.SwiftBank/SetTransactionCategory"
+	SwiftBank_GetSpendingAnalytics_FullMethodName          = "/pb.SwiftBank/GetSpendingAnalytics"
+	SwiftBank_CreateAlertRule_FullMethodName               = "/pb.SwiftBank/CreateAlertRule"
+	SwiftBank_ListAlertRules_FullMethodName                = "/pb.SwiftBank/ListAlertRules"
+	SwiftBank_DeleteAlertRule_FullMethodName               = "/pb.SwiftBank/DeleteAlertRule"
+	SwiftBank_RequestOverdraft_FullMethodName              = "/pb.SwiftBank/RequestOverdraft"
+	SwiftBank_ListOverdraftRequests_FullMethodName         = "/pb.SwiftBank/ListOverdraftRequests"
+	SwiftBank_ApproveOverdraftRequest_FullMethodName       = "/pb.SwiftBank/ApproveOverdraftRequest"
+	SwiftBank_RejectOverdraftRequest_FullMethodName        = "/pb.SwiftBank/RejectOverdraftRequest"
+	SwiftBank_DisburseLoan_FullMethodName                  = "/pb.SwiftBank/DisburseLoan"
+	SwiftBank_ListLoans_FullMethodName                     = "/pb.SwiftBank/ListLoans"
+	SwiftBank_GetLoanSchedule_FullMethodName               = "/pb.SwiftBank/GetLoanSchedule"
+	SwiftBank_PayOffLoan_FullMethodName                    = "/pb.SwiftBank/PayOffLoan"
+	SwiftBank_CreatePocket_FullMethodName                  = "/pb.SwiftBank/CreatePocket"
+	SwiftBank_ListPockets_FullMethodName                   = "/pb.SwiftBank/ListPockets"
+	SwiftBank_ListPocketEntries_FullMethodName             = "/pb.SwiftBank/ListPocketEntries"
+	SwiftBank_MovePocketFunds_FullMethodName               = "/pb.SwiftBank/MovePocketFunds"
+	SwiftBank_ClosePocket_FullMethodName                   = "/pb.SwiftBank/ClosePocket"
+	SwiftBank_CreatePocketRule_FullMethodName              = "/pb.SwiftBank/CreatePocketRule"
+	SwiftBank_DeletePocketRule_FullMethodName              = "/pb.SwiftBank/DeletePocketRule"
+	SwiftBank_SetTransactionNote_FullMethodName            = "/pb.SwiftBank/SetTransactionNote"
+	SwiftBank_GetTransactionAnnotations_FullMethodName     = "/pb.SwiftBank/GetTransactionAnnotations"
+	SwiftBank_SearchTransactionsByTag_FullMethodName       = "/pb.SwiftBank/SearchTransactionsByTag"
+	SwiftBank_UploadTransactionAttachment_FullMethodName   = "/pb.SwiftBank/UploadTransactionAttachment"
+	SwiftBank_DownloadTransactionAttachment_FullMethodName = "/pb.SwiftBank/DownloadTransactionAttachment"
+	SwiftBank_DeleteTransactionAttachment_FullMethodName   = "/pb.SwiftBank/DeleteTransactionAttachment"
+	SwiftBank_OpenDispute_FullMethodName                   = "/pb.SwiftBank/OpenDispute"
+	SwiftBank_GetDispute_FullMethodName                    = "/pb.SwiftBank/GetDispute"
+	SwiftBank_ListDisputes_FullMethodName                  = "/pb.SwiftBank/ListDisputes"
+	SwiftBank_UpdateDispute_FullMethodName                 = "/pb.SwiftBank/UpdateDispute"
+	SwiftBank_ExportGeneralLedger_FullMethodName           = "/pb.SwiftBank/ExportGeneralLedger"
+	SwiftBank_ListGLExports_FullMethodName                 = "/pb.SwiftBank/ListGLExports"
+	SwiftBank_DownloadGLExport_FullMethodName              = "/pb.SwiftBank/DownloadGLExport"
+	SwiftBank_PreviewEmailTemplate_FullMethodName          = "/pb.SwiftBank/PreviewEmailTemplate"
 )
 
 // SwiftBankClient is the client API for SwiftBank service.
@@ -33,6 +90,62 @@ type SwiftBankClient interface {
 	LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
 	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	InviteAccountHolder(ctx context.Context, in *InviteAccountHolderRequest, opts ...grpc.CallOption) (*InviteAccountHolderResponse, error)
+	AcceptAccountInvitation(ctx context.Context, in *AcceptAccountInvitationRequest, opts ...grpc.CallOption) (*AcceptAccountInvitationResponse, error)
+	ListAccountHolders(ctx context.Context, in *ListAccountHoldersRequest, opts ...grpc.CallOption) (*ListAccountHoldersResponse, error)
+	CreateBeneficiary(ctx context.Context, in *CreateBeneficiaryRequest, opts ...grpc.CallOption) (*CreateBeneficiaryResponse, error)
+	ListBeneficiaries(ctx context.Context, in *ListBeneficiariesRequest, opts ...grpc.CallOption) (*ListBeneficiariesResponse, error)
+	UpdateBeneficiary(ctx context.Context, in *UpdateBeneficiaryRequest, opts ...grpc.CallOption) (*UpdateBeneficiaryResponse, error)
+	DeleteBeneficiary(ctx context.Context, in *DeleteBeneficiaryRequest, opts ...grpc.CallOption) (*DeleteBeneficiaryResponse, error)
+	CreatePaymentAlias(ctx context.Context, in *CreatePaymentAliasRequest, opts ...grpc.CallOption) (*CreatePaymentAliasResponse, error)
+	VerifyPaymentAlias(ctx context.Context, in *VerifyPaymentAliasRequest, opts ...grpc.CallOption) (*VerifyPaymentAliasResponse, error)
+	ListPaymentAliases(ctx context.Context, in *ListPaymentAliasesRequest, opts ...grpc.CallOption) (*ListPaymentAliasesResponse, error)
+	DeletePaymentAlias(ctx context.Context, in *DeletePaymentAliasRequest, opts ...grpc.CallOption) (*DeletePaymentAliasResponse, error)
+	SetDefaultAccount(ctx context.Context, in *SetDefaultAccountRequest, opts ...grpc.CallOption) (*SetDefaultAccountResponse, error)
+	ListPendingTransfers(ctx context.Context, in *ListPendingTransfersRequest, opts ...grpc.CallOption) (*ListPendingTransfersResponse, error)
+	GetPendingTransfer(ctx context.Context, in *GetPendingTransferRequest, opts ...grpc.CallOption) (*GetPendingTransferResponse, error)
+	ApprovePendingTransfer(ctx context.Context, in *ApprovePendingTransferRequest, opts ...grpc.CallOption) (*ApprovePendingTransferResponse, error)
+	RejectPendingTransfer(ctx context.Context, in *RejectPendingTransferRequest, opts ...grpc.CallOption) (*RejectPendingTransferResponse, error)
+	GetBalanceAt(ctx context.Context, in *GetBalanceAtRequest, opts ...grpc.CallOption) (*GetBalanceAtResponse, error)
+	GetTrialBalance(ctx context.Context, in *GetTrialBalanceRequest, opts ...grpc.CallOption) (*GetTrialBalanceResponse, error)
+	DownloadTrialBalance(ctx context.Context, in *DownloadTrialBalanceRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	CreateCategoryRule(ctx context.Context, in *CreateCategoryRuleRequest, opts ...grpc.CallOption) (*CreateCategoryRuleResponse, error)
+	ListCategoryRules(ctx context.Context, in *ListCategoryRulesRequest, opts ...grpc.CallOption) (*ListCategoryRulesResponse, error)
+	DeleteCategoryRule(ctx context.Context, in *DeleteCategoryRuleRequest, opts ...grpc.CallOption) (*DeleteCategoryRuleResponse, error)
+	SetTransactionCategory(ctx context.Context, in *SetTransactionCategoryRequest, opts ...grpc.CallOption) (*SetTransactionCategoryResponse, error)
+	GetSpendingAnalytics(ctx context.Context, in *GetSpendingAnalyticsRequest, opts ...grpc.CallOption) (*GetSpendingAnalyticsResponse, error)
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error)
+	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
+	RequestOverdraft(ctx context.Context, in *RequestOverdraftRequest, opts ...grpc.CallOption) (*RequestOverdraftResponse, error)
+	ListOverdraftRequests(ctx context.Context, in *ListOverdraftRequestsRequest, opts ...grpc.CallOption) (*ListOverdraftRequestsResponse, error)
+	ApproveOverdraftRequest(ctx context.Context, in *ApproveOverdraftRequestRequest, opts ...grpc.CallOption) (*ApproveOverdraftRequestResponse, error)
+	RejectOverdraftRequest(ctx context.Context, in *RejectOverdraftRequestRequest, opts ...grpc.CallOption) (*RejectOverdraftRequestResponse, error)
+	DisburseLoan(ctx context.Context, in *DisburseLoanRequest, opts ...grpc.CallOption) (*DisburseLoanResponse, error)
+	ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
+	GetLoanSchedule(ctx context.Context, in *GetLoanScheduleRequest, opts ...grpc.CallOption) (*GetLoanScheduleResponse, error)
+	PayOffLoan(ctx context.Context, in *PayOffLoanRequest, opts ...grpc.CallOption) (*PayOffLoanResponse, error)
+	CreatePocket(ctx context.Context, in *CreatePocketRequest, opts ...grpc.CallOption) (*CreatePocketResponse, error)
+	ListPockets(ctx context.Context, in *ListPocketsRequest, opts ...grpc.CallOption) (*ListPocketsResponse, error)
+	ListPocketEntries(ctx context.Context, in *ListPocketEntriesRequest, opts ...grpc.CallOption) (*ListPocketEntriesResponse, error)
+	MovePocketFunds(ctx context.Context, in *MovePocketFundsRequest, opts ...grpc.CallOption) (*MovePocketFundsResponse, error)
+	ClosePocket(ctx context.Context, in *ClosePocketRequest, opts ...grpc.CallOption) (*ClosePocketResponse, error)
+	CreatePocketRule(ctx context.Context, in *CreatePocketRuleRequest, opts ...grpc.CallOption) (*CreatePocketRuleResponse, error)
+	DeletePocketRule(ctx context.Context, in *DeletePocketRuleRequest, opts ...grpc.CallOption) (*DeletePocketRuleResponse, error)
+	SetTransactionNote(ctx context.Context, in *SetTransactionNoteRequest, opts ...grpc.CallOption) (*SetTransactionNoteResponse, error)
+	GetTransactionAnnotations(ctx context.Context, in *GetTransactionAnnotationsRequest, opts ...grpc.CallOption) (*GetTransactionAnnotationsResponse, error)
+	SearchTransactionsByTag(ctx context.Context, in *SearchTransactionsByTagRequest, opts ...grpc.CallOption) (*SearchTransactionsByTagResponse, error)
+	UploadTransactionAttachment(ctx context.Context, in *UploadTransactionAttachmentRequest, opts ...grpc.CallOption) (*UploadTransactionAttachmentResponse, error)
+	DownloadTransactionAttachment(ctx context.Context, in *DownloadTransactionAttachmentRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	DeleteTransactionAttachment(ctx context.Context, in *DeleteTransactionAttachmentRequest, opts ...grpc.CallOption) (*DeleteTransactionAttachmentResponse, error)
+	OpenDispute(ctx context.Context, in *OpenDisputeRequest, opts ...grpc.CallOption) (*OpenDisputeResponse, error)
+	GetDispute(ctx context.Context, in *GetDisputeRequest, opts ...grpc.CallOption) (*GetDisputeResponse, error)
+	ListDisputes(ctx context.Context, in *ListDisputesRequest, opts ...grpc.CallOption) (*ListDisputesResponse, error)
+	UpdateDispute(ctx context.Context, in *UpdateDisputeRequest, opts ...grpc.CallOption) (*UpdateDisputeResponse, error)
+	ExportGeneralLedger(ctx context.Context, in *ExportGeneralLedgerRequest, opts ...grpc.CallOption) (*ExportGeneralLedgerResponse, error)
+	ListGLExports(ctx context.Context, in *ListGLExportsRequest, opts ...grpc.CallOption) (*ListGLExportsResponse, error)
+	DownloadGLExport(ctx context.Context, in *DownloadGLExportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	PreviewEmailTemplate(ctx context.Context, in *PreviewEmailTemplateRequest, opts ...grpc.CallOption) (*PreviewEmailTemplateResponse, error)
 }
 
 type swiftBankClient struct {
@@ -79,6 +192,510 @@ func (c *swiftBankClient) UpdateUser(ctx context.Context, in *UpdateUserRequest,
 	return out, nil
 }
 
+func (c *swiftBankClient) InviteAccountHolder(ctx context.Context, in *InviteAccountHolderRequest, opts ...grpc.CallOption) (*InviteAccountHolderResponse, error) {
+	out := new(InviteAccountHolderResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_InviteAccountHolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) AcceptAccountInvitation(ctx context.Context, in *AcceptAccountInvitationRequest, opts ...grpc.CallOption) (*AcceptAccountInvitationResponse, error) {
+	out := new(AcceptAccountInvitationResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_AcceptAccountInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ListAccountHolders(ctx context.Context, in *ListAccountHoldersRequest, opts ...grpc.CallOption) (*ListAccountHoldersResponse, error) {
+	out := new(ListAccountHoldersResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ListAccountHolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) CreateBeneficiary(ctx context.Context, in *CreateBeneficiaryRequest, opts ...grpc.CallOption) (*CreateBeneficiaryResponse, error) {
+	out := new(CreateBeneficiaryResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_CreateBeneficiary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ListBeneficiaries(ctx context.Context, in *ListBeneficiariesRequest, opts ...grpc.CallOption) (*ListBeneficiariesResponse, error) {
+	out := new(ListBeneficiariesResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ListBeneficiaries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) UpdateBeneficiary(ctx context.Context, in *UpdateBeneficiaryRequest, opts ...grpc.CallOption) (*UpdateBeneficiaryResponse, error) {
+	out := new(UpdateBeneficiaryResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_UpdateBeneficiary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) DeleteBeneficiary(ctx context.Context, in *DeleteBeneficiaryRequest, opts ...grpc.CallOption) (*DeleteBeneficiaryResponse, error) {
+	out := new(DeleteBeneficiaryResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_DeleteBeneficiary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) CreatePaymentAlias(ctx context.Context, in *CreatePaymentAliasRequest, opts ...grpc.CallOption) (*CreatePaymentAliasResponse, error) {
+	out := new(CreatePaymentAliasResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_CreatePaymentAlias_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) VerifyPaymentAlias(ctx context.Context, in *VerifyPaymentAliasRequest, opts ...grpc.CallOption) (*VerifyPaymentAliasResponse, error) {
+	out := new(VerifyPaymentAliasResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_VerifyPaymentAlias_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ListPaymentAliases(ctx context.Context, in *ListPaymentAliasesRequest, opts ...grpc.CallOption) (*ListPaymentAliasesResponse, error) {
+	out := new(ListPaymentAliasesResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ListPaymentAliases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) DeletePaymentAlias(ctx context.Context, in *DeletePaymentAliasRequest, opts ...grpc.CallOption) (*DeletePaymentAliasResponse, error) {
+	out := new(DeletePaymentAliasResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_DeletePaymentAlias_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) SetDefaultAccount(ctx context.Context, in *SetDefaultAccountRequest, opts ...grpc.CallOption) (*SetDefaultAccountResponse, error) {
+	out := new(SetDefaultAccountResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_SetDefaultAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ListPendingTransfers(ctx context.Context, in *ListPendingTransfersRequest, opts ...grpc.CallOption) (*ListPendingTransfersResponse, error) {
+	out := new(ListPendingTransfersResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ListPendingTransfers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) GetPendingTransfer(ctx context.Context, in *GetPendingTransferRequest, opts ...grpc.CallOption) (*GetPendingTransferResponse, error) {
+	out := new(GetPendingTransferResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_GetPendingTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ApprovePendingTransfer(ctx context.Context, in *ApprovePendingTransferRequest, opts ...grpc.CallOption) (*ApprovePendingTransferResponse, error) {
+	out := new(ApprovePendingTransferResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ApprovePendingTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) RejectPendingTransfer(ctx context.Context, in *RejectPendingTransferRequest, opts ...grpc.CallOption) (*RejectPendingTransferResponse, error) {
+	out := new(RejectPendingTransferResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_RejectPendingTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) GetBalanceAt(ctx context.Context, in *GetBalanceAtRequest, opts ...grpc.CallOption) (*GetBalanceAtResponse, error) {
+	out := new(GetBalanceAtResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_GetBalanceAt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) GetTrialBalance(ctx context.Context, in *GetTrialBalanceRequest, opts ...grpc.CallOption) (*GetTrialBalanceResponse, error) {
+	out := new(GetTrialBalanceResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_GetTrialBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) DownloadTrialBalance(ctx context.Context, in *DownloadTrialBalanceRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, SwiftBank_DownloadTrialBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) CreateCategoryRule(ctx context.Context, in *CreateCategoryRuleRequest, opts ...grpc.CallOption) (*CreateCategoryRuleResponse, error) {
+	out := new(CreateCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_CreateCategoryRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ListCategoryRules(ctx context.Context, in *ListCategoryRulesRequest, opts ...grpc.CallOption) (*ListCategoryRulesResponse, error) {
+	out := new(ListCategoryRulesResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ListCategoryRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) DeleteCategoryRule(ctx context.Context, in *DeleteCategoryRuleRequest, opts ...grpc.CallOption) (*DeleteCategoryRuleResponse, error) {
+	out := new(DeleteCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_DeleteCategoryRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) SetTransactionCategory(ctx context.Context, in *SetTransactionCategoryRequest, opts ...grpc.CallOption) (*SetTransactionCategoryResponse, error) {
+	out := new(SetTransactionCategoryResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_SetTransactionCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) GetSpendingAnalytics(ctx context.Context, in *GetSpendingAnalyticsRequest, opts ...grpc.CallOption) (*GetSpendingAnalyticsResponse, error) {
+	out := new(GetSpendingAnalyticsResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_GetSpendingAnalytics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error) {
+	out := new(CreateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_CreateAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error) {
+	out := new(ListAlertRulesResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ListAlertRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_DeleteAlertRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) RequestOverdraft(ctx context.Context, in *RequestOverdraftRequest, opts ...grpc.CallOption) (*RequestOverdraftResponse, error) {
+	out := new(RequestOverdraftResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_RequestOverdraft_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ListOverdraftRequests(ctx context.Context, in *ListOverdraftRequestsRequest, opts ...grpc.CallOption) (*ListOverdraftRequestsResponse, error) {
+	out := new(ListOverdraftRequestsResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ListOverdraftRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ApproveOverdraftRequest(ctx context.Context, in *ApproveOverdraftRequestRequest, opts ...grpc.CallOption) (*ApproveOverdraftRequestResponse, error) {
+	out := new(ApproveOverdraftRequestResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ApproveOverdraftRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) RejectOverdraftRequest(ctx context.Context, in *RejectOverdraftRequestRequest, opts ...grpc.CallOption) (*RejectOverdraftRequestResponse, error) {
+	out := new(RejectOverdraftRequestResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_RejectOverdraftRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) DisburseLoan(ctx context.Context, in *DisburseLoanRequest, opts ...grpc.CallOption) (*DisburseLoanResponse, error) {
+	out := new(DisburseLoanResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_DisburseLoan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error) {
+	out := new(ListLoansResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ListLoans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) GetLoanSchedule(ctx context.Context, in *GetLoanScheduleRequest, opts ...grpc.CallOption) (*GetLoanScheduleResponse, error) {
+	out := new(GetLoanScheduleResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_GetLoanSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) PayOffLoan(ctx context.Context, in *PayOffLoanRequest, opts ...grpc.CallOption) (*PayOffLoanResponse, error) {
+	out := new(PayOffLoanResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_PayOffLoan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) CreatePocket(ctx context.Context, in *CreatePocketRequest, opts ...grpc.CallOption) (*CreatePocketResponse, error) {
+	out := new(CreatePocketResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_CreatePocket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ListPockets(ctx context.Context, in *ListPocketsRequest, opts ...grpc.CallOption) (*ListPocketsResponse, error) {
+	out := new(ListPocketsResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ListPockets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ListPocketEntries(ctx context.Context, in *ListPocketEntriesRequest, opts ...grpc.CallOption) (*ListPocketEntriesResponse, error) {
+	out := new(ListPocketEntriesResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ListPocketEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) MovePocketFunds(ctx context.Context, in *MovePocketFundsRequest, opts ...grpc.CallOption) (*MovePocketFundsResponse, error) {
+	out := new(MovePocketFundsResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_MovePocketFunds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ClosePocket(ctx context.Context, in *ClosePocketRequest, opts ...grpc.CallOption) (*ClosePocketResponse, error) {
+	out := new(ClosePocketResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ClosePocket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) CreatePocketRule(ctx context.Context, in *CreatePocketRuleRequest, opts ...grpc.CallOption) (*CreatePocketRuleResponse, error) {
+	out := new(CreatePocketRuleResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_CreatePocketRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) DeletePocketRule(ctx context.Context, in *DeletePocketRuleRequest, opts ...grpc.CallOption) (*DeletePocketRuleResponse, error) {
+	out := new(DeletePocketRuleResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_DeletePocketRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) SetTransactionNote(ctx context.Context, in *SetTransactionNoteRequest, opts ...grpc.CallOption) (*SetTransactionNoteResponse, error) {
+	out := new(SetTransactionNoteResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_SetTransactionNote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) GetTransactionAnnotations(ctx context.Context, in *GetTransactionAnnotationsRequest, opts ...grpc.CallOption) (*GetTransactionAnnotationsResponse, error) {
+	out := new(GetTransactionAnnotationsResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_GetTransactionAnnotations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) SearchTransactionsByTag(ctx context.Context, in *SearchTransactionsByTagRequest, opts ...grpc.CallOption) (*SearchTransactionsByTagResponse, error) {
+	out := new(SearchTransactionsByTagResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_SearchTransactionsByTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) UploadTransactionAttachment(ctx context.Context, in *UploadTransactionAttachmentRequest, opts ...grpc.CallOption) (*UploadTransactionAttachmentResponse, error) {
+	out := new(UploadTransactionAttachmentResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_UploadTransactionAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) DownloadTransactionAttachment(ctx context.Context, in *DownloadTransactionAttachmentRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, SwiftBank_DownloadTransactionAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) DeleteTransactionAttachment(ctx context.Context, in *DeleteTransactionAttachmentRequest, opts ...grpc.CallOption) (*DeleteTransactionAttachmentResponse, error) {
+	out := new(DeleteTransactionAttachmentResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_DeleteTransactionAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) OpenDispute(ctx context.Context, in *OpenDisputeRequest, opts ...grpc.CallOption) (*OpenDisputeResponse, error) {
+	out := new(OpenDisputeResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_OpenDispute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) GetDispute(ctx context.Context, in *GetDisputeRequest, opts ...grpc.CallOption) (*GetDisputeResponse, error) {
+	out := new(GetDisputeResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_GetDispute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ListDisputes(ctx context.Context, in *ListDisputesRequest, opts ...grpc.CallOption) (*ListDisputesResponse, error) {
+	out := new(ListDisputesResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ListDisputes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) UpdateDispute(ctx context.Context, in *UpdateDisputeRequest, opts ...grpc.CallOption) (*UpdateDisputeResponse, error) {
+	out := new(UpdateDisputeResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_UpdateDispute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ExportGeneralLedger(ctx context.Context, in *ExportGeneralLedgerRequest, opts ...grpc.CallOption) (*ExportGeneralLedgerResponse, error) {
+	out := new(ExportGeneralLedgerResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ExportGeneralLedger_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) ListGLExports(ctx context.Context, in *ListGLExportsRequest, opts ...grpc.CallOption) (*ListGLExportsResponse, error) {
+	out := new(ListGLExportsResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_ListGLExports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) DownloadGLExport(ctx context.Context, in *DownloadGLExportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, SwiftBank_DownloadGLExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swiftBankClient) PreviewEmailTemplate(ctx context.Context, in *PreviewEmailTemplateRequest, opts ...grpc.CallOption) (*PreviewEmailTemplateResponse, error) {
+	out := new(PreviewEmailTemplateResponse)
+	err := c.cc.Invoke(ctx, SwiftBank_PreviewEmailTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SwiftBankServer is the server API for SwiftBank service.
 // All implementations must embed UnimplementedSwiftBankServer
 // for forward compatibility
@@ -87,106 +704,1338 @@ type SwiftBankServer interface {
 	LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error)
 	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	InviteAccountHolder(context.Context, *InviteAccountHolderRequest) (*InviteAccountHolderResponse, error)
+	AcceptAccountInvitation(context.Context, *AcceptAccountInvitationRequest) (*AcceptAccountInvitationResponse, error)
+	ListAccountHolders(context.Context, *ListAccountHoldersRequest) (*ListAccountHoldersResponse, error)
+	CreateBeneficiary(context.Context, *CreateBeneficiaryRequest) (*CreateBeneficiaryResponse, error)
+	ListBeneficiaries(context.Context, *ListBeneficiariesRequest) (*ListBeneficiariesResponse, error)
+	UpdateBeneficiary(context.Context, *UpdateBeneficiaryRequest) (*UpdateBeneficiaryResponse, error)
+	DeleteBeneficiary(context.Context, *DeleteBeneficiaryRequest) (*DeleteBeneficiaryResponse, error)
+	CreatePaymentAlias(context.Context, *CreatePaymentAliasRequest) (*CreatePaymentAliasResponse, error)
+	VerifyPaymentAlias(context.Context, *VerifyPaymentAliasRequest) (*VerifyPaymentAliasResponse, error)
+	ListPaymentAliases(context.Context, *ListPaymentAliasesRequest) (*ListPaymentAliasesResponse, error)
+	DeletePaymentAlias(context.Context, *DeletePaymentAliasRequest) (*DeletePaymentAliasResponse, error)
+	SetDefaultAccount(context.Context, *SetDefaultAccountRequest) (*SetDefaultAccountResponse, error)
+	ListPendingTransfers(context.Context, *ListPendingTransfersRequest) (*ListPendingTransfersResponse, error)
+	GetPendingTransfer(context.Context, *GetPendingTransferRequest) (*GetPendingTransferResponse, error)
+	ApprovePendingTransfer(context.Context, *ApprovePendingTransferRequest) (*ApprovePendingTransferResponse, error)
+	RejectPendingTransfer(context.Context, *RejectPendingTransferRequest) (*RejectPendingTransferResponse, error)
+	GetBalanceAt(context.Context, *GetBalanceAtRequest) (*GetBalanceAtResponse, error)
+	GetTrialBalance(context.Context, *GetTrialBalanceRequest) (*GetTrialBalanceResponse, error)
+	DownloadTrialBalance(context.Context, *DownloadTrialBalanceRequest) (*httpbody.HttpBody, error)
+	CreateCategoryRule(context.Context, *CreateCategoryRuleRequest) (*CreateCategoryRuleResponse, error)
+	ListCategoryRules(context.Context, *ListCategoryRulesRequest) (*ListCategoryRulesResponse, error)
+	DeleteCategoryRule(context.Context, *DeleteCategoryRuleRequest) (*DeleteCategoryRuleResponse, error)
+	SetTransactionCategory(context.Context, *SetTransactionCategoryRequest) (*SetTransactionCategoryResponse, error)
+	GetSpendingAnalytics(context.Context, *GetSpendingAnalyticsRequest) (*GetSpendingAnalyticsResponse, error)
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error)
+	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error)
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error)
+	RequestOverdraft(context.Context, *RequestOverdraftRequest) (*RequestOverdraftResponse, error)
+	ListOverdraftRequests(context.Context, *ListOverdraftRequestsRequest) (*ListOverdraftRequestsResponse, error)
+	ApproveOverdraftRequest(context.Context, *ApproveOverdraftRequestRequest) (*ApproveOverdraftRequestResponse, error)
+	RejectOverdraftRequest(context.Context, *RejectOverdraftRequestRequest) (*RejectOverdraftRequestResponse, error)
+	DisburseLoan(context.Context, *DisburseLoanRequest) (*DisburseLoanResponse, error)
+	ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error)
+	GetLoanSchedule(context.Context, *GetLoanScheduleRequest) (*GetLoanScheduleResponse, error)
+	PayOffLoan(context.Context, *PayOffLoanRequest) (*PayOffLoanResponse, error)
+	CreatePocket(context.Context, *CreatePocketRequest) (*CreatePocketResponse, error)
+	ListPockets(context.Context, *ListPocketsRequest) (*ListPocketsResponse, error)
+	ListPocketEntries(context.Context, *ListPocketEntriesRequest) (*ListPocketEntriesResponse, error)
+	MovePocketFunds(context.Context, *MovePocketFundsRequest) (*MovePocketFundsResponse, error)
+	ClosePocket(context.Context, *ClosePocketRequest) (*ClosePocketResponse, error)
+	CreatePocketRule(context.Context, *CreatePocketRuleRequest) (*CreatePocketRuleResponse, error)
+	DeletePocketRule(context.Context, *DeletePocketRuleRequest) (*DeletePocketRuleResponse, error)
+	SetTransactionNote(context.Context, *SetTransactionNoteRequest) (*SetTransactionNoteResponse, error)
+	GetTransactionAnnotations(context.Context, *GetTransactionAnnotationsRequest) (*GetTransactionAnnotationsResponse, error)
+	SearchTransactionsByTag(context.Context, *SearchTransactionsByTagRequest) (*SearchTransactionsByTagResponse, error)
+	UploadTransactionAttachment(context.Context, *UploadTransactionAttachmentRequest) (*UploadTransactionAttachmentResponse, error)
+	DownloadTransactionAttachment(context.Context, *DownloadTransactionAttachmentRequest) (*httpbody.HttpBody, error)
+	DeleteTransactionAttachment(context.Context, *DeleteTransactionAttachmentRequest) (*DeleteTransactionAttachmentResponse, error)
+	OpenDispute(context.Context, *OpenDisputeRequest) (*OpenDisputeResponse, error)
+	GetDispute(context.Context, *GetDisputeRequest) (*GetDisputeResponse, error)
+	ListDisputes(context.Context, *ListDisputesRequest) (*ListDisputesResponse, error)
+	UpdateDispute(context.Context, *UpdateDisputeRequest) (*UpdateDisputeResponse, error)
+	ExportGeneralLedger(context.Context, *ExportGeneralLedgerRequest) (*ExportGeneralLedgerResponse, error)
+	ListGLExports(context.Context, *ListGLExportsRequest) (*ListGLExportsResponse, error)
+	DownloadGLExport(context.Context, *DownloadGLExportRequest) (*httpbody.HttpBody, error)
+	PreviewEmailTemplate(context.Context, *PreviewEmailTemplateRequest) (*PreviewEmailTemplateResponse, error)
+	mustEmbedUnimplementedSwiftBankServer()
+}
+
+// UnimplementedSwiftBankServer must be embedded to have forward compatible implementations.
+type UnimplementedSwiftBankServer struct {
+}
+
+func (UnimplementedSwiftBankServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedSwiftBankServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+}
+func (UnimplementedSwiftBankServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedSwiftBankServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedSwiftBankServer) InviteAccountHolder(context.Context, *InviteAccountHolderRequest) (*InviteAccountHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteAccountHolder not implemented")
+}
+func (UnimplementedSwiftBankServer) AcceptAccountInvitation(context.Context, *AcceptAccountInvitationRequest) (*AcceptAccountInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAccountInvitation not implemented")
+}
+func (UnimplementedSwiftBankServer) ListAccountHolders(context.Context, *ListAccountHoldersRequest) (*ListAccountHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountHolders not implemented")
+}
+func (UnimplementedSwiftBankServer) CreateBeneficiary(context.Context, *CreateBeneficiaryRequest) (*CreateBeneficiaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBeneficiary not implemented")
+}
+func (UnimplementedSwiftBankServer) ListBeneficiaries(context.Context, *ListBeneficiariesRequest) (*ListBeneficiariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBeneficiaries not implemented")
+}
+func (UnimplementedSwiftBankServer) UpdateBeneficiary(context.Context, *UpdateBeneficiaryRequest) (*UpdateBeneficiaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBeneficiary not implemented")
+}
+func (UnimplementedSwiftBankServer) DeleteBeneficiary(context.Context, *DeleteBeneficiaryRequest) (*DeleteBeneficiaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBeneficiary not implemented")
+}
+func (UnimplementedSwiftBankServer) CreatePaymentAlias(context.Context, *CreatePaymentAliasRequest) (*CreatePaymentAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentAlias not implemented")
+}
+func (UnimplementedSwiftBankServer) VerifyPaymentAlias(context.Context, *VerifyPaymentAliasRequest) (*VerifyPaymentAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPaymentAlias not implemented")
+}
+func (UnimplementedSwiftBankServer) ListPaymentAliases(context.Context, *ListPaymentAliasesRequest) (*ListPaymentAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPaymentAliases not implemented")
+}
+func (UnimplementedSwiftBankServer) DeletePaymentAlias(context.Context, *DeletePaymentAliasRequest) (*DeletePaymentAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePaymentAlias not implemented")
+}
+func (UnimplementedSwiftBankServer) SetDefaultAccount(context.Context, *SetDefaultAccountRequest) (*SetDefaultAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultAccount not implemented")
+}
+func (UnimplementedSwiftBankServer) ListPendingTransfers(context.Context, *ListPendingTransfersRequest) (*ListPendingTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingTransfers not implemented")
+}
+func (UnimplementedSwiftBankServer) GetPendingTransfer(context.Context, *GetPendingTransferRequest) (*GetPendingTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingTransfer not implemented")
+}
+func (UnimplementedSwiftBankServer) ApprovePendingTransfer(context.Context, *ApprovePendingTransferRequest) (*ApprovePendingTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePendingTransfer not implemented")
+}
+func (UnimplementedSwiftBankServer) RejectPendingTransfer(context.Context, *RejectPendingTransferRequest) (*RejectPendingTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectPendingTransfer not implemented")
+}
+func (UnimplementedSwiftBankServer) GetBalanceAt(context.Context, *GetBalanceAtRequest) (*GetBalanceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceAt not implemented")
+}
+func (UnimplementedSwiftBankServer) GetTrialBalance(context.Context, *GetTrialBalanceRequest) (*GetTrialBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrialBalance not implemented")
+}
+func (UnimplementedSwiftBankServer) DownloadTrialBalance(context.Context, *DownloadTrialBalanceRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadTrialBalance not implemented")
+}
+func (UnimplementedSwiftBankServer) CreateCategoryRule(context.Context, *CreateCategoryRuleRequest) (*CreateCategoryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategoryRule not implemented")
+}
+func (UnimplementedSwiftBankServer) ListCategoryRules(context.Context, *ListCategoryRulesRequest) (*ListCategoryRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategoryRules not implemented")
+}
+func (UnimplementedSwiftBankServer) DeleteCategoryRule(context.Context, *DeleteCategoryRuleRequest) (*DeleteCategoryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryRule not implemented")
+}
+func (UnimplementedSwiftBankServer) SetTransactionCategory(context.Context, *SetTransactionCategoryRequest) (*SetTransactionCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransactionCategory not implemented")
+}
+func (UnimplementedSwiftBankServer) GetSpendingAnalytics(context.Context, *GetSpendingAnalyticsRequest) (*GetSpendingAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingAnalytics not implemented")
+}
+func (UnimplementedSwiftBankServer) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedSwiftBankServer) ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedSwiftBankServer) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedSwiftBankServer) RequestOverdraft(context.Context, *RequestOverdraftRequest) (*RequestOverdraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestOverdraft not implemented")
+}
+func (UnimplementedSwiftBankServer) ListOverdraftRequests(context.Context, *ListOverdraftRequestsRequest) (*ListOverdraftRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOverdraftRequests not implemented")
+}
+func (UnimplementedSwiftBankServer) ApproveOverdraftRequest(context.Context, *ApproveOverdraftRequestRequest) (*ApproveOverdraftRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveOverdraftRequest not implemented")
+}
+func (UnimplementedSwiftBankServer) RejectOverdraftRequest(context.Context, *RejectOverdraftRequestRequest) (*RejectOverdraftRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectOverdraftRequest not implemented")
+}
+func (UnimplementedSwiftBankServer) DisburseLoan(context.Context, *DisburseLoanRequest) (*DisburseLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisburseLoan not implemented")
+}
+func (UnimplementedSwiftBankServer) ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoans not implemented")
+}
+func (UnimplementedSwiftBankServer) GetLoanSchedule(context.Context, *GetLoanScheduleRequest) (*GetLoanScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoanSchedule not implemented")
+}
+func (UnimplementedSwiftBankServer) PayOffLoan(context.Context, *PayOffLoanRequest) (*PayOffLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayOffLoan not implemented")
+}
+func (UnimplementedSwiftBankServer) CreatePocket(context.Context, *CreatePocketRequest) (*CreatePocketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePocket not implemented")
+}
+func (UnimplementedSwiftBankServer) ListPockets(context.Context, *ListPocketsRequest) (*ListPocketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPockets not implemented")
+}
+func (UnimplementedSwiftBankServer) ListPocketEntries(context.Context, *ListPocketEntriesRequest) (*ListPocketEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPocketEntries not implemented")
+}
+func (UnimplementedSwiftBankServer) MovePocketFunds(context.Context, *MovePocketFundsRequest) (*MovePocketFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MovePocketFunds not implemented")
+}
+func (UnimplementedSwiftBankServer) ClosePocket(context.Context, *ClosePocketRequest) (*ClosePocketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePocket not implemented")
+}
+func (UnimplementedSwiftBankServer) CreatePocketRule(context.Context, *CreatePocketRuleRequest) (*CreatePocketRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePocketRule not implemented")
+}
+func (UnimplementedSwiftBankServer) DeletePocketRule(context.Context, *DeletePocketRuleRequest) (*DeletePocketRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePocketRule not implemented")
+}
+func (UnimplementedSwiftBankServer) SetTransactionNote(context.Context, *SetTransactionNoteRequest) (*SetTransactionNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransactionNote not implemented")
+}
+func (UnimplementedSwiftBankServer) GetTransactionAnnotations(context.Context, *GetTransactionAnnotationsRequest) (*GetTransactionAnnotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionAnnotations not implemented")
+}
+func (UnimplementedSwiftBankServer) SearchTransactionsByTag(context.Context, *SearchTransactionsByTagRequest) (*SearchTransactionsByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTransactionsByTag not implemented")
+}
+func (UnimplementedSwiftBankServer) UploadTransactionAttachment(context.Context, *UploadTransactionAttachmentRequest) (*UploadTransactionAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadTransactionAttachment not implemented")
+}
+func (UnimplementedSwiftBankServer) DownloadTransactionAttachment(context.Context, *DownloadTransactionAttachmentRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadTransactionAttachment not implemented")
+}
+func (UnimplementedSwiftBankServer) DeleteTransactionAttachment(context.Context, *DeleteTransactionAttachmentRequest) (*DeleteTransactionAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransactionAttachment not implemented")
+}
+func (UnimplementedSwiftBankServer) OpenDispute(context.Context, *OpenDisputeRequest) (*OpenDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDispute not implemented")
+}
+func (UnimplementedSwiftBankServer) GetDispute(context.Context, *GetDisputeRequest) (*GetDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDispute not implemented")
+}
+func (UnimplementedSwiftBankServer) ListDisputes(context.Context, *ListDisputesRequest) (*ListDisputesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDisputes not implemented")
+}
+func (UnimplementedSwiftBankServer) UpdateDispute(context.Context, *UpdateDisputeRequest) (*UpdateDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDispute not implemented")
+}
+func (UnimplementedSwiftBankServer) ExportGeneralLedger(context.Context, *ExportGeneralLedgerRequest) (*ExportGeneralLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGeneralLedger not implemented")
+}
+func (UnimplementedSwiftBankServer) ListGLExports(context.Context, *ListGLExportsRequest) (*ListGLExportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGLExports not implemented")
+}
+func (UnimplementedSwiftBankServer) DownloadGLExport(context.Context, *DownloadGLExportRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadGLExport not implemented")
+}
+func (UnimplementedSwiftBankServer) PreviewEmailTemplate(context.Context, *PreviewEmailTemplateRequest) (*PreviewEmailTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewEmailTemplate not implemented")
+}
+func (UnimplementedSwiftBankServer) mustEmbedUnimplementedSwiftBankServer() {}
+
+// UnsafeSwiftBankServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SwiftBankServer will
+// result in compilation errors.
+type UnsafeSwiftBankServer interface {
 	mustEmbedUnimplementedSwiftBankServer()
 }
 
-// UnimplementedSwiftBankServer must be embedded to have forward compatible implementations.
-type UnimplementedSwiftBankServer struct {
+func RegisterSwiftBankServer(s grpc.ServiceRegistrar, srv SwiftBankServer) {
+	s.RegisterService(&SwiftBank_ServiceDesc, srv)
+}
+
+func _SwiftBank_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).LoginUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_LoginUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).LoginUser(ctx, req.(*LoginUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_InviteAccountHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteAccountHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).InviteAccountHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_InviteAccountHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).InviteAccountHolder(ctx, req.(*InviteAccountHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_AcceptAccountInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptAccountInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).AcceptAccountInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_AcceptAccountInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).AcceptAccountInvitation(ctx, req.(*AcceptAccountInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ListAccountHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ListAccountHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ListAccountHolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ListAccountHolders(ctx, req.(*ListAccountHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_CreateBeneficiary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBeneficiaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).CreateBeneficiary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_CreateBeneficiary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).CreateBeneficiary(ctx, req.(*CreateBeneficiaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ListBeneficiaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBeneficiariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ListBeneficiaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ListBeneficiaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ListBeneficiaries(ctx, req.(*ListBeneficiariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_UpdateBeneficiary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBeneficiaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).UpdateBeneficiary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_UpdateBeneficiary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).UpdateBeneficiary(ctx, req.(*UpdateBeneficiaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_DeleteBeneficiary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBeneficiaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).DeleteBeneficiary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_DeleteBeneficiary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).DeleteBeneficiary(ctx, req.(*DeleteBeneficiaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_CreatePaymentAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).CreatePaymentAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_CreatePaymentAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).CreatePaymentAlias(ctx, req.(*CreatePaymentAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_VerifyPaymentAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPaymentAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).VerifyPaymentAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_VerifyPaymentAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).VerifyPaymentAlias(ctx, req.(*VerifyPaymentAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ListPaymentAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ListPaymentAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ListPaymentAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ListPaymentAliases(ctx, req.(*ListPaymentAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_DeletePaymentAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePaymentAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).DeletePaymentAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_DeletePaymentAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).DeletePaymentAlias(ctx, req.(*DeletePaymentAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_SetDefaultAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).SetDefaultAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_SetDefaultAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).SetDefaultAccount(ctx, req.(*SetDefaultAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ListPendingTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ListPendingTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ListPendingTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ListPendingTransfers(ctx, req.(*ListPendingTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_GetPendingTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).GetPendingTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_GetPendingTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).GetPendingTransfer(ctx, req.(*GetPendingTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ApprovePendingTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovePendingTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ApprovePendingTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ApprovePendingTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ApprovePendingTransfer(ctx, req.(*ApprovePendingTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_RejectPendingTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectPendingTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).RejectPendingTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_RejectPendingTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).RejectPendingTransfer(ctx, req.(*RejectPendingTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_GetBalanceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).GetBalanceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_GetBalanceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).GetBalanceAt(ctx, req.(*GetBalanceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_GetTrialBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrialBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).GetTrialBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_GetTrialBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).GetTrialBalance(ctx, req.(*GetTrialBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_DownloadTrialBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadTrialBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).DownloadTrialBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_DownloadTrialBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).DownloadTrialBalance(ctx, req.(*DownloadTrialBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_CreateCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).CreateCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_CreateCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).CreateCategoryRule(ctx, req.(*CreateCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ListCategoryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoryRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ListCategoryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ListCategoryRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ListCategoryRules(ctx, req.(*ListCategoryRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_DeleteCategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).DeleteCategoryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_DeleteCategoryRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).DeleteCategoryRule(ctx, req.(*DeleteCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_SetTransactionCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransactionCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).SetTransactionCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_SetTransactionCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).SetTransactionCategory(ctx, req.(*SetTransactionCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_GetSpendingAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).GetSpendingAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_GetSpendingAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).GetSpendingAnalytics(ctx, req.(*GetSpendingAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ListAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ListAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ListAlertRules(ctx, req.(*ListAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_DeleteAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_RequestOverdraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestOverdraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).RequestOverdraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_RequestOverdraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).RequestOverdraft(ctx, req.(*RequestOverdraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ListOverdraftRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOverdraftRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ListOverdraftRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ListOverdraftRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ListOverdraftRequests(ctx, req.(*ListOverdraftRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ApproveOverdraftRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveOverdraftRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ApproveOverdraftRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ApproveOverdraftRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ApproveOverdraftRequest(ctx, req.(*ApproveOverdraftRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_RejectOverdraftRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectOverdraftRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).RejectOverdraftRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_RejectOverdraftRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).RejectOverdraftRequest(ctx, req.(*RejectOverdraftRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_DisburseLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisburseLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).DisburseLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_DisburseLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).DisburseLoan(ctx, req.(*DisburseLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ListLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ListLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ListLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ListLoans(ctx, req.(*ListLoansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_GetLoanSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).GetLoanSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_GetLoanSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).GetLoanSchedule(ctx, req.(*GetLoanScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_PayOffLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayOffLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).PayOffLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_PayOffLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).PayOffLoan(ctx, req.(*PayOffLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_CreatePocket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePocketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).CreatePocket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_CreatePocket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).CreatePocket(ctx, req.(*CreatePocketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ListPockets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPocketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ListPockets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ListPockets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ListPockets(ctx, req.(*ListPocketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ListPocketEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPocketEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ListPocketEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ListPocketEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ListPocketEntries(ctx, req.(*ListPocketEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_MovePocketFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovePocketFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).MovePocketFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_MovePocketFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).MovePocketFunds(ctx, req.(*MovePocketFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_ClosePocket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePocketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).ClosePocket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_ClosePocket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).ClosePocket(ctx, req.(*ClosePocketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_CreatePocketRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePocketRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).CreatePocketRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_CreatePocketRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).CreatePocketRule(ctx, req.(*CreatePocketRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_DeletePocketRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePocketRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).DeletePocketRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_DeletePocketRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).DeletePocketRule(ctx, req.(*DeletePocketRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_SetTransactionNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransactionNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).SetTransactionNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_SetTransactionNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).SetTransactionNote(ctx, req.(*SetTransactionNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_GetTransactionAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionAnnotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).GetTransactionAnnotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_GetTransactionAnnotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).GetTransactionAnnotations(ctx, req.(*GetTransactionAnnotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedSwiftBankServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+func _SwiftBank_SearchTransactionsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTransactionsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).SearchTransactionsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_SearchTransactionsByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).SearchTransactionsByTag(ctx, req.(*SearchTransactionsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSwiftBankServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+
+func _SwiftBank_UploadTransactionAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadTransactionAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).UploadTransactionAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_UploadTransactionAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).UploadTransactionAttachment(ctx, req.(*UploadTransactionAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSwiftBankServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+
+func _SwiftBank_DownloadTransactionAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadTransactionAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).DownloadTransactionAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_DownloadTransactionAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).DownloadTransactionAttachment(ctx, req.(*DownloadTransactionAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSwiftBankServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func _SwiftBank_DeleteTransactionAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).DeleteTransactionAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_DeleteTransactionAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).DeleteTransactionAttachment(ctx, req.(*DeleteTransactionAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSwiftBankServer) mustEmbedUnimplementedSwiftBankServer() {}
 
-// UnsafeSwiftBankServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SwiftBankServer will
-// result in compilation errors.
-type UnsafeSwiftBankServer interface {
-	mustEmbedUnimplementedSwiftBankServer()
+func _SwiftBank_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).OpenDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_OpenDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).OpenDispute(ctx, req.(*OpenDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSwiftBankServer(s grpc.ServiceRegistrar, srv SwiftBankServer) {
-	s.RegisterService(&SwiftBank_ServiceDesc, srv)
+func _SwiftBank_GetDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).GetDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_GetDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).GetDispute(ctx, req.(*GetDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SwiftBank_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _SwiftBank_ListDisputes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDisputesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwiftBankServer).CreateUser(ctx, in)
+		return srv.(SwiftBankServer).ListDisputes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SwiftBank_CreateUser_FullMethodName,
+		FullMethod: SwiftBank_ListDisputes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwiftBankServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(SwiftBankServer).ListDisputes(ctx, req.(*ListDisputesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwiftBank_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginUserRequest)
+func _SwiftBank_UpdateDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDisputeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwiftBankServer).LoginUser(ctx, in)
+		return srv.(SwiftBankServer).UpdateDispute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SwiftBank_LoginUser_FullMethodName,
+		FullMethod: SwiftBank_UpdateDispute_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwiftBankServer).LoginUser(ctx, req.(*LoginUserRequest))
+		return srv.(SwiftBankServer).UpdateDispute(ctx, req.(*UpdateDisputeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwiftBank_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyEmailRequest)
+func _SwiftBank_ExportGeneralLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGeneralLedgerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwiftBankServer).VerifyEmail(ctx, in)
+		return srv.(SwiftBankServer).ExportGeneralLedger(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SwiftBank_VerifyEmail_FullMethodName,
+		FullMethod: SwiftBank_ExportGeneralLedger_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwiftBankServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+		return srv.(SwiftBankServer).ExportGeneralLedger(ctx, req.(*ExportGeneralLedgerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwiftBank_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _SwiftBank_ListGLExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGLExportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwiftBankServer).UpdateUser(ctx, in)
+		return srv.(SwiftBankServer).ListGLExports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SwiftBank_UpdateUser_FullMethodName,
+		FullMethod: SwiftBank_ListGLExports_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwiftBankServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(SwiftBankServer).ListGLExports(ctx, req.(*ListGLExportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_DownloadGLExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadGLExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).DownloadGLExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_DownloadGLExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).DownloadGLExport(ctx, req.(*DownloadGLExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwiftBank_PreviewEmailTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewEmailTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwiftBankServer).PreviewEmailTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwiftBank_PreviewEmailTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwiftBankServer).PreviewEmailTemplate(ctx, req.(*PreviewEmailTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -214,6 +2063,230 @@ var SwiftBank_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _SwiftBank_UpdateUser_Handler,
 		},
+		{
+			MethodName: "InviteAccountHolder",
+			Handler:    _SwiftBank_InviteAccountHolder_Handler,
+		},
+		{
+			MethodName: "AcceptAccountInvitation",
+			Handler:    _SwiftBank_AcceptAccountInvitation_Handler,
+		},
+		{
+			MethodName: "ListAccountHolders",
+			Handler:    _SwiftBank_ListAccountHolders_Handler,
+		},
+		{
+			MethodName: "CreateBeneficiary",
+			Handler:    _SwiftBank_CreateBeneficiary_Handler,
+		},
+		{
+			MethodName: "ListBeneficiaries",
+			Handler:    _SwiftBank_ListBeneficiaries_Handler,
+		},
+		{
+			MethodName: "UpdateBeneficiary",
+			Handler:    _SwiftBank_UpdateBeneficiary_Handler,
+		},
+		{
+			MethodName: "DeleteBeneficiary",
+			Handler:    _SwiftBank_DeleteBeneficiary_Handler,
+		},
+		{
+			MethodName: "CreatePaymentAlias",
+			Handler:    _SwiftBank_CreatePaymentAlias_Handler,
+		},
+		{
+			MethodName: "VerifyPaymentAlias",
+			Handler:    _SwiftBank_VerifyPaymentAlias_Handler,
+		},
+		{
+			MethodName: "ListPaymentAliases",
+			Handler:    _SwiftBank_ListPaymentAliases_Handler,
+		},
+		{
+			MethodName: "DeletePaymentAlias",
+			Handler:    _SwiftBank_DeletePaymentAlias_Handler,
+		},
+		{
+			MethodName: "SetDefaultAccount",
+			Handler:    _SwiftBank_SetDefaultAccount_Handler,
+		},
+		{
+			MethodName: "ListPendingTransfers",
+			Handler:    _SwiftBank_ListPendingTransfers_Handler,
+		},
+		{
+			MethodName: "GetPendingTransfer",
+			Handler:    _SwiftBank_GetPendingTransfer_Handler,
+		},
+		{
+			MethodName: "ApprovePendingTransfer",
+			Handler:    _SwiftBank_ApprovePendingTransfer_Handler,
+		},
+		{
+			MethodName: "RejectPendingTransfer",
+			Handler:    _SwiftBank_RejectPendingTransfer_Handler,
+		},
+		{
+			MethodName: "GetBalanceAt",
+			Handler:    _SwiftBank_GetBalanceAt_Handler,
+		},
+		{
+			MethodName: "GetTrialBalance",
+			Handler:    _SwiftBank_GetTrialBalance_Handler,
+		},
+		{
+			MethodName: "DownloadTrialBalance",
+			Handler:    _SwiftBank_DownloadTrialBalance_Handler,
+		},
+		{
+			MethodName: "CreateCategoryRule",
+			Handler:    _SwiftBank_CreateCategoryRule_Handler,
+		},
+		{
+			MethodName: "ListCategoryRules",
+			Handler:    _SwiftBank_ListCategoryRules_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryRule",
+			Handler:    _SwiftBank_DeleteCategoryRule_Handler,
+		},
+		{
+			MethodName: "SetTransactionCategory",
+			Handler:    _SwiftBank_SetTransactionCategory_Handler,
+		},
+		{
+			MethodName: "GetSpendingAnalytics",
+			Handler:    _SwiftBank_GetSpendingAnalytics_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _SwiftBank_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _SwiftBank_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _SwiftBank_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "RequestOverdraft",
+			Handler:    _SwiftBank_RequestOverdraft_Handler,
+		},
+		{
+			MethodName: "ListOverdraftRequests",
+			Handler:    _SwiftBank_ListOverdraftRequests_Handler,
+		},
+		{
+			MethodName: "ApproveOverdraftRequest",
+			Handler:    _SwiftBank_ApproveOverdraftRequest_Handler,
+		},
+		{
+			MethodName: "RejectOverdraftRequest",
+			Handler:    _SwiftBank_RejectOverdraftRequest_Handler,
+		},
+		{
+			MethodName: "DisburseLoan",
+			Handler:    _SwiftBank_DisburseLoan_Handler,
+		},
+		{
+			MethodName: "ListLoans",
+			Handler:    _SwiftBank_ListLoans_Handler,
+		},
+		{
+			MethodName: "GetLoanSchedule",
+			Handler:    _SwiftBank_GetLoanSchedule_Handler,
+		},
+		{
+			MethodName: "PayOffLoan",
+			Handler:    _SwiftBank_PayOffLoan_Handler,
+		},
+		{
+			MethodName: "CreatePocket",
+			Handler:    _SwiftBank_CreatePocket_Handler,
+		},
+		{
+			MethodName: "ListPockets",
+			Handler:    _SwiftBank_ListPockets_Handler,
+		},
+		{
+			MethodName: "ListPocketEntries",
+			Handler:    _SwiftBank_ListPocketEntries_Handler,
+		},
+		{
+			MethodName: "MovePocketFunds",
+			Handler:    _SwiftBank_MovePocketFunds_Handler,
+		},
+		{
+			MethodName: "ClosePocket",
+			Handler:    _SwiftBank_ClosePocket_Handler,
+		},
+		{
+			MethodName: "CreatePocketRule",
+			Handler:    _SwiftBank_CreatePocketRule_Handler,
+		},
+		{
+			MethodName: "DeletePocketRule",
+			Handler:    _SwiftBank_DeletePocketRule_Handler,
+		},
+		{
+			MethodName: "SetTransactionNote",
+			Handler:    _SwiftBank_SetTransactionNote_Handler,
+		},
+		{
+			MethodName: "GetTransactionAnnotations",
+			Handler:    _SwiftBank_GetTransactionAnnotations_Handler,
+		},
+		{
+			MethodName: "SearchTransactionsByTag",
+			Handler:    _SwiftBank_SearchTransactionsByTag_Handler,
+		},
+		{
+			MethodName: "UploadTransactionAttachment",
+			Handler:    _SwiftBank_UploadTransactionAttachment_Handler,
+		},
+		{
+			MethodName: "DownloadTransactionAttachment",
+			Handler:    _SwiftBank_DownloadTransactionAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteTransactionAttachment",
+			Handler:    _SwiftBank_DeleteTransactionAttachment_Handler,
+		},
+		{
+			MethodName: "OpenDispute",
+			Handler:    _SwiftBank_OpenDispute_Handler,
+		},
+		{
+			MethodName: "GetDispute",
+			Handler:    _SwiftBank_GetDispute_Handler,
+		},
+		{
+			MethodName: "ListDisputes",
+			Handler:    _SwiftBank_ListDisputes_Handler,
+		},
+		{
+			MethodName: "UpdateDispute",
+			Handler:    _SwiftBank_UpdateDispute_Handler,
+		},
+		{
+			MethodName: "ExportGeneralLedger",
+			Handler:    _SwiftBank_ExportGeneralLedger_Handler,
+		},
+		{
+			MethodName: "ListGLExports",
+			Handler:    _SwiftBank_ListGLExports_Handler,
+		},
+		{
+			MethodName: "DownloadGLExport",
+			Handler:    _SwiftBank_DownloadGLExport_Handler,
+		},
+		{
+			MethodName: "PreviewEmailTemplate",
+			Handler:    _SwiftBank_PreviewEmailTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_swift_bank.proto",
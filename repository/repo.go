@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -11,11 +12,18 @@ import (
 type RepositoryProvider interface {
 	CreateAccount(ctx context.Context, account models.Account) (models.Account, error)
 	GetAccount(ctx context.Context, id int64) (models.Account, error)
+	GetAccountByNumber(ctx context.Context, number string) (models.Account, error)
+	ListAccountIDsByNumber(ctx context.Context, numbers []string) (map[string]int64, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (models.Account, error)
 	ListAccounts(ctx context.Context, name string, limit, offset int32) ([]models.Account, error)
 	UpdateAccount(ctx context.Context, id int64, balance float64) (models.Account, error)
-	AddAccountBalance(ctx context.Context, id int64, amount float64) (models.Account, error)
-	DeleteAccount(ctx context.Context, id int64) error
+	UpdateAccountStatusTx(ctx context.Context, arg models.UpdateAccountStatusParams) (models.Account, error)
+	CloseAccountTx(ctx context.Context, arg models.CloseAccountTxParams) (models.CloseAccountTxResult, error)
+	ListTellerReasonCodes(ctx context.Context) ([]models.TellerReasonCode, error)
+	CreateTellerOperationTx(ctx context.Context, arg models.CreateTellerOperationParams) (models.TellerOperation, error)
+	GetTellerOperation(ctx context.Context, id int64) (models.TellerOperation, error)
+	ListTellerOperations(ctx context.Context, status string, limit, offset int32) ([]models.TellerOperation, error)
+	DecideTellerOperationTx(ctx context.Context, arg models.DecideTellerOperationParams) (models.TellerOperation, error)
 	CreateEntry(ctx context.Context, entry models.Entry) (models.Entry, error)
 	GetEntry(ctx context.Context, id int64) (models.Entry, error)
 	ListEntries(ctx context.Context, accountID, limit, offset int64) ([]models.Entry, error)
@@ -25,6 +33,37 @@ type RepositoryProvider interface {
 	GetTransaction(ctx context.Context, id int64) (models.Transaction, error)
 	ListTransactions(ctx context.Context, fromAccountID, toAccountID, limit, offset int64) ([]models.Transaction, error)
 	TransferTx(ctx context.Context, arg models.TransferTxParams) (models.TransferTxResult, error)
+	BatchTransferTx(ctx context.Context, arg models.BatchTransferTxParams) (models.BatchTransferTxResult, error)
+	CreatePendingTransferTx(ctx context.Context, arg models.CreatePendingTransferParams) (models.PendingTransfer, error)
+	GetPendingTransfer(ctx context.Context, id int64) (models.PendingTransfer, error)
+	ListPendingTransfers(ctx context.Context, status string, limit, offset int32) ([]models.PendingTransfer, error)
+	ListPendingTransferEvents(ctx context.Context, pendingTransferID int64) ([]models.PendingTransferEvent, error)
+	DecidePendingTransferTx(ctx context.Context, arg models.DecidePendingTransferParams) (models.DecidePendingTransferResult, error)
+	ExpirePendingTransfers(ctx context.Context) (int64, error)
+	GetTransferSignals(ctx context.Context, arg models.TransferSignalsParams) (models.TransferSignals, error)
+	CreateFraudScreening(ctx context.Context, screening models.FraudScreening) (models.FraudScreening, error)
+	GetFraudScreening(ctx context.Context, id int64) (models.FraudScreening, error)
+	ListFraudScreenings(ctx context.Context, status string, limit, offset int32) ([]models.FraudScreening, error)
+	ResolveFraudScreeningTx(ctx context.Context, arg models.ResolveFraudScreeningParams) (models.ResolveFraudScreeningResult, error)
+	GetAccountHolder(ctx context.Context, accountID int64, username string) (models.AccountHolder, error)
+	ListAccountHolders(ctx context.Context, accountID int64) ([]models.AccountHolder, error)
+	InviteAccountHolder(ctx context.Context, arg models.InviteAccountHolderParams) (models.AccountHolder, error)
+	AcceptAccountInvitation(ctx context.Context, accountID int64, username string) (models.AccountHolder, error)
+	CreateBeneficiary(ctx context.Context, beneficiary models.Beneficiary) (models.Beneficiary, error)
+	GetBeneficiary(ctx context.Context, id int64, owner string) (models.Beneficiary, error)
+	GetBeneficiaryByAccount(ctx context.Context, owner string, accountID int64) (models.Beneficiary, error)
+	ListBeneficiaries(ctx context.Context, owner string) ([]models.Beneficiary, error)
+	UpdateBeneficiary(ctx context.Context, arg models.UpdateBeneficiaryParams) (models.Beneficiary, error)
+	DeleteBeneficiary(ctx context.Context, id int64, owner string) error
+	CreatePaymentAlias(ctx context.Context, alias models.PaymentAlias) (models.PaymentAlias, error)
+	GetPaymentAlias(ctx context.Context, alias string) (models.PaymentAlias, error)
+	SetPaymentAliasCode(ctx context.Context, alias, username, codeHash string, expiresAt time.Time) error
+	VerifyPaymentAliasTx(ctx context.Context, arg models.VerifyPaymentAliasParams) (models.PaymentAlias, error)
+	ListPaymentAliases(ctx context.Context, username string) ([]models.PaymentAlias, error)
+	DeletePaymentAlias(ctx context.Context, alias, username string) error
+	SetDefaultAccount(ctx context.Context, username string, accountID int64) (models.DefaultAccount, error)
+	ListDefaultAccounts(ctx context.Context, username string) ([]models.DefaultAccount, error)
+	ResolvePaymentAlias(ctx context.Context, alias, currency string) (models.Account, error)
 	CreateUserTx(ctx context.Context, data models.CreateUserTxParams) (models.User, error)
 	CreateUser(ctx context.Context, user models.User) (models.User, error)
 	GetUser(ctx context.Context, userName string) (models.User, error)
@@ -32,6 +71,75 @@ type RepositoryProvider interface {
 	ListUsers(ctx context.Context, limit, offset int32) ([]models.User, error)
 	CreateSession(ctx context.Context, session models.Session) (models.Session, error)
 	GetSession(ctx context.Context, id uuid.UUID) (models.Session, error)
+	CreateHoldTx(ctx context.Context, arg models.CreateHoldParams) (models.Hold, error)
+	GetHold(ctx context.Context, id int64) (models.Hold, error)
+	ListHolds(ctx context.Context, accountID int64, limit, offset int32) ([]models.Hold, error)
+	CaptureHoldTx(ctx context.Context, arg models.CaptureHoldParams) (models.CaptureHoldResult, error)
+	VoidHoldTx(ctx context.Context, id int64) (models.Hold, error)
+	ReleaseExpiredHolds(ctx context.Context) (int64, error)
+	GetAccountProduct(ctx context.Context, code string) (models.AccountProduct, error)
+	ListAccountProducts(ctx context.Context) ([]models.AccountProduct, error)
+	ListInterestBearingAccounts(ctx context.Context, day time.Time, afterID int64, limit int32) ([]models.InterestBearingAccount, error)
+	CreateInterestAccrual(ctx context.Context, accrual models.InterestAccrual) (bool, error)
+	ListInterestAccruals(ctx context.Context, accountID int64, limit, offset int32) ([]models.InterestAccrual, error)
+	ListAccountsWithUnpaidInterest(ctx context.Context, before time.Time) ([]int64, error)
+	PayInterestTx(ctx context.Context, arg models.PayInterestTxParams) (models.PayInterestTxResult, error)
+	CreateBalanceSnapshots(ctx context.Context, day time.Time) (int64, error)
+	GetBalanceAt(ctx context.Context, accountID int64, at time.Time) (models.BalanceAt, error)
+	GetLastClosedBusinessDate(ctx context.Context) (*time.Time, error)
+	CloseBusinessDayTx(ctx context.Context, day time.Time) ([]models.TrialBalance, error)
+	GetTrialBalances(ctx context.Context, day time.Time) ([]models.TrialBalance, error)
+	ListStatementRecipients(ctx context.Context, period time.Time) ([]models.StatementRecipient, error)
+	GetStatement(ctx context.Context, accountID int64, period time.Time) (models.Statement, error)
+	CreateStatementDelivery(ctx context.Context, delivery models.StatementDelivery) (bool, error)
+	CreateCategoryRule(ctx context.Context, rule models.CategoryRule) (models.CategoryRule, error)
+	ListCategoryRules(ctx context.Context, owner string) ([]models.CategoryRule, error)
+	DeleteCategoryRule(ctx context.Context, id int64, owner string) error
+	SetTransactionCategory(ctx context.Context, category models.TransactionCategory) (models.TransactionCategory, error)
+	GetSpendingAnalytics(ctx context.Context, arg models.SpendingAnalyticsParams) (models.SpendingAnalytics, error)
+	CreateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error)
+	ListAlertRules(ctx context.Context, accountID int64, owner string) ([]models.AlertRule, error)
+	DeleteAlertRule(ctx context.Context, id int64, owner string) error
+	CreateOverdraftRequest(ctx context.Context, req models.OverdraftRequest) (models.OverdraftRequest, error)
+	ListOverdraftRequests(ctx context.Context, status string, limit, offset int32) ([]models.OverdraftRequest, error)
+	DecideOverdraftRequestTx(ctx context.Context, arg models.DecideOverdraftRequestParams) (models.OverdraftRequest, error)
+	ListOverdrawnAccounts(ctx context.Context, day time.Time, afterID int64, limit int32) ([]models.OverdrawnAccount, error)
+	ListUnchargedOverdrafts(ctx context.Context) ([]models.UnchargedOverdraft, error)
+	ChargeOverdraftTx(ctx context.Context, charge models.OverdraftCharge) (bool, error)
+	ListOverdraftCharges(ctx context.Context, accountID int64, limit, offset int32) ([]models.OverdraftCharge, error)
+	DisburseLoanTx(ctx context.Context, arg models.DisburseLoanParams) (models.LoanSchedule, error)
+	GetLoan(ctx context.Context, id int64) (models.Loan, error)
+	GetLoanSchedule(ctx context.Context, id int64, day time.Time) (models.LoanSchedule, error)
+	ListLoans(ctx context.Context, accountID int64) ([]models.Loan, error)
+	ListDueLoanInstallments(ctx context.Context, day time.Time) ([]models.LoanInstallment, error)
+	RepayLoanInstallmentTx(ctx context.Context, id int64, day time.Time) (models.LoanRepaymentResult, error)
+	PayOffLoanTx(ctx context.Context, id int64, day time.Time) (models.LoanSchedule, error)
+	CreatePocket(ctx context.Context, pocket models.Pocket) (models.Pocket, error)
+	GetPocket(ctx context.Context, id int64) (models.Pocket, error)
+	ListPockets(ctx context.Context, accountID int64) ([]models.Pocket, error)
+	ListPocketEntries(ctx context.Context, pocketID int64, limit, offset int32) ([]models.PocketEntry, error)
+	MovePocketFundsTx(ctx context.Context, arg models.MovePocketFundsParams) (models.MovePocketFundsResult, error)
+	ClosePocketTx(ctx context.Context, id int64) (models.Pocket, error)
+	CreatePocketRule(ctx context.Context, rule models.PocketRule) (models.PocketRule, error)
+	GetPocketRule(ctx context.Context, id int64) (models.PocketRule, error)
+	DeletePocketRule(ctx context.Context, id int64) error
+	SetTransactionNote(ctx context.Context, note models.TransactionNote) (models.TransactionNote, error)
+	GetTransactionNote(ctx context.Context, transactionID int64, owner string) (models.TransactionNote, error)
+	SearchTransactionsByTag(ctx context.Context, arg models.SearchTransactionsByTagParams) ([]models.TaggedTransaction, error)
+	CreateTransactionAttachment(ctx context.Context, attachment models.TransactionAttachment) (models.TransactionAttachment, error)
+	GetTransactionAttachment(ctx context.Context, id int64, owner string) (models.TransactionAttachment, error)
+	ListTransactionAttachments(ctx context.Context, transactionID int64, owner string) ([]models.TransactionAttachment, error)
+	DeleteTransactionAttachment(ctx context.Context, id int64, owner string) (models.TransactionAttachment, error)
+	OpenDisputeTx(ctx context.Context, arg models.OpenDisputeParams) (models.DisputeUpdate, error)
+	GetDispute(ctx context.Context, id int64) (models.Dispute, error)
+	ListDisputes(ctx context.Context, accountID int64, status string, limit, offset int32) ([]models.Dispute, error)
+	UpdateDisputeTx(ctx context.Context, arg models.UpdateDisputeParams) (models.DisputeUpdate, error)
+	GetJournal(ctx context.Context, start, end time.Time) ([]models.JournalLine, error)
+	CreateGLExport(ctx context.Context, export models.GLExport) (models.GLExport, error)
+	GetGLExport(ctx context.Context, id int64) (models.GLExport, error)
+	GetGLExportByPeriod(ctx context.Context, start, end time.Time, format string) (models.GLExport, error)
+	ListGLExports(ctx context.Context, limit, offset int32) ([]models.GLExport, error)
+	GetLastGLExportDate(ctx context.Context, format string) (*time.Time, error)
 }
 
 type Repository struct {
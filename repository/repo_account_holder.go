@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const accountHolderColumns = `account_id, (SELECT account_number FROM accounts WHERE id = account_id), username, role,
+	status, invited_by, accepted_at, created_at`
+
+func scanAccountHolder(row pgx.Row, holder *models.AccountHolder) error {
+	return row.Scan(&holder.AccountID, &holder.AccountNumber, &holder.UserName, &holder.Role, &holder.Status,
+		&holder.InvitedBy, &holder.AcceptedAt, &holder.CreatedAt)
+}
+
+func (r *repositoryImpl) GetAccountHolder(ctx context.Context, accountID int64, username string) (models.AccountHolder, error) {
+	var holder models.AccountHolder
+	query := `SELECT ` + accountHolderColumns + ` FROM account_holders WHERE account_id = @accountID AND username = @username`
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+		"username":  username,
+	}
+
+	err := scanAccountHolder(r.pool.QueryRow(ctx, query, args), &holder)
+	return holder, err
+}
+
+func (r *repositoryImpl) ListAccountHolders(ctx context.Context, accountID int64) ([]models.AccountHolder, error) {
+	query := `SELECT ` + accountHolderColumns + ` FROM account_holders WHERE account_id = @accountID ORDER BY created_at`
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	holders := []models.AccountHolder{}
+	for rows.Next() {
+		var holder models.AccountHolder
+		if err := scanAccountHolder(rows, &holder); err != nil {
+			return nil, err
+		}
+		holders = append(holders, holder)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return holders, nil
+}
+
+// InviteAccountHolder records an invitation for an existing user, it returns pgx.ErrNoRows when the user
+// does not exist and models.ErrAlreadyAccountHolder when they already hold or were invited to the account
+func (r *repositoryImpl) InviteAccountHolder(ctx context.Context, arg models.InviteAccountHolderParams) (models.AccountHolder, error) {
+	var holder models.AccountHolder
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var exists bool
+		query := `SELECT EXISTS (SELECT 1 FROM users WHERE username = @username)`
+		args := pgx.NamedArgs{
+			"username": arg.UserName,
+		}
+
+		if err := tx.QueryRow(ctx, query, args).Scan(&exists); err != nil {
+			return err
+		}
+
+		if !exists {
+			return pgx.ErrNoRows
+		}
+
+		query2 := `INSERT INTO account_holders (account_id, username, role, status, invited_by) VALUES
+					(@accountID, @username, @role, @status, @invitedBy) ON CONFLICT DO NOTHING RETURNING ` + accountHolderColumns
+		args2 := pgx.NamedArgs{
+			"accountID": arg.AccountID,
+			"username":  arg.UserName,
+			"role":      arg.Role,
+			"status":    models.HolderStatusInvited,
+			"invitedBy": arg.InvitedBy,
+		}
+
+		err := scanAccountHolder(tx.QueryRow(ctx, query2, args2), &holder)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ErrAlreadyAccountHolder
+		}
+		return err
+	})
+
+	return holder, err
+}
+
+// AcceptAccountInvitation activates the user's pending invitation, pgx.ErrNoRows means there is none
+func (r *repositoryImpl) AcceptAccountInvitation(ctx context.Context, accountID int64, username string) (models.AccountHolder, error) {
+	var holder models.AccountHolder
+	query := `UPDATE account_holders SET status = @active, accepted_at = now()
+				WHERE account_id = @accountID AND username = @username AND status = @invited RETURNING ` + accountHolderColumns
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+		"username":  username,
+		"active":    models.HolderStatusActive,
+		"invited":   models.HolderStatusInvited,
+	}
+
+	err := scanAccountHolder(r.pool.QueryRow(ctx, query, args), &holder)
+	return holder, err
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestCreateAccountAddsOwnerHolder(t *testing.T) {
+	account := createRandomAccount(t)
+
+	holder, err := testRepo.R.GetAccountHolder(context.Background(), account.ID, account.Owner)
+	require.NoError(t, err)
+	require.Equal(t, models.HolderRoleOwner, holder.Role)
+	require.Equal(t, models.HolderStatusActive, holder.Status)
+	require.Nil(t, holder.InvitedBy)
+	require.NotNil(t, holder.AcceptedAt)
+	require.True(t, holder.Can(models.PermissionManage))
+}
+
+func TestInviteAndAcceptAccountHolder(t *testing.T) {
+	account := createRandomAccount(t)
+	user := createRandomUser(t)
+
+	invited, err := testRepo.R.InviteAccountHolder(context.Background(), models.InviteAccountHolderParams{
+		AccountID: account.ID,
+		UserName:  user.UserName,
+		Role:      models.HolderRoleCoOwner,
+		InvitedBy: account.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.HolderStatusInvited, invited.Status)
+	require.Equal(t, account.Owner, *invited.InvitedBy)
+	require.Nil(t, invited.AcceptedAt)
+
+	// an invitation grants nothing and does not show the account in the user's list yet
+	require.False(t, invited.Can(models.PermissionView))
+
+	accounts, err := testRepo.R.ListAccounts(context.Background(), user.UserName, 5, 0)
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+
+	_, err = testRepo.R.InviteAccountHolder(context.Background(), models.InviteAccountHolderParams{
+		AccountID: account.ID,
+		UserName:  user.UserName,
+		Role:      models.HolderRoleViewer,
+		InvitedBy: account.Owner,
+	})
+	require.ErrorIs(t, err, models.ErrAlreadyAccountHolder)
+
+	accepted, err := testRepo.R.AcceptAccountInvitation(context.Background(), account.ID, user.UserName)
+	require.NoError(t, err)
+	require.Equal(t, models.HolderStatusActive, accepted.Status)
+	require.NotNil(t, accepted.AcceptedAt)
+	require.True(t, accepted.Can(models.PermissionTransact))
+
+	_, err = testRepo.R.AcceptAccountInvitation(context.Background(), account.ID, user.UserName)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	accounts, err = testRepo.R.ListAccounts(context.Background(), user.UserName, 5, 0)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, account.ID, accounts[0].ID)
+	require.Equal(t, account.Owner, accounts[0].Owner)
+
+	holders, err := testRepo.R.ListAccountHolders(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, holders, 2)
+	require.Equal(t, account.Owner, holders[0].UserName)
+	require.Equal(t, user.UserName, holders[1].UserName)
+}
+
+func TestInviteUnknownUser(t *testing.T) {
+	account := createRandomAccount(t)
+
+	_, err := testRepo.R.InviteAccountHolder(context.Background(), models.InviteAccountHolderParams{
+		AccountID: account.ID,
+		UserName:  helpers.RandomOwner(),
+		Role:      models.HolderRoleViewer,
+		InvitedBy: account.Owner,
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func (r *repositoryImpl) UpdateAccountStatusTx(ctx context.Context, arg models.UpdateAccountStatusParams) (models.Account, error) {
+	var account models.Account
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		accounts, err := lockAccounts(ctx, tx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// closing needs the balance settled first, that is CloseAccountTx's job
+		current := accounts[arg.AccountID]
+		if arg.Status == models.AccountStatusClosed || !models.CanTransitionAccountStatus(current.Status, arg.Status) {
+			return models.ErrInvalidStatusTransition
+		}
+
+		// a freeze may be imposed by the bank, so holders can't lift it themselves
+		if current.Status == models.AccountStatusFrozen && !arg.ByStaff {
+			return models.ErrFreezeNeedsStaff
+		}
+
+		account, err = setAccountStatus(ctx, tx, current, arg.Status, arg.Reason, arg.ChangedBy)
+		return err
+	})
+
+	return account, err
+}
+
+// CloseAccountTx pays out any interest still owed, moves the remaining balance to the sweep account when one
+// is given and marks the account closed. An account still repaying a loan can't be closed. The account row is
+// kept so its history stays readable.
+func (r *repositoryImpl) CloseAccountTx(ctx context.Context, arg models.CloseAccountTxParams) (models.CloseAccountTxResult, error) {
+	var result models.CloseAccountTxResult
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		account, err := getAccount(ctx, tx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// take every lock the closure can need up front, in id order
+		ids := []int64{account.ID}
+		if arg.SweepToAccountID != 0 {
+			ids = append(ids, arg.SweepToAccountID)
+		}
+
+		expenseAccountID, err := getInternalAccountID(ctx, tx, models.InternalAccountInterestExpense, account.Currency)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		if err == nil {
+			ids = append(ids, expenseAccountID)
+		}
+
+		accounts, err := lockAccounts(ctx, tx, ids...)
+		if err != nil {
+			return err
+		}
+
+		account = accounts[arg.AccountID]
+		if !models.CanTransitionAccountStatus(account.Status, models.AccountStatusClosed) {
+			return models.ErrInvalidStatusTransition
+		}
+
+		// installments are collected from the account, once closed it could never pay them
+		var hasLoan bool
+		query := `SELECT EXISTS (SELECT 1 FROM loans WHERE account_id = @accountID AND status <> @paidOff)`
+		args := pgx.NamedArgs{
+			"accountID": account.ID,
+			"paidOff":   models.LoanStatusPaidOff,
+		}
+
+		if err := tx.QueryRow(ctx, query, args).Scan(&hasLoan); err != nil {
+			return err
+		}
+
+		if hasLoan {
+			return models.ErrAccountHasLoan
+		}
+
+		// money set aside in pockets is part of the balance being paid out
+		account, err = closeAccountPockets(ctx, tx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		if account.AvailableBalance != account.Balance {
+			return models.ErrAccountHasHolds
+		}
+
+		interest, err := payInterest(ctx, tx, models.PayInterestTxParams{
+			AccountID:   account.ID,
+			Before:      time.Now().AddDate(0, 0, 1),
+			Description: fmt.Sprintf("interest to closure of account %s", account.AccountNumber),
+		})
+		if err != nil {
+			return err
+		}
+
+		if interest.Transfer != nil {
+			result.Interest = &interest
+			account = interest.Transfer.ToAccount
+		}
+
+		if account.Balance < 0 || (account.Balance > 0 && arg.SweepToAccountID == 0) {
+			return models.ErrAccountNotEmpty
+		}
+
+		if account.Balance > 0 {
+			sweepAccount := accounts[arg.SweepToAccountID]
+			if sweepAccount.ID == account.ID || sweepAccount.Currency != account.Currency || checkCredit(sweepAccount) != nil {
+				return models.ErrSweepAccountInvalid
+			}
+
+			sweep, err := createTransfer(ctx, tx, models.TransferTxParams{
+				FromAccountID: account.ID,
+				ToAccountID:   sweepAccount.ID,
+				Amount:        account.Balance,
+				Currency:      account.Currency,
+				Description:   fmt.Sprintf("closing balance of account %s", account.AccountNumber),
+			})
+			if err != nil {
+				return err
+			}
+
+			result.Sweep = &sweep
+			account = sweep.FromAccount
+		}
+
+		result.Account, err = setAccountStatus(ctx, tx, account, models.AccountStatusClosed, "", arg.ChangedBy)
+		return err
+	})
+
+	return result, err
+}
+
+// setAccountStatus moves an account locked by the caller to the new status and records the change
+func setAccountStatus(ctx context.Context, tx pgx.Tx, account models.Account, status, reason, changedBy string) (models.Account, error) {
+	var updated models.Account
+	query := `UPDATE accounts SET status = @status, closed_at = CASE WHEN @closed THEN now() END
+				WHERE id = @id RETURNING ` + accountColumns
+	args := pgx.NamedArgs{
+		"id":     account.ID,
+		"status": status,
+		"closed": status == models.AccountStatusClosed,
+	}
+
+	if err := scanAccount(tx.QueryRow(ctx, query, args), &updated); err != nil {
+		return updated, err
+	}
+
+	query2 := `INSERT INTO account_status_changes (account_id, from_status, to_status, reason, changed_by) VALUES
+				(@accountID, @fromStatus, @toStatus, @reason, @changedBy)`
+	args2 := pgx.NamedArgs{
+		"accountID":  account.ID,
+		"fromStatus": account.Status,
+		"toStatus":   status,
+		"reason":     reason,
+		"changedBy":  changedBy,
+	}
+
+	_, err := tx.Exec(ctx, query2, args2)
+	return updated, err
+}
+
+// checkDebit reports whether money may leave the account in its current status
+func checkDebit(account models.Account) error {
+	switch account.Status {
+	case models.AccountStatusFrozen:
+		return models.ErrAccountFrozen
+	case models.AccountStatusClosing:
+		return models.ErrAccountClosing
+	case models.AccountStatusClosed:
+		return models.ErrAccountClosed
+	}
+	return nil
+}
+
+// checkCredit reports whether money may arrive in the account in its current status
+func checkCredit(account models.Account) error {
+	if account.Status == models.AccountStatusClosed {
+		return models.ErrAccountClosed
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/amortization"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestCloseEmptyAccount(t *testing.T) {
+	account := createRandomAccount(t)
+	_, err := testRepo.R.UpdateAccount(context.Background(), account.ID, 0)
+	require.NoError(t, err)
+
+	result, err := testRepo.R.CloseAccountTx(context.Background(), models.CloseAccountTxParams{
+		AccountID: account.ID,
+		ChangedBy: account.Owner,
+	})
+	require.NoError(t, err)
+	require.Nil(t, result.Sweep)
+	require.Equal(t, models.AccountStatusClosed, result.Account.Status)
+	require.NotNil(t, result.Account.ClosedAt)
+
+	// the account stays readable
+	closed, err := testRepo.R.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, models.AccountStatusClosed, closed.Status)
+}
+
+func TestCloseAccountRequiresZeroBalance(t *testing.T) {
+	account := createRandomAccount(t)
+	fundAccount(t, &account)
+
+	_, err := testRepo.R.CloseAccountTx(context.Background(), models.CloseAccountTxParams{
+		AccountID: account.ID,
+		ChangedBy: account.Owner,
+	})
+	require.ErrorIs(t, err, models.ErrAccountNotEmpty)
+
+	unchanged, err := testRepo.R.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, models.AccountStatusActive, unchanged.Status)
+}
+
+func TestCloseAccountWithLoan(t *testing.T) {
+	account := createRandomAccount(t)
+	teller := createRandomTeller(t, models.RoleTeller)
+
+	now := time.Now().UTC()
+	installments, err := amortization.Schedule(models.LoanMethodAnnuity, 1200, 0.12, 12, now)
+	require.NoError(t, err)
+
+	_, err = testRepo.R.DisburseLoanTx(context.Background(), models.DisburseLoanParams{
+		Loan: models.Loan{
+			AccountID:    account.ID,
+			Principal:    1200,
+			InterestRate: 0.12,
+			TermMonths:   12,
+			Method:       models.LoanMethodAnnuity,
+			Currency:     account.Currency,
+			DisbursedBy:  teller.UserName,
+		},
+		Installments: installments,
+	})
+	require.NoError(t, err)
+
+	sweepTo := createRandomAccountIn(t, account.Currency)
+
+	// the installments could never be collected from a closed account
+	_, err = testRepo.R.CloseAccountTx(context.Background(), models.CloseAccountTxParams{
+		AccountID:        account.ID,
+		SweepToAccountID: sweepTo.ID,
+		ChangedBy:        account.Owner,
+	})
+	require.ErrorIs(t, err, models.ErrAccountHasLoan)
+
+	unchanged, err := testRepo.R.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, models.AccountStatusActive, unchanged.Status)
+}
+
+func TestCloseAccountWithSweep(t *testing.T) {
+	account := createRandomAccount(t)
+	fundAccount(t, &account)
+
+	sweepTo, err := testRepo.R.CreateAccount(context.Background(), models.Account{
+		Owner:       account.Owner,
+		Currency:    account.Currency,
+		ProductCode: models.DefaultProductCode,
+	})
+	require.NoError(t, err)
+
+	result, err := testRepo.R.CloseAccountTx(context.Background(), models.CloseAccountTxParams{
+		AccountID:        account.ID,
+		SweepToAccountID: sweepTo.ID,
+		ChangedBy:        account.Owner,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Sweep)
+	require.Equal(t, account.Balance, result.Sweep.Transaction.Amount)
+	require.Equal(t, sweepTo.Balance+account.Balance, result.Sweep.ToAccount.Balance)
+	require.Zero(t, result.Account.Balance)
+	require.Equal(t, models.AccountStatusClosed, result.Account.Status)
+
+	// closed accounts take no postings at all
+	_, err = testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: sweepTo.ID,
+		ToAccountID:   account.ID,
+		Amount:        1,
+		Currency:      account.Currency,
+	})
+	require.ErrorIs(t, err, models.ErrAccountClosed)
+}
+
+func TestFrozenAccountRejectsDebits(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	fundAccount(t, &account1)
+	fundAccount(t, &account2)
+
+	frozen, err := testRepo.R.UpdateAccountStatusTx(context.Background(), models.UpdateAccountStatusParams{
+		AccountID: account1.ID,
+		Status:    models.AccountStatusFrozen,
+		Reason:    "card lost",
+		ChangedBy: account1.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.AccountStatusFrozen, frozen.Status)
+
+	_, err = testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Currency:      account1.Currency,
+	})
+	require.ErrorIs(t, err, models.ErrAccountFrozen)
+
+	// credits still arrive
+	_, err = testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: account2.ID,
+		ToAccountID:   account1.ID,
+		Amount:        10,
+		Currency:      account1.Currency,
+	})
+	require.NoError(t, err)
+
+	// a frozen account can't be closed before it is unfrozen
+	_, err = testRepo.R.UpdateAccountStatusTx(context.Background(), models.UpdateAccountStatusParams{
+		AccountID: account1.ID,
+		Status:    models.AccountStatusClosing,
+		ChangedBy: account1.Owner,
+	})
+	require.ErrorIs(t, err, models.ErrInvalidStatusTransition)
+
+	// the holder can't lift the freeze, staff can
+	_, err = testRepo.R.UpdateAccountStatusTx(context.Background(), models.UpdateAccountStatusParams{
+		AccountID: account1.ID,
+		Status:    models.AccountStatusActive,
+		ChangedBy: account1.Owner,
+	})
+	require.ErrorIs(t, err, models.ErrFreezeNeedsStaff)
+
+	active, err := testRepo.R.UpdateAccountStatusTx(context.Background(), models.UpdateAccountStatusParams{
+		AccountID: account1.ID,
+		Status:    models.AccountStatusActive,
+		Reason:    "card found",
+		ChangedBy: "teller",
+		ByStaff:   true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.AccountStatusActive, active.Status)
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const alertRuleColumns = `id, account_id, (SELECT account_number FROM accounts WHERE id = account_id), owner, kind,
+	threshold, channel, triggered, created_at`
+
+func scanAlertRule(row pgx.Row, rule *models.AlertRule) error {
+	return row.Scan(&rule.ID, &rule.AccountID, &rule.AccountNumber, &rule.Owner, &rule.Kind, &rule.Threshold, &rule.Channel,
+		&rule.Triggered, &rule.CreatedAt)
+}
+
+func alertRuleError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return models.ErrAlertRuleExists
+	}
+	return err
+}
+
+func (r *repositoryImpl) CreateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error) {
+	var ar models.AlertRule
+	query := `INSERT INTO alert_rules (account_id, owner, kind, threshold, channel) VALUES
+				(@accountID, @owner, @kind, @threshold, @channel) RETURNING ` + alertRuleColumns
+	args := pgx.NamedArgs{
+		"accountID": rule.AccountID,
+		"owner":     rule.Owner,
+		"kind":      rule.Kind,
+		"threshold": rule.Threshold,
+		"channel":   rule.Channel,
+	}
+
+	err := scanAlertRule(r.pool.QueryRow(ctx, query, args), &ar)
+	if err != nil {
+		return ar, alertRuleError(err)
+	}
+
+	return ar, nil
+}
+
+// ListAlertRules lists the rules the user set up for the account
+func (r *repositoryImpl) ListAlertRules(ctx context.Context, accountID int64, owner string) ([]models.AlertRule, error) {
+	query := `SELECT ` + alertRuleColumns + ` FROM alert_rules WHERE account_id = @accountID AND owner = @owner ORDER BY id`
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+		"owner":     owner,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	rules := []models.AlertRule{}
+	for rows.Next() {
+		var rule models.AlertRule
+		if err := scanAlertRule(rows, &rule); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (r *repositoryImpl) DeleteAlertRule(ctx context.Context, id int64, owner string) error {
+	query := `DELETE FROM alert_rules WHERE id = @id AND owner = @owner`
+	args := pgx.NamedArgs{
+		"id":    id,
+		"owner": owner,
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// evaluateAlertRules returns the rules on either side of the transfer that it set off. Low balance rules are
+// marked triggered when they fire and re-armed once the balance is back at or above their threshold, so a
+// balance that stays low only alerts once.
+func evaluateAlertRules(ctx context.Context, tx pgx.Tx, transfer models.TransferTxResult) ([]models.TriggeredAlert, error) {
+	query := `SELECT ` + alertRuleColumns + ` FROM alert_rules WHERE account_id IN (@fromAccountID, @toAccountID)
+				ORDER BY id FOR UPDATE`
+	args := pgx.NamedArgs{
+		"fromAccountID": transfer.FromAccount.ID,
+		"toAccountID":   transfer.ToAccount.ID,
+	}
+
+	rows, err := tx.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// read every rule before updating any, the connection can't run another query while rows are open
+	var rules []models.AlertRule
+	for rows.Next() {
+		var rule models.AlertRule
+		if err := scanAlertRule(rows, &rule); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var alerts []models.TriggeredAlert
+	for _, rule := range rules {
+		account := transfer.FromAccount
+		if rule.AccountID == transfer.ToAccount.ID {
+			account = transfer.ToAccount
+		}
+
+		fire := false
+		switch rule.Kind {
+		case models.AlertKindLargeTransaction:
+			fire = transfer.Transaction.Amount >= rule.Threshold
+		case models.AlertKindLowBalance:
+			low := account.AvailableBalance < rule.Threshold
+			fire = low && !rule.Triggered
+			if low != rule.Triggered {
+				if err := setAlertRuleTriggered(ctx, tx, rule.ID, low); err != nil {
+					return nil, err
+				}
+				rule.Triggered = low
+			}
+		}
+
+		if fire {
+			alerts = append(alerts, models.TriggeredAlert{
+				Rule:          rule,
+				TransactionID: transfer.Transaction.ID,
+				Amount:        transfer.Transaction.Amount,
+				Currency:      transfer.Transaction.Currency,
+				Incoming:      account.ID == transfer.ToAccount.ID,
+				Balance:       account.AvailableBalance,
+			})
+		}
+	}
+
+	return alerts, nil
+}
+
+func setAlertRuleTriggered(ctx context.Context, tx pgx.Tx, id int64, triggered bool) error {
+	query := `UPDATE alert_rules SET triggered = @triggered WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id":        id,
+		"triggered": triggered,
+	}
+
+	_, err := tx.Exec(ctx, query, args)
+	return err
+}
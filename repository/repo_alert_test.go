@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestAlertRules(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+
+	lowBalance, err := testRepo.R.CreateAlertRule(context.Background(), models.AlertRule{
+		AccountID: from.ID,
+		Owner:     from.Owner,
+		Kind:      models.AlertKindLowBalance,
+		Threshold: 900,
+		Channel:   models.AlertChannelEmail,
+	})
+	require.NoError(t, err)
+	require.False(t, lowBalance.Triggered)
+
+	_, err = testRepo.R.CreateAlertRule(context.Background(), models.AlertRule{
+		AccountID: from.ID,
+		Owner:     from.Owner,
+		Kind:      models.AlertKindLowBalance,
+		Threshold: 500,
+		Channel:   models.AlertChannelEmail,
+	})
+	require.ErrorIs(t, err, models.ErrAlertRuleExists)
+
+	largeTransaction, err := testRepo.R.CreateAlertRule(context.Background(), models.AlertRule{
+		AccountID: to.ID,
+		Owner:     to.Owner,
+		Kind:      models.AlertKindLargeTransaction,
+		Threshold: 300,
+		Channel:   models.AlertChannelEmail,
+	})
+	require.NoError(t, err)
+
+	transfer := func(from, to models.Account, amount float64) []models.TriggeredAlert {
+		result, err := testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        amount,
+			Currency:      from.Currency,
+		})
+		require.NoError(t, err)
+		return result.Alerts
+	}
+
+	require.Empty(t, transfer(from, to, 50))
+
+	alerts := transfer(from, to, 100)
+	require.Len(t, alerts, 1)
+	require.Equal(t, lowBalance.ID, alerts[0].Rule.ID)
+	require.Equal(t, float64(850), alerts[0].Balance)
+	require.False(t, alerts[0].Incoming)
+
+	// still below the threshold, no second alert
+	require.Empty(t, transfer(from, to, 10))
+
+	alerts = transfer(from, to, 300)
+	require.Len(t, alerts, 1)
+	require.Equal(t, largeTransaction.ID, alerts[0].Rule.ID)
+	require.True(t, alerts[0].Incoming)
+
+	// large transfers are reported on the way out too, and the sender's balance recovering re-arms its alert
+	alerts = transfer(to, from, 460)
+	require.Len(t, alerts, 1)
+	require.Equal(t, largeTransaction.ID, alerts[0].Rule.ID)
+	require.False(t, alerts[0].Incoming)
+
+	alerts = transfer(from, to, 150)
+	require.Len(t, alerts, 1)
+	require.Equal(t, lowBalance.ID, alerts[0].Rule.ID)
+
+	rules, err := testRepo.R.ListAlertRules(context.Background(), from.ID, from.Owner)
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	require.True(t, rules[0].Triggered)
+
+	err = testRepo.R.DeleteAlertRule(context.Background(), lowBalance.ID, to.Owner)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	err = testRepo.R.DeleteAlertRule(context.Background(), lowBalance.ID, from.Owner)
+	require.NoError(t, err)
+}
+
+func TestAlertRulesOnEveryPostingPath(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+	staff := createRandomTeller(t, models.RoleTeller)
+
+	rule, err := testRepo.R.CreateAlertRule(context.Background(), models.AlertRule{
+		AccountID: to.ID,
+		Owner:     to.Owner,
+		Kind:      models.AlertKindLargeTransaction,
+		Threshold: 300,
+		Channel:   models.AlertChannelEmail,
+	})
+	require.NoError(t, err)
+
+	requireAlert := func(alerts []models.TriggeredAlert, transactionID int64, incoming bool) {
+		require.Len(t, alerts, 1)
+		require.Equal(t, rule.ID, alerts[0].Rule.ID)
+		require.Equal(t, transactionID, alerts[0].TransactionID)
+		require.Equal(t, incoming, alerts[0].Incoming)
+	}
+
+	batch, err := testRepo.R.BatchTransferTx(context.Background(), models.BatchTransferTxParams{
+		FromAccountID: from.ID,
+		Currency:      from.Currency,
+		Mode:          models.BatchModeAllOrNothing,
+		Lines: []models.BatchTransferLine{
+			{ToAccountID: to.ID, Amount: 50},
+			{ToAccountID: to.ID, Amount: 300},
+		},
+	})
+	require.NoError(t, err)
+	requireAlert(batch.Alerts, batch.Lines[1].Transaction.ID, true)
+
+	pending := createRandomPendingTransfer(t, from, to, time.Now().Add(time.Hour))
+	approved, err := testRepo.R.DecidePendingTransferTx(context.Background(), models.DecidePendingTransferParams{
+		ID:        pending.ID,
+		DecidedBy: staff.UserName,
+		Approve:   true,
+	})
+	require.NoError(t, err)
+	requireAlert(approved.Transfer.Alerts, approved.Transfer.Transaction.ID, true)
+
+	// a refund leaves the receiving account as a large outgoing transfer
+	opened, err := testRepo.R.OpenDisputeTx(context.Background(), models.OpenDisputeParams{
+		TransactionID: approved.Transfer.Transaction.ID,
+		AccountID:     from.ID,
+		Amount:        400,
+		Reason:        "paid twice",
+		OpenedBy:      from.Owner,
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.UpdateDisputeTx(context.Background(), models.UpdateDisputeParams{
+		ID:        opened.Dispute.ID,
+		Status:    models.DisputeStatusRefundProposed,
+		DecidedBy: staff.UserName,
+	})
+	require.NoError(t, err)
+
+	resolved, err := testRepo.R.UpdateDisputeTx(context.Background(), models.UpdateDisputeParams{
+		ID:        opened.Dispute.ID,
+		Status:    models.DisputeStatusResolvedCustomer,
+		DecidedBy: createRandomUser(t).UserName,
+	})
+	require.NoError(t, err)
+	requireAlert(resolved.Alerts, *resolved.Dispute.RefundTransactionID, false)
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const transactionNoteColumns = `transaction_id, owner, note, tags, updated_at`
+
+func scanTransactionNote(row pgx.Row, note *models.TransactionNote) error {
+	return row.Scan(&note.TransactionID, &note.Owner, &note.Note, &note.Tags, &note.UpdatedAt)
+}
+
+const transactionAttachmentColumns = `id, transaction_id, owner, file_name, content_type, size, storage_key, created_at`
+
+func scanTransactionAttachment(row pgx.Row, attachment *models.TransactionAttachment) error {
+	return row.Scan(&attachment.ID, &attachment.TransactionID, &attachment.Owner, &attachment.FileName,
+		&attachment.ContentType, &attachment.Size, &attachment.StorageKey, &attachment.CreatedAt)
+}
+
+// SetTransactionNote saves the user's note and tags on a transaction, replacing what they saved before
+func (r *repositoryImpl) SetTransactionNote(ctx context.Context, note models.TransactionNote) (models.TransactionNote, error) {
+	var tn models.TransactionNote
+	query := `INSERT INTO transaction_notes (transaction_id, owner, note, tags) VALUES (@transactionID, @owner, @note, @tags)
+				ON CONFLICT (transaction_id, owner) DO UPDATE SET note = EXCLUDED.note, tags = EXCLUDED.tags, updated_at = now()
+				RETURNING ` + transactionNoteColumns
+	args := pgx.NamedArgs{
+		"transactionID": note.TransactionID,
+		"owner":         note.Owner,
+		"note":          note.Note,
+		"tags":          note.Tags,
+	}
+
+	err := scanTransactionNote(r.pool.QueryRow(ctx, query, args), &tn)
+	if err != nil {
+		return tn, err
+	}
+
+	return tn, nil
+}
+
+func (r *repositoryImpl) GetTransactionNote(ctx context.Context, transactionID int64, owner string) (models.TransactionNote, error) {
+	var note models.TransactionNote
+	query := `SELECT ` + transactionNoteColumns + ` FROM transaction_notes WHERE transaction_id = @transactionID AND owner = @owner`
+	args := pgx.NamedArgs{
+		"transactionID": transactionID,
+		"owner":         owner,
+	}
+
+	err := scanTransactionNote(r.pool.QueryRow(ctx, query, args), &note)
+	if err != nil {
+		return note, err
+	}
+
+	return note, nil
+}
+
+// SearchTransactionsByTag lists the account's transactions the user has tagged with the tag, newest first
+func (r *repositoryImpl) SearchTransactionsByTag(ctx context.Context, arg models.SearchTransactionsByTagParams) ([]models.TaggedTransaction, error) {
+	query := `SELECT t.id, t.from_account_id, (SELECT account_number FROM accounts WHERE id = t.from_account_id), t.to_account_id,
+				(SELECT account_number FROM accounts WHERE id = t.to_account_id), t.amount, t.fee, t.currency, t.description, t.created_at,
+				n.transaction_id, n.owner, n.note, n.tags, n.updated_at
+				FROM transactions t JOIN transaction_notes n ON n.transaction_id = t.id AND n.owner = @owner
+				WHERE (t.from_account_id = @accountID OR t.to_account_id = @accountID) AND n.tags @> ARRAY[@tag]::varchar[]
+				ORDER BY t.id DESC LIMIT @limit OFFSET @offset`
+	args := pgx.NamedArgs{
+		"accountID": arg.AccountID,
+		"owner":     arg.Owner,
+		"tag":       arg.Tag,
+		"limit":     arg.Limit,
+		"offset":    arg.Offset,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	transactions := []models.TaggedTransaction{}
+	for rows.Next() {
+		var tt models.TaggedTransaction
+		t, n := &tt.Transaction, &tt.Note
+		if err := rows.Scan(&t.ID, &t.FromAccountID, &t.FromAccountNumber, &t.ToAccountID, &t.ToAccountNumber, &t.Amount, &t.Fee,
+			&t.Currency, &t.Description, &t.CreatedAt,
+			&n.TransactionID, &n.Owner, &n.Note, &n.Tags, &n.UpdatedAt); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, tt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+func (r *repositoryImpl) CreateTransactionAttachment(ctx context.Context, attachment models.TransactionAttachment) (models.TransactionAttachment, error) {
+	var ta models.TransactionAttachment
+	query := `INSERT INTO transaction_attachments (transaction_id, owner, file_name, content_type, size, storage_key)
+				VALUES (@transactionID, @owner, @fileName, @contentType, @size, @storageKey)
+				RETURNING ` + transactionAttachmentColumns
+	args := pgx.NamedArgs{
+		"transactionID": attachment.TransactionID,
+		"owner":         attachment.Owner,
+		"fileName":      attachment.FileName,
+		"contentType":   attachment.ContentType,
+		"size":          attachment.Size,
+		"storageKey":    attachment.StorageKey,
+	}
+
+	err := scanTransactionAttachment(r.pool.QueryRow(ctx, query, args), &ta)
+	if err != nil {
+		return ta, err
+	}
+
+	return ta, nil
+}
+
+// GetTransactionAttachment gets one of the user's attachments, other users' attachments are not found
+func (r *repositoryImpl) GetTransactionAttachment(ctx context.Context, id int64, owner string) (models.TransactionAttachment, error) {
+	var attachment models.TransactionAttachment
+	query := `SELECT ` + transactionAttachmentColumns + ` FROM transaction_attachments WHERE id = @id AND owner = @owner`
+	args := pgx.NamedArgs{
+		"id":    id,
+		"owner": owner,
+	}
+
+	err := scanTransactionAttachment(r.pool.QueryRow(ctx, query, args), &attachment)
+	if err != nil {
+		return attachment, err
+	}
+
+	return attachment, nil
+}
+
+func (r *repositoryImpl) ListTransactionAttachments(ctx context.Context, transactionID int64, owner string) ([]models.TransactionAttachment, error) {
+	query := `SELECT ` + transactionAttachmentColumns + ` FROM transaction_attachments
+				WHERE transaction_id = @transactionID AND owner = @owner ORDER BY id`
+	args := pgx.NamedArgs{
+		"transactionID": transactionID,
+		"owner":         owner,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	attachments := []models.TransactionAttachment{}
+	for rows.Next() {
+		var attachment models.TransactionAttachment
+		if err := scanTransactionAttachment(rows, &attachment); err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+// DeleteTransactionAttachment deletes one of the user's attachments and returns it so its blob can be removed
+func (r *repositoryImpl) DeleteTransactionAttachment(ctx context.Context, id int64, owner string) (models.TransactionAttachment, error) {
+	var attachment models.TransactionAttachment
+	query := `DELETE FROM transaction_attachments WHERE id = @id AND owner = @owner RETURNING ` + transactionAttachmentColumns
+	args := pgx.NamedArgs{
+		"id":    id,
+		"owner": owner,
+	}
+
+	err := scanTransactionAttachment(r.pool.QueryRow(ctx, query, args), &attachment)
+	if err != nil {
+		return attachment, err
+	}
+
+	return attachment, nil
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestTransactionAnnotations(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+
+	transfer := func() models.Transaction {
+		result, err := testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        10,
+			Currency:      from.Currency,
+		})
+		require.NoError(t, err)
+		return result.Transaction
+	}
+
+	lunch := transfer()
+	taxi := transfer()
+
+	note, err := testRepo.R.SetTransactionNote(context.Background(), models.TransactionNote{
+		TransactionID: lunch.ID,
+		Owner:         from.Owner,
+		Note:          "team lunch",
+		Tags:          []string{"work", "food"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"work", "food"}, note.Tags)
+
+	// saving again replaces the note
+	note, err = testRepo.R.SetTransactionNote(context.Background(), models.TransactionNote{
+		TransactionID: lunch.ID,
+		Owner:         from.Owner,
+		Note:          "team lunch, expensed",
+		Tags:          []string{"work"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "team lunch, expensed", note.Note)
+
+	_, err = testRepo.R.SetTransactionNote(context.Background(), models.TransactionNote{
+		TransactionID: taxi.ID,
+		Owner:         from.Owner,
+		Tags:          []string{"work", "travel"},
+	})
+	require.NoError(t, err)
+
+	// the other side of the transfer has its own, separate annotations
+	_, err = testRepo.R.SetTransactionNote(context.Background(), models.TransactionNote{
+		TransactionID: lunch.ID,
+		Owner:         to.Owner,
+		Tags:          []string{"work"},
+	})
+	require.NoError(t, err)
+
+	tagged, err := testRepo.R.SearchTransactionsByTag(context.Background(), models.SearchTransactionsByTagParams{
+		AccountID: from.ID,
+		Owner:     from.Owner,
+		Tag:       "work",
+		Limit:     5,
+	})
+	require.NoError(t, err)
+	require.Len(t, tagged, 2)
+	require.Equal(t, taxi.ID, tagged[0].Transaction.ID)
+	require.Equal(t, from.Owner, tagged[1].Note.Owner)
+
+	tagged, err = testRepo.R.SearchTransactionsByTag(context.Background(), models.SearchTransactionsByTagParams{
+		AccountID: from.ID,
+		Owner:     from.Owner,
+		Tag:       "food",
+		Limit:     5,
+	})
+	require.NoError(t, err)
+	require.Empty(t, tagged)
+
+	attachment, err := testRepo.R.CreateTransactionAttachment(context.Background(), models.TransactionAttachment{
+		TransactionID: lunch.ID,
+		Owner:         from.Owner,
+		FileName:      "receipt.pdf",
+		ContentType:   "application/pdf",
+		Size:          1024,
+		StorageKey:    "attachments/" + from.Owner,
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.GetTransactionAttachment(context.Background(), attachment.ID, to.Owner)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	attachments, err := testRepo.R.ListTransactionAttachments(context.Background(), lunch.ID, from.Owner)
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	require.Equal(t, attachment.StorageKey, attachments[0].StorageKey)
+
+	_, err = testRepo.R.DeleteTransactionAttachment(context.Background(), attachment.ID, to.Owner)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	deleted, err := testRepo.R.DeleteTransactionAttachment(context.Background(), attachment.ID, from.Owner)
+	require.NoError(t, err)
+	require.Equal(t, attachment.StorageKey, deleted.StorageKey)
+}
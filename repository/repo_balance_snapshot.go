@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+// CreateBalanceSnapshots records every account's balance at the end of the given UTC day by replaying the
+// entries since each account's previous snapshot. Snapshots are unique per account and day, so running it
+// again only fills in the accounts it missed.
+func (r *repositoryImpl) CreateBalanceSnapshots(ctx context.Context, day time.Time) (int64, error) {
+	query := `INSERT INTO account_balance_snapshots (account_id, snapshot_date, as_of, balance)
+				SELECT a.id, @day::date, @asOf::timestamptz, COALESCE(s.balance, 0) + COALESCE(e.total, 0) FROM accounts a
+				LEFT JOIN LATERAL (SELECT as_of, balance FROM account_balance_snapshots
+					WHERE account_id = a.id AND as_of <= @asOf ORDER BY as_of DESC LIMIT 1) s ON true
+				LEFT JOIN LATERAL (SELECT SUM(amount) AS total FROM entries
+					WHERE account_id = a.id AND created_at >= COALESCE(s.as_of, '-infinity') AND created_at < @asOf) e ON true
+				WHERE a.created_at < @asOf
+				ON CONFLICT (account_id, snapshot_date) DO NOTHING`
+	args := pgx.NamedArgs{
+		"day":  day,
+		"asOf": day.AddDate(0, 0, 1),
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// GetBalanceAt starts from the account's latest snapshot taken at or before the given time and adds the
+// entries created since, up to and including that time
+func (r *repositoryImpl) GetBalanceAt(ctx context.Context, accountID int64, at time.Time) (models.BalanceAt, error) {
+	query := `SELECT a.id, a.currency, COALESCE(s.balance, 0) + COALESCE(e.total, 0), s.snapshot_date FROM accounts a
+				LEFT JOIN LATERAL (SELECT snapshot_date, as_of, balance FROM account_balance_snapshots
+					WHERE account_id = a.id AND as_of <= @at ORDER BY as_of DESC LIMIT 1) s ON true
+				LEFT JOIN LATERAL (SELECT SUM(amount) AS total FROM entries
+					WHERE account_id = a.id AND created_at >= COALESCE(s.as_of, '-infinity') AND created_at <= @at) e ON true
+				WHERE a.id = @id`
+	args := pgx.NamedArgs{
+		"id": accountID,
+		"at": at,
+	}
+
+	balance := models.BalanceAt{At: at}
+	err := r.pool.QueryRow(ctx, query, args).Scan(&balance.AccountID, &balance.Currency, &balance.Balance, &balance.SnapshotDate)
+	if err != nil {
+		return balance, err
+	}
+
+	return balance, nil
+}
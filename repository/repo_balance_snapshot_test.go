@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestGetBalanceAt(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+
+	before := time.Now()
+
+	_, err := testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        40,
+		Currency:      from.Currency,
+		Fee:           1,
+	})
+	require.NoError(t, err)
+
+	// nothing was there before the transfer
+	balance, err := testRepo.R.GetBalanceAt(context.Background(), to.ID, before)
+	require.NoError(t, err)
+	require.Zero(t, balance.Balance)
+	require.Equal(t, to.Currency, balance.Currency)
+
+	// without a snapshot every entry is replayed
+	balance, err = testRepo.R.GetBalanceAt(context.Background(), to.ID, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, float64(40), balance.Balance)
+	require.Nil(t, balance.SnapshotDate)
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	created, err := testRepo.R.CreateBalanceSnapshots(context.Background(), today)
+	require.NoError(t, err)
+	require.NotZero(t, created)
+
+	// taking the snapshot again changes nothing for the accounts it already covered
+	_, err = testRepo.R.CreateBalanceSnapshots(context.Background(), today)
+	require.NoError(t, err)
+
+	balance, err = testRepo.R.GetBalanceAt(context.Background(), to.ID, today.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Equal(t, float64(40), balance.Balance)
+	require.NotNil(t, balance.SnapshotDate)
+	require.True(t, today.Equal(*balance.SnapshotDate))
+
+	_, err = testRepo.R.GetBalanceAt(context.Background(), 0, time.Now())
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
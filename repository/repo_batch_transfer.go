@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+// line level failures, reported in the result rather than returned
+var (
+	errUnknownRecipient = errors.New("recipient account does not exist")
+	errSameAccount      = errors.New("recipient is the source account")
+)
+
+// BatchTransferTx runs every line of a batch from one source account in a single transaction. All accounts
+// are locked up front and every line is validated before any money moves. In all or nothing mode a single
+// bad line rejects the batch with models.ErrBatchRejected, in best effort mode bad lines are reported and skipped.
+// Lines marked pending only reserve their amount and wait for approval as pending transfers, lines the fraud
+// rules blocked or a beneficiary limit refused fail like any other bad line.
+func (r *repositoryImpl) BatchTransferTx(ctx context.Context, arg models.BatchTransferTxParams) (models.BatchTransferTxResult, error) {
+	var result models.BatchTransferTxResult
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		result = models.BatchTransferTxResult{
+			Lines: make([]models.BatchTransferLineResult, len(arg.Lines)),
+		}
+
+		ids := make([]int64, 0, len(arg.Lines)+1)
+		ids = append(ids, arg.FromAccountID)
+		for _, line := range arg.Lines {
+			ids = append(ids, line.ToAccountID)
+		}
+
+		accounts, err := lockExistingAccounts(ctx, tx, ids...)
+		if err != nil {
+			return err
+		}
+
+		from, ok := accounts[arg.FromAccountID]
+		if !ok {
+			return pgx.ErrNoRows
+		}
+
+		if from.Currency != arg.Currency {
+			return models.ErrCurrencyMismatch
+		}
+
+		if err = checkDebit(from); err != nil {
+			return err
+		}
+
+		if !isMatured(from) {
+			return models.ErrAccountNotMatured
+		}
+
+		result.FromAccount = from
+
+		// validate every line against the locked rows before moving any money
+		// an arranged overdraft counts as money the batch may spend
+		available := from.AvailableBalance + from.OverdraftLimit
+		for i, line := range arg.Lines {
+			result.Lines[i] = models.BatchTransferLineResult{
+				Line:        i + 1,
+				ToAccountID: line.ToAccountID,
+				Amount:      line.Amount,
+			}
+
+			// a line refused before the batch got here fails with the reason it was refused
+			err := line.Refused
+			if err == nil {
+				err = checkBatchLine(accounts, arg, line)
+			}
+			if err != nil {
+				result.Lines[i].Status = models.BatchLineFailed
+				result.Lines[i].Error = err.Error()
+				result.Failed++
+				continue
+			}
+
+			// the service recorded the screening already, a rejected batch must not roll it back
+			if line.Screening != nil && line.Screening.Decision == models.FraudDecisionBlock {
+				result.Lines[i].Status = models.BatchLineFailed
+				result.Lines[i].Error = models.ErrTransferBlocked.Error()
+				result.Failed++
+				continue
+			}
+
+			if available < line.Amount {
+				result.Lines[i].Status = models.BatchLineFailed
+				result.Lines[i].Error = models.ErrInsufficientFunds.Error()
+				result.Failed++
+				continue
+			}
+
+			available = helpers.RoundMoney(available - line.Amount)
+		}
+
+		if result.Failed > 0 && arg.Mode == models.BatchModeAllOrNothing {
+			for i := range result.Lines {
+				if result.Lines[i].Status == "" {
+					result.Lines[i].Status = models.BatchLineSkipped
+				}
+			}
+			return models.ErrBatchRejected
+		}
+
+		for i, line := range arg.Lines {
+			if result.Lines[i].Status == models.BatchLineFailed {
+				continue
+			}
+
+			transferArg := models.TransferTxParams{
+				FromAccountID: arg.FromAccountID,
+				ToAccountID:   line.ToAccountID,
+				Amount:        line.Amount,
+				Currency:      arg.Currency,
+				Description:   line.Description,
+				InitiatedBy:   arg.InitiatedBy,
+			}
+
+			if line.Pending {
+				pending, err := createPendingTransfer(ctx, tx, models.CreatePendingTransferParams{
+					Transfer:  transferArg,
+					ExpiresAt: arg.PendingExpiresAt,
+					Screening: line.Screening,
+				})
+				if err != nil {
+					return err
+				}
+
+				result.Lines[i].Status = models.BatchLinePending
+				result.Lines[i].PendingTransfer = &pending
+				result.Pending++
+				continue
+			}
+
+			transfer, err := createTransfer(ctx, tx, transferArg)
+			if err != nil {
+				return err
+			}
+
+			if err = settleTransfer(ctx, tx, &transfer); err != nil {
+				return err
+			}
+
+			result.FromAccount = transfer.FromAccount
+			result.Alerts = append(result.Alerts, transfer.Alerts...)
+			result.Notices = append(result.Notices, transfer.Notices...)
+			result.Lines[i].Status = models.BatchLineCompleted
+			result.Lines[i].Transaction = &transfer.Transaction
+			result.Completed++
+		}
+
+		// holds for pending lines change the available balance without a transfer
+		result.FromAccount, err = getAccount(ctx, tx, arg.FromAccountID)
+		return err
+	})
+
+	return result, err
+}
+
+func checkBatchLine(accounts map[int64]models.Account, arg models.BatchTransferTxParams, line models.BatchTransferLine) error {
+	if line.ToAccountID == arg.FromAccountID {
+		return errSameAccount
+	}
+
+	to, ok := accounts[line.ToAccountID]
+	if !ok {
+		return errUnknownRecipient
+	}
+
+	if to.Currency != arg.Currency {
+		return models.ErrCurrencyMismatch
+	}
+
+	return checkCredit(to)
+}
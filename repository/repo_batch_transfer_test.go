@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+// createRandomAccountIn creates an account in the given currency, batches reject recipients in any other
+func createRandomAccountIn(t *testing.T, currency string) models.Account {
+	account, err := testRepo.R.CreateAccount(context.Background(), models.Account{
+		Owner:       createRandomUser(t).UserName,
+		Currency:    currency,
+		ProductCode: models.DefaultProductCode,
+	})
+	require.NoError(t, err)
+
+	return account
+}
+
+func TestBatchTransferTx(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+
+	to1 := createRandomAccountIn(t, from.Currency)
+	to2 := createRandomAccountIn(t, from.Currency)
+
+	result, err := testRepo.R.BatchTransferTx(context.Background(), models.BatchTransferTxParams{
+		FromAccountID: from.ID,
+		Currency:      from.Currency,
+		Mode:          models.BatchModeAllOrNothing,
+		Lines: []models.BatchTransferLine{
+			{ToAccountID: to1.ID, Amount: 100, Description: "salary"},
+			{ToAccountID: to2.ID, Amount: 250},
+			{ToAccountID: to1.ID, Amount: 50},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, result.Completed)
+	require.Zero(t, result.Failed)
+	require.Equal(t, from.Balance-400, result.FromAccount.Balance)
+
+	for i, line := range result.Lines {
+		require.Equal(t, i+1, line.Line)
+		require.Equal(t, models.BatchLineCompleted, line.Status)
+		require.NotNil(t, line.Transaction)
+		require.Equal(t, from.ID, line.Transaction.FromAccountID)
+		require.Equal(t, line.ToAccountID, line.Transaction.ToAccountID)
+	}
+
+	updated1, err := testRepo.R.GetAccount(context.Background(), to1.ID)
+	require.NoError(t, err)
+	require.Equal(t, to1.Balance+150, updated1.Balance)
+
+	updated2, err := testRepo.R.GetAccount(context.Background(), to2.ID)
+	require.NoError(t, err)
+	require.Equal(t, to2.Balance+250, updated2.Balance)
+}
+
+func TestBatchTransferTxAllOrNothing(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+
+	to := createRandomAccountIn(t, from.Currency)
+
+	result, err := testRepo.R.BatchTransferTx(context.Background(), models.BatchTransferTxParams{
+		FromAccountID: from.ID,
+		Currency:      from.Currency,
+		Mode:          models.BatchModeAllOrNothing,
+		Lines: []models.BatchTransferLine{
+			{ToAccountID: to.ID, Amount: 100},
+			{ToAccountID: from.ID, Amount: 10},
+			{ToAccountID: to.ID, Amount: from.Balance},
+		},
+	})
+	require.ErrorIs(t, err, models.ErrBatchRejected)
+	require.Equal(t, 2, result.Failed)
+	require.Equal(t, models.BatchLineSkipped, result.Lines[0].Status)
+	require.Equal(t, models.BatchLineFailed, result.Lines[1].Status)
+	require.Equal(t, models.BatchLineFailed, result.Lines[2].Status)
+	require.Equal(t, models.ErrInsufficientFunds.Error(), result.Lines[2].Error)
+
+	// nothing moved
+	updated, err := testRepo.R.GetAccount(context.Background(), from.ID)
+	require.NoError(t, err)
+	require.Equal(t, from.Balance, updated.Balance)
+}
+
+func TestBatchTransferTxBestEffort(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+
+	to := createRandomAccountIn(t, from.Currency)
+
+	result, err := testRepo.R.BatchTransferTx(context.Background(), models.BatchTransferTxParams{
+		FromAccountID: from.ID,
+		Currency:      from.Currency,
+		Mode:          models.BatchModeBestEffort,
+		Lines: []models.BatchTransferLine{
+			{ToAccountID: to.ID, Amount: 100},
+			{ToAccountID: -1, Amount: 10},
+			{ToAccountID: to.ID, Amount: from.Balance},
+			{ToAccountID: to.ID, Amount: 200},
+			{ToAccountID: to.ID, Amount: 50, Refused: models.ErrBeneficiaryCoolingOff},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Completed)
+	require.Equal(t, 3, result.Failed)
+	require.Equal(t, models.BatchLineCompleted, result.Lines[0].Status)
+	require.Equal(t, models.BatchLineFailed, result.Lines[1].Status)
+	require.Equal(t, models.BatchLineFailed, result.Lines[2].Status)
+	require.Equal(t, models.BatchLineCompleted, result.Lines[3].Status)
+	require.Equal(t, models.BatchLineFailed, result.Lines[4].Status)
+	require.Equal(t, models.ErrBeneficiaryCoolingOff.Error(), result.Lines[4].Error)
+	require.Equal(t, from.Balance-300, result.FromAccount.Balance)
+}
+
+func TestBatchTransferTxPendingLines(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+
+	result, err := testRepo.R.BatchTransferTx(context.Background(), models.BatchTransferTxParams{
+		FromAccountID: from.ID,
+		Currency:      from.Currency,
+		Mode:          models.BatchModeAllOrNothing,
+		Lines: []models.BatchTransferLine{
+			{ToAccountID: to.ID, Amount: 100},
+			{ToAccountID: to.ID, Amount: 600, Pending: true},
+		},
+		InitiatedBy:      from.Owner,
+		PendingExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Completed)
+	require.Equal(t, 1, result.Pending)
+
+	line := result.Lines[1]
+	require.Equal(t, models.BatchLinePending, line.Status)
+	require.Nil(t, line.Transaction)
+	require.NotNil(t, line.PendingTransfer)
+	require.Equal(t, models.PendingTransferPendingApproval, line.PendingTransfer.Status)
+	require.Equal(t, from.Owner, line.PendingTransfer.InitiatedBy)
+
+	// the pending line is reserved but hasn't moved
+	require.Equal(t, from.Balance-100, result.FromAccount.Balance)
+	require.Equal(t, from.Balance-700, result.FromAccount.AvailableBalance)
+
+	updated, err := testRepo.R.GetAccount(context.Background(), to.ID)
+	require.NoError(t, err)
+	require.Equal(t, to.Balance+100, updated.Balance)
+
+	// what the pending line reserves counts against the rest of the batch
+	result, err = testRepo.R.BatchTransferTx(context.Background(), models.BatchTransferTxParams{
+		FromAccountID: from.ID,
+		Currency:      from.Currency,
+		Mode:          models.BatchModeAllOrNothing,
+		Lines: []models.BatchTransferLine{
+			{ToAccountID: to.ID, Amount: 200, Pending: true},
+			{ToAccountID: to.ID, Amount: 150},
+		},
+		InitiatedBy:      from.Owner,
+		PendingExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.ErrorIs(t, err, models.ErrBatchRejected)
+	require.Equal(t, models.BatchLineFailed, result.Lines[1].Status)
+}
+
+func TestBatchTransferTxScreenedLines(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+
+	screening := func(decision string) *models.FraudScreening {
+		return &models.FraudScreening{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        50,
+			Currency:      from.Currency,
+			InitiatedBy:   from.Owner,
+			Decision:      decision,
+			Reasons:       []string{"new_payee: first transfer to this payee"},
+		}
+	}
+
+	result, err := testRepo.R.BatchTransferTx(context.Background(), models.BatchTransferTxParams{
+		FromAccountID: from.ID,
+		Currency:      from.Currency,
+		Mode:          models.BatchModeBestEffort,
+		Lines: []models.BatchTransferLine{
+			{ToAccountID: to.ID, Amount: 50, Pending: true, Screening: screening(models.FraudDecisionReview)},
+			{ToAccountID: to.ID, Amount: 50, Screening: screening(models.FraudDecisionBlock)},
+		},
+		InitiatedBy:      from.Owner,
+		PendingExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Pending)
+	require.Equal(t, 1, result.Failed)
+	require.Equal(t, models.ErrTransferBlocked.Error(), result.Lines[1].Error)
+
+	// the reviewed line waits on its screening like a single transfer would
+	pending := result.Lines[0].PendingTransfer
+	require.NotNil(t, pending)
+
+	screenings, err := testRepo.R.ListFraudScreenings(context.Background(), models.FraudScreeningOpen, 1000, 0)
+	require.NoError(t, err)
+
+	var linked int
+	for _, s := range screenings {
+		if s.PendingTransferID != nil && *s.PendingTransferID == pending.ID {
+			linked++
+		}
+	}
+	require.Equal(t, 1, linked)
+}
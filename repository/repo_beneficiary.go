@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const beneficiaryColumns = `id, owner, nickname, account_id, (SELECT account_number FROM accounts WHERE id = account_id),
+	currency, transfer_limit, created_at, updated_at`
+
+func scanBeneficiary(row pgx.Row, beneficiary *models.Beneficiary) error {
+	return row.Scan(&beneficiary.ID, &beneficiary.Owner, &beneficiary.Nickname, &beneficiary.AccountID,
+		&beneficiary.AccountNumber, &beneficiary.Currency, &beneficiary.TransferLimit, &beneficiary.CreatedAt,
+		&beneficiary.UpdatedAt)
+}
+
+// beneficiaryError turns the unique index violations on nickname and account into models.ErrBeneficiaryExists
+func beneficiaryError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return models.ErrBeneficiaryExists
+	}
+	return err
+}
+
+func (r *repositoryImpl) CreateBeneficiary(ctx context.Context, beneficiary models.Beneficiary) (models.Beneficiary, error) {
+	var b models.Beneficiary
+	query := `INSERT INTO beneficiaries (owner, nickname, account_id, currency, transfer_limit) VALUES
+				(@owner, @nickname, @accountID, @currency, @transferLimit) RETURNING ` + beneficiaryColumns
+	args := pgx.NamedArgs{
+		"owner":         beneficiary.Owner,
+		"nickname":      beneficiary.Nickname,
+		"accountID":     beneficiary.AccountID,
+		"currency":      beneficiary.Currency,
+		"transferLimit": beneficiary.TransferLimit,
+	}
+
+	err := scanBeneficiary(r.pool.QueryRow(ctx, query, args), &b)
+	return b, beneficiaryError(err)
+}
+
+// GetBeneficiary only finds the owner's own beneficiaries, anyone else's look like they do not exist
+func (r *repositoryImpl) GetBeneficiary(ctx context.Context, id int64, owner string) (models.Beneficiary, error) {
+	var beneficiary models.Beneficiary
+	query := `SELECT ` + beneficiaryColumns + ` FROM beneficiaries WHERE id = @id AND owner = @owner`
+	args := pgx.NamedArgs{
+		"id":    id,
+		"owner": owner,
+	}
+
+	err := scanBeneficiary(r.pool.QueryRow(ctx, query, args), &beneficiary)
+	return beneficiary, err
+}
+
+// GetBeneficiaryByAccount finds the owner's beneficiary for the account, each account is saved at most once
+// per owner
+func (r *repositoryImpl) GetBeneficiaryByAccount(ctx context.Context, owner string, accountID int64) (models.Beneficiary, error) {
+	var beneficiary models.Beneficiary
+	query := `SELECT ` + beneficiaryColumns + ` FROM beneficiaries WHERE owner = @owner AND account_id = @accountID`
+	args := pgx.NamedArgs{
+		"owner":     owner,
+		"accountID": accountID,
+	}
+
+	err := scanBeneficiary(r.pool.QueryRow(ctx, query, args), &beneficiary)
+	return beneficiary, err
+}
+
+func (r *repositoryImpl) ListBeneficiaries(ctx context.Context, owner string) ([]models.Beneficiary, error) {
+	query := `SELECT ` + beneficiaryColumns + ` FROM beneficiaries WHERE owner = @owner ORDER BY nickname`
+	args := pgx.NamedArgs{
+		"owner": owner,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	beneficiaries := []models.Beneficiary{}
+	for rows.Next() {
+		var beneficiary models.Beneficiary
+		if err := scanBeneficiary(rows, &beneficiary); err != nil {
+			return nil, err
+		}
+		beneficiaries = append(beneficiaries, beneficiary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return beneficiaries, nil
+}
+
+func (r *repositoryImpl) UpdateBeneficiary(ctx context.Context, arg models.UpdateBeneficiaryParams) (models.Beneficiary, error) {
+	var beneficiary models.Beneficiary
+	query := `UPDATE beneficiaries SET
+				nickname = COALESCE(@nickname, nickname),
+				transfer_limit = CASE WHEN @setLimit THEN NULLIF(GREATEST(@transferLimit::float, 0), 0) ELSE transfer_limit END,
+				updated_at = now()
+				WHERE id = @id AND owner = @owner RETURNING ` + beneficiaryColumns
+	args := pgx.NamedArgs{
+		"id":            arg.ID,
+		"owner":         arg.Owner,
+		"nickname":      arg.Nickname,
+		"setLimit":      arg.TransferLimit.Valid,
+		"transferLimit": arg.TransferLimit.Float64,
+	}
+
+	err := scanBeneficiary(r.pool.QueryRow(ctx, query, args), &beneficiary)
+	return beneficiary, beneficiaryError(err)
+}
+
+func (r *repositoryImpl) DeleteBeneficiary(ctx context.Context, id int64, owner string) error {
+	query := `DELETE FROM beneficiaries WHERE id = @id AND owner = @owner`
+	args := pgx.NamedArgs{
+		"id":    id,
+		"owner": owner,
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func createRandomBeneficiary(t *testing.T, owner string) models.Beneficiary {
+	account := createRandomAccount(t)
+	limit := float64(helpers.RandomMoney())
+
+	arg := models.Beneficiary{
+		Owner:         owner,
+		Nickname:      helpers.RandomOwner(),
+		AccountID:     account.ID,
+		Currency:      account.Currency,
+		TransferLimit: &limit,
+	}
+
+	beneficiary, err := testRepo.R.CreateBeneficiary(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, beneficiary.ID)
+	require.Equal(t, arg.Owner, beneficiary.Owner)
+	require.Equal(t, arg.Nickname, beneficiary.Nickname)
+	require.Equal(t, arg.AccountID, beneficiary.AccountID)
+	require.Equal(t, arg.Currency, beneficiary.Currency)
+	require.Equal(t, limit, *beneficiary.TransferLimit)
+	require.NotZero(t, beneficiary.CreatedAt)
+
+	return beneficiary
+}
+
+func TestCreateBeneficiary(t *testing.T) {
+	user := createRandomUser(t)
+	beneficiary := createRandomBeneficiary(t, user.UserName)
+
+	// the same payee cannot be saved twice
+	_, err := testRepo.R.CreateBeneficiary(context.Background(), models.Beneficiary{
+		Owner:     user.UserName,
+		Nickname:  helpers.RandomOwner(),
+		AccountID: beneficiary.AccountID,
+		Currency:  beneficiary.Currency,
+	})
+	require.ErrorIs(t, err, models.ErrBeneficiaryExists)
+}
+
+func TestGetBeneficiaryOnlyForOwner(t *testing.T) {
+	user := createRandomUser(t)
+	beneficiary := createRandomBeneficiary(t, user.UserName)
+
+	got, err := testRepo.R.GetBeneficiary(context.Background(), beneficiary.ID, user.UserName)
+	require.NoError(t, err)
+	require.Equal(t, beneficiary.ID, got.ID)
+
+	_, err = testRepo.R.GetBeneficiary(context.Background(), beneficiary.ID, createRandomUser(t).UserName)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	got, err = testRepo.R.GetBeneficiaryByAccount(context.Background(), user.UserName, beneficiary.AccountID)
+	require.NoError(t, err)
+	require.Equal(t, beneficiary.ID, got.ID)
+
+	_, err = testRepo.R.GetBeneficiaryByAccount(context.Background(), createRandomUser(t).UserName, beneficiary.AccountID)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestListBeneficiaries(t *testing.T) {
+	user := createRandomUser(t)
+	for i := 0; i < 3; i++ {
+		createRandomBeneficiary(t, user.UserName)
+	}
+
+	beneficiaries, err := testRepo.R.ListBeneficiaries(context.Background(), user.UserName)
+	require.NoError(t, err)
+	require.Len(t, beneficiaries, 3)
+
+	for _, beneficiary := range beneficiaries {
+		require.Equal(t, user.UserName, beneficiary.Owner)
+	}
+}
+
+func TestUpdateBeneficiary(t *testing.T) {
+	user := createRandomUser(t)
+	beneficiary := createRandomBeneficiary(t, user.UserName)
+
+	nickname := helpers.RandomOwner()
+	updated, err := testRepo.R.UpdateBeneficiary(context.Background(), models.UpdateBeneficiaryParams{
+		ID:       beneficiary.ID,
+		Owner:    user.UserName,
+		Nickname: sql.NullString{String: nickname, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, nickname, updated.Nickname)
+	require.Equal(t, *beneficiary.TransferLimit, *updated.TransferLimit)
+	require.Equal(t, beneficiary.AccountID, updated.AccountID)
+
+	// a zero limit removes it
+	updated, err = testRepo.R.UpdateBeneficiary(context.Background(), models.UpdateBeneficiaryParams{
+		ID:            beneficiary.ID,
+		Owner:         user.UserName,
+		TransferLimit: sql.NullFloat64{Float64: 0, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Nil(t, updated.TransferLimit)
+	require.Equal(t, nickname, updated.Nickname)
+}
+
+func TestDeleteBeneficiary(t *testing.T) {
+	user := createRandomUser(t)
+	beneficiary := createRandomBeneficiary(t, user.UserName)
+
+	err := testRepo.R.DeleteBeneficiary(context.Background(), beneficiary.ID, createRandomUser(t).UserName)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	err = testRepo.R.DeleteBeneficiary(context.Background(), beneficiary.ID, user.UserName)
+	require.NoError(t, err)
+
+	_, err = testRepo.R.GetBeneficiary(context.Background(), beneficiary.ID, user.UserName)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+// closedBusinessDateCode is the SQLSTATE the entries trigger raises for postings dated in a closed business day
+const closedBusinessDateCode = "SB001"
+
+// postingError turns the closed business date trigger's error into models.ErrBusinessDateClosed
+func postingError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == closedBusinessDateCode {
+		return fmt.Errorf("%w: %s", models.ErrBusinessDateClosed, pgErr.Message)
+	}
+	return err
+}
+
+// GetLastClosedBusinessDate returns nil when no business day was closed yet
+func (r *repositoryImpl) GetLastClosedBusinessDate(ctx context.Context) (*time.Time, error) {
+	var day *time.Time
+	err := r.pool.QueryRow(ctx, `SELECT max(business_date) FROM business_days`).Scan(&day)
+	if err != nil {
+		return nil, err
+	}
+
+	return day, nil
+}
+
+// CloseBusinessDayTx closes the given UTC day and stores one trial balance per currency. Closing fails, and the
+// day stays open, with models.ErrTrialBalanceUnbalanced if any currency doesn't net to zero, or with
+// models.ErrLedgerMismatch if any account's balance differs from the sum of its entries.
+func (r *repositoryImpl) CloseBusinessDayTx(ctx context.Context, day time.Time) ([]models.TrialBalance, error) {
+	var trialBalances []models.TrialBalance
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		// wait for postings already in flight and hold new ones back until the day is locked
+		if _, err := tx.Exec(ctx, `LOCK TABLE entries IN SHARE MODE`); err != nil {
+			return err
+		}
+
+		query := `INSERT INTO business_days (business_date) VALUES (@day::date) ON CONFLICT DO NOTHING`
+		tag, err := tx.Exec(ctx, query, pgx.NamedArgs{"day": day})
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return models.ErrBusinessDateClosed
+		}
+
+		trialBalances, err = computeTrialBalances(ctx, tx, day)
+		if err != nil {
+			return err
+		}
+
+		for i := range trialBalances {
+			if err := createTrialBalance(ctx, tx, &trialBalances[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return trialBalances, err
+}
+
+// computeTrialBalances groups every account's entries for the day and closing balance by currency. Closing
+// balances start from the latest balance snapshot so only the entries since then are summed. The same sum carried
+// up to now must match the account's stored balance, entries are locked so nothing moves in between.
+func computeTrialBalances(ctx context.Context, tx pgx.Tx, day time.Time) ([]models.TrialBalance, error) {
+	query := `SELECT a.id, a.account_number, a.owner, a.currency, a.balance, i.code, COALESCE(d.debits, 0),
+				COALESCE(d.credits, 0), COALESCE(d.count, 0), COALESCE(s.balance, 0) + COALESCE(b.total, 0),
+				COALESCE(s.balance, 0) + COALESCE(b.ledger, 0) FROM accounts a
+				LEFT JOIN internal_accounts i ON i.account_id = a.id
+				LEFT JOIN LATERAL (SELECT -SUM(amount) FILTER (WHERE amount < 0) AS debits,
+					SUM(amount) FILTER (WHERE amount > 0) AS credits, count(*) AS count FROM entries
+					WHERE account_id = a.id AND created_at >= @dayStart AND created_at < @dayEnd) d ON true
+				LEFT JOIN LATERAL (SELECT as_of, balance FROM account_balance_snapshots
+					WHERE account_id = a.id AND as_of <= @dayEnd ORDER BY as_of DESC LIMIT 1) s ON true
+				LEFT JOIN LATERAL (SELECT SUM(amount) FILTER (WHERE created_at < @dayEnd) AS total, SUM(amount) AS ledger
+					FROM entries WHERE account_id = a.id AND created_at >= COALESCE(s.as_of, '-infinity')) b ON true
+				WHERE a.created_at < @dayEnd
+				ORDER BY a.currency, a.id`
+	args := pgx.NamedArgs{
+		"dayStart": day,
+		"dayEnd":   day.AddDate(0, 0, 1),
+	}
+
+	rows, err := tx.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	trialBalances := []models.TrialBalance{}
+	for rows.Next() {
+		var line models.TrialBalanceLine
+		var accountNumber, currency string
+		var count int64
+		var balance, ledger float64
+		if err := rows.Scan(&line.AccountID, &accountNumber, &line.Owner, &currency, &balance, &line.InternalCode,
+			&line.Debits, &line.Credits, &count, &line.Balance, &ledger); err != nil {
+			return nil, err
+		}
+
+		if helpers.RoundMoney(ledger) != helpers.RoundMoney(balance) {
+			return nil, fmt.Errorf("%w: account %s balance %.2f, entries %.2f", models.ErrLedgerMismatch,
+				accountNumber, balance, ledger)
+		}
+
+		// rows come ordered by currency, so a new currency starts a new trial balance
+		if n := len(trialBalances); n == 0 || trialBalances[n-1].Currency != currency {
+			trialBalances = append(trialBalances, models.TrialBalance{BusinessDate: day, Currency: currency})
+		}
+
+		tb := &trialBalances[len(trialBalances)-1]
+		tb.TotalDebits = helpers.RoundMoney(tb.TotalDebits + line.Debits)
+		tb.TotalCredits = helpers.RoundMoney(tb.TotalCredits + line.Credits)
+		tb.TotalBalance = helpers.RoundMoney(tb.TotalBalance + line.Balance)
+		tb.EntryCount += count
+		tb.Lines = append(tb.Lines, line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, tb := range trialBalances {
+		if tb.TotalDebits != tb.TotalCredits || tb.TotalBalance != 0 {
+			return nil, fmt.Errorf("%w: %s debits %.2f, credits %.2f, balance %.2f", models.ErrTrialBalanceUnbalanced,
+				tb.Currency, tb.TotalDebits, tb.TotalCredits, tb.TotalBalance)
+		}
+	}
+
+	return trialBalances, nil
+}
+
+func createTrialBalance(ctx context.Context, tx pgx.Tx, tb *models.TrialBalance) error {
+	query := `INSERT INTO trial_balances (business_date, currency, total_debits, total_credits, total_balance, entry_count)
+				VALUES (@businessDate::date, @currency, @totalDebits, @totalCredits, @totalBalance, @entryCount) RETURNING id, created_at`
+	args := pgx.NamedArgs{
+		"businessDate": tb.BusinessDate,
+		"currency":     tb.Currency,
+		"totalDebits":  tb.TotalDebits,
+		"totalCredits": tb.TotalCredits,
+		"totalBalance": tb.TotalBalance,
+		"entryCount":   tb.EntryCount,
+	}
+
+	if err := tx.QueryRow(ctx, query, args).Scan(&tb.ID, &tb.CreatedAt); err != nil {
+		return err
+	}
+
+	_, err := tx.CopyFrom(ctx, pgx.Identifier{"trial_balance_lines"},
+		[]string{"trial_balance_id", "account_id", "owner", "internal_code", "debits", "credits", "balance"},
+		pgx.CopyFromSlice(len(tb.Lines), func(i int) ([]any, error) {
+			line := tb.Lines[i]
+			return []any{tb.ID, line.AccountID, line.Owner, line.InternalCode, line.Debits, line.Credits, line.Balance}, nil
+		}),
+	)
+	return err
+}
+
+// GetTrialBalances returns the trial balances stored when the day was closed, with their lines, ordered by currency
+func (r *repositoryImpl) GetTrialBalances(ctx context.Context, day time.Time) ([]models.TrialBalance, error) {
+	query := `SELECT id, business_date, currency, total_debits, total_credits, total_balance, entry_count, created_at
+				FROM trial_balances WHERE business_date = @day::date ORDER BY currency`
+
+	rows, err := r.pool.Query(ctx, query, pgx.NamedArgs{"day": day})
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	trialBalances := []models.TrialBalance{}
+	for rows.Next() {
+		var tb models.TrialBalance
+		if err := rows.Scan(&tb.ID, &tb.BusinessDate, &tb.Currency, &tb.TotalDebits, &tb.TotalCredits, &tb.TotalBalance,
+			&tb.EntryCount, &tb.CreatedAt); err != nil {
+			return nil, err
+		}
+		trialBalances = append(trialBalances, tb)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range trialBalances {
+		trialBalances[i].Lines, err = r.listTrialBalanceLines(ctx, trialBalances[i].ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return trialBalances, nil
+}
+
+func (r *repositoryImpl) listTrialBalanceLines(ctx context.Context, trialBalanceID int64) ([]models.TrialBalanceLine, error) {
+	query := `SELECT account_id, owner, internal_code, debits, credits, balance FROM trial_balance_lines
+				WHERE trial_balance_id = @trialBalanceID ORDER BY account_id`
+
+	rows, err := r.pool.Query(ctx, query, pgx.NamedArgs{"trialBalanceID": trialBalanceID})
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	lines := []models.TrialBalanceLine{}
+	for rows.Next() {
+		var line models.TrialBalanceLine
+		if err := rows.Scan(&line.AccountID, &line.Owner, &line.InternalCode, &line.Debits, &line.Credits, &line.Balance); err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, rows.Err()
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestCloseBusinessDay(t *testing.T) {
+	// a day long before any test data, so it balances trivially and no other test has closed it
+	day := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, int(helpers.RandomInt(0, 9000)))
+
+	trialBalances, err := testRepo.R.CloseBusinessDayTx(context.Background(), day)
+	require.NoError(t, err)
+	require.Empty(t, trialBalances)
+
+	lastClosed, err := testRepo.R.GetLastClosedBusinessDate(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, lastClosed)
+	require.False(t, lastClosed.Before(day))
+
+	_, err = testRepo.R.CloseBusinessDayTx(context.Background(), day)
+	require.ErrorIs(t, err, models.ErrBusinessDateClosed)
+
+	stored, err := testRepo.R.GetTrialBalances(context.Background(), day)
+	require.NoError(t, err)
+	require.Empty(t, stored)
+
+	// backdated postings into the closed day are refused by the database
+	account := createRandomAccount(t)
+	query := `INSERT INTO entries (account_id, amount, created_at) VALUES (@accountID, @amount, @createdAt)`
+	args := pgx.NamedArgs{
+		"accountID": account.ID,
+		"amount":    10,
+		"createdAt": day.Add(time.Hour),
+	}
+
+	_, err = testRepo.R.(*repositoryImpl).pool.Exec(context.Background(), query, args)
+	var pgErr *pgconn.PgError
+	require.True(t, errors.As(err, &pgErr))
+	require.Equal(t, closedBusinessDateCode, pgErr.Code)
+	require.ErrorIs(t, postingError(err), models.ErrBusinessDateClosed)
+}
+
+func TestCloseBusinessDayLedgerMismatch(t *testing.T) {
+	// earlier than the days TestCloseBusinessDay closes, so the account below never shows up in them
+	day := time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, int(helpers.RandomInt(0, 9000)))
+	pool := testRepo.R.(*repositoryImpl).pool
+
+	// an account from that day whose balance was changed without posting any entries
+	account := createRandomAccountIn(t, helpers.RandomCurrency())
+	query := `UPDATE accounts SET created_at = @createdAt, balance = @balance WHERE id = @id`
+	_, err := pool.Exec(context.Background(), query, pgx.NamedArgs{
+		"id":        account.ID,
+		"createdAt": day.Add(time.Hour),
+		"balance":   25,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		query := `UPDATE accounts SET created_at = now(), balance = 0 WHERE id = @id`
+		_, err := pool.Exec(context.Background(), query, pgx.NamedArgs{"id": account.ID})
+		require.NoError(t, err)
+	})
+
+	_, err = testRepo.R.CloseBusinessDayTx(context.Background(), day)
+	require.ErrorIs(t, err, models.ErrLedgerMismatch)
+	require.ErrorContains(t, err, account.AccountNumber)
+
+	// the day stays open
+	stored, err := testRepo.R.GetTrialBalances(context.Background(), day)
+	require.NoError(t, err)
+	require.Empty(t, stored)
+}
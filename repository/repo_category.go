@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const categoryRuleColumns = `id, owner, kind, pattern, category_code, created_at`
+
+func scanCategoryRule(row pgx.Row, rule *models.CategoryRule) error {
+	return row.Scan(&rule.ID, &rule.Owner, &rule.Kind, &rule.Pattern, &rule.Category, &rule.CreatedAt)
+}
+
+// categorizedTransactions gives every transaction that debits or credits @accountID between @from and @to its
+// direction and category. A category the account's user picked wins over the owner's own rules, which win
+// over the shared ones, and among matching rules the longest pattern wins.
+const categorizedTransactions = `categorized AS (
+	SELECT t.amount, t.created_at, t.from_account_id = @accountID AS outflow,
+		COALESCE(o.category_code, r.category_code, @uncategorized) AS category
+	FROM transactions t
+	JOIN accounts a ON a.id = @accountID
+	JOIN accounts c ON c.id = CASE WHEN t.from_account_id = @accountID THEN t.to_account_id ELSE t.from_account_id END
+	LEFT JOIN transaction_categories o ON o.transaction_id = t.id AND o.account_id = @accountID
+	LEFT JOIN LATERAL (SELECT category_code FROM category_rules
+		WHERE (owner IS NULL OR owner = a.owner)
+		AND ((kind = @keyword AND strpos(lower(COALESCE(t.description, '')), lower(pattern)) > 0)
+			OR (kind = @merchant AND lower(c.owner) = lower(pattern)))
+		ORDER BY owner IS NULL, length(pattern) DESC, id LIMIT 1) r ON true
+	WHERE (t.from_account_id = @accountID OR t.to_account_id = @accountID)
+	AND t.created_at >= @from AND t.created_at < @to
+)`
+
+func categoryRuleError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return models.ErrCategoryRuleExists
+	}
+	return err
+}
+
+func (r *repositoryImpl) CreateCategoryRule(ctx context.Context, rule models.CategoryRule) (models.CategoryRule, error) {
+	var cr models.CategoryRule
+	query := `INSERT INTO category_rules (owner, kind, pattern, category_code) VALUES (@owner, @kind, @pattern, @category)
+				RETURNING ` + categoryRuleColumns
+	args := pgx.NamedArgs{
+		"owner":    rule.Owner,
+		"kind":     rule.Kind,
+		"pattern":  rule.Pattern,
+		"category": rule.Category,
+	}
+
+	err := scanCategoryRule(r.pool.QueryRow(ctx, query, args), &cr)
+	if err != nil {
+		return cr, categoryRuleError(err)
+	}
+
+	return cr, nil
+}
+
+// ListCategoryRules lists the user's own rules followed by the shared ones
+func (r *repositoryImpl) ListCategoryRules(ctx context.Context, owner string) ([]models.CategoryRule, error) {
+	query := `SELECT ` + categoryRuleColumns + ` FROM category_rules WHERE owner IS NULL OR owner = @owner
+				ORDER BY owner IS NULL, id`
+	args := pgx.NamedArgs{
+		"owner": owner,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	rules := []models.CategoryRule{}
+	for rows.Next() {
+		var rule models.CategoryRule
+		if err := scanCategoryRule(rows, &rule); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// DeleteCategoryRule deletes one of the user's own rules, the shared ones can't be deleted
+func (r *repositoryImpl) DeleteCategoryRule(ctx context.Context, id int64, owner string) error {
+	query := `DELETE FROM category_rules WHERE id = @id AND owner = @owner`
+	args := pgx.NamedArgs{
+		"id":    id,
+		"owner": owner,
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// SetTransactionCategory puts one account's side of a transaction in a category, replacing any category
+// picked for it before
+func (r *repositoryImpl) SetTransactionCategory(ctx context.Context, category models.TransactionCategory) (models.TransactionCategory, error) {
+	var tc models.TransactionCategory
+
+	transaction, err := r.GetTransaction(ctx, category.TransactionID)
+	if err != nil {
+		return tc, err
+	}
+
+	if transaction.FromAccountID != category.AccountID && transaction.ToAccountID != category.AccountID {
+		return tc, models.ErrTransactionNotOnAccount
+	}
+
+	query := `INSERT INTO transaction_categories (transaction_id, account_id, category_code, set_by)
+				VALUES (@transactionID, @accountID, @category, @setBy)
+				ON CONFLICT (transaction_id, account_id) DO UPDATE SET category_code = EXCLUDED.category_code,
+				set_by = EXCLUDED.set_by, created_at = now()
+				RETURNING transaction_id, account_id, category_code, set_by, created_at`
+	args := pgx.NamedArgs{
+		"transactionID": category.TransactionID,
+		"accountID":     category.AccountID,
+		"category":      category.Category,
+		"setBy":         category.SetBy,
+	}
+
+	err = r.pool.QueryRow(ctx, query, args).Scan(&tc.TransactionID, &tc.AccountID, &tc.Category, &tc.SetBy, &tc.CreatedAt)
+	if err != nil {
+		return tc, err
+	}
+
+	return tc, nil
+}
+
+// GetSpendingAnalytics totals the account's inflows and outflows over the period, splits the outflows by
+// category and trends both per calendar month (UTC), including months without any transactions
+func (r *repositoryImpl) GetSpendingAnalytics(ctx context.Context, arg models.SpendingAnalyticsParams) (models.SpendingAnalytics, error) {
+	analytics := models.SpendingAnalytics{
+		AccountID: arg.AccountID,
+		From:      arg.From,
+		To:        arg.To,
+	}
+	args := pgx.NamedArgs{
+		"accountID":     arg.AccountID,
+		"from":          arg.From,
+		"to":            arg.To,
+		"keyword":       models.CategoryRuleKeyword,
+		"merchant":      models.CategoryRuleMerchant,
+		"uncategorized": models.CategoryUncategorized,
+	}
+
+	// make sure the account exists, the aggregates below would just come back empty
+	if _, err := r.GetAccount(ctx, arg.AccountID); err != nil {
+		return analytics, err
+	}
+
+	query := `WITH ` + categorizedTransactions + `
+				SELECT COALESCE(SUM(amount) FILTER (WHERE NOT outflow), 0), COALESCE(SUM(amount) FILTER (WHERE outflow), 0)
+				FROM categorized`
+
+	if err := r.pool.QueryRow(ctx, query, args).Scan(&analytics.Inflows, &analytics.Outflows); err != nil {
+		return analytics, err
+	}
+
+	query2 := `WITH ` + categorizedTransactions + `
+				SELECT category, SUM(amount), count(*) FROM categorized WHERE outflow
+				GROUP BY category ORDER BY SUM(amount) DESC, category`
+
+	var err error
+	analytics.Categories, err = r.listCategorySpend(ctx, query2, args)
+	if err != nil {
+		return analytics, err
+	}
+
+	query3 := `WITH ` + categorizedTransactions + `, months AS (
+					SELECT generate_series(date_trunc('month', @from::timestamptz AT TIME ZONE 'UTC'),
+						@to::timestamptz AT TIME ZONE 'UTC' - interval '1 microsecond', interval '1 month') AS month
+				), totals AS (
+					SELECT m.month, COALESCE(SUM(c.amount) FILTER (WHERE NOT c.outflow), 0) AS inflows,
+						COALESCE(SUM(c.amount) FILTER (WHERE c.outflow), 0) AS outflows
+					FROM months m LEFT JOIN categorized c ON date_trunc('month', c.created_at AT TIME ZONE 'UTC') = m.month
+					GROUP BY m.month
+				)
+				SELECT month AT TIME ZONE 'UTC', inflows, outflows, outflows - lag(outflows) OVER (ORDER BY month)
+				FROM totals ORDER BY month`
+
+	analytics.Months, err = r.listMonthlyTrends(ctx, query3, args)
+	return analytics, err
+}
+
+func (r *repositoryImpl) listCategorySpend(ctx context.Context, query string, args pgx.NamedArgs) ([]models.CategorySpend, error) {
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	categories := []models.CategorySpend{}
+	for rows.Next() {
+		var spend models.CategorySpend
+		if err := rows.Scan(&spend.Category, &spend.Amount, &spend.Count); err != nil {
+			return nil, err
+		}
+		categories = append(categories, spend)
+	}
+
+	return categories, rows.Err()
+}
+
+func (r *repositoryImpl) listMonthlyTrends(ctx context.Context, query string, args pgx.NamedArgs) ([]models.MonthlyTrend, error) {
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	months := []models.MonthlyTrend{}
+	for rows.Next() {
+		var trend models.MonthlyTrend
+		if err := rows.Scan(&trend.Month, &trend.Inflows, &trend.Outflows, &trend.OutflowsChange); err != nil {
+			return nil, err
+		}
+		months = append(months, trend)
+	}
+
+	return months, rows.Err()
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestGetSpendingAnalytics(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+
+	transfer := func(from, to models.Account, amount float64, description string) models.Transaction {
+		result, err := testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        amount,
+			Currency:      from.Currency,
+			Description:   description,
+		})
+		require.NoError(t, err)
+		return result.Transaction
+	}
+
+	transfer(from, to, 50, "Weekly shop at the SUPERMARKET")
+	transfer(from, to, 20, "Dinner at a restaurant")
+	misc := transfer(from, to, 10, "misc")
+	transfer(from, to, 5, "gym")
+	transfer(to, from, 15, "refund")
+
+	// the owner's own rule wins over a shared one with a longer pattern
+	rule, err := testRepo.R.CreateCategoryRule(context.Background(), models.CategoryRule{
+		Owner:    &from.Owner,
+		Kind:     models.CategoryRuleKeyword,
+		Pattern:  "dinner",
+		Category: models.CategoryEntertainment,
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.CreateCategoryRule(context.Background(), models.CategoryRule{
+		Owner:    &from.Owner,
+		Kind:     models.CategoryRuleKeyword,
+		Pattern:  "DINNER",
+		Category: models.CategoryDining,
+	})
+	require.ErrorIs(t, err, models.ErrCategoryRuleExists)
+
+	_, err = testRepo.R.SetTransactionCategory(context.Background(), models.TransactionCategory{
+		TransactionID: misc.ID,
+		AccountID:     from.ID,
+		Category:      models.CategoryShopping,
+		SetBy:         from.Owner,
+	})
+	require.NoError(t, err)
+
+	other := createRandomAccount(t)
+	_, err = testRepo.R.SetTransactionCategory(context.Background(), models.TransactionCategory{
+		TransactionID: misc.ID,
+		AccountID:     other.ID,
+		Category:      models.CategoryShopping,
+		SetBy:         other.Owner,
+	})
+	require.ErrorIs(t, err, models.ErrTransactionNotOnAccount)
+
+	now := time.Now().UTC()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	analytics, err := testRepo.R.GetSpendingAnalytics(context.Background(), models.SpendingAnalyticsParams{
+		AccountID: from.ID,
+		From:      month.AddDate(0, -1, 0),
+		To:        month.AddDate(0, 1, 0),
+	})
+	require.NoError(t, err)
+	require.Equal(t, float64(15), analytics.Inflows)
+	require.Equal(t, float64(85), analytics.Outflows)
+	require.Equal(t, []models.CategorySpend{
+		{Category: models.CategoryGroceries, Amount: 50, Count: 1},
+		{Category: models.CategoryEntertainment, Amount: 20, Count: 1},
+		{Category: models.CategoryShopping, Amount: 10, Count: 1},
+		{Category: models.CategoryUncategorized, Amount: 5, Count: 1},
+	}, analytics.Categories)
+
+	// the empty month before still shows up so the change is against it
+	require.Len(t, analytics.Months, 2)
+	require.Zero(t, analytics.Months[0].Outflows)
+	require.Nil(t, analytics.Months[0].OutflowsChange)
+	require.True(t, month.Equal(analytics.Months[1].Month))
+	require.Equal(t, float64(85), analytics.Months[1].Outflows)
+	require.Equal(t, float64(85), *analytics.Months[1].OutflowsChange)
+
+	// the receiving side sees its own categories, without the sender's rules and overrides
+	analytics, err = testRepo.R.GetSpendingAnalytics(context.Background(), models.SpendingAnalyticsParams{
+		AccountID: to.ID,
+		From:      month,
+		To:        month.AddDate(0, 1, 0),
+	})
+	require.NoError(t, err)
+	require.Equal(t, float64(85), analytics.Inflows)
+	require.Equal(t, float64(15), analytics.Outflows)
+
+	err = testRepo.R.DeleteCategoryRule(context.Background(), rule.ID, to.Owner)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	err = testRepo.R.DeleteCategoryRule(context.Background(), rule.ID, from.Owner)
+	require.NoError(t, err)
+}
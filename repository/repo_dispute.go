@@ -0,0 +1,323 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const disputeColumns = `id, transaction_id, account_id, (SELECT account_number FROM accounts WHERE id = account_id),
+	receiver_account_id, (SELECT account_number FROM accounts WHERE id = receiver_account_id), amount, currency, reason,
+	evidence, status, opened_by, hold_id, refund_transaction_id, refund_proposed_by, decided_by, comment, resolved_at,
+	updated_at, created_at`
+
+func scanDispute(row pgx.Row, dispute *models.Dispute) error {
+	return row.Scan(&dispute.ID, &dispute.TransactionID, &dispute.AccountID, &dispute.AccountNumber,
+		&dispute.ReceiverAccountID, &dispute.ReceiverAccountNumber, &dispute.Amount, &dispute.Currency, &dispute.Reason,
+		&dispute.Evidence, &dispute.Status, &dispute.OpenedBy, &dispute.HoldID, &dispute.RefundTransactionID,
+		&dispute.RefundProposedBy, &dispute.DecidedBy, &dispute.Comment, &dispute.ResolvedAt, &dispute.UpdatedAt, &dispute.CreatedAt)
+}
+
+func disputeError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return models.ErrDisputeExists
+	}
+	return err
+}
+
+// OpenDisputeTx opens a dispute on a transfer the account sent. Disputes on the same transfer can't add up to
+// more than was transferred, counting the ones still open and the ones resolved for the customer. With
+// HoldFunds the disputed amount is held at the receiving account until the dispute is decided.
+func (r *repositoryImpl) OpenDisputeTx(ctx context.Context, arg models.OpenDisputeParams) (models.DisputeUpdate, error) {
+	var update models.DisputeUpdate
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		// lock the transfer so concurrent disputes on it are counted one after the other
+		var transaction models.Transaction
+		query := `SELECT id, from_account_id, to_account_id, amount, currency FROM transactions WHERE id = @id FOR UPDATE`
+		args := pgx.NamedArgs{
+			"id": arg.TransactionID,
+		}
+
+		err := tx.QueryRow(ctx, query, args).Scan(&transaction.ID, &transaction.FromAccountID, &transaction.ToAccountID,
+			&transaction.Amount, &transaction.Currency)
+		if err != nil {
+			return err
+		}
+
+		if transaction.FromAccountID != arg.AccountID {
+			return models.ErrDisputeNotSender
+		}
+
+		amount := arg.Amount
+		if amount == 0 {
+			amount = transaction.Amount
+		}
+
+		var disputed float64
+		query2 := `SELECT COALESCE(SUM(amount), 0) FROM disputes WHERE transaction_id = @id AND status <> @rejected`
+		args2 := pgx.NamedArgs{
+			"id":       transaction.ID,
+			"rejected": models.DisputeStatusRejected,
+		}
+
+		if err := tx.QueryRow(ctx, query2, args2).Scan(&disputed); err != nil {
+			return err
+		}
+
+		if disputed+amount > transaction.Amount {
+			return models.ErrDisputeExceedsAmount
+		}
+
+		var holdID *int64
+		if arg.HoldFunds {
+			hold, err := createHold(ctx, tx, models.CreateHoldParams{
+				AccountID:   transaction.ToAccountID,
+				ToAccountID: transaction.FromAccountID,
+				Amount:      amount,
+				Currency:    transaction.Currency,
+				Description: fmt.Sprintf("dispute on transaction %d", transaction.ID),
+				ExpiresAt:   arg.HoldExpiresAt,
+			})
+			if err != nil {
+				return err
+			}
+			holdID = &hold.ID
+		}
+
+		query3 := `INSERT INTO disputes (transaction_id, account_id, receiver_account_id, amount, currency, reason, evidence,
+					opened_by, hold_id) VALUES (@transactionID, @accountID, @receiverAccountID, @amount, @currency, @reason,
+					@evidence, @openedBy, @holdID) RETURNING ` + disputeColumns
+		args3 := pgx.NamedArgs{
+			"transactionID":     transaction.ID,
+			"accountID":         transaction.FromAccountID,
+			"receiverAccountID": transaction.ToAccountID,
+			"amount":            amount,
+			"currency":          transaction.Currency,
+			"reason":            arg.Reason,
+			"evidence":          arg.Evidence,
+			"openedBy":          arg.OpenedBy,
+			"holdID":            holdID,
+		}
+
+		if err := scanDispute(tx.QueryRow(ctx, query3, args3), &update.Dispute); err != nil {
+			return disputeError(err)
+		}
+
+		update.Notify, err = disputeParties(ctx, tx, update.Dispute)
+		return err
+	})
+
+	return update, err
+}
+
+func (r *repositoryImpl) GetDispute(ctx context.Context, id int64) (models.Dispute, error) {
+	var dispute models.Dispute
+	query := `SELECT ` + disputeColumns + ` FROM disputes WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	err := scanDispute(r.pool.QueryRow(ctx, query, args), &dispute)
+	if err != nil {
+		return dispute, err
+	}
+
+	return dispute, nil
+}
+
+// ListDisputes lists disputes oldest first so agents work through the queue in order. An empty status lists
+// all of them and an account id of 0 lists every account's.
+func (r *repositoryImpl) ListDisputes(ctx context.Context, accountID int64, status string, limit, offset int32) ([]models.Dispute, error) {
+	query := `SELECT ` + disputeColumns + ` FROM disputes WHERE (@accountID = 0 OR account_id = @accountID)
+				AND (@status = '' OR status = @status) ORDER BY id LIMIT @limit OFFSET @offset`
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+		"status":    status,
+		"limit":     limit,
+		"offset":    offset,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	disputes := []models.Dispute{}
+	for rows.Next() {
+		var dispute models.Dispute
+		if err := scanDispute(rows, &dispute); err != nil {
+			return nil, err
+		}
+		disputes = append(disputes, dispute)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return disputes, nil
+}
+
+// UpdateDisputeTx moves the dispute along its state machine. A refund is proposed by one agent and resolved for
+// the customer by another. Resolving it captures the hold at the receiving account, or transfers the disputed
+// amount back when nothing is held anymore, which can take the receiver overdrawn but fails when the receiving
+// account is frozen, closing or closed. Rejecting it releases the hold.
+func (r *repositoryImpl) UpdateDisputeTx(ctx context.Context, arg models.UpdateDisputeParams) (models.DisputeUpdate, error) {
+	var update models.DisputeUpdate
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var dispute models.Dispute
+		query := `SELECT ` + disputeColumns + ` FROM disputes WHERE id = @id FOR UPDATE`
+		args := pgx.NamedArgs{
+			"id": arg.ID,
+		}
+
+		if err := scanDispute(tx.QueryRow(ctx, query, args), &dispute); err != nil {
+			return err
+		}
+
+		if !dispute.CanMoveTo(arg.Status) {
+			return models.ErrDisputeTransition
+		}
+
+		if arg.Status == models.DisputeStatusResolvedCustomer && dispute.RefundProposedBy != nil &&
+			*dispute.RefundProposedBy == arg.DecidedBy {
+			return models.ErrApproverIsInitiator
+		}
+
+		var hold *models.Hold
+		if dispute.HoldID != nil {
+			active, err := getActiveHoldForUpdate(ctx, tx, *dispute.HoldID)
+			if err != nil && !errors.Is(err, models.ErrHoldNotActive) {
+				return err
+			}
+			if err == nil {
+				hold = &active
+			}
+		}
+
+		var refund *models.TransferTxResult
+		switch {
+		case arg.Status == models.DisputeStatusResolvedCustomer && hold != nil:
+			captured, err := captureHold(ctx, tx, hold.ID, hold.Amount, 0)
+			if err != nil {
+				return err
+			}
+			refund = &captured.Transfer
+		case arg.Status == models.DisputeStatusResolvedCustomer:
+			accounts, err := lockAccounts(ctx, tx, dispute.ReceiverAccountID, dispute.AccountID)
+			if err != nil {
+				return err
+			}
+
+			if err := checkDebit(accounts[dispute.ReceiverAccountID]); err != nil {
+				return err
+			}
+
+			if err := checkCredit(accounts[dispute.AccountID]); err != nil {
+				return err
+			}
+
+			transfer, err := createTransfer(ctx, tx, models.TransferTxParams{
+				FromAccountID: dispute.ReceiverAccountID,
+				ToAccountID:   dispute.AccountID,
+				Amount:        dispute.Amount,
+				Currency:      dispute.Currency,
+				Description:   fmt.Sprintf("dispute %d refund", dispute.ID),
+			})
+			if err != nil {
+				return err
+			}
+			refund = &transfer
+		case arg.Status == models.DisputeStatusRejected && hold != nil:
+			if _, err := voidHold(ctx, tx, hold.ID); err != nil {
+				return err
+			}
+		}
+
+		var refundID *int64
+		if refund != nil {
+			if err := settleTransfer(ctx, tx, refund); err != nil {
+				return err
+			}
+			refundID = &refund.Transaction.ID
+			update.Alerts = refund.Alerts
+			update.Notices = refund.Notices
+		}
+
+		// a proposed refund records who proposed it, moving away from it without a refund clears that
+		query2 := `UPDATE disputes SET status = @status, decided_by = @decidedBy, comment = @comment,
+					refund_transaction_id = COALESCE(@refundID, refund_transaction_id), updated_at = now(),
+					refund_proposed_by = CASE WHEN @status = @proposed THEN @decidedBy
+						WHEN @status = @resolved THEN refund_proposed_by END,
+					resolved_at = CASE WHEN @status IN (@resolved, @rejected) THEN now() END
+					WHERE id = @id RETURNING ` + disputeColumns
+		args2 := pgx.NamedArgs{
+			"id":        dispute.ID,
+			"status":    arg.Status,
+			"decidedBy": arg.DecidedBy,
+			"comment":   arg.Comment,
+			"refundID":  refundID,
+			"proposed":  models.DisputeStatusRefundProposed,
+			"resolved":  models.DisputeStatusResolvedCustomer,
+			"rejected":  models.DisputeStatusRejected,
+		}
+
+		if err := scanDispute(tx.QueryRow(ctx, query2, args2), &update.Dispute); err != nil {
+			return err
+		}
+
+		// the customers hear about the decision, not about a refund still waiting for its second agent
+		if arg.Status == models.DisputeStatusRefundProposed {
+			return nil
+		}
+
+		var err error
+		update.Notify, err = disputeParties(ctx, tx, update.Dispute)
+		return err
+	})
+
+	return update, err
+}
+
+// disputeParties lists the users to notify about a dispute, the customer who opened it first and then every
+// active holder of the receiving account
+func disputeParties(ctx context.Context, tx pgx.Tx, dispute models.Dispute) ([]string, error) {
+	query := `SELECT username FROM account_holders WHERE account_id = @accountID AND status = @status
+				AND username <> @openedBy ORDER BY created_at, username`
+	args := pgx.NamedArgs{
+		"accountID": dispute.ReceiverAccountID,
+		"status":    models.HolderStatusActive,
+		"openedBy":  dispute.OpenedBy,
+	}
+
+	rows, err := tx.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	parties := []string{dispute.OpenedBy}
+	for rows.Next() {
+		var username string
+		if err := rows.Scan(&username); err != nil {
+			return nil, err
+		}
+		parties = append(parties, username)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return parties, nil
+}
@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestDispute(t *testing.T) {
+	customer := createRandomAccount(t)
+	fundAccount(t, &customer)
+	merchant := createRandomAccountIn(t, customer.Currency)
+	agent := createRandomUser(t)
+	approver := createRandomUser(t)
+
+	transfer, err := testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: customer.ID,
+		ToAccountID:   merchant.ID,
+		Amount:        100,
+		Currency:      customer.Currency,
+	})
+	require.NoError(t, err)
+
+	open := func(accountID int64, amount float64, holdFunds bool) (models.DisputeUpdate, error) {
+		return testRepo.R.OpenDisputeTx(context.Background(), models.OpenDisputeParams{
+			TransactionID: transfer.Transaction.ID,
+			AccountID:     accountID,
+			Amount:        amount,
+			Reason:        "item never arrived",
+			HoldFunds:     holdFunds,
+			HoldExpiresAt: time.Now().Add(time.Hour),
+			OpenedBy:      customer.Owner,
+		})
+	}
+
+	_, err = open(merchant.ID, 0, false)
+	require.ErrorIs(t, err, models.ErrDisputeNotSender)
+
+	_, err = open(customer.ID, 101, false)
+	require.ErrorIs(t, err, models.ErrDisputeExceedsAmount)
+
+	opened, err := open(customer.ID, 60, true)
+	require.NoError(t, err)
+	require.Equal(t, models.DisputeStatusOpen, opened.Dispute.Status)
+	require.Equal(t, merchant.ID, opened.Dispute.ReceiverAccountID)
+	require.NotNil(t, opened.Dispute.HoldID)
+	require.ElementsMatch(t, []string{customer.Owner, merchant.Owner}, opened.Notify)
+
+	held, err := testRepo.R.GetAccount(context.Background(), merchant.ID)
+	require.NoError(t, err)
+	require.Equal(t, float64(40), held.AvailableBalance)
+
+	_, err = open(customer.ID, 10, false)
+	require.ErrorIs(t, err, models.ErrDisputeExists)
+
+	updateBy := func(decidedBy string, id int64, status string) (models.DisputeUpdate, error) {
+		return testRepo.R.UpdateDisputeTx(context.Background(), models.UpdateDisputeParams{
+			ID:        id,
+			Status:    status,
+			Comment:   "checked with the merchant",
+			DecidedBy: decidedBy,
+		})
+	}
+	update := func(id int64, status string) (models.DisputeUpdate, error) {
+		return updateBy(agent.UserName, id, status)
+	}
+
+	investigating, err := update(opened.Dispute.ID, models.DisputeStatusInvestigating)
+	require.NoError(t, err)
+	require.Nil(t, investigating.Dispute.ResolvedAt)
+
+	_, err = update(opened.Dispute.ID, models.DisputeStatusOpen)
+	require.ErrorIs(t, err, models.ErrDisputeTransition)
+
+	_, err = update(opened.Dispute.ID, models.DisputeStatusResolvedCustomer)
+	require.ErrorIs(t, err, models.ErrDisputeTransition)
+
+	// a proposed refund moves no money and waits for a second agent
+	proposed, err := update(opened.Dispute.ID, models.DisputeStatusRefundProposed)
+	require.NoError(t, err)
+	require.Equal(t, agent.UserName, *proposed.Dispute.RefundProposedBy)
+	require.Nil(t, proposed.Dispute.RefundTransactionID)
+	require.Empty(t, proposed.Notify)
+
+	_, err = update(opened.Dispute.ID, models.DisputeStatusResolvedCustomer)
+	require.ErrorIs(t, err, models.ErrApproverIsInitiator)
+
+	// resolving for the customer captures the hold
+	resolved, err := updateBy(approver.UserName, opened.Dispute.ID, models.DisputeStatusResolvedCustomer)
+	require.NoError(t, err)
+	require.NotNil(t, resolved.Dispute.RefundTransactionID)
+	require.NotNil(t, resolved.Dispute.ResolvedAt)
+	require.Equal(t, agent.UserName, *resolved.Dispute.RefundProposedBy)
+	require.Equal(t, approver.UserName, *resolved.Dispute.DecidedBy)
+
+	customer, err = testRepo.R.GetAccount(context.Background(), customer.ID)
+	require.NoError(t, err)
+	require.Equal(t, float64(960), customer.Balance)
+
+	merchant, err = testRepo.R.GetAccount(context.Background(), merchant.ID)
+	require.NoError(t, err)
+	require.Equal(t, float64(40), merchant.Balance)
+	require.Equal(t, float64(40), merchant.AvailableBalance)
+
+	_, err = update(opened.Dispute.ID, models.DisputeStatusRejected)
+	require.ErrorIs(t, err, models.ErrDisputeTransition)
+
+	// only what is left of the transfer can still be disputed, and a rejection leaves the balances alone
+	_, err = open(customer.ID, 41, false)
+	require.ErrorIs(t, err, models.ErrDisputeExceedsAmount)
+
+	_, err = open(customer.ID, 0, false)
+	require.ErrorIs(t, err, models.ErrDisputeExceedsAmount)
+
+	second, err := open(customer.ID, 40, false)
+	require.NoError(t, err)
+
+	rejected, err := update(second.Dispute.ID, models.DisputeStatusRejected)
+	require.NoError(t, err)
+	require.Nil(t, rejected.Dispute.RefundTransactionID)
+
+	disputes, err := testRepo.R.ListDisputes(context.Background(), customer.ID, "", 5, 0)
+	require.NoError(t, err)
+	require.Len(t, disputes, 2)
+	require.Equal(t, models.DisputeStatusResolvedCustomer, disputes[0].Status)
+	require.Equal(t, models.DisputeStatusRejected, disputes[1].Status)
+}
+
+func TestDisputeRefundFromFrozenAccount(t *testing.T) {
+	customer := createRandomAccount(t)
+	fundAccount(t, &customer)
+	merchant := createRandomAccountIn(t, customer.Currency)
+	agent := createRandomUser(t)
+	approver := createRandomUser(t)
+
+	transfer, err := testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: customer.ID,
+		ToAccountID:   merchant.ID,
+		Amount:        100,
+		Currency:      customer.Currency,
+	})
+	require.NoError(t, err)
+
+	opened, err := testRepo.R.OpenDisputeTx(context.Background(), models.OpenDisputeParams{
+		TransactionID: transfer.Transaction.ID,
+		AccountID:     customer.ID,
+		Reason:        "item never arrived",
+		OpenedBy:      customer.Owner,
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.UpdateDisputeTx(context.Background(), models.UpdateDisputeParams{
+		ID:        opened.Dispute.ID,
+		Status:    models.DisputeStatusRefundProposed,
+		DecidedBy: agent.UserName,
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.UpdateAccountStatusTx(context.Background(), models.UpdateAccountStatusParams{
+		AccountID: merchant.ID,
+		Status:    models.AccountStatusFrozen,
+		Reason:    "under investigation",
+		ChangedBy: merchant.Owner,
+	})
+	require.NoError(t, err)
+
+	// with nothing held the refund would debit the frozen account
+	_, err = testRepo.R.UpdateDisputeTx(context.Background(), models.UpdateDisputeParams{
+		ID:        opened.Dispute.ID,
+		Status:    models.DisputeStatusResolvedCustomer,
+		DecidedBy: approver.UserName,
+	})
+	require.ErrorIs(t, err, models.ErrAccountFrozen)
+
+	merchant, err = testRepo.R.GetAccount(context.Background(), merchant.ID)
+	require.NoError(t, err)
+	require.Equal(t, float64(100), merchant.Balance)
+
+	dispute, err := testRepo.R.GetDispute(context.Background(), opened.Dispute.ID)
+	require.NoError(t, err)
+	require.Equal(t, models.DisputeStatusRefundProposed, dispute.Status)
+}
+
+func TestDisputeNotifiesReceiverHolders(t *testing.T) {
+	customer := createRandomAccount(t)
+	fundAccount(t, &customer)
+	merchant := createRandomAccountIn(t, customer.Currency)
+
+	coOwner := createRandomUser(t)
+	_, err := testRepo.R.InviteAccountHolder(context.Background(), models.InviteAccountHolderParams{
+		AccountID: merchant.ID,
+		UserName:  coOwner.UserName,
+		Role:      models.HolderRoleCoOwner,
+		InvitedBy: merchant.Owner,
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.AcceptAccountInvitation(context.Background(), merchant.ID, coOwner.UserName)
+	require.NoError(t, err)
+
+	// invited holders hear nothing until they accept
+	invited := createRandomUser(t)
+	_, err = testRepo.R.InviteAccountHolder(context.Background(), models.InviteAccountHolderParams{
+		AccountID: merchant.ID,
+		UserName:  invited.UserName,
+		Role:      models.HolderRoleViewer,
+		InvitedBy: merchant.Owner,
+	})
+	require.NoError(t, err)
+
+	transfer, err := testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: customer.ID,
+		ToAccountID:   merchant.ID,
+		Amount:        100,
+		Currency:      customer.Currency,
+	})
+	require.NoError(t, err)
+
+	opened, err := testRepo.R.OpenDisputeTx(context.Background(), models.OpenDisputeParams{
+		TransactionID: transfer.Transaction.ID,
+		AccountID:     customer.ID,
+		Reason:        "item never arrived",
+		OpenedBy:      customer.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{customer.Owner, merchant.Owner, coOwner.UserName}, opened.Notify)
+}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const fraudScreeningColumns = `id, from_account_id, (SELECT account_number FROM accounts WHERE id = from_account_id),
+	to_account_id, (SELECT account_number FROM accounts WHERE id = to_account_id), amount, currency, initiated_by, decision,
+	reasons, status, pending_transfer_id, reviewed_by, review_note, reviewed_at, created_at`
+
+func scanFraudScreening(row pgx.Row, screening *models.FraudScreening) error {
+	return row.Scan(&screening.ID, &screening.FromAccountID, &screening.FromAccountNumber, &screening.ToAccountID,
+		&screening.ToAccountNumber, &screening.Amount, &screening.Currency, &screening.InitiatedBy, &screening.Decision,
+		&screening.Reasons, &screening.Status, &screening.PendingTransferID, &screening.ReviewedBy, &screening.ReviewNote,
+		&screening.ReviewedAt, &screening.CreatedAt)
+}
+
+// GetTransferSignals gathers the device, payee and history facts the fraud rules need in one round trip
+func (r *repositoryImpl) GetTransferSignals(ctx context.Context, arg models.TransferSignalsParams) (models.TransferSignals, error) {
+	query := `SELECT
+				NOT EXISTS (SELECT 1 FROM sessions WHERE username = @username AND user_agent = @userAgent
+					AND created_at <= @deviceSeenBefore),
+				NOT EXISTS (SELECT 1 FROM transactions WHERE from_account_id = @fromAccountID AND to_account_id = @toAccountID),
+				(SELECT count(*) FROM transactions WHERE from_account_id = @fromAccountID AND created_at > @velocitySince),
+				h.count, h.average
+			  FROM (SELECT count(*) AS count, COALESCE(avg(amount), 0) AS average FROM transactions
+				WHERE from_account_id = @fromAccountID AND created_at > @historySince) h`
+	args := pgx.NamedArgs{
+		"username":         arg.UserName,
+		"userAgent":        arg.UserAgent,
+		"fromAccountID":    arg.FromAccountID,
+		"toAccountID":      arg.ToAccountID,
+		"deviceSeenBefore": arg.DeviceSeenBefore,
+		"velocitySince":    arg.VelocitySince,
+		"historySince":     arg.HistorySince,
+	}
+
+	var signals models.TransferSignals
+	err := r.pool.QueryRow(ctx, query, args).Scan(&signals.NewDevice, &signals.NewPayee, &signals.RecentTransfers,
+		&signals.HistoryCount, &signals.AverageAmount)
+	if err != nil {
+		return signals, err
+	}
+
+	return signals, nil
+}
+
+func (r *repositoryImpl) CreateFraudScreening(ctx context.Context, screening models.FraudScreening) (models.FraudScreening, error) {
+	var result models.FraudScreening
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		result, err = createFraudScreening(ctx, tx, screening)
+		return err
+	})
+
+	return result, err
+}
+
+func createFraudScreening(ctx context.Context, tx pgx.Tx, screening models.FraudScreening) (models.FraudScreening, error) {
+	var result models.FraudScreening
+	query := `INSERT INTO fraud_screenings (from_account_id, to_account_id, amount, currency, initiated_by, decision, reasons,
+				pending_transfer_id) VALUES (@fromAccountID, @toAccountID, @amount, @currency, @initiatedBy, @decision, @reasons,
+				@pendingTransferID) RETURNING ` + fraudScreeningColumns
+	args := pgx.NamedArgs{
+		"fromAccountID":     screening.FromAccountID,
+		"toAccountID":       screening.ToAccountID,
+		"amount":            screening.Amount,
+		"currency":          screening.Currency,
+		"initiatedBy":       screening.InitiatedBy,
+		"decision":          screening.Decision,
+		"reasons":           screening.Reasons,
+		"pendingTransferID": screening.PendingTransferID,
+	}
+
+	err := scanFraudScreening(tx.QueryRow(ctx, query, args), &result)
+	return result, err
+}
+
+func (r *repositoryImpl) GetFraudScreening(ctx context.Context, id int64) (models.FraudScreening, error) {
+	var screening models.FraudScreening
+	query := `SELECT ` + fraudScreeningColumns + ` FROM fraud_screenings WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	err := scanFraudScreening(r.pool.QueryRow(ctx, query, args), &screening)
+	if err != nil {
+		return screening, err
+	}
+
+	return screening, nil
+}
+
+// ListFraudScreenings lists screenings oldest first so the review queue is worked in order, an empty status lists all of them
+func (r *repositoryImpl) ListFraudScreenings(ctx context.Context, status string, limit, offset int32) ([]models.FraudScreening, error) {
+	query := `SELECT ` + fraudScreeningColumns + ` FROM fraud_screenings WHERE (@status = '' OR status = @status)
+				ORDER BY id LIMIT @limit OFFSET @offset`
+	args := pgx.NamedArgs{
+		"status": status,
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	screenings := []models.FraudScreening{}
+	for rows.Next() {
+		var screening models.FraudScreening
+		if err := scanFraudScreening(rows, &screening); err != nil {
+			return nil, err
+		}
+		screenings = append(screenings, screening)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return screenings, nil
+}
+
+// ResolveFraudScreeningTx closes an open screening and settles the transfer it sent for review if nobody decided
+// on it yet. Confirming fraud rejects the transfer, clearing the screening releases it with the reviewer as approver.
+func (r *repositoryImpl) ResolveFraudScreeningTx(ctx context.Context, arg models.ResolveFraudScreeningParams) (models.ResolveFraudScreeningResult, error) {
+	var result models.ResolveFraudScreeningResult
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		query := `SELECT ` + fraudScreeningColumns + ` FROM fraud_screenings WHERE id = @id FOR UPDATE`
+		args := pgx.NamedArgs{
+			"id": arg.ID,
+		}
+
+		var screening models.FraudScreening
+		if err := scanFraudScreening(tx.QueryRow(ctx, query, args), &screening); err != nil {
+			return err
+		}
+
+		if screening.Status != models.FraudScreeningOpen {
+			return models.ErrScreeningNotOpen
+		}
+
+		// closed first, an open screening stops the transfer being approved
+		query2 := `UPDATE fraud_screenings SET status = @status, reviewed_by = @reviewedBy, review_note = @note, reviewed_at = now()
+					WHERE id = @id RETURNING ` + fraudScreeningColumns
+		args2 := pgx.NamedArgs{
+			"id":         screening.ID,
+			"status":     arg.Resolution,
+			"reviewedBy": arg.ReviewedBy,
+			"note":       arg.Note,
+		}
+
+		if err := scanFraudScreening(tx.QueryRow(ctx, query2, args2), &result.Screening); err != nil {
+			return err
+		}
+
+		if screening.PendingTransferID == nil {
+			return nil
+		}
+
+		decision, err := decidePendingTransfer(ctx, tx, models.DecidePendingTransferParams{
+			ID:        *screening.PendingTransferID,
+			DecidedBy: arg.ReviewedBy,
+			Approve:   arg.Resolution == models.FraudScreeningLegitimate,
+			Comment:   arg.Note,
+		})
+		// an approver or the expiry job may have got there first
+		if errors.Is(err, models.ErrOperationNotPending) || errors.Is(err, models.ErrPendingTransferExpired) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		result.Transfer = decision.Transfer
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestGetTransferSignals(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+
+	arg := models.TransferSignalsParams{
+		UserName:         from.Owner,
+		UserAgent:        "test-agent",
+		FromAccountID:    from.ID,
+		ToAccountID:      to.ID,
+		DeviceSeenBefore: time.Now(),
+		VelocitySince:    time.Now().Add(-time.Minute),
+		HistorySince:     time.Now().Add(-time.Hour),
+	}
+
+	signals, err := testRepo.R.GetTransferSignals(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, signals.NewDevice)
+	require.True(t, signals.NewPayee)
+	require.Zero(t, signals.RecentTransfers)
+	require.Zero(t, signals.HistoryCount)
+
+	_, err = testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        40,
+		Currency:      from.Currency,
+		Fee:           1,
+	})
+	require.NoError(t, err)
+
+	signals, err = testRepo.R.GetTransferSignals(context.Background(), arg)
+	require.NoError(t, err)
+	require.False(t, signals.NewPayee)
+	require.Equal(t, int64(1), signals.RecentTransfers)
+	require.Equal(t, int64(1), signals.HistoryCount)
+	require.Equal(t, float64(40), signals.AverageAmount)
+}
+
+func TestResolveFraudScreeningRejectsPendingTransfer(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+	admin := createRandomTeller(t, models.RoleAdmin)
+
+	pending, err := testRepo.R.CreatePendingTransferTx(context.Background(), models.CreatePendingTransferParams{
+		Transfer: models.TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        300,
+			Currency:      from.Currency,
+			Fee:           1,
+			InitiatedBy:   from.Owner,
+		},
+		ExpiresAt: time.Now().Add(time.Hour),
+		Screening: &models.FraudScreening{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        300,
+			Currency:      from.Currency,
+			InitiatedBy:   from.Owner,
+			Decision:      models.FraudDecisionReview,
+			Reasons:       []string{"new_payee: first transfer to this payee"},
+		},
+	})
+	require.NoError(t, err)
+
+	screenings, err := testRepo.R.ListFraudScreenings(context.Background(), models.FraudScreeningOpen, 1000, 0)
+	require.NoError(t, err)
+
+	var screening models.FraudScreening
+	for _, s := range screenings {
+		if s.PendingTransferID != nil && *s.PendingTransferID == pending.ID {
+			screening = s
+		}
+	}
+	require.NotZero(t, screening.ID)
+	require.Equal(t, []string{"new_payee: first transfer to this payee"}, screening.Reasons)
+
+	resolved, err := testRepo.R.ResolveFraudScreeningTx(context.Background(), models.ResolveFraudScreeningParams{
+		ID:         screening.ID,
+		ReviewedBy: admin.UserName,
+		Resolution: models.FraudScreeningFraudulent,
+		Note:       "customer did not recognise the payee",
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.FraudScreeningFraudulent, resolved.Screening.Status)
+	require.Equal(t, admin.UserName, *resolved.Screening.ReviewedBy)
+	require.Nil(t, resolved.Transfer)
+
+	rejected, err := testRepo.R.GetPendingTransfer(context.Background(), pending.ID)
+	require.NoError(t, err)
+	require.Equal(t, models.PendingTransferRejected, rejected.Status)
+
+	_, err = testRepo.R.ResolveFraudScreeningTx(context.Background(), models.ResolveFraudScreeningParams{
+		ID:         screening.ID,
+		ReviewedBy: admin.UserName,
+		Resolution: models.FraudScreeningLegitimate,
+	})
+	require.ErrorIs(t, err, models.ErrScreeningNotOpen)
+}
+
+func TestResolveFraudScreeningReleasesPendingTransfer(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+	admin := createRandomTeller(t, models.RoleAdmin)
+	teller := createRandomTeller(t, models.RoleTeller)
+
+	pending, err := testRepo.R.CreatePendingTransferTx(context.Background(), models.CreatePendingTransferParams{
+		Transfer: models.TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        300,
+			Currency:      from.Currency,
+			InitiatedBy:   from.Owner,
+		},
+		ExpiresAt: time.Now().Add(time.Hour),
+		Screening: &models.FraudScreening{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        300,
+			Currency:      from.Currency,
+			InitiatedBy:   from.Owner,
+			Decision:      models.FraudDecisionReview,
+			Reasons:       []string{"new_device: transfer from a device not seen before"},
+		},
+	})
+	require.NoError(t, err)
+
+	// nobody can approve around an open screening
+	_, err = testRepo.R.DecidePendingTransferTx(context.Background(), models.DecidePendingTransferParams{
+		ID:        pending.ID,
+		DecidedBy: teller.UserName,
+		Approve:   true,
+	})
+	require.ErrorIs(t, err, models.ErrScreeningOpen)
+
+	screenings, err := testRepo.R.ListFraudScreenings(context.Background(), models.FraudScreeningOpen, 1000, 0)
+	require.NoError(t, err)
+
+	var screening models.FraudScreening
+	for _, s := range screenings {
+		if s.PendingTransferID != nil && *s.PendingTransferID == pending.ID {
+			screening = s
+		}
+	}
+	require.NotZero(t, screening.ID)
+
+	resolved, err := testRepo.R.ResolveFraudScreeningTx(context.Background(), models.ResolveFraudScreeningParams{
+		ID:         screening.ID,
+		ReviewedBy: admin.UserName,
+		Resolution: models.FraudScreeningLegitimate,
+		Note:       "customer confirmed the new phone",
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.FraudScreeningLegitimate, resolved.Screening.Status)
+	require.NotNil(t, resolved.Transfer)
+	require.Equal(t, float64(300), resolved.Transfer.Transaction.Amount)
+
+	approved, err := testRepo.R.GetPendingTransfer(context.Background(), pending.ID)
+	require.NoError(t, err)
+	require.Equal(t, models.PendingTransferApproved, approved.Status)
+	require.Equal(t, admin.UserName, *approved.DecidedBy)
+
+	updated, err := testRepo.R.GetAccount(context.Background(), to.ID)
+	require.NoError(t, err)
+	require.Equal(t, to.Balance+300, updated.Balance)
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const glExportColumns = `id, run_id, start_date, end_date, format, storage_key, line_count, created_by, created_at`
+
+func scanGLExport(row pgx.Row, export *models.GLExport) error {
+	return row.Scan(&export.ID, &export.RunID, &export.StartDate, &export.EndDate, &export.Format, &export.StorageKey, &export.LineCount,
+		&export.CreatedBy, &export.CreatedAt)
+}
+
+func glExportError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return models.ErrGLExportExists
+	}
+	return err
+}
+
+// GetJournal totals the entries of every UTC day from start to end, both included, by currency and general
+// ledger account. The bank's own accounts map by their internal code and customer accounts by their product's
+// account type, an entry on an account the chart of accounts doesn't cover fails with models.ErrGLAccountUnmapped.
+func (r *repositoryImpl) GetJournal(ctx context.Context, start, end time.Time) ([]models.JournalLine, error) {
+	query := `SELECT (e.created_at AT TIME ZONE 'UTC')::date, a.currency, m.gl_code,
+				COALESCE(-SUM(e.amount) FILTER (WHERE e.amount < 0), 0), COALESCE(SUM(e.amount) FILTER (WHERE e.amount > 0), 0),
+				count(*) FROM entries e
+				JOIN accounts a ON a.id = e.account_id
+				JOIN account_products p ON p.code = a.product_code
+				LEFT JOIN internal_accounts i ON i.account_id = a.id
+				LEFT JOIN gl_account_mappings m ON (m.source = 'internal' AND m.source_code = i.code)
+					OR (i.code IS NULL AND m.source = 'account_type' AND m.source_code = p.account_type)
+				WHERE e.created_at >= @start AND e.created_at < @end
+				GROUP BY 1, 2, 3 ORDER BY 1, 2, 3`
+	args := pgx.NamedArgs{
+		"start": start,
+		"end":   end.AddDate(0, 0, 1),
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	lines := []models.JournalLine{}
+	for rows.Next() {
+		var line models.JournalLine
+		var code *string
+		if err := rows.Scan(&line.BusinessDate, &line.Currency, &code, &line.Debits, &line.Credits, &line.EntryCount); err != nil {
+			return nil, err
+		}
+
+		if code == nil {
+			return nil, fmt.Errorf("%w: %d %s entries on %s", models.ErrGLAccountUnmapped, line.EntryCount, line.Currency,
+				line.BusinessDate.Format(time.DateOnly))
+		}
+
+		line.GLCode = *code
+		lines = append(lines, line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// CreateGLExport records an export run with its control totals, a period that was already exported in the
+// format fails with models.ErrGLExportExists
+func (r *repositoryImpl) CreateGLExport(ctx context.Context, export models.GLExport) (models.GLExport, error) {
+	var created models.GLExport
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		query := `INSERT INTO gl_exports (run_id, start_date, end_date, format, storage_key, line_count, created_by)
+					VALUES (@runID, @startDate::date, @endDate::date, @format, @storageKey, @lineCount, @createdBy)
+					RETURNING ` + glExportColumns
+		args := pgx.NamedArgs{
+			"runID":      export.RunID,
+			"startDate":  export.StartDate,
+			"endDate":    export.EndDate,
+			"format":     export.Format,
+			"storageKey": export.StorageKey,
+			"lineCount":  export.LineCount,
+			"createdBy":  export.CreatedBy,
+		}
+
+		if err := scanGLExport(tx.QueryRow(ctx, query, args), &created); err != nil {
+			return glExportError(err)
+		}
+
+		_, err := tx.CopyFrom(ctx, pgx.Identifier{"gl_export_totals"},
+			[]string{"export_id", "currency", "line_count", "total_debits", "total_credits"},
+			pgx.CopyFromSlice(len(export.Totals), func(i int) ([]any, error) {
+				total := export.Totals[i]
+				return []any{created.ID, total.Currency, total.LineCount, total.TotalDebits, total.TotalCredits}, nil
+			}),
+		)
+		created.Totals = export.Totals
+		return err
+	})
+
+	return created, err
+}
+
+func (r *repositoryImpl) GetGLExport(ctx context.Context, id int64) (models.GLExport, error) {
+	var export models.GLExport
+	query := `SELECT ` + glExportColumns + ` FROM gl_exports WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	if err := scanGLExport(r.pool.QueryRow(ctx, query, args), &export); err != nil {
+		return export, err
+	}
+
+	var err error
+	export.Totals, err = r.listGLControlTotals(ctx, export.ID)
+	return export, err
+}
+
+// GetGLExportByPeriod finds the run that exported exactly the period in the format
+func (r *repositoryImpl) GetGLExportByPeriod(ctx context.Context, start, end time.Time, format string) (models.GLExport, error) {
+	var export models.GLExport
+	query := `SELECT ` + glExportColumns + ` FROM gl_exports
+				WHERE start_date = @startDate::date AND end_date = @endDate::date AND format = @format`
+	args := pgx.NamedArgs{
+		"startDate": start,
+		"endDate":   end,
+		"format":    format,
+	}
+
+	if err := scanGLExport(r.pool.QueryRow(ctx, query, args), &export); err != nil {
+		return export, err
+	}
+
+	var err error
+	export.Totals, err = r.listGLControlTotals(ctx, export.ID)
+	return export, err
+}
+
+// ListGLExports lists export runs newest first, without their control totals
+func (r *repositoryImpl) ListGLExports(ctx context.Context, limit, offset int32) ([]models.GLExport, error) {
+	query := `SELECT ` + glExportColumns + ` FROM gl_exports ORDER BY id DESC LIMIT @limit OFFSET @offset`
+	args := pgx.NamedArgs{
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	exports := []models.GLExport{}
+	for rows.Next() {
+		var export models.GLExport
+		if err := scanGLExport(rows, &export); err != nil {
+			return nil, err
+		}
+		exports = append(exports, export)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return exports, nil
+}
+
+// GetLastGLExportDate returns the last day the daily job exported in the format, nil when it exported nothing
+// yet. Runs an admin asked for have a creator and don't count, they can end past days the job never exported.
+func (r *repositoryImpl) GetLastGLExportDate(ctx context.Context, format string) (*time.Time, error) {
+	var day *time.Time
+	query := `SELECT max(end_date) FROM gl_exports WHERE format = @format AND created_by IS NULL`
+	args := pgx.NamedArgs{
+		"format": format,
+	}
+
+	if err := r.pool.QueryRow(ctx, query, args).Scan(&day); err != nil {
+		return nil, err
+	}
+
+	return day, nil
+}
+
+func (r *repositoryImpl) listGLControlTotals(ctx context.Context, exportID int64) ([]models.GLControlTotal, error) {
+	query := `SELECT currency, line_count, total_debits, total_credits FROM gl_export_totals
+				WHERE export_id = @exportID ORDER BY currency`
+
+	rows, err := r.pool.Query(ctx, query, pgx.NamedArgs{"exportID": exportID})
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	totals := []models.GLControlTotal{}
+	for rows.Next() {
+		var total models.GLControlTotal
+		if err := rows.Scan(&total.Currency, &total.LineCount, &total.TotalDebits, &total.TotalCredits); err != nil {
+			return nil, err
+		}
+		totals = append(totals, total)
+	}
+
+	return totals, rows.Err()
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestGetJournal(t *testing.T) {
+	account := createRandomAccount(t)
+	fundAccount(t, &account)
+	receiver := createRandomAccountIn(t, account.Currency)
+
+	_, err := testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   receiver.ID,
+		Amount:        10,
+		Currency:      account.Currency,
+	})
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	lines, err := testRepo.R.GetJournal(context.Background(), today, today)
+	require.NoError(t, err)
+
+	var found bool
+	for _, line := range lines {
+		require.True(t, line.BusinessDate.Equal(today))
+		if line.Currency == account.Currency && line.GLCode == "2000" {
+			require.GreaterOrEqual(t, line.Debits, float64(10))
+			require.GreaterOrEqual(t, line.Credits, float64(10))
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestCreateGLExport(t *testing.T) {
+	// a period no other run has exported
+	day := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, int(helpers.RandomInt(0, 9000)))
+	creator := createRandomUser(t)
+
+	arg := models.GLExport{
+		RunID:      uuid.NewString(),
+		StartDate:  day,
+		EndDate:    day.AddDate(0, 0, 1),
+		Format:     models.GLFormatCSV,
+		StorageKey: "gl/" + uuid.NewString(),
+		LineCount:  2,
+		Totals:     []models.GLControlTotal{{Currency: "USD", LineCount: 2, TotalDebits: 5, TotalCredits: 5}},
+		CreatedBy:  &creator.UserName,
+	}
+
+	export, err := testRepo.R.CreateGLExport(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, export.ID)
+	require.Equal(t, arg.RunID, export.RunID)
+
+	stored, err := testRepo.R.GetGLExportByPeriod(context.Background(), arg.StartDate, arg.EndDate, models.GLFormatCSV)
+	require.NoError(t, err)
+	require.Equal(t, export.ID, stored.ID)
+	require.Equal(t, arg.Totals, stored.Totals)
+
+	arg.RunID, arg.StorageKey = uuid.NewString(), "gl/"+uuid.NewString()
+	_, err = testRepo.R.CreateGLExport(context.Background(), arg)
+	require.ErrorIs(t, err, models.ErrGLExportExists)
+
+	// the same period can still be exported in the other format
+	arg.Format = models.GLFormatFixedWidth
+	_, err = testRepo.R.CreateGLExport(context.Background(), arg)
+	require.NoError(t, err)
+}
+
+func TestGetLastGLExportDateIgnoresAdHocRuns(t *testing.T) {
+	// the day after every run recorded so far, with an admin run ending after the daily one
+	var day time.Time
+	query := `SELECT COALESCE(max(end_date), '2000-01-01') + 1 FROM gl_exports`
+	err := testRepo.R.(*repositoryImpl).pool.QueryRow(context.Background(), query).Scan(&day)
+	require.NoError(t, err)
+	admin := createRandomUser(t)
+
+	export := func(start, end time.Time, createdBy *string) {
+		_, err := testRepo.R.CreateGLExport(context.Background(), models.GLExport{
+			RunID:      uuid.NewString(),
+			StartDate:  start,
+			EndDate:    end,
+			Format:     models.GLFormatFixedWidth,
+			StorageKey: "gl/" + uuid.NewString(),
+			CreatedBy:  createdBy,
+		})
+		require.NoError(t, err)
+	}
+
+	export(day, day, nil)
+	export(day.AddDate(0, 0, -1), day.AddDate(0, 0, 5), &admin.UserName)
+
+	last, err := testRepo.R.GetLastGLExportDate(context.Background(), models.GLFormatFixedWidth)
+	require.NoError(t, err)
+	require.NotNil(t, last)
+	require.True(t, last.Equal(day))
+}
@@ -0,0 +1,289 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+// holdColumns lists the columns every hold query returns, in the order scanHold expects them
+const holdColumns = `id, account_id, (SELECT account_number FROM accounts WHERE id = account_id), to_account_id,
+	(SELECT account_number FROM accounts WHERE id = to_account_id), amount, captured_amount, currency, description, status,
+	transaction_id, expires_at, released_at, created_at`
+
+func scanHold(row pgx.Row, hold *models.Hold) error {
+	return row.Scan(&hold.ID, &hold.AccountID, &hold.AccountNumber, &hold.ToAccountID, &hold.ToAccountNumber, &hold.Amount,
+		&hold.CapturedAmount, &hold.Currency, &hold.Description, &hold.Status, &hold.TransactionID, &hold.ExpiresAt,
+		&hold.ReleasedAt, &hold.CreatedAt)
+}
+
+func (r *repositoryImpl) CreateHoldTx(ctx context.Context, arg models.CreateHoldParams) (models.Hold, error) {
+	var hold models.Hold
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		hold, err = createHold(ctx, tx, arg)
+		return err
+	})
+
+	return hold, err
+}
+
+// createHold reserves the funds on the account and records the hold
+func createHold(ctx context.Context, tx pgx.Tx, arg models.CreateHoldParams) (models.Hold, error) {
+	var hold models.Hold
+
+	// reserve the funds, the row lock makes concurrent holds and transfers see each other
+	query := `UPDATE accounts SET hold_balance = hold_balance + @amount WHERE id = @id RETURNING ` + accountColumns
+	args := pgx.NamedArgs{
+		"id":     arg.AccountID,
+		"amount": arg.Amount,
+	}
+
+	var account models.Account
+	if err := scanAccount(tx.QueryRow(ctx, query, args), &account); err != nil {
+		return hold, err
+	}
+
+	if err := checkFunds(account); err != nil {
+		return hold, err
+	}
+
+	if err := checkDebit(account); err != nil {
+		return hold, err
+	}
+
+	if !isMatured(account) {
+		return hold, models.ErrAccountNotMatured
+	}
+
+	query2 := `INSERT INTO holds (account_id, to_account_id, amount, currency, description, expires_at) VALUES
+				(@accountID, @toAccountID, @amount, @currency, @description, @expiresAt) RETURNING ` + holdColumns
+	args2 := pgx.NamedArgs{
+		"accountID":   arg.AccountID,
+		"toAccountID": arg.ToAccountID,
+		"amount":      arg.Amount,
+		"currency":    arg.Currency,
+		"description": arg.Description,
+		"expiresAt":   arg.ExpiresAt,
+	}
+
+	err := scanHold(tx.QueryRow(ctx, query2, args2), &hold)
+	return hold, err
+}
+
+func (r *repositoryImpl) GetHold(ctx context.Context, id int64) (models.Hold, error) {
+	var hold models.Hold
+	query := `SELECT ` + holdColumns + ` FROM holds WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	err := scanHold(r.pool.QueryRow(ctx, query, args), &hold)
+	if err != nil {
+		return hold, err
+	}
+
+	return hold, nil
+}
+
+func (r *repositoryImpl) ListHolds(ctx context.Context, accountID int64, limit, offset int32) ([]models.Hold, error) {
+	query := `SELECT ` + holdColumns + ` FROM holds WHERE account_id = @accountID ORDER BY id DESC LIMIT @limit OFFSET @offset`
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+		"limit":     limit,
+		"offset":    offset,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	holds := []models.Hold{}
+	for rows.Next() {
+		var hold models.Hold
+		if err := scanHold(rows, &hold); err != nil {
+			return nil, err
+		}
+		holds = append(holds, hold)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return holds, nil
+}
+
+func (r *repositoryImpl) CaptureHoldTx(ctx context.Context, arg models.CaptureHoldParams) (models.CaptureHoldResult, error) {
+	var result models.CaptureHoldResult
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		result, err = captureHold(ctx, tx, arg.HoldID, arg.Amount, 0)
+		return err
+	})
+
+	return result, err
+}
+
+// captureHold moves up to the held amount to the receiving account and releases the reservation, fee is
+// only recorded on the transaction like any other transfer's
+func captureHold(ctx context.Context, tx pgx.Tx, holdID int64, amount, fee float64) (models.CaptureHoldResult, error) {
+	var result models.CaptureHoldResult
+
+	hold, err := getActiveHoldForUpdate(ctx, tx, holdID)
+	if err != nil {
+		return result, err
+	}
+
+	if amount > hold.Amount {
+		return result, models.ErrCaptureExceedsHold
+	}
+
+	accounts, err := lockAccounts(ctx, tx, hold.AccountID, hold.ToAccountID)
+	if err != nil {
+		return result, err
+	}
+
+	if err = checkDebit(accounts[hold.AccountID]); err != nil {
+		return result, err
+	}
+
+	if err = checkCredit(accounts[hold.ToAccountID]); err != nil {
+		return result, err
+	}
+
+	// release the whole reservation, a partial capture gives the remainder back to the account
+	if err = releaseHold(ctx, tx, hold); err != nil {
+		return result, err
+	}
+
+	result.Transfer, err = createTransfer(ctx, tx, models.TransferTxParams{
+		FromAccountID: hold.AccountID,
+		ToAccountID:   hold.ToAccountID,
+		Amount:        amount,
+		Currency:      hold.Currency,
+		Description:   hold.Description,
+		Fee:           fee,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	query := `UPDATE holds SET status = @status, captured_amount = @amount, transaction_id = @transactionID, released_at = now()
+				WHERE id = @id RETURNING ` + holdColumns
+	args := pgx.NamedArgs{
+		"id":            hold.ID,
+		"status":        models.HoldStatusCaptured,
+		"amount":        amount,
+		"transactionID": result.Transfer.Transaction.ID,
+	}
+
+	err = scanHold(tx.QueryRow(ctx, query, args), &result.Hold)
+	return result, err
+}
+
+func (r *repositoryImpl) VoidHoldTx(ctx context.Context, id int64) (models.Hold, error) {
+	var result models.Hold
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		result, err = voidHold(ctx, tx, id)
+		return err
+	})
+
+	return result, err
+}
+
+func voidHold(ctx context.Context, tx pgx.Tx, id int64) (models.Hold, error) {
+	var result models.Hold
+
+	hold, err := getActiveHoldForUpdate(ctx, tx, id)
+	if err != nil {
+		return result, err
+	}
+
+	if err = releaseHold(ctx, tx, hold); err != nil {
+		return result, err
+	}
+
+	query := `UPDATE holds SET status = @status, released_at = now() WHERE id = @id RETURNING ` + holdColumns
+	args := pgx.NamedArgs{
+		"id":     hold.ID,
+		"status": models.HoldStatusVoided,
+	}
+
+	err = scanHold(tx.QueryRow(ctx, query, args), &result)
+	return result, err
+}
+
+func (r *repositoryImpl) ReleaseExpiredHolds(ctx context.Context) (int64, error) {
+	// lock the accounts in id order first, as lockAccounts does, so the update can't deadlock with a transfer
+	query := `SELECT id FROM accounts WHERE id IN (SELECT account_id FROM holds WHERE status = @active AND expires_at <= now())
+				ORDER BY id FOR NO KEY UPDATE`
+
+	// data-modifying CTEs always run to completion, so the account update happens even though only the count is read
+	query2 := `WITH expired AS (
+				UPDATE holds SET status = @expired, released_at = now()
+				WHERE status = @active AND expires_at <= now() RETURNING account_id, amount
+			  ), released AS (
+				UPDATE accounts a SET hold_balance = a.hold_balance - e.total
+				FROM (SELECT account_id, SUM(amount) AS total FROM expired GROUP BY account_id) e
+				WHERE a.id = e.account_id RETURNING a.id
+			  )
+			  SELECT count(*) FROM expired`
+	args := pgx.NamedArgs{
+		"active":  models.HoldStatusActive,
+		"expired": models.HoldStatusExpired,
+	}
+
+	var count int64
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, query, args); err != nil {
+			return err
+		}
+
+		return tx.QueryRow(ctx, query2, args).Scan(&count)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// getActiveHoldForUpdate locks the hold row and makes sure it can still be captured or voided
+func getActiveHoldForUpdate(ctx context.Context, tx pgx.Tx, id int64) (models.Hold, error) {
+	var hold models.Hold
+	query := `SELECT ` + holdColumns + ` FROM holds WHERE id = @id FOR UPDATE`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	if err := scanHold(tx.QueryRow(ctx, query, args), &hold); err != nil {
+		return hold, err
+	}
+
+	if hold.Status != models.HoldStatusActive || !hold.ExpiresAt.After(time.Now()) {
+		return hold, models.ErrHoldNotActive
+	}
+
+	return hold, nil
+}
+
+func releaseHold(ctx context.Context, tx pgx.Tx, hold models.Hold) error {
+	query := `UPDATE accounts SET hold_balance = hold_balance - @amount WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id":     hold.AccountID,
+		"amount": hold.Amount,
+	}
+
+	_, err := tx.Exec(ctx, query, args)
+	return err
+}
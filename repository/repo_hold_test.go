@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func createRandomHold(t *testing.T, account, toAccount *models.Account, amount float64, expiresAt time.Time) models.Hold {
+	arg := models.CreateHoldParams{
+		AccountID:   account.ID,
+		ToAccountID: toAccount.ID,
+		Amount:      amount,
+		Currency:    account.Currency,
+		Description: helpers.RandomString(20),
+		ExpiresAt:   expiresAt,
+	}
+
+	hold, err := testRepo.R.CreateHoldTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, hold)
+
+	require.Equal(t, arg.AccountID, hold.AccountID)
+	require.Equal(t, arg.ToAccountID, hold.ToAccountID)
+	require.Equal(t, arg.Amount, hold.Amount)
+	require.Equal(t, models.HoldStatusActive, hold.Status)
+	require.Nil(t, hold.TransactionID)
+	require.WithinDuration(t, arg.ExpiresAt, hold.ExpiresAt, time.Second)
+
+	return hold
+}
+
+func TestCreateHold(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	fundAccount(t, &account1)
+
+	createRandomHold(t, &account1, &account2, 100, time.Now().Add(time.Hour))
+
+	updatedAccount1, err := testRepo.R.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, updatedAccount1.Balance)
+	require.Equal(t, account1.AvailableBalance-100, updatedAccount1.AvailableBalance)
+
+	// the rest of the balance can't be reserved twice
+	_, err = testRepo.R.CreateHoldTx(context.Background(), models.CreateHoldParams{
+		AccountID:   account1.ID,
+		ToAccountID: account2.ID,
+		Amount:      updatedAccount1.AvailableBalance + 1,
+		Currency:    account1.Currency,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	require.ErrorIs(t, err, models.ErrInsufficientFunds)
+}
+
+func TestHoldBlocksTransfer(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	fundAccount(t, &account1)
+
+	createRandomHold(t, &account1, &account2, account1.Balance, time.Now().Add(time.Hour))
+
+	_, err := testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1,
+		Currency:      account1.Currency,
+	})
+	require.ErrorIs(t, err, models.ErrInsufficientFunds)
+}
+
+func TestCapturePartialHold(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	fundAccount(t, &account1)
+
+	hold := createRandomHold(t, &account1, &account2, 100, time.Now().Add(time.Hour))
+
+	result, err := testRepo.R.CaptureHoldTx(context.Background(), models.CaptureHoldParams{
+		HoldID: hold.ID,
+		Amount: 60,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, models.HoldStatusCaptured, result.Hold.Status)
+	require.Equal(t, float64(60), result.Hold.CapturedAmount)
+	require.NotNil(t, result.Hold.TransactionID)
+	require.Equal(t, result.Transfer.Transaction.ID, *result.Hold.TransactionID)
+
+	// the uncaptured 40 is available again
+	require.Equal(t, account1.Balance-60, result.Transfer.FromAccount.Balance)
+	require.Equal(t, result.Transfer.FromAccount.Balance, result.Transfer.FromAccount.AvailableBalance)
+	require.Equal(t, account2.Balance+60, result.Transfer.ToAccount.Balance)
+
+	// a captured hold is final
+	_, err = testRepo.R.CaptureHoldTx(context.Background(), models.CaptureHoldParams{HoldID: hold.ID, Amount: 1})
+	require.ErrorIs(t, err, models.ErrHoldNotActive)
+}
+
+func TestCaptureHoldExceedsAmount(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	fundAccount(t, &account1)
+
+	hold := createRandomHold(t, &account1, &account2, 100, time.Now().Add(time.Hour))
+
+	_, err := testRepo.R.CaptureHoldTx(context.Background(), models.CaptureHoldParams{
+		HoldID: hold.ID,
+		Amount: 101,
+	})
+	require.ErrorIs(t, err, models.ErrCaptureExceedsHold)
+}
+
+func TestVoidHold(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	fundAccount(t, &account1)
+
+	hold := createRandomHold(t, &account1, &account2, 100, time.Now().Add(time.Hour))
+
+	voided, err := testRepo.R.VoidHoldTx(context.Background(), hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, models.HoldStatusVoided, voided.Status)
+	require.NotNil(t, voided.ReleasedAt)
+
+	updatedAccount1, err := testRepo.R.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.AvailableBalance, updatedAccount1.AvailableBalance)
+}
+
+func TestReleaseExpiredHolds(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	fundAccount(t, &account1)
+
+	hold := createRandomHold(t, &account1, &account2, 100, time.Now().Add(-time.Second))
+
+	released, err := testRepo.R.ReleaseExpiredHolds(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, released, int64(1))
+
+	expired, err := testRepo.R.GetHold(context.Background(), hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, models.HoldStatusExpired, expired.Status)
+
+	updatedAccount1, err := testRepo.R.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.AvailableBalance, updatedAccount1.AvailableBalance)
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -20,16 +21,62 @@ func newRepositoryImpl(p *pgxpool.Pool) *repositoryImpl {
 	}
 }
 
+// accountColumns lists the columns every account query returns, in the order scanAccount expects them
+const accountColumns = `id, account_number, owner, balance, balance - hold_balance - pocket_balance AS available_balance, currency, product_code, matures_at,
+	status, closed_at, overdraft_limit, overdrawn_since, created_at`
+
+func scanAccount(row pgx.Row, account *models.Account) error {
+	return row.Scan(&account.ID, &account.AccountNumber, &account.Owner, &account.Balance, &account.AvailableBalance, &account.Currency, &account.ProductCode,
+		&account.MaturesAt, &account.Status, &account.ClosedAt, &account.OverdraftLimit, &account.OverdrawnSince, &account.CreatedAt)
+}
+
+// transactionColumns lists the columns every transaction query returns, in the order scanTransaction expects them.
+// The account numbers come along so callers never have to show the internal ids.
+const transactionColumns = `id, from_account_id, (SELECT account_number FROM accounts WHERE id = from_account_id),
+	to_account_id, (SELECT account_number FROM accounts WHERE id = to_account_id),
+	amount, fee, currency, description, created_at`
+
+func scanTransaction(row pgx.Row, transaction *models.Transaction) error {
+	return row.Scan(&transaction.ID, &transaction.FromAccountID, &transaction.FromAccountNumber, &transaction.ToAccountID,
+		&transaction.ToAccountNumber, &transaction.Amount, &transaction.Fee, &transaction.Currency, &transaction.Description,
+		&transaction.CreatedAt)
+}
+
+// entryColumns lists the columns every entry query returns, in the order scanEntry expects them
+const entryColumns = `id, account_id, (SELECT account_number FROM accounts WHERE id = account_id), amount, created_at`
+
+func scanEntry(row pgx.Row, entry *models.Entry) error {
+	return row.Scan(&entry.ID, &entry.AccountID, &entry.AccountNumber, &entry.Amount, &entry.CreatedAt)
+}
+
+// userColumns lists the columns every user query returns, in the order scanUser expects them
+const userColumns = `username, hashed_password, full_name, email, is_email_verified, role, statements_opt_out,
+	locale, password_changed_at, created_at`
+
+func scanUser(row pgx.Row, user *models.User) error {
+	return row.Scan(&user.UserName, &user.HashedPassword, &user.FullName, &user.Email, &user.IsEmailVerified, &user.Role,
+		&user.StatementsOptOut, &user.Locale, &user.PasswordChangedAt, &user.CreatedAt)
+}
+
 func (r *repositoryImpl) CreateAccount(ctx context.Context, account models.Account) (models.Account, error) {
 	var a models.Account
-	query := `INSERT INTO accounts (owner, balance, currency) VALUES (@owner, @balance, @currency) RETURNING id, owner, balance, currency, created_at`
+	// the owner becomes the account's first holder in the same statement
+	query := `WITH a AS (INSERT INTO accounts (owner, balance, currency, product_code, matures_at) VALUES
+				(@owner, @balance, @currency, @productCode, @maturesAt) RETURNING *),
+			h AS (INSERT INTO account_holders (account_id, username, role, status, accepted_at)
+				SELECT id, owner, @role, @status, created_at FROM a)
+			SELECT ` + accountColumns + ` FROM a`
 	args := pgx.NamedArgs{
-		"owner":    account.Owner,
-		"balance":  account.Balance,
-		"currency": account.Currency,
+		"owner":       account.Owner,
+		"balance":     account.Balance,
+		"currency":    account.Currency,
+		"productCode": account.ProductCode,
+		"maturesAt":   account.MaturesAt,
+		"role":        models.HolderRoleOwner,
+		"status":      models.HolderStatusActive,
 	}
 
-	err := r.pool.QueryRow(ctx, query, args).Scan(&a.ID, &a.Owner, &a.Balance, &a.Currency, &a.CreatedAt)
+	err := scanAccount(r.pool.QueryRow(ctx, query, args), &a)
 	if err != nil {
 		return a, err
 	}
@@ -39,12 +86,12 @@ func (r *repositoryImpl) CreateAccount(ctx context.Context, account models.Accou
 
 func (r *repositoryImpl) GetAccount(ctx context.Context, id int64) (models.Account, error) {
 	var account models.Account
-	query := `SELECT id, owner, balance, currency, created_at FROM accounts WHERE id = @id`
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE id = @id`
 	args := pgx.NamedArgs{
 		"id": id,
 	}
 
-	err := r.pool.QueryRow(ctx, query, args).Scan(&account.ID, &account.Owner, &account.Balance, &account.Currency, &account.CreatedAt)
+	err := scanAccount(r.pool.QueryRow(ctx, query, args), &account)
 	if err != nil {
 		return account, err
 	}
@@ -52,14 +99,14 @@ func (r *repositoryImpl) GetAccount(ctx context.Context, id int64) (models.Accou
 	return account, nil
 }
 
-func (r *repositoryImpl) GetAccountForUpdate(ctx context.Context, id int64) (models.Account, error) {
+func (r *repositoryImpl) GetAccountByNumber(ctx context.Context, number string) (models.Account, error) {
 	var account models.Account
-	query := `SELECT id, owner, balance, currency, created_at FROM accounts WHERE id = @id FOR NO KEY UPDATE`
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE account_number = @number`
 	args := pgx.NamedArgs{
-		"id": id,
+		"number": number,
 	}
 
-	err := r.pool.QueryRow(ctx, query, args).Scan(&account.ID, &account.Owner, &account.Balance, &account.Currency, &account.CreatedAt)
+	err := scanAccount(r.pool.QueryRow(ctx, query, args), &account)
 	if err != nil {
 		return account, err
 	}
@@ -67,12 +114,11 @@ func (r *repositoryImpl) GetAccountForUpdate(ctx context.Context, id int64) (mod
 	return account, nil
 }
 
-func (r *repositoryImpl) ListAccounts(ctx context.Context, name string, limit, offset int32) ([]models.Account, error) {
-	query := `SELECT id, owner, balance, currency, created_at FROM accounts WHERE owner = @name ORDER BY id LIMIT @limit OFFSET @offset`
+// ListAccountIDsByNumber maps account numbers to account ids, numbers with no account are left out
+func (r *repositoryImpl) ListAccountIDsByNumber(ctx context.Context, numbers []string) (map[string]int64, error) {
+	query := `SELECT account_number, id FROM accounts WHERE account_number = ANY(@numbers)`
 	args := pgx.NamedArgs{
-		"name":   name,
-		"limit":  limit,
-		"offset": offset,
+		"numbers": numbers,
 	}
 
 	rows, err := r.pool.Query(ctx, query, args)
@@ -82,32 +128,31 @@ func (r *repositoryImpl) ListAccounts(ctx context.Context, name string, limit, o
 
 	defer rows.Close()
 
-	accounts := []models.Account{}
+	ids := make(map[string]int64, len(numbers))
 	for rows.Next() {
-		var account models.Account
-		if err := rows.Scan(&account.ID, &account.Owner, &account.Balance, &account.Currency, &account.CreatedAt); err != nil {
+		var number string
+		var id int64
+		if err := rows.Scan(&number, &id); err != nil {
 			return nil, err
 		}
-		accounts = append(accounts, account)
+		ids[number] = id
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return accounts, nil
+	return ids, nil
 }
 
-func (r *repositoryImpl) UpdateAccount(ctx context.Context, id int64, balance float64) (models.Account, error) {
-	query := "UPDATE accounts SET balance = @amount WHERE id = @id RETURNING id, owner, balance, currency, created_at"
+func (r *repositoryImpl) GetAccountForUpdate(ctx context.Context, id int64) (models.Account, error) {
+	var account models.Account
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE id = @id FOR NO KEY UPDATE`
 	args := pgx.NamedArgs{
-		"id":     id,
-		"amount": balance,
+		"id": id,
 	}
 
-	var account models.Account
-
-	err := r.pool.QueryRow(ctx, query, args).Scan(&account.ID, &account.Owner, &account.Balance, &account.Currency, &account.CreatedAt)
+	err := scanAccount(r.pool.QueryRow(ctx, query, args), &account)
 	if err != nil {
 		return account, err
 	}
@@ -115,35 +160,54 @@ func (r *repositoryImpl) UpdateAccount(ctx context.Context, id int64, balance fl
 	return account, nil
 }
 
-func (r *repositoryImpl) AddAccountBalance(ctx context.Context, id int64, amount float64) (models.Account, error) {
-	query := "UPDATE accounts SET balance = balance + @amount WHERE id = @id RETURNING id, owner, balance, currency, created_at"
+func (r *repositoryImpl) ListAccounts(ctx context.Context, name string, limit, offset int32) ([]models.Account, error) {
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE id IN
+				(SELECT account_id FROM account_holders WHERE username = @name AND status = 'active')
+				ORDER BY id LIMIT @limit OFFSET @offset`
 	args := pgx.NamedArgs{
-		"id":     id,
-		"amount": amount,
+		"name":   name,
+		"limit":  limit,
+		"offset": offset,
 	}
 
-	var account models.Account
-
-	err := r.pool.QueryRow(ctx, query, args).Scan(&account.ID, &account.Owner, &account.Balance, &account.Currency, &account.CreatedAt)
+	rows, err := r.pool.Query(ctx, query, args)
 	if err != nil {
-		return account, err
+		return nil, err
 	}
 
-	return account, nil
+	defer rows.Close()
+
+	accounts := []models.Account{}
+	for rows.Next() {
+		var account models.Account
+		if err := scanAccount(rows, &account); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
 }
 
-func (r *repositoryImpl) DeleteAccount(ctx context.Context, id int64) error {
-	query := "DELETE FROM accounts WHERE id = @id"
+func (r *repositoryImpl) UpdateAccount(ctx context.Context, id int64, balance float64) (models.Account, error) {
+	query := "UPDATE accounts SET balance = @amount WHERE id = @id RETURNING " + accountColumns
 	args := pgx.NamedArgs{
-		"id": id,
+		"id":     id,
+		"amount": balance,
 	}
 
-	_, err := r.pool.Exec(ctx, query, args)
+	var account models.Account
+
+	err := scanAccount(r.pool.QueryRow(ctx, query, args), &account)
 	if err != nil {
-		return err
+		return account, err
 	}
 
-	return nil
+	return account, nil
 }
 
 func (r *repositoryImpl) CreateSession(ctx context.Context, session models.Session) (models.Session, error) {
@@ -185,14 +249,14 @@ func (r *repositoryImpl) GetSession(ctx context.Context, id uuid.UUID) (models.S
 }
 
 func (r *repositoryImpl) CreateEntry(ctx context.Context, entry models.Entry) (models.Entry, error) {
-	query := `INSERT INTO entries (account_id, amount) VALUES (@accountID, @amount) RETURNING id, account_id, amount, created_at`
+	query := `INSERT INTO entries (account_id, amount) VALUES (@accountID, @amount) RETURNING ` + entryColumns
 	args := pgx.NamedArgs{
 		"accountID": entry.AccountID,
 		"amount":    entry.Amount,
 	}
 
 	var e models.Entry
-	err := r.pool.QueryRow(ctx, query, args).Scan(&e.ID, &e.AccountID, &e.Amount, &e.CreatedAt)
+	err := scanEntry(r.pool.QueryRow(ctx, query, args), &e)
 	if err != nil {
 		return e, err
 	}
@@ -202,12 +266,12 @@ func (r *repositoryImpl) CreateEntry(ctx context.Context, entry models.Entry) (m
 
 func (r *repositoryImpl) GetEntry(ctx context.Context, id int64) (models.Entry, error) {
 	var entry models.Entry
-	query := `SELECT id, account_id, amount, created_at FROM entries WHERE id = @id`
+	query := `SELECT ` + entryColumns + ` FROM entries WHERE id = @id`
 	args := pgx.NamedArgs{
 		"id": id,
 	}
 
-	err := r.pool.QueryRow(ctx, query, args).Scan(&entry.ID, &entry.AccountID, &entry.Amount, &entry.CreatedAt)
+	err := scanEntry(r.pool.QueryRow(ctx, query, args), &entry)
 	if err != nil {
 		return entry, err
 	}
@@ -216,7 +280,7 @@ func (r *repositoryImpl) GetEntry(ctx context.Context, id int64) (models.Entry,
 }
 
 func (r *repositoryImpl) ListEntries(ctx context.Context, accountID, limit, offset int64) ([]models.Entry, error) {
-	query := `SELECT id, account_id, amount, created_at FROM entries WHERE account_id = @accountID ORDER BY id LIMIT @limit OFFSET @offset`
+	query := `SELECT ` + entryColumns + ` FROM entries WHERE account_id = @accountID ORDER BY id LIMIT @limit OFFSET @offset`
 	args := pgx.NamedArgs{
 		"accountID": accountID,
 		"limit":     limit,
@@ -233,7 +297,7 @@ func (r *repositoryImpl) ListEntries(ctx context.Context, accountID, limit, offs
 	var entries []models.Entry
 	for rows.Next() {
 		var entry models.Entry
-		if err := rows.Scan(&entry.ID, &entry.AccountID, &entry.Amount, &entry.CreatedAt); err != nil {
+		if err := scanEntry(rows, &entry); err != nil {
 			return nil, err
 		}
 		entries = append(entries, entry)
@@ -248,8 +312,7 @@ func (r *repositoryImpl) ListEntries(ctx context.Context, accountID, limit, offs
 
 func (r *repositoryImpl) CreateTransaction(ctx context.Context, transaction models.Transaction) (models.Transaction, error) {
 	query := `INSERT INTO transactions (amount, fee, currency, description, to_account_id, from_account_id) VALUES 
-	(@amount, @fee, @currency, @description, @toAccountID, @fromAccountID) RETURNING  id, from_account_id, to_account_id,
-	 amount, fee, currency, description, created_at`
+	(@amount, @fee, @currency, @description, @toAccountID, @fromAccountID) RETURNING ` + transactionColumns
 	args := pgx.NamedArgs{
 		"amount":        transaction.Amount,
 		"fee":           transaction.Fee,
@@ -260,7 +323,7 @@ func (r *repositoryImpl) CreateTransaction(ctx context.Context, transaction mode
 	}
 
 	var t models.Transaction
-	err := r.pool.QueryRow(ctx, query, args).Scan(&t.ID, &t.FromAccountID, &t.ToAccountID, &t.Amount, &t.Fee, &t.Currency, &t.Description, &t.CreatedAt)
+	err := scanTransaction(r.pool.QueryRow(ctx, query, args), &t)
 	if err != nil {
 		return t, err
 	}
@@ -270,12 +333,12 @@ func (r *repositoryImpl) CreateTransaction(ctx context.Context, transaction mode
 
 func (r *repositoryImpl) GetTransaction(ctx context.Context, id int64) (models.Transaction, error) {
 	var transaction models.Transaction
-	query := `SELECT id, from_account_id, to_account_id, amount, fee, currency, description, created_at FROM transactions WHERE id = @id`
+	query := `SELECT ` + transactionColumns + ` FROM transactions WHERE id = @id`
 	args := pgx.NamedArgs{
 		"id": id,
 	}
 
-	err := r.pool.QueryRow(ctx, query, args).Scan(&transaction.ID, &transaction.FromAccountID, &transaction.ToAccountID, &transaction.Amount, &transaction.Fee, &transaction.Currency, &transaction.Description, &transaction.CreatedAt)
+	err := scanTransaction(r.pool.QueryRow(ctx, query, args), &transaction)
 	if err != nil {
 		return transaction, err
 	}
@@ -284,7 +347,7 @@ func (r *repositoryImpl) GetTransaction(ctx context.Context, id int64) (models.T
 }
 
 func (r *repositoryImpl) ListTransactions(ctx context.Context, fromAccountID, toAccountID, limit, offset int64) ([]models.Transaction, error) {
-	query := `SELECT id, from_account_id, to_account_id, amount, fee, currency, description, created_at FROM transactions
+	query := `SELECT ` + transactionColumns + ` FROM transactions
 				WHERE from_account_id = @fromAccountID OR to_account_id = @toAccountID ORDER BY id LIMIT @limit OFFSET @offset`
 	args := pgx.NamedArgs{
 		"fromAccountID": fromAccountID,
@@ -303,7 +366,7 @@ func (r *repositoryImpl) ListTransactions(ctx context.Context, fromAccountID, to
 	var transactions []models.Transaction
 	for rows.Next() {
 		var transaction models.Transaction
-		if err := rows.Scan(&transaction.ID, &transaction.FromAccountID, &transaction.ToAccountID, &transaction.Amount, &transaction.Fee, &transaction.Currency, &transaction.Description, &transaction.CreatedAt); err != nil {
+		if err := scanTransaction(rows, &transaction); err != nil {
 			return nil, err
 		}
 		transactions = append(transactions, transaction)
@@ -318,7 +381,7 @@ func (r *repositoryImpl) ListTransactions(ctx context.Context, fromAccountID, to
 
 func (r *repositoryImpl) CreateUser(ctx context.Context, user models.User) (models.User, error) {
 	var u models.User
-	query := `INSERT INTO users (username, hashed_password, full_name, email) VALUES (@userName, @password, @fullName, @email) RETURNING *`
+	query := `INSERT INTO users (username, hashed_password, full_name, email) VALUES (@userName, @password, @fullName, @email) RETURNING ` + userColumns
 	args := pgx.NamedArgs{
 		"userName": user.UserName,
 		"password": user.HashedPassword,
@@ -326,7 +389,7 @@ func (r *repositoryImpl) CreateUser(ctx context.Context, user models.User) (mode
 		"email":    user.Email,
 	}
 
-	err := r.pool.QueryRow(ctx, query, args).Scan(&u.UserName, &u.HashedPassword, &u.FullName, &u.Email, &u.PasswordChangedAt, &u.CreatedAt, &user.IsEmailVerified)
+	err := scanUser(r.pool.QueryRow(ctx, query, args), &u)
 	if err != nil {
 		return u, err
 	}
@@ -336,12 +399,12 @@ func (r *repositoryImpl) CreateUser(ctx context.Context, user models.User) (mode
 
 func (r *repositoryImpl) GetUser(ctx context.Context, username string) (models.User, error) {
 	var user models.User
-	query := `SELECT  * FROM users WHERE username = @username`
+	query := `SELECT ` + userColumns + ` FROM users WHERE username = @username`
 	args := pgx.NamedArgs{
 		"username": username,
 	}
 
-	err := r.pool.QueryRow(ctx, query, args).Scan(&user.UserName, &user.HashedPassword, &user.FullName, &user.Email, &user.PasswordChangedAt, &user.CreatedAt, &user.IsEmailVerified)
+	err := scanUser(r.pool.QueryRow(ctx, query, args), &user)
 	if err != nil {
 		return user, err
 	}
@@ -350,7 +413,7 @@ func (r *repositoryImpl) GetUser(ctx context.Context, username string) (models.U
 }
 
 func (r *repositoryImpl) ListUsers(ctx context.Context, limit, offset int32) ([]models.User, error) {
-	query := `SELECT * FROM users ORDER BY username LIMIT @limit OFFSET @offset`
+	query := `SELECT ` + userColumns + ` FROM users ORDER BY username LIMIT @limit OFFSET @offset`
 	args := pgx.NamedArgs{
 		"limit":  limit,
 		"offset": offset,
@@ -366,7 +429,7 @@ func (r *repositoryImpl) ListUsers(ctx context.Context, limit, offset int32) ([]
 	users := []models.User{}
 	for rows.Next() {
 		var user models.User
-		if err := rows.Scan(&user.UserName, &user.HashedPassword, &user.FullName, &user.Email, &user.PasswordChangedAt, &user.CreatedAt, &user.IsEmailVerified); err != nil {
+		if err := scanUser(rows, &user); err != nil {
 			return nil, err
 		}
 		users = append(users, user)
@@ -385,7 +448,10 @@ func (r *repositoryImpl) UpdateUser(ctx context.Context, user models.UpdateUserP
 			  hashed_password = COALESCE(@newPassword, hashed_password),
 			  password_changed_at = COALESCE(@newPasswordChangedTime, password_changed_at),
 			  is_email_verified = COALESCE(@emailVerified, is_email_verified),
-			  email = COALESCE(@newEmail, email) WHERE username = @username  RETURNING *`
+			  role = COALESCE(@newRole, role),
+			  statements_opt_out = COALESCE(@statementsOptOut, statements_opt_out),
+			  locale = COALESCE(@locale, locale),
+			  email = COALESCE(@newEmail, email) WHERE username = @username RETURNING ` + userColumns
 	args := pgx.NamedArgs{
 		"username":               user.UserName,
 		"newFullName":            user.FullName,
@@ -393,9 +459,12 @@ func (r *repositoryImpl) UpdateUser(ctx context.Context, user models.UpdateUserP
 		"newPasswordChangedTime": user.PasswordChangedAt,
 		"newEmail":               user.Email,
 		"emailVerified":          user.IsEmailVerified,
+		"newRole":                user.Role,
+		"statementsOptOut":       user.StatementsOptOut,
+		"locale":                 user.Locale,
 	}
 
-	err := r.pool.QueryRow(ctx, query, args).Scan(&u.UserName, &u.HashedPassword, &u.FullName, &u.Email, &u.PasswordChangedAt, &u.CreatedAt, &user.IsEmailVerified)
+	err := scanUser(r.pool.QueryRow(ctx, query, args), &u)
 	if err != nil {
 		return u, err
 	}
@@ -428,6 +497,7 @@ func (r *repositoryImpl) execTx(ctx context.Context, fn func(pgx.Tx) error) erro
 
 	err = fn(tx)
 	if err != nil {
+		err = postingError(err)
 		if rbErr := tx.Rollback(ctx); rbErr != nil {
 			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
 		}
@@ -457,14 +527,13 @@ func (r *repositoryImpl) VerifyEmailTx(ctx context.Context, arg models.VerifyEma
 		}
 
 		// update users table
-		query2 := `UPDATE users SET is_email_verified = COALESCE(@emailVerified, is_email_verified) WHERE username = @username RETURNING *`
+		query2 := `UPDATE users SET is_email_verified = COALESCE(@emailVerified, is_email_verified) WHERE username = @username RETURNING ` + userColumns
 		args2 := pgx.NamedArgs{
 			"username":      result.VerifyEmail.Username,
 			"emailVerified": true,
 		}
 
-		err = tx.QueryRow(ctx, query2, args2).Scan(&result.User.UserName, &result.User.HashedPassword, &result.User.FullName,
-			&result.User.Email, &result.User.PasswordChangedAt, &result.User.CreatedAt, &result.User.IsEmailVerified)
+		err = scanUser(tx.QueryRow(ctx, query2, args2), &result.User)
 		return err
 	})
 
@@ -478,7 +547,7 @@ func (r *repositoryImpl) CreateUserTx(ctx context.Context, arg models.CreateUser
 		var err error
 
 		// create user
-		query := `INSERT INTO users (username, hashed_password, full_name, email) VALUES (@userName, @password, @fullName, @email) RETURNING *`
+		query := `INSERT INTO users (username, hashed_password, full_name, email) VALUES (@userName, @password, @fullName, @email) RETURNING ` + userColumns
 		args := pgx.NamedArgs{
 			"userName": arg.User.UserName,
 			"password": arg.User.HashedPassword,
@@ -486,8 +555,7 @@ func (r *repositoryImpl) CreateUserTx(ctx context.Context, arg models.CreateUser
 			"email":    arg.User.Email,
 		}
 
-		err = tx.QueryRow(ctx, query, args).Scan(&result.UserName, &result.HashedPassword, &result.FullName, &result.Email,
-			 &result.PasswordChangedAt, &result.CreatedAt, &result.IsEmailVerified)
+		err = scanUser(tx.QueryRow(ctx, query, args), &result)
 		if err != nil {
 			return err
 		}
@@ -503,62 +571,165 @@ func (r *repositoryImpl) TransferTx(ctx context.Context, arg models.TransferTxPa
 
 	err := r.execTx(ctx, func(tx pgx.Tx) error {
 		var err error
-		// create transaction
-		query := `INSERT INTO transactions (amount, fee, currency, description, to_account_id, from_account_id) VALUES 
-					(@amount, @fee, @currency, @description, @toAccountID, @fromAccountID) RETURNING  id, from_account_id, to_account_id,
-					 amount, fee, currency, description, created_at`
-		args := pgx.NamedArgs{
-			"amount":        arg.Amount,
-			"fee":           arg.Fee,
-			"currency":      arg.Currency,
-			"description":   arg.Description,
-			"fromAccountID": arg.FromAccountID,
-			"toAccountID":   arg.ToAccountID,
-		}
-
-		err = tx.QueryRow(ctx, query, args).Scan(&result.Transaction.ID, &result.Transaction.FromAccountID, &result.Transaction.ToAccountID, &result.Transaction.Amount, &result.Transaction.Fee, &result.Transaction.Currency, &result.Transaction.Description, &result.Transaction.CreatedAt)
+		result, err = createTransfer(ctx, tx, arg)
 		if err != nil {
 			return err
 		}
 
-		// create entry for sender account
-		query2 := `INSERT INTO entries (account_id, amount) VALUES (@accountID, @amount) RETURNING id, account_id, amount, created_at`
-		args2 := pgx.NamedArgs{
-			"accountID": arg.FromAccountID,
-			"amount":    -arg.Amount,
-		}
-
-		err = tx.QueryRow(ctx, query2, args2).Scan(&result.FromEntry.ID, &result.FromEntry.AccountID, &result.FromEntry.Amount, &result.FromEntry.CreatedAt)
-		if err != nil {
+		if err = checkDebit(result.FromAccount); err != nil {
 			return err
 		}
 
-		// create entry for receiver account
-		query3 := `INSERT INTO entries (account_id, amount) VALUES (@accountID, @amount) RETURNING id, account_id, amount, created_at`
-		args3 := pgx.NamedArgs{
-			"accountID": arg.ToAccountID,
-			"amount":    arg.Amount,
+		if err = checkCredit(result.ToAccount); err != nil {
+			return err
 		}
 
-		err = tx.QueryRow(ctx, query3, args3).Scan(&result.ToEntry.ID, &result.ToEntry.AccountID, &result.ToEntry.Amount, &result.ToEntry.CreatedAt)
-		if err != nil {
+		// the balance check runs after the update so that it sees the locked, current row
+		if err = checkFunds(result.FromAccount); err != nil {
 			return err
 		}
 
-		if arg.FromAccountID < arg.ToAccountID {
-			// update the sender's new balance first and then receiver's balance to avoid deadlock
-			result.FromAccount, result.ToAccount, err = addMoney(ctx, tx, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
-		} else {
-			// update the receiver's new balance first and then sender's balance to avoid deadlock
-			result.ToAccount, result.FromAccount, err = addMoney(ctx, tx, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
+		if !isMatured(result.FromAccount) {
+			return models.ErrAccountNotMatured
 		}
 
-		return err
+		return settleTransfer(ctx, tx, &result)
 	})
 
 	return result, err
 }
 
+// settleTransfer runs what every transfer goes through once the money moved, whichever way it was posted: it
+// evaluates the alert rules, adds the overdraft alerts, lists the debit and credit notices and then applies the
+// receiving account's pocket rules
+func settleTransfer(ctx context.Context, tx pgx.Tx, result *models.TransferTxResult) error {
+	var err error
+	result.Alerts, err = evaluateAlertRules(ctx, tx, *result)
+	if err != nil {
+		return err
+	}
+
+	result.Alerts = append(result.Alerts, overdraftAlerts(*result)...)
+
+	result.Notices, err = transferNotices(ctx, tx, *result)
+	if err != nil {
+		return err
+	}
+
+	// alerts are about the transfer itself, so the rules set money aside only after they have been evaluated
+	return applyPocketRules(ctx, tx, result)
+}
+
+// createTransfer records the transaction and both entries and moves the money. It performs no balance
+// checks, callers decide what the sender is allowed to end up with.
+func createTransfer(ctx context.Context, tx pgx.Tx, arg models.TransferTxParams) (models.TransferTxResult, error) {
+	var result models.TransferTxResult
+	var err error
+
+	// create transaction
+	query := `INSERT INTO transactions (amount, fee, currency, description, to_account_id, from_account_id) VALUES 
+				(@amount, @fee, @currency, @description, @toAccountID, @fromAccountID) RETURNING ` + transactionColumns
+	args := pgx.NamedArgs{
+		"amount":        arg.Amount,
+		"fee":           arg.Fee,
+		"currency":      arg.Currency,
+		"description":   arg.Description,
+		"fromAccountID": arg.FromAccountID,
+		"toAccountID":   arg.ToAccountID,
+	}
+
+	err = scanTransaction(tx.QueryRow(ctx, query, args), &result.Transaction)
+	if err != nil {
+		return result, err
+	}
+
+	// create entry for sender account
+	query2 := `INSERT INTO entries (account_id, amount) VALUES (@accountID, @amount) RETURNING ` + entryColumns
+	args2 := pgx.NamedArgs{
+		"accountID": arg.FromAccountID,
+		"amount":    -arg.Amount,
+	}
+
+	err = scanEntry(tx.QueryRow(ctx, query2, args2), &result.FromEntry)
+	if err != nil {
+		return result, err
+	}
+
+	// create entry for receiver account
+	query3 := `INSERT INTO entries (account_id, amount) VALUES (@accountID, @amount) RETURNING ` + entryColumns
+	args3 := pgx.NamedArgs{
+		"accountID": arg.ToAccountID,
+		"amount":    arg.Amount,
+	}
+
+	err = scanEntry(tx.QueryRow(ctx, query3, args3), &result.ToEntry)
+	if err != nil {
+		return result, err
+	}
+
+	if arg.FromAccountID < arg.ToAccountID {
+		// update the sender's new balance first and then receiver's balance to avoid deadlock
+		result.FromAccount, result.ToAccount, err = addMoney(ctx, tx, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
+	} else {
+		// update the receiver's new balance first and then sender's balance to avoid deadlock
+		result.ToAccount, result.FromAccount, err = addMoney(ctx, tx, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	err = recordOverdraftEvents(ctx, tx, &result)
+	return result, err
+}
+
+// lockAccounts takes the row locks for the given accounts in ascending id order, the same order
+// TransferTx updates them in, so that callers touching several accounts cannot deadlock with it
+func lockAccounts(ctx context.Context, tx pgx.Tx, ids ...int64) (map[int64]models.Account, error) {
+	accounts, err := lockExistingAccounts(ctx, tx, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		if _, ok := accounts[id]; !ok {
+			return nil, pgx.ErrNoRows
+		}
+	}
+
+	return accounts, nil
+}
+
+// lockExistingAccounts is lockAccounts without the existence check, ids with no account are left out of the map
+func lockExistingAccounts(ctx context.Context, tx pgx.Tx, ids ...int64) (map[int64]models.Account, error) {
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE id = ANY(@ids) ORDER BY id FOR NO KEY UPDATE`
+	args := pgx.NamedArgs{
+		"ids": ids,
+	}
+
+	rows, err := tx.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	accounts := make(map[int64]models.Account, len(ids))
+	for rows.Next() {
+		var account models.Account
+		if err := scanAccount(rows, &account); err != nil {
+			return nil, err
+		}
+		accounts[account.ID] = account
+	}
+
+	return accounts, rows.Err()
+}
+
+// isMatured reports whether a fixed term account has reached its maturity date, other accounts always have
+func isMatured(account models.Account) bool {
+	return account.MaturesAt == nil || !account.MaturesAt.After(time.Now())
+}
+
 func addMoney(
 	ctx context.Context,
 	tx pgx.Tx,
@@ -568,25 +739,25 @@ func addMoney(
 	amount2 float64,
 ) (account1 models.Account, account2 models.Account, err error) {
 	// update account 1
-	query := "UPDATE accounts SET balance = balance + @amount WHERE id = @id RETURNING id, owner, balance, currency, created_at"
+	query := "UPDATE accounts SET balance = balance + @amount WHERE id = @id RETURNING " + accountColumns
 	args := pgx.NamedArgs{
 		"id":     accountID1,
 		"amount": amount1,
 	}
 
-	err = tx.QueryRow(ctx, query, args).Scan(&account1.ID, &account1.Owner, &account1.Balance, &account1.Currency, &account1.CreatedAt)
+	err = scanAccount(tx.QueryRow(ctx, query, args), &account1)
 	if err != nil {
 		return account1, account2, err
 	}
 
 	// update account 2
-	query2 := "UPDATE accounts SET balance = balance + @amount WHERE id = @id RETURNING id, owner, balance, currency, created_at"
+	query2 := "UPDATE accounts SET balance = balance + @amount WHERE id = @id RETURNING " + accountColumns
 	args2 := pgx.NamedArgs{
 		"id":     accountID2,
 		"amount": amount2,
 	}
 
-	err = tx.QueryRow(ctx, query2, args2).Scan(&account2.ID, &account2.Owner, &account2.Balance, &account2.Currency, &account2.CreatedAt)
+	err = scanAccount(tx.QueryRow(ctx, query2, args2), &account2)
 	if err != nil {
 		return account1, account2, err
 	}
@@ -0,0 +1,312 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const productColumns = `code, name, account_type, interest_rate, day_count_convention, term_months, created_at`
+
+func scanProduct(row pgx.Row, product *models.AccountProduct) error {
+	return row.Scan(&product.Code, &product.Name, &product.AccountType, &product.InterestRate, &product.DayCountConvention,
+		&product.TermMonths, &product.CreatedAt)
+}
+
+func (r *repositoryImpl) GetAccountProduct(ctx context.Context, code string) (models.AccountProduct, error) {
+	var product models.AccountProduct
+	query := `SELECT ` + productColumns + ` FROM account_products WHERE code = @code`
+	args := pgx.NamedArgs{
+		"code": code,
+	}
+
+	err := scanProduct(r.pool.QueryRow(ctx, query, args), &product)
+	if err != nil {
+		return product, err
+	}
+
+	return product, nil
+}
+
+func (r *repositoryImpl) ListAccountProducts(ctx context.Context) ([]models.AccountProduct, error) {
+	query := `SELECT ` + productColumns + ` FROM account_products ORDER BY code`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	products := []models.AccountProduct{}
+	for rows.Next() {
+		var product models.AccountProduct
+		if err := scanProduct(rows, &product); err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// closingBalance is the balance of account a at @dayEnd, its current balance less everything posted since
+const closingBalance = `a.balance - COALESCE((SELECT SUM(e.amount) FROM entries e
+				WHERE e.account_id = a.id AND e.created_at >= @dayEnd), 0)`
+
+// ListInterestBearingAccounts pages through the accounts that earn interest on the given day, ordered by id,
+// with the balance they closed the day on. Accruals usually run after midnight, so the current balance would
+// include postings from the next day. It pages by id rather than offset because accruing changes nothing
+// about which accounts qualify.
+func (r *repositoryImpl) ListInterestBearingAccounts(ctx context.Context, day time.Time, afterID int64, limit int32) ([]models.InterestBearingAccount, error) {
+	query := `SELECT id, balance, interest_rate, day_count_convention FROM (
+				SELECT a.id, ` + closingBalance + ` AS balance, p.interest_rate, p.day_count_convention FROM accounts a
+				JOIN account_products p ON p.code = a.product_code
+				WHERE p.interest_rate > 0 AND a.created_at < @dayEnd AND a.id > @afterID
+			) b WHERE balance > 0
+			ORDER BY id LIMIT @limit`
+	args := pgx.NamedArgs{
+		"dayEnd":  day.AddDate(0, 0, 1),
+		"afterID": afterID,
+		"limit":   limit,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	accounts := []models.InterestBearingAccount{}
+	for rows.Next() {
+		var account models.InterestBearingAccount
+		if err := rows.Scan(&account.AccountID, &account.Balance, &account.InterestRate, &account.DayCountConvention); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// CreateInterestAccrual records one day of interest. It reports false when the account already has an accrual
+// for that day, so rerunning the accrual task for a day is harmless.
+func (r *repositoryImpl) CreateInterestAccrual(ctx context.Context, accrual models.InterestAccrual) (bool, error) {
+	query := `INSERT INTO interest_accruals (account_id, accrual_date, balance, interest_rate, day_count_convention, amount) VALUES
+				(@accountID, @accrualDate, @balance, @interestRate, @dayCountConvention, @amount)
+				ON CONFLICT (account_id, accrual_date) DO NOTHING`
+	args := pgx.NamedArgs{
+		"accountID":          accrual.AccountID,
+		"accrualDate":        accrual.AccrualDate,
+		"balance":            accrual.Balance,
+		"interestRate":       accrual.InterestRate,
+		"dayCountConvention": accrual.DayCountConvention,
+		"amount":             accrual.Amount,
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *repositoryImpl) ListInterestAccruals(ctx context.Context, accountID int64, limit, offset int32) ([]models.InterestAccrual, error) {
+	query := `SELECT id, account_id, (SELECT account_number FROM accounts WHERE id = account_id), accrual_date, balance,
+				interest_rate, day_count_convention, amount, transaction_id, created_at
+				FROM interest_accruals WHERE account_id = @accountID ORDER BY accrual_date DESC LIMIT @limit OFFSET @offset`
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+		"limit":     limit,
+		"offset":    offset,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	accruals := []models.InterestAccrual{}
+	for rows.Next() {
+		var a models.InterestAccrual
+		if err := rows.Scan(&a.ID, &a.AccountID, &a.AccountNumber, &a.AccrualDate, &a.Balance, &a.InterestRate,
+			&a.DayCountConvention, &a.Amount, &a.TransactionID, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		accruals = append(accruals, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accruals, nil
+}
+
+// ListAccountsWithUnpaidInterest returns the ids of accounts holding unpaid accruals dated before the given day
+func (r *repositoryImpl) ListAccountsWithUnpaidInterest(ctx context.Context, before time.Time) ([]int64, error) {
+	query := `SELECT DISTINCT account_id FROM interest_accruals WHERE transaction_id IS NULL AND accrual_date < @before ORDER BY account_id`
+	args := pgx.NamedArgs{
+		"before": before,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	ids := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// PayInterestTx pays the account's unpaid accruals from the bank's interest expense account as a single
+// transfer and marks them paid. Accruals that round to less than a cent are left for the next payment.
+func (r *repositoryImpl) PayInterestTx(ctx context.Context, arg models.PayInterestTxParams) (models.PayInterestTxResult, error) {
+	var result models.PayInterestTxResult
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		result, err = payInterest(ctx, tx, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// payInterest does the work of PayInterestTx inside the caller's transaction
+func payInterest(ctx context.Context, tx pgx.Tx, arg models.PayInterestTxParams) (models.PayInterestTxResult, error) {
+	var result models.PayInterestTxResult
+
+	query := `SELECT id, amount FROM interest_accruals WHERE account_id = @accountID AND accrual_date < @before
+				AND transaction_id IS NULL FOR UPDATE`
+	args := pgx.NamedArgs{
+		"accountID": arg.AccountID,
+		"before":    arg.Before,
+	}
+
+	rows, err := tx.Query(ctx, query, args)
+	if err != nil {
+		return result, err
+	}
+
+	var ids []int64
+	var total float64
+	for rows.Next() {
+		var id int64
+		var amount float64
+		if err := rows.Scan(&id, &amount); err != nil {
+			rows.Close()
+			return result, err
+		}
+		ids = append(ids, id)
+		total += amount
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return result, err
+	}
+
+	total = helpers.RoundMoney(total)
+	if total <= 0 {
+		return result, nil
+	}
+
+	account, err := getAccount(ctx, tx, arg.AccountID)
+	if err != nil {
+		return result, err
+	}
+
+	expenseAccountID, err := getInternalAccountID(ctx, tx, models.InternalAccountInterestExpense, account.Currency)
+	if err != nil {
+		return result, err
+	}
+
+	accounts, err := lockAccounts(ctx, tx, expenseAccountID, account.ID)
+	if err != nil {
+		return result, err
+	}
+
+	if err = checkCredit(accounts[account.ID]); err != nil {
+		return result, err
+	}
+
+	transfer, err := createTransfer(ctx, tx, models.TransferTxParams{
+		FromAccountID: expenseAccountID,
+		ToAccountID:   account.ID,
+		Amount:        total,
+		Currency:      account.Currency,
+		Description:   arg.Description,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	query2 := `UPDATE interest_accruals SET transaction_id = @transactionID WHERE id = ANY(@ids)`
+	args2 := pgx.NamedArgs{
+		"transactionID": transfer.Transaction.ID,
+		"ids":           ids,
+	}
+
+	tag, err := tx.Exec(ctx, query2, args2)
+	if err != nil {
+		return result, err
+	}
+
+	result.Accruals = tag.RowsAffected()
+	result.Transfer = &transfer
+	return result, nil
+}
+
+func getAccount(ctx context.Context, tx pgx.Tx, id int64) (models.Account, error) {
+	var account models.Account
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	err := scanAccount(tx.QueryRow(ctx, query, args), &account)
+	return account, err
+}
+
+// getInternalAccountID looks up the bank's own account for the given purpose and currency
+func getInternalAccountID(ctx context.Context, tx pgx.Tx, code, currency string) (int64, error) {
+	var id int64
+	query := `SELECT account_id FROM internal_accounts WHERE code = @code AND currency = @currency`
+	args := pgx.NamedArgs{
+		"code":     code,
+		"currency": currency,
+	}
+
+	err := tx.QueryRow(ctx, query, args).Scan(&id)
+	return id, err
+}
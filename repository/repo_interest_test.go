@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func createRandomSavingsAccount(t *testing.T) models.Account {
+	user := createRandomUser(t)
+
+	account, err := testRepo.R.CreateAccount(context.Background(), models.Account{
+		Owner:       user.UserName,
+		Balance:     1000,
+		Currency:    helpers.RandomCurrency(),
+		ProductCode: "savings",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "savings", account.ProductCode)
+	require.Nil(t, account.MaturesAt)
+
+	return account
+}
+
+func TestListAccountProducts(t *testing.T) {
+	products, err := testRepo.R.ListAccountProducts(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, products)
+
+	for _, product := range products {
+		require.True(t, helpers.IsSupportedDayCount(product.DayCountConvention))
+	}
+
+	checking, err := testRepo.R.GetAccountProduct(context.Background(), models.DefaultProductCode)
+	require.NoError(t, err)
+	require.Equal(t, models.AccountTypeChecking, checking.AccountType)
+}
+
+func TestCreateInterestAccrualOncePerDay(t *testing.T) {
+	account := createRandomSavingsAccount(t)
+	day := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	accrual := models.InterestAccrual{
+		AccountID:          account.ID,
+		AccrualDate:        day,
+		Balance:            account.Balance,
+		InterestRate:       0.02,
+		DayCountConvention: helpers.DayCountActual365,
+		Amount:             account.Balance * 0.02 / 365,
+	}
+
+	created, err := testRepo.R.CreateInterestAccrual(context.Background(), accrual)
+	require.NoError(t, err)
+	require.True(t, created)
+
+	created, err = testRepo.R.CreateInterestAccrual(context.Background(), accrual)
+	require.NoError(t, err)
+	require.False(t, created)
+
+	accruals, err := testRepo.R.ListInterestAccruals(context.Background(), account.ID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, accruals, 1)
+	require.True(t, accruals[0].AccrualDate.Equal(day))
+}
+
+func TestListInterestBearingAccountsUsesClosingBalance(t *testing.T) {
+	account := createRandomSavingsAccount(t)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	yesterday := today.AddDate(0, 0, -1)
+
+	// the account was opened two days ago and money arrived today
+	query := `UPDATE accounts SET created_at = @createdAt WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id":        account.ID,
+		"createdAt": yesterday.AddDate(0, 0, -1),
+	}
+	_, err := testRepo.R.(*repositoryImpl).pool.Exec(context.Background(), query, args)
+	require.NoError(t, err)
+
+	_, err = testRepo.R.CreateEntry(context.Background(), models.Entry{AccountID: account.ID, Amount: 500})
+	require.NoError(t, err)
+	_, err = testRepo.R.UpdateAccount(context.Background(), account.ID, account.Balance+500)
+	require.NoError(t, err)
+
+	accounts, err := testRepo.R.ListInterestBearingAccounts(context.Background(), yesterday, account.ID-1, 1)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, account.ID, accounts[0].AccountID)
+	require.Equal(t, account.Balance, accounts[0].Balance)
+
+	accounts, err = testRepo.R.ListInterestBearingAccounts(context.Background(), today, account.ID-1, 1)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, account.Balance+500, accounts[0].Balance)
+}
+
+func TestPayInterestTx(t *testing.T) {
+	account := createRandomSavingsAccount(t)
+	firstDay := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		_, err := testRepo.R.CreateInterestAccrual(context.Background(), models.InterestAccrual{
+			AccountID:          account.ID,
+			AccrualDate:        firstDay.AddDate(0, 0, i),
+			Balance:            account.Balance,
+			InterestRate:       0.02,
+			DayCountConvention: helpers.DayCountActual365,
+			Amount:             1.01,
+		})
+		require.NoError(t, err)
+	}
+
+	ids, err := testRepo.R.ListAccountsWithUnpaidInterest(context.Background(), firstDay.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	require.Contains(t, ids, account.ID)
+
+	result, err := testRepo.R.PayInterestTx(context.Background(), models.PayInterestTxParams{
+		AccountID:   account.ID,
+		Before:      firstDay.AddDate(0, 1, 0),
+		Description: "interest March 2024",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), result.Accruals)
+	require.NotNil(t, result.Transfer)
+	require.Equal(t, 3.03, result.Transfer.Transaction.Amount)
+	require.Equal(t, account.ID, result.Transfer.ToAccount.ID)
+	require.Equal(t, account.Balance+3.03, result.Transfer.ToAccount.Balance)
+	require.Equal(t, account.Currency, result.Transfer.Transaction.Currency)
+
+	// paying again finds nothing left
+	result, err = testRepo.R.PayInterestTx(context.Background(), models.PayInterestTxParams{
+		AccountID: account.ID,
+		Before:    firstDay.AddDate(0, 1, 0),
+	})
+	require.NoError(t, err)
+	require.Zero(t, result.Accruals)
+	require.Nil(t, result.Transfer)
+}
+
+func TestFixedTermAccountRejectsDebits(t *testing.T) {
+	user := createRandomUser(t)
+	maturesAt := time.Now().AddDate(1, 0, 0)
+
+	account, err := testRepo.R.CreateAccount(context.Background(), models.Account{
+		Owner:       user.UserName,
+		Balance:     1000,
+		Currency:    helpers.RandomCurrency(),
+		ProductCode: "fixed_term_12m",
+		MaturesAt:   &maturesAt,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, account.MaturesAt)
+
+	receiver := createRandomAccount(t)
+
+	_, err = testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   receiver.ID,
+		Amount:        10,
+		Currency:      account.Currency,
+	})
+	require.ErrorIs(t, err, models.ErrAccountNotMatured)
+}
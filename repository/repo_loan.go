@@ -0,0 +1,558 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const loanColumns = `id, account_id, (SELECT account_number FROM accounts WHERE id = account_id), principal, interest_rate,
+	term_months, method, currency, late_fee, outstanding_principal, status, disbursed_by, transaction_id, paid_off_at,
+	created_at`
+
+func scanLoan(row pgx.Row, loan *models.Loan) error {
+	return row.Scan(&loan.ID, &loan.AccountID, &loan.AccountNumber, &loan.Principal, &loan.InterestRate, &loan.TermMonths,
+		&loan.Method, &loan.Currency, &loan.LateFee, &loan.OutstandingPrincipal, &loan.Status, &loan.DisbursedBy,
+		&loan.TransactionID, &loan.PaidOffAt, &loan.CreatedAt)
+}
+
+const loanInstallmentColumns = `id, loan_id, number, due_date, principal, interest, amount, late_fee, status, transaction_id, paid_at`
+
+func scanLoanInstallment(row pgx.Row, installment *models.LoanInstallment) error {
+	return row.Scan(&installment.ID, &installment.LoanID, &installment.Number, &installment.DueDate, &installment.Principal,
+		&installment.Interest, &installment.Amount, &installment.LateFee, &installment.Status, &installment.TransactionID,
+		&installment.PaidAt)
+}
+
+// DisburseLoanTx pays the loan principal into the customer's account from the bank's loan principal account
+// and stores the loan together with its repayment schedule
+func (r *repositoryImpl) DisburseLoanTx(ctx context.Context, arg models.DisburseLoanParams) (models.LoanSchedule, error) {
+	var schedule models.LoanSchedule
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		principalAccountID, err := getInternalAccountID(ctx, tx, models.InternalAccountLoanPrincipal, arg.Loan.Currency)
+		if err != nil {
+			return err
+		}
+
+		accounts, err := lockAccounts(ctx, tx, arg.Loan.AccountID, principalAccountID)
+		if err != nil {
+			return err
+		}
+
+		account := accounts[arg.Loan.AccountID]
+		if account.Currency != arg.Loan.Currency {
+			return models.ErrCurrencyMismatch
+		}
+
+		if err = checkCredit(account); err != nil {
+			return err
+		}
+
+		transfer, err := createTransfer(ctx, tx, models.TransferTxParams{
+			FromAccountID: principalAccountID,
+			ToAccountID:   account.ID,
+			Amount:        arg.Loan.Principal,
+			Currency:      arg.Loan.Currency,
+			Description:   "loan disbursement",
+		})
+		if err != nil {
+			return err
+		}
+
+		query := `INSERT INTO loans (account_id, principal, interest_rate, term_months, method, currency, late_fee,
+					outstanding_principal, disbursed_by, transaction_id) VALUES (@accountID, @principal, @interestRate,
+					@termMonths, @method, @currency, @lateFee, @principal, @disbursedBy, @transactionID) RETURNING ` + loanColumns
+		args := pgx.NamedArgs{
+			"accountID":     arg.Loan.AccountID,
+			"principal":     arg.Loan.Principal,
+			"interestRate":  arg.Loan.InterestRate,
+			"termMonths":    arg.Loan.TermMonths,
+			"method":        arg.Loan.Method,
+			"currency":      arg.Loan.Currency,
+			"lateFee":       arg.Loan.LateFee,
+			"disbursedBy":   arg.Loan.DisbursedBy,
+			"transactionID": transfer.Transaction.ID,
+		}
+
+		if err = scanLoan(tx.QueryRow(ctx, query, args), &schedule.Loan); err != nil {
+			return err
+		}
+
+		rows := make([][]any, len(arg.Installments))
+		for i, installment := range arg.Installments {
+			rows[i] = []any{schedule.Loan.ID, installment.Number, installment.DueDate, installment.Principal,
+				installment.Interest, installment.Amount}
+		}
+
+		_, err = tx.CopyFrom(ctx, pgx.Identifier{"loan_installments"},
+			[]string{"loan_id", "number", "due_date", "principal", "interest", "amount"}, pgx.CopyFromRows(rows))
+		if err != nil {
+			return err
+		}
+
+		schedule.Installments, err = listLoanInstallments(ctx, tx, schedule.Loan.ID, false)
+		if err != nil {
+			return err
+		}
+
+		schedule.PayoffAmount, _, _ = payoffAmount(schedule.Installments, time.Now())
+		return nil
+	})
+
+	return schedule, err
+}
+
+func (r *repositoryImpl) GetLoan(ctx context.Context, id int64) (models.Loan, error) {
+	var loan models.Loan
+	query := `SELECT ` + loanColumns + ` FROM loans WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	err := scanLoan(r.pool.QueryRow(ctx, query, args), &loan)
+	if err != nil {
+		return loan, err
+	}
+
+	return loan, nil
+}
+
+// GetLoanSchedule returns the loan with its installments and the amount that would pay it off on the given day
+func (r *repositoryImpl) GetLoanSchedule(ctx context.Context, id int64, day time.Time) (models.LoanSchedule, error) {
+	var schedule models.LoanSchedule
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		query := `SELECT ` + loanColumns + ` FROM loans WHERE id = @id`
+		args := pgx.NamedArgs{
+			"id": id,
+		}
+
+		if err := scanLoan(tx.QueryRow(ctx, query, args), &schedule.Loan); err != nil {
+			return err
+		}
+
+		var err error
+		schedule.Installments, err = listLoanInstallments(ctx, tx, id, false)
+		if err != nil {
+			return err
+		}
+
+		schedule.PayoffAmount, _, _ = payoffAmount(schedule.Installments, day)
+		return nil
+	})
+
+	return schedule, err
+}
+
+// ListLoans lists the account's loans, newest first
+func (r *repositoryImpl) ListLoans(ctx context.Context, accountID int64) ([]models.Loan, error) {
+	query := `SELECT ` + loanColumns + ` FROM loans WHERE account_id = @accountID ORDER BY id DESC`
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	loans := []models.Loan{}
+	for rows.Next() {
+		var loan models.Loan
+		if err := scanLoan(rows, &loan); err != nil {
+			return nil, err
+		}
+		loans = append(loans, loan)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return loans, nil
+}
+
+// ListDueLoanInstallments returns the unpaid installments due on or before the given day, grouped by loan and
+// the oldest first within a loan so that each loan's installments are collected in order
+func (r *repositoryImpl) ListDueLoanInstallments(ctx context.Context, day time.Time) ([]models.LoanInstallment, error) {
+	query := `SELECT ` + loanInstallmentColumns + ` FROM loan_installments
+				WHERE status IN (@scheduled, @overdue) AND due_date <= @day
+				ORDER BY loan_id, due_date, number`
+	args := pgx.NamedArgs{
+		"scheduled": models.LoanInstallmentScheduled,
+		"overdue":   models.LoanInstallmentOverdue,
+		"day":       day,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	installments := []models.LoanInstallment{}
+	for rows.Next() {
+		var installment models.LoanInstallment
+		if err := scanLoanInstallment(rows, &installment); err != nil {
+			return nil, err
+		}
+		installments = append(installments, installment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return installments, nil
+}
+
+// RepayLoanInstallmentTx collects a due installment from the loan's account. When the account can't cover it
+// the installment becomes overdue, picks up the loan's late fee the first time, and the loan turns delinquent,
+// or defaulted once an installment has been overdue for models.LoanDefaultAfterDays. That outcome is committed
+// and reported with Paid set to false rather than as an error.
+func (r *repositoryImpl) RepayLoanInstallmentTx(ctx context.Context, id int64, day time.Time) (models.LoanRepaymentResult, error) {
+	var result models.LoanRepaymentResult
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		query := `SELECT ` + loanInstallmentColumns + ` FROM loan_installments WHERE id = @id FOR UPDATE`
+		args := pgx.NamedArgs{
+			"id": id,
+		}
+
+		if err := scanLoanInstallment(tx.QueryRow(ctx, query, args), &result.Installment); err != nil {
+			return err
+		}
+
+		loan, err := getLoanForUpdate(ctx, tx, result.Installment.LoanID)
+		if err != nil {
+			return err
+		}
+		result.Loan = loan
+
+		installment := result.Installment
+		if installment.Status != models.LoanInstallmentScheduled && installment.Status != models.LoanInstallmentOverdue {
+			return nil
+		}
+
+		accounts, err := lockLoanAccounts(ctx, tx, loan)
+		if err != nil {
+			return err
+		}
+
+		if checkDebitAmount(accounts.account, installment.Amount+installment.LateFee) != nil {
+			if installment.Status == models.LoanInstallmentScheduled {
+				query2 := `UPDATE loan_installments SET status = @status, late_fee = @lateFee WHERE id = @id
+							RETURNING ` + loanInstallmentColumns
+				args2 := pgx.NamedArgs{
+					"id":      installment.ID,
+					"status":  models.LoanInstallmentOverdue,
+					"lateFee": loan.LateFee,
+				}
+
+				if err := scanLoanInstallment(tx.QueryRow(ctx, query2, args2), &result.Installment); err != nil {
+					return err
+				}
+			}
+
+			result.Loan, err = updateLoanStatus(ctx, tx, loan.ID, 0, day)
+			return err
+		}
+
+		transfer, err := postLoanRepayment(ctx, tx, loan, accounts, installment.Principal, installment.Interest, installment.LateFee,
+			fmt.Sprintf("loan %d installment %d", loan.ID, installment.Number))
+		if err != nil {
+			return err
+		}
+
+		query3 := `UPDATE loan_installments SET status = @status, transaction_id = @transactionID, paid_at = now()
+					WHERE id = @id RETURNING ` + loanInstallmentColumns
+		args3 := pgx.NamedArgs{
+			"id":            installment.ID,
+			"status":        models.LoanInstallmentPaid,
+			"transactionID": transfer.Transaction.ID,
+		}
+
+		if err := scanLoanInstallment(tx.QueryRow(ctx, query3, args3), &result.Installment); err != nil {
+			return err
+		}
+
+		result.Loan, err = updateLoanStatus(ctx, tx, loan.ID, installment.Principal, day)
+		if err != nil {
+			return err
+		}
+
+		result.Paid = true
+		return nil
+	})
+
+	return result, err
+}
+
+// PayOffLoanTx settles the loan early. The customer pays the outstanding principal plus the interest and late
+// fees of installments already due, interest that isn't due yet is not charged.
+func (r *repositoryImpl) PayOffLoanTx(ctx context.Context, id int64, day time.Time) (models.LoanSchedule, error) {
+	var schedule models.LoanSchedule
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		loan, err := getLoanForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if loan.Status == models.LoanStatusPaidOff {
+			return models.ErrLoanPaidOff
+		}
+
+		installments, err := listLoanInstallments(ctx, tx, id, true)
+		if err != nil {
+			return err
+		}
+
+		total, interest, fees := payoffAmount(installments, day)
+		principal := helpers.RoundMoney(total - interest - fees)
+
+		accounts, err := lockLoanAccounts(ctx, tx, loan)
+		if err != nil {
+			return err
+		}
+
+		if err = checkDebitAmount(accounts.account, total); err != nil {
+			return err
+		}
+
+		transfer, err := postLoanRepayment(ctx, tx, loan, accounts, principal, interest, fees, fmt.Sprintf("loan %d payoff", loan.ID))
+		if err != nil {
+			return err
+		}
+
+		// installments already due count as paid by the payoff, the rest are no longer owed
+		query := `UPDATE loan_installments SET
+					status = CASE WHEN due_date <= @day THEN @paid ELSE @cancelled END,
+					transaction_id = CASE WHEN due_date <= @day THEN @transactionID::bigint END,
+					paid_at = CASE WHEN due_date <= @day THEN now() END
+					WHERE loan_id = @loanID AND status IN (@scheduled, @overdue)`
+		args := pgx.NamedArgs{
+			"loanID":        loan.ID,
+			"day":           day,
+			"paid":          models.LoanInstallmentPaid,
+			"cancelled":     models.LoanInstallmentCancelled,
+			"transactionID": transfer.Transaction.ID,
+			"scheduled":     models.LoanInstallmentScheduled,
+			"overdue":       models.LoanInstallmentOverdue,
+		}
+
+		if _, err = tx.Exec(ctx, query, args); err != nil {
+			return err
+		}
+
+		schedule.Loan, err = updateLoanStatus(ctx, tx, loan.ID, principal, day)
+		if err != nil {
+			return err
+		}
+
+		schedule.Installments, err = listLoanInstallments(ctx, tx, loan.ID, false)
+		return err
+	})
+
+	return schedule, err
+}
+
+func getLoanForUpdate(ctx context.Context, tx pgx.Tx, id int64) (models.Loan, error) {
+	var loan models.Loan
+	query := `SELECT ` + loanColumns + ` FROM loans WHERE id = @id FOR UPDATE`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	err := scanLoan(tx.QueryRow(ctx, query, args), &loan)
+	return loan, err
+}
+
+// listLoanInstallments lists the loan's installments in order, optionally only the unpaid ones
+func listLoanInstallments(ctx context.Context, tx pgx.Tx, loanID int64, unpaidOnly bool) ([]models.LoanInstallment, error) {
+	query := `SELECT ` + loanInstallmentColumns + ` FROM loan_installments WHERE loan_id = @loanID
+				AND (NOT @unpaidOnly OR status IN (@scheduled, @overdue)) ORDER BY number`
+	args := pgx.NamedArgs{
+		"loanID":     loanID,
+		"unpaidOnly": unpaidOnly,
+		"scheduled":  models.LoanInstallmentScheduled,
+		"overdue":    models.LoanInstallmentOverdue,
+	}
+
+	rows, err := tx.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	installments := []models.LoanInstallment{}
+	for rows.Next() {
+		var installment models.LoanInstallment
+		if err := scanLoanInstallment(rows, &installment); err != nil {
+			return nil, err
+		}
+		installments = append(installments, installment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return installments, nil
+}
+
+// payoffAmount adds up the unpaid principal and the interest and late fees of the unpaid installments due
+// on or before the given day, and returns the total along with its interest and fee parts
+func payoffAmount(installments []models.LoanInstallment, day time.Time) (total, interest, fees float64) {
+	for _, installment := range installments {
+		if installment.Status != models.LoanInstallmentScheduled && installment.Status != models.LoanInstallmentOverdue {
+			continue
+		}
+
+		total += installment.Principal
+		if !installment.DueDate.After(day) {
+			interest += installment.Interest
+			fees += installment.LateFee
+		}
+	}
+
+	interest = helpers.RoundMoney(interest)
+	fees = helpers.RoundMoney(fees)
+	return helpers.RoundMoney(total + interest + fees), interest, fees
+}
+
+// checkDebitAmount reports whether the given amount may be taken from the account
+func checkDebitAmount(account models.Account, amount float64) error {
+	if err := checkDebit(account); err != nil {
+		return err
+	}
+
+	account.AvailableBalance -= amount
+	return checkFunds(account)
+}
+
+// loanAccounts are the accounts a loan repayment moves money between
+type loanAccounts struct {
+	account     models.Account // the customer account the loan is repaid from
+	principalID int64
+	interestID  int64
+	feesID      int64
+}
+
+// lockLoanAccounts takes the row locks for the customer account and the bank's loan accounts all at once, so
+// that they are taken in the same order as everywhere else
+func lockLoanAccounts(ctx context.Context, tx pgx.Tx, loan models.Loan) (loanAccounts, error) {
+	var accounts loanAccounts
+	var err error
+
+	if accounts.principalID, err = getInternalAccountID(ctx, tx, models.InternalAccountLoanPrincipal, loan.Currency); err != nil {
+		return accounts, err
+	}
+	if accounts.interestID, err = getInternalAccountID(ctx, tx, models.InternalAccountLoanInterest, loan.Currency); err != nil {
+		return accounts, err
+	}
+	if accounts.feesID, err = getInternalAccountID(ctx, tx, models.InternalAccountLoanFees, loan.Currency); err != nil {
+		return accounts, err
+	}
+
+	locked, err := lockAccounts(ctx, tx, loan.AccountID, accounts.principalID, accounts.interestID, accounts.feesID)
+	if err != nil {
+		return accounts, err
+	}
+
+	accounts.account = locked[loan.AccountID]
+	return accounts, nil
+}
+
+// postLoanRepayment takes a repayment from the loan's account in one transaction and moves the interest and
+// fee parts on from the loan principal account to the bank's income accounts
+func postLoanRepayment(ctx context.Context, tx pgx.Tx, loan models.Loan, accounts loanAccounts, principal, interest, fee float64, description string) (models.TransferTxResult, error) {
+	transfer, err := createTransfer(ctx, tx, models.TransferTxParams{
+		FromAccountID: loan.AccountID,
+		ToAccountID:   accounts.principalID,
+		Amount:        helpers.RoundMoney(principal + interest + fee),
+		Currency:      loan.Currency,
+		Description:   description,
+	})
+	if err != nil {
+		return transfer, err
+	}
+
+	splits := []struct {
+		accountID int64
+		amount    float64
+	}{
+		{accounts.interestID, interest},
+		{accounts.feesID, fee},
+	}
+	for _, split := range splits {
+		if split.amount <= 0 {
+			continue
+		}
+
+		if _, err = createTransfer(ctx, tx, models.TransferTxParams{
+			FromAccountID: accounts.principalID,
+			ToAccountID:   split.accountID,
+			Amount:        split.amount,
+			Currency:      loan.Currency,
+			Description:   description,
+		}); err != nil {
+			return transfer, err
+		}
+	}
+
+	return transfer, nil
+}
+
+// updateLoanStatus takes the repaid principal off the loan and works out its status from its installments
+func updateLoanStatus(ctx context.Context, tx pgx.Tx, loanID int64, repaid float64, day time.Time) (models.Loan, error) {
+	var loan models.Loan
+
+	var unpaid int64
+	var oldestOverdue *time.Time
+	query := `SELECT count(*) FILTER (WHERE status IN (@scheduled, @overdue)), min(due_date) FILTER (WHERE status = @overdue)
+				FROM loan_installments WHERE loan_id = @loanID`
+	args := pgx.NamedArgs{
+		"loanID":    loanID,
+		"scheduled": models.LoanInstallmentScheduled,
+		"overdue":   models.LoanInstallmentOverdue,
+	}
+
+	if err := tx.QueryRow(ctx, query, args).Scan(&unpaid, &oldestOverdue); err != nil {
+		return loan, err
+	}
+
+	status := models.LoanStatusActive
+	var paidOffAt *time.Time
+	switch {
+	case unpaid == 0:
+		status = models.LoanStatusPaidOff
+		now := time.Now()
+		paidOffAt = &now
+	case oldestOverdue != nil && !oldestOverdue.AddDate(0, 0, models.LoanDefaultAfterDays).After(day):
+		status = models.LoanStatusDefaulted
+	case oldestOverdue != nil:
+		status = models.LoanStatusDelinquent
+	}
+
+	query2 := `UPDATE loans SET outstanding_principal = round((outstanding_principal - @repaid)::numeric, 2), status = @status,
+				paid_off_at = @paidOffAt WHERE id = @id RETURNING ` + loanColumns
+	args2 := pgx.NamedArgs{
+		"id":        loanID,
+		"repaid":    repaid,
+		"status":    status,
+		"paidOffAt": paidOffAt,
+	}
+
+	err := scanLoan(tx.QueryRow(ctx, query2, args2), &loan)
+	return loan, err
+}
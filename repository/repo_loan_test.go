@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/amortization"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestLoan(t *testing.T) {
+	account := createRandomAccount(t)
+	fundAccount(t, &account)
+	teller := createRandomTeller(t, models.RoleTeller)
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	// taken out a month ago, so the first installment is due
+	installments, err := amortization.Schedule(models.LoanMethodAnnuity, 1200, 0.12, 12, amortization.AddMonths(today, -1))
+	require.NoError(t, err)
+
+	schedule, err := testRepo.R.DisburseLoanTx(context.Background(), models.DisburseLoanParams{
+		Loan: models.Loan{
+			AccountID:    account.ID,
+			Principal:    1200,
+			InterestRate: 0.12,
+			TermMonths:   12,
+			Method:       models.LoanMethodAnnuity,
+			Currency:     account.Currency,
+			LateFee:      15,
+			DisbursedBy:  teller.UserName,
+		},
+		Installments: installments,
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.LoanStatusActive, schedule.Loan.Status)
+	require.Equal(t, float64(1200), schedule.Loan.OutstandingPrincipal)
+	require.Len(t, schedule.Installments, 12)
+
+	disbursed, err := testRepo.R.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, account.Balance+1200, disbursed.Balance)
+
+	schedule, err = testRepo.R.GetLoanSchedule(context.Background(), schedule.Loan.ID, today)
+	require.NoError(t, err)
+	require.Equal(t, 1212.0, schedule.PayoffAmount)
+
+	first, second := schedule.Installments[0], schedule.Installments[1]
+
+	due, err := testRepo.R.ListDueLoanInstallments(context.Background(), today)
+	require.NoError(t, err)
+
+	var dueIDs []int64
+	for i, installment := range due {
+		dueIDs = append(dueIDs, installment.ID)
+		if i > 0 {
+			// each loan's installments come together, the oldest first
+			previous := due[i-1]
+			require.True(t, previous.LoanID < installment.LoanID ||
+				(previous.LoanID == installment.LoanID && !previous.DueDate.After(installment.DueDate)))
+		}
+	}
+	require.Contains(t, dueIDs, first.ID)
+	require.NotContains(t, dueIDs, second.ID)
+
+	result, err := testRepo.R.RepayLoanInstallmentTx(context.Background(), first.ID, today)
+	require.NoError(t, err)
+	require.True(t, result.Paid)
+	require.Equal(t, models.LoanInstallmentPaid, result.Installment.Status)
+	require.NotNil(t, result.Installment.TransactionID)
+	require.Equal(t, 1105.38, result.Loan.OutstandingPrincipal)
+
+	// collecting it again does nothing
+	result, err = testRepo.R.RepayLoanInstallmentTx(context.Background(), first.ID, today)
+	require.NoError(t, err)
+	require.False(t, result.Paid)
+	require.Equal(t, 1105.38, result.Loan.OutstandingPrincipal)
+
+	// an empty account misses the next installment
+	_, err = testRepo.R.UpdateAccount(context.Background(), account.ID, 0)
+	require.NoError(t, err)
+
+	result, err = testRepo.R.RepayLoanInstallmentTx(context.Background(), second.ID, second.DueDate)
+	require.NoError(t, err)
+	require.False(t, result.Paid)
+	require.Equal(t, models.LoanInstallmentOverdue, result.Installment.Status)
+	require.Equal(t, float64(15), result.Installment.LateFee)
+	require.Equal(t, models.LoanStatusDelinquent, result.Loan.Status)
+
+	result, err = testRepo.R.RepayLoanInstallmentTx(context.Background(), second.ID, second.DueDate.AddDate(0, 0, models.LoanDefaultAfterDays))
+	require.NoError(t, err)
+	require.False(t, result.Paid)
+	require.Equal(t, float64(15), result.Installment.LateFee)
+	require.Equal(t, models.LoanStatusDefaulted, result.Loan.Status)
+
+	_, err = testRepo.R.UpdateAccount(context.Background(), account.ID, 1000)
+	require.NoError(t, err)
+
+	result, err = testRepo.R.RepayLoanInstallmentTx(context.Background(), second.ID, second.DueDate.AddDate(0, 0, models.LoanDefaultAfterDays))
+	require.NoError(t, err)
+	require.True(t, result.Paid)
+	require.Equal(t, models.LoanStatusActive, result.Loan.Status)
+
+	repaid, err := testRepo.R.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.InDelta(t, 1000-second.Amount-15, repaid.Balance, 0.001)
+
+	// paying off today only owes the principal left, none of the future interest
+	outstanding := result.Loan.OutstandingPrincipal
+	schedule, err = testRepo.R.PayOffLoanTx(context.Background(), schedule.Loan.ID, today)
+	require.NoError(t, err)
+	require.Equal(t, models.LoanStatusPaidOff, schedule.Loan.Status)
+	require.Zero(t, schedule.Loan.OutstandingPrincipal)
+	require.NotNil(t, schedule.Loan.PaidOffAt)
+	for _, installment := range schedule.Installments[2:] {
+		require.Equal(t, models.LoanInstallmentCancelled, installment.Status)
+	}
+
+	paidOff, err := testRepo.R.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.InDelta(t, repaid.Balance-outstanding, paidOff.Balance, 0.001)
+
+	_, err = testRepo.R.PayOffLoanTx(context.Background(), schedule.Loan.ID, today)
+	require.ErrorIs(t, err, models.ErrLoanPaidOff)
+}
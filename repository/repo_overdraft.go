@@ -0,0 +1,422 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const overdraftRequestColumns = `id, account_id, (SELECT account_number FROM accounts WHERE id = account_id),
+	requested_limit, status, requested_by, decided_by, comment, decided_at, created_at`
+
+func scanOverdraftRequest(row pgx.Row, req *models.OverdraftRequest) error {
+	return row.Scan(&req.ID, &req.AccountID, &req.AccountNumber, &req.RequestedLimit, &req.Status, &req.RequestedBy,
+		&req.DecidedBy, &req.Comment, &req.DecidedAt, &req.CreatedAt)
+}
+
+func overdraftRequestError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return models.ErrOverdraftRequestExists
+	}
+	return err
+}
+
+// checkFunds reports whether the account's available balance is still within its arranged overdraft
+func checkFunds(account models.Account) error {
+	if account.AvailableBalance < -account.OverdraftLimit {
+		return models.ErrInsufficientFunds
+	}
+	return nil
+}
+
+// CreateOverdraftRequest records a request for a new overdraft limit, an account has at most one waiting
+func (r *repositoryImpl) CreateOverdraftRequest(ctx context.Context, req models.OverdraftRequest) (models.OverdraftRequest, error) {
+	var or models.OverdraftRequest
+	query := `INSERT INTO overdraft_requests (account_id, requested_limit, requested_by) VALUES
+				(@accountID, @requestedLimit, @requestedBy) RETURNING ` + overdraftRequestColumns
+	args := pgx.NamedArgs{
+		"accountID":      req.AccountID,
+		"requestedLimit": req.RequestedLimit,
+		"requestedBy":    req.RequestedBy,
+	}
+
+	err := scanOverdraftRequest(r.pool.QueryRow(ctx, query, args), &or)
+	if err != nil {
+		return or, overdraftRequestError(err)
+	}
+
+	return or, nil
+}
+
+// ListOverdraftRequests lists requests oldest first so admins work through the queue in order, an empty
+// status lists all of them
+func (r *repositoryImpl) ListOverdraftRequests(ctx context.Context, status string, limit, offset int32) ([]models.OverdraftRequest, error) {
+	query := `SELECT ` + overdraftRequestColumns + ` FROM overdraft_requests WHERE (@status = '' OR status = @status)
+				ORDER BY id LIMIT @limit OFFSET @offset`
+	args := pgx.NamedArgs{
+		"status": status,
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	requests := []models.OverdraftRequest{}
+	for rows.Next() {
+		var req models.OverdraftRequest
+		if err := scanOverdraftRequest(rows, &req); err != nil {
+			return nil, err
+		}
+		requests = append(requests, req)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+// DecideOverdraftRequestTx approves a request by giving the account the requested limit on the given terms,
+// or rejects it. The approver must not be the user who asked for the overdraft.
+func (r *repositoryImpl) DecideOverdraftRequestTx(ctx context.Context, arg models.DecideOverdraftRequestParams) (models.OverdraftRequest, error) {
+	var req models.OverdraftRequest
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		query := `SELECT ` + overdraftRequestColumns + ` FROM overdraft_requests WHERE id = @id FOR UPDATE`
+		args := pgx.NamedArgs{
+			"id": arg.ID,
+		}
+
+		if err := scanOverdraftRequest(tx.QueryRow(ctx, query, args), &req); err != nil {
+			return err
+		}
+
+		if req.Status != models.OverdraftRequestPendingApproval {
+			return models.ErrOperationNotPending
+		}
+
+		if arg.DecidedBy == req.RequestedBy {
+			return models.ErrApproverIsInitiator
+		}
+
+		status := models.OverdraftRequestRejected
+		if arg.Approve {
+			if _, err := lockAccounts(ctx, tx, req.AccountID); err != nil {
+				return err
+			}
+
+			query2 := `UPDATE accounts SET overdraft_limit = @limit, overdraft_interest_rate = @interestRate,
+						overdraft_fee = @fee WHERE id = @id`
+			args2 := pgx.NamedArgs{
+				"id":           req.AccountID,
+				"limit":        req.RequestedLimit,
+				"interestRate": arg.InterestRate,
+				"fee":          arg.Fee,
+			}
+
+			if _, err := tx.Exec(ctx, query2, args2); err != nil {
+				return err
+			}
+
+			status = models.OverdraftRequestApproved
+		}
+
+		query3 := `UPDATE overdraft_requests SET status = @status, decided_by = @decidedBy, comment = @comment,
+					decided_at = now() WHERE id = @id RETURNING ` + overdraftRequestColumns
+		args3 := pgx.NamedArgs{
+			"id":        req.ID,
+			"status":    status,
+			"decidedBy": arg.DecidedBy,
+			"comment":   arg.Comment,
+		}
+
+		return scanOverdraftRequest(tx.QueryRow(ctx, query3, args3), &req)
+	})
+
+	return req, err
+}
+
+// recordOverdraftEvents notes a transfer taking the sender below zero or the receiver back to zero or above.
+// Only accounts with an arranged overdraft are tracked going in, which leaves out the bank's own accounts.
+func recordOverdraftEvents(ctx context.Context, tx pgx.Tx, transfer *models.TransferTxResult) error {
+	from := &transfer.FromAccount
+	if from.Balance < 0 && from.OverdrawnSince == nil && from.OverdraftLimit > 0 {
+		event, err := createOverdraftEvent(ctx, tx, *from, models.OverdraftEventEntered, transfer.Transaction.ID)
+		if err != nil {
+			return err
+		}
+		from.OverdrawnSince = &event.CreatedAt
+		transfer.Overdrafts = append(transfer.Overdrafts, event)
+	}
+
+	to := &transfer.ToAccount
+	if to.Balance >= 0 && to.OverdrawnSince != nil {
+		event, err := createOverdraftEvent(ctx, tx, *to, models.OverdraftEventLeft, transfer.Transaction.ID)
+		if err != nil {
+			return err
+		}
+		to.OverdrawnSince = nil
+		transfer.Overdrafts = append(transfer.Overdrafts, event)
+	}
+
+	return nil
+}
+
+func createOverdraftEvent(ctx context.Context, tx pgx.Tx, account models.Account, kind string, transactionID int64) (models.OverdraftEvent, error) {
+	var event models.OverdraftEvent
+	query := `INSERT INTO overdraft_events (account_id, kind, balance, transaction_id) VALUES
+				(@accountID, @kind, @balance, @transactionID) RETURNING id, account_id, kind, balance, transaction_id, created_at`
+	args := pgx.NamedArgs{
+		"accountID":     account.ID,
+		"kind":          kind,
+		"balance":       account.Balance,
+		"transactionID": transactionID,
+	}
+
+	err := tx.QueryRow(ctx, query, args).Scan(&event.ID, &event.AccountID, &event.Kind, &event.Balance, &event.TransactionID,
+		&event.CreatedAt)
+	if err != nil {
+		return event, err
+	}
+
+	var since *time.Time
+	if kind == models.OverdraftEventEntered {
+		since = &event.CreatedAt
+	}
+
+	query2 := `UPDATE accounts SET overdrawn_since = @since WHERE id = @id`
+	args2 := pgx.NamedArgs{
+		"id":    account.ID,
+		"since": since,
+	}
+
+	_, err = tx.Exec(ctx, query2, args2)
+	return event, err
+}
+
+// overdraftAlerts turns the overdraft events of a transfer into notifications for the account owners. They go out
+// whether or not the owner set up any alert rules.
+func overdraftAlerts(transfer models.TransferTxResult) []models.TriggeredAlert {
+	var alerts []models.TriggeredAlert
+	for _, event := range transfer.Overdrafts {
+		account, kind := transfer.FromAccount, models.AlertKindOverdraftEntered
+		if event.Kind == models.OverdraftEventLeft {
+			account, kind = transfer.ToAccount, models.AlertKindOverdraftLeft
+		}
+
+		alerts = append(alerts, models.TriggeredAlert{
+			Rule: models.AlertRule{
+				AccountID:     account.ID,
+				AccountNumber: account.AccountNumber,
+				Owner:         account.Owner,
+				Kind:          kind,
+				Threshold:     account.OverdraftLimit,
+				Channel:       models.AlertChannelEmail,
+			},
+			TransactionID: transfer.Transaction.ID,
+			Amount:        transfer.Transaction.Amount,
+			Currency:      transfer.Transaction.Currency,
+			Incoming:      account.ID == transfer.ToAccount.ID,
+			Balance:       account.AvailableBalance,
+		})
+	}
+
+	return alerts
+}
+
+// ListOverdrawnAccounts pages through the accounts that pay interest on a negative balance and closed the given
+// day overdrawn, ordered by id, with the balance they closed the day on. Like interest accruals the charge runs
+// after midnight, when the current balance already includes the next day's postings.
+func (r *repositoryImpl) ListOverdrawnAccounts(ctx context.Context, day time.Time, afterID int64, limit int32) ([]models.OverdrawnAccount, error) {
+	query := `SELECT id, balance, overdraft_interest_rate FROM (
+				SELECT a.id, ` + closingBalance + ` AS balance, a.overdraft_interest_rate FROM accounts a
+				WHERE a.overdraft_interest_rate > 0 AND a.created_at < @dayEnd AND a.id > @afterID
+			) b WHERE balance < 0
+			ORDER BY id LIMIT @limit`
+	args := pgx.NamedArgs{
+		"dayEnd":  day.AddDate(0, 0, 1),
+		"afterID": afterID,
+		"limit":   limit,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	accounts := []models.OverdrawnAccount{}
+	for rows.Next() {
+		var account models.OverdrawnAccount
+		if err := rows.Scan(&account.AccountID, &account.Balance, &account.InterestRate); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// ListUnchargedOverdrafts returns the times accounts went overdrawn that no fee has been charged for yet.
+// Going overdrawn because of an overdraft charge doesn't cost another fee.
+func (r *repositoryImpl) ListUnchargedOverdrafts(ctx context.Context) ([]models.UnchargedOverdraft, error) {
+	query := `SELECT e.id, e.account_id, e.kind, e.balance, e.transaction_id, e.created_at, a.overdraft_fee
+				FROM overdraft_events e JOIN accounts a ON a.id = e.account_id
+				WHERE e.kind = @entered AND a.overdraft_fee > 0
+				AND NOT EXISTS (SELECT 1 FROM overdraft_charges c WHERE c.event_id = e.id)
+				AND NOT EXISTS (SELECT 1 FROM overdraft_charges c WHERE c.transaction_id = e.transaction_id)
+				ORDER BY e.id`
+	args := pgx.NamedArgs{
+		"entered": models.OverdraftEventEntered,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	overdrafts := []models.UnchargedOverdraft{}
+	for rows.Next() {
+		var o models.UnchargedOverdraft
+		if err := rows.Scan(&o.Event.ID, &o.Event.AccountID, &o.Event.Kind, &o.Event.Balance, &o.Event.TransactionID,
+			&o.Event.CreatedAt, &o.Fee); err != nil {
+			return nil, err
+		}
+		overdrafts = append(overdrafts, o)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return overdrafts, nil
+}
+
+// ChargeOverdraftTx records an overdraft charge and posts it from the account to the bank's overdraft income
+// account. It reports false when the charge was already made, so rerunning the charge task is harmless.
+// Charges are taken whatever the account's status and may take it past its limit.
+func (r *repositoryImpl) ChargeOverdraftTx(ctx context.Context, charge models.OverdraftCharge) (bool, error) {
+	var charged bool
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var id int64
+		query := `INSERT INTO overdraft_charges (account_id, kind, charge_date, balance, amount, event_id) VALUES
+					(@accountID, @kind, @chargeDate, @balance, @amount, @eventID) ON CONFLICT DO NOTHING RETURNING id`
+		args := pgx.NamedArgs{
+			"accountID":  charge.AccountID,
+			"kind":       charge.Kind,
+			"chargeDate": charge.ChargeDate,
+			"balance":    charge.Balance,
+			"amount":     charge.Amount,
+			"eventID":    charge.EventID,
+		}
+
+		err := tx.QueryRow(ctx, query, args).Scan(&id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		charged = true
+		if charge.Amount <= 0 {
+			return nil
+		}
+
+		account, err := getAccount(ctx, tx, charge.AccountID)
+		if err != nil {
+			return err
+		}
+
+		code := models.InternalAccountOverdraftInterest
+		description := fmt.Sprintf("overdraft interest for %s", charge.ChargeDate.Format(time.DateOnly))
+		if charge.Kind == models.OverdraftChargeFee {
+			code = models.InternalAccountOverdraftFees
+			description = "overdraft fee"
+		}
+
+		incomeAccountID, err := getInternalAccountID(ctx, tx, code, account.Currency)
+		if err != nil {
+			return err
+		}
+
+		if _, err = lockAccounts(ctx, tx, account.ID, incomeAccountID); err != nil {
+			return err
+		}
+
+		transfer, err := createTransfer(ctx, tx, models.TransferTxParams{
+			FromAccountID: account.ID,
+			ToAccountID:   incomeAccountID,
+			Amount:        charge.Amount,
+			Currency:      account.Currency,
+			Description:   description,
+		})
+		if err != nil {
+			return err
+		}
+
+		query2 := `UPDATE overdraft_charges SET transaction_id = @transactionID WHERE id = @id`
+		args2 := pgx.NamedArgs{
+			"id":            id,
+			"transactionID": transfer.Transaction.ID,
+		}
+
+		_, err = tx.Exec(ctx, query2, args2)
+		return err
+	})
+
+	return charged, err
+}
+
+func (r *repositoryImpl) ListOverdraftCharges(ctx context.Context, accountID int64, limit, offset int32) ([]models.OverdraftCharge, error) {
+	query := `SELECT id, account_id, kind, charge_date, balance, amount, event_id, transaction_id, created_at
+				FROM overdraft_charges WHERE account_id = @accountID ORDER BY id DESC LIMIT @limit OFFSET @offset`
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+		"limit":     limit,
+		"offset":    offset,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	charges := []models.OverdraftCharge{}
+	for rows.Next() {
+		var c models.OverdraftCharge
+		if err := rows.Scan(&c.ID, &c.AccountID, &c.Kind, &c.ChargeDate, &c.Balance, &c.Amount, &c.EventID,
+			&c.TransactionID, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		charges = append(charges, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return charges, nil
+}
@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestOverdraft(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+	admin := createRandomTeller(t, models.RoleAdmin)
+
+	transfer := func(from, to models.Account, amount float64) (models.TransferTxResult, error) {
+		return testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        amount,
+			Currency:      from.Currency,
+		})
+	}
+
+	// without an overdraft the balance can't go below zero
+	_, err := transfer(from, to, 1100)
+	require.ErrorIs(t, err, models.ErrInsufficientFunds)
+
+	req, err := testRepo.R.CreateOverdraftRequest(context.Background(), models.OverdraftRequest{
+		AccountID:      from.ID,
+		RequestedLimit: 500,
+		RequestedBy:    from.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.OverdraftRequestPendingApproval, req.Status)
+
+	_, err = testRepo.R.CreateOverdraftRequest(context.Background(), models.OverdraftRequest{
+		AccountID:      from.ID,
+		RequestedLimit: 800,
+		RequestedBy:    from.Owner,
+	})
+	require.ErrorIs(t, err, models.ErrOverdraftRequestExists)
+
+	_, err = testRepo.R.DecideOverdraftRequestTx(context.Background(), models.DecideOverdraftRequestParams{
+		ID:        req.ID,
+		DecidedBy: from.Owner,
+		Approve:   true,
+	})
+	require.ErrorIs(t, err, models.ErrApproverIsInitiator)
+
+	req, err = testRepo.R.DecideOverdraftRequestTx(context.Background(), models.DecideOverdraftRequestParams{
+		ID:           req.ID,
+		DecidedBy:    admin.UserName,
+		Approve:      true,
+		InterestRate: 0.18,
+		Fee:          25,
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.OverdraftRequestApproved, req.Status)
+	require.Equal(t, admin.UserName, *req.DecidedBy)
+
+	// going overdrawn is recorded and notified
+	result, err := transfer(from, to, 1100)
+	require.NoError(t, err)
+	require.Equal(t, float64(-100), result.FromAccount.Balance)
+	require.Equal(t, float64(500), result.FromAccount.OverdraftLimit)
+	require.NotNil(t, result.FromAccount.OverdrawnSince)
+	require.Len(t, result.Overdrafts, 1)
+	require.Equal(t, models.OverdraftEventEntered, result.Overdrafts[0].Kind)
+	require.Len(t, result.Alerts, 1)
+	require.Equal(t, models.AlertKindOverdraftEntered, result.Alerts[0].Rule.Kind)
+	require.Equal(t, from.Owner, result.Alerts[0].Rule.Owner)
+
+	// but not beyond the limit
+	_, err = transfer(from, to, 401)
+	require.ErrorIs(t, err, models.ErrInsufficientFunds)
+
+	overdrafts, err := testRepo.R.ListUnchargedOverdrafts(context.Background())
+	require.NoError(t, err)
+
+	var fee *models.UnchargedOverdraft
+	for i := range overdrafts {
+		if overdrafts[i].Event.ID == result.Overdrafts[0].ID {
+			fee = &overdrafts[i]
+		}
+	}
+	require.NotNil(t, fee)
+	require.Equal(t, float64(25), fee.Fee)
+
+	feeCharge := models.OverdraftCharge{
+		AccountID:  from.ID,
+		Kind:       models.OverdraftChargeFee,
+		ChargeDate: fee.Event.CreatedAt,
+		Balance:    fee.Event.Balance,
+		Amount:     fee.Fee,
+		EventID:    &fee.Event.ID,
+	}
+	charged, err := testRepo.R.ChargeOverdraftTx(context.Background(), feeCharge)
+	require.NoError(t, err)
+	require.True(t, charged)
+
+	charged, err = testRepo.R.ChargeOverdraftTx(context.Background(), feeCharge)
+	require.NoError(t, err)
+	require.False(t, charged)
+
+	interestCharge := models.OverdraftCharge{
+		AccountID:  from.ID,
+		Kind:       models.OverdraftChargeInterest,
+		ChargeDate: time.Now().UTC().AddDate(0, 0, -1),
+		Balance:    -125,
+		Amount:     0.06,
+	}
+	charged, err = testRepo.R.ChargeOverdraftTx(context.Background(), interestCharge)
+	require.NoError(t, err)
+	require.True(t, charged)
+
+	charged, err = testRepo.R.ChargeOverdraftTx(context.Background(), interestCharge)
+	require.NoError(t, err)
+	require.False(t, charged)
+
+	charges, err := testRepo.R.ListOverdraftCharges(context.Background(), from.ID, 5, 0)
+	require.NoError(t, err)
+	require.Len(t, charges, 2)
+	for _, charge := range charges {
+		require.NotNil(t, charge.TransactionID)
+	}
+
+	overdrawn, err := testRepo.R.GetAccount(context.Background(), from.ID)
+	require.NoError(t, err)
+	require.InDelta(t, -125.06, overdrawn.Balance, 0.001)
+
+	// paying it back leaves the overdraft
+	result, err = transfer(to, from, 200)
+	require.NoError(t, err)
+	require.Nil(t, result.ToAccount.OverdrawnSince)
+	require.Len(t, result.Overdrafts, 1)
+	require.Equal(t, models.OverdraftEventLeft, result.Overdrafts[0].Kind)
+	require.Len(t, result.Alerts, 1)
+	require.Equal(t, models.AlertKindOverdraftLeft, result.Alerts[0].Rule.Kind)
+	require.True(t, result.Alerts[0].Incoming)
+}
+
+// grantOverdraft arranges an approved overdraft on the account
+func grantOverdraft(t *testing.T, account models.Account, limit float64) {
+	admin := createRandomTeller(t, models.RoleAdmin)
+
+	req, err := testRepo.R.CreateOverdraftRequest(context.Background(), models.OverdraftRequest{
+		AccountID:      account.ID,
+		RequestedLimit: limit,
+		RequestedBy:    account.Owner,
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.DecideOverdraftRequestTx(context.Background(), models.DecideOverdraftRequestParams{
+		ID:           req.ID,
+		DecidedBy:    admin.UserName,
+		Approve:      true,
+		InterestRate: 0.18,
+	})
+	require.NoError(t, err)
+}
+
+func TestOverdraftAlertsOnEveryPostingPath(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+	staff := createRandomTeller(t, models.RoleTeller)
+	grantOverdraft(t, from, 500)
+
+	requireOverdraftAlert := func(alerts []models.TriggeredAlert, kind string) {
+		require.Len(t, alerts, 1)
+		require.Equal(t, kind, alerts[0].Rule.Kind)
+		require.Equal(t, from.ID, alerts[0].Rule.AccountID)
+		require.Equal(t, from.AccountNumber, alerts[0].Rule.AccountNumber)
+	}
+
+	batch, err := testRepo.R.BatchTransferTx(context.Background(), models.BatchTransferTxParams{
+		FromAccountID: from.ID,
+		Currency:      from.Currency,
+		Mode:          models.BatchModeAllOrNothing,
+		Lines:         []models.BatchTransferLine{{ToAccountID: to.ID, Amount: from.Balance + 100}},
+	})
+	require.NoError(t, err)
+	requireOverdraftAlert(batch.Alerts, models.AlertKindOverdraftEntered)
+
+	pending := createRandomPendingTransfer(t, to, from, time.Now().Add(time.Hour))
+	approved, err := testRepo.R.DecidePendingTransferTx(context.Background(), models.DecidePendingTransferParams{
+		ID:        pending.ID,
+		DecidedBy: staff.UserName,
+		Approve:   true,
+	})
+	require.NoError(t, err)
+	requireOverdraftAlert(approved.Transfer.Alerts, models.AlertKindOverdraftLeft)
+
+	// refunding the approved transfer takes the receiver overdrawn again
+	opened, err := testRepo.R.OpenDisputeTx(context.Background(), models.OpenDisputeParams{
+		TransactionID: approved.Transfer.Transaction.ID,
+		AccountID:     to.ID,
+		Reason:        "sent to the wrong account",
+		OpenedBy:      to.Owner,
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.UpdateDisputeTx(context.Background(), models.UpdateDisputeParams{
+		ID:        opened.Dispute.ID,
+		Status:    models.DisputeStatusRefundProposed,
+		DecidedBy: staff.UserName,
+	})
+	require.NoError(t, err)
+
+	resolved, err := testRepo.R.UpdateDisputeTx(context.Background(), models.UpdateDisputeParams{
+		ID:        opened.Dispute.ID,
+		Status:    models.DisputeStatusResolvedCustomer,
+		DecidedBy: createRandomUser(t).UserName,
+	})
+	require.NoError(t, err)
+	requireOverdraftAlert(resolved.Alerts, models.AlertKindOverdraftEntered)
+}
+
+func TestListOverdrawnAccountsUsesClosingBalance(t *testing.T) {
+	account := createRandomAccount(t)
+	grantOverdraft(t, account, 500)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	yesterday := today.AddDate(0, 0, -1)
+
+	// the account closed yesterday overdrawn and was paid back today
+	query := `UPDATE accounts SET created_at = @createdAt WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id":        account.ID,
+		"createdAt": yesterday.AddDate(0, 0, -1),
+	}
+	_, err := testRepo.R.(*repositoryImpl).pool.Exec(context.Background(), query, args)
+	require.NoError(t, err)
+
+	_, err = testRepo.R.CreateEntry(context.Background(), models.Entry{AccountID: account.ID, Amount: 300})
+	require.NoError(t, err)
+	_, err = testRepo.R.UpdateAccount(context.Background(), account.ID, 100)
+	require.NoError(t, err)
+
+	accounts, err := testRepo.R.ListOverdrawnAccounts(context.Background(), yesterday, account.ID-1, 1)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, account.ID, accounts[0].AccountID)
+	require.Equal(t, float64(-200), accounts[0].Balance)
+	require.Equal(t, 0.18, accounts[0].InterestRate)
+
+	accounts, err = testRepo.R.ListOverdrawnAccounts(context.Background(), today, account.ID-1, 1)
+	require.NoError(t, err)
+	require.True(t, len(accounts) == 0 || accounts[0].AccountID != account.ID)
+}
@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const paymentAliasColumns = `alias, username, kind, verified_at, created_at`
+
+const defaultAccountColumns = `username, currency, account_id, (SELECT account_number FROM accounts WHERE id = account_id),
+	updated_at`
+
+func scanPaymentAlias(row pgx.Row, alias *models.PaymentAlias) error {
+	return row.Scan(&alias.Alias, &alias.UserName, &alias.Kind, &alias.VerifiedAt, &alias.CreatedAt)
+}
+
+func scanDefaultAccount(row pgx.Row, defaultAccount *models.DefaultAccount) error {
+	return row.Scan(&defaultAccount.UserName, &defaultAccount.Currency, &defaultAccount.AccountID,
+		&defaultAccount.AccountNumber, &defaultAccount.UpdatedAt)
+}
+
+// CreatePaymentAlias registers an unverified alias. An alias nobody verified can be registered again by the
+// same user, to get a new code, or by anyone once its code has expired, so a number can't be held hostage
+// by someone who doesn't own it. models.ErrAliasTaken otherwise.
+func (r *repositoryImpl) CreatePaymentAlias(ctx context.Context, alias models.PaymentAlias) (models.PaymentAlias, error) {
+	var a models.PaymentAlias
+	query := `INSERT INTO payment_aliases (alias, username, kind) VALUES (@alias, @username, @kind)
+			ON CONFLICT (alias) DO UPDATE SET username = EXCLUDED.username, kind = EXCLUDED.kind, code_hash = NULL,
+				code_expires_at = NULL, attempts = 0, created_at = now()
+				WHERE payment_aliases.verified_at IS NULL
+				AND (payment_aliases.username = EXCLUDED.username OR payment_aliases.created_at < @claimableBefore)
+			RETURNING ` + paymentAliasColumns
+	args := pgx.NamedArgs{
+		"alias":           alias.Alias,
+		"username":        alias.UserName,
+		"kind":            alias.Kind,
+		"claimableBefore": time.Now().Add(-models.AliasCodeTTL),
+	}
+
+	err := scanPaymentAlias(r.pool.QueryRow(ctx, query, args), &a)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return a, models.ErrAliasTaken
+	}
+
+	return a, err
+}
+
+func (r *repositoryImpl) GetPaymentAlias(ctx context.Context, alias string) (models.PaymentAlias, error) {
+	var a models.PaymentAlias
+	query := `SELECT ` + paymentAliasColumns + ` FROM payment_aliases WHERE alias = @alias`
+	args := pgx.NamedArgs{
+		"alias": alias,
+	}
+
+	err := scanPaymentAlias(r.pool.QueryRow(ctx, query, args), &a)
+	return a, err
+}
+
+// SetPaymentAliasCode stores the hash of a newly sent code and resets the attempts. pgx.ErrNoRows when the
+// alias is verified already or no longer registered to the user.
+func (r *repositoryImpl) SetPaymentAliasCode(ctx context.Context, alias, username, codeHash string, expiresAt time.Time) error {
+	query := `UPDATE payment_aliases SET code_hash = @codeHash, code_expires_at = @expiresAt, attempts = 0
+				WHERE alias = @alias AND username = @username AND verified_at IS NULL`
+	args := pgx.NamedArgs{
+		"alias":     alias,
+		"username":  username,
+		"codeHash":  codeHash,
+		"expiresAt": expiresAt,
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// VerifyPaymentAliasTx checks the code sent to the alias and marks it verified. A wrong code counts as an
+// attempt, after models.AliasCodeAttempts of them or once the code expired only a new code will do.
+func (r *repositoryImpl) VerifyPaymentAliasTx(ctx context.Context, arg models.VerifyPaymentAliasParams) (models.PaymentAlias, error) {
+	var alias models.PaymentAlias
+	var mismatch bool
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var codeHash *string
+		var expiresAt *time.Time
+		var attempts int
+		query := `SELECT code_hash, code_expires_at, attempts, ` + paymentAliasColumns + ` FROM payment_aliases
+					WHERE alias = @alias AND username = @username FOR UPDATE`
+		args := pgx.NamedArgs{
+			"alias":    arg.Alias,
+			"username": arg.UserName,
+		}
+
+		err := tx.QueryRow(ctx, query, args).Scan(&codeHash, &expiresAt, &attempts, &alias.Alias, &alias.UserName,
+			&alias.Kind, &alias.VerifiedAt, &alias.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		if alias.VerifiedAt != nil {
+			return nil
+		}
+
+		if codeHash == nil || expiresAt == nil || time.Now().After(*expiresAt) || attempts >= models.AliasCodeAttempts {
+			return models.ErrAliasCodeInvalid
+		}
+
+		if helpers.CheckPassword(arg.Code, *codeHash) != nil {
+			query := `UPDATE payment_aliases SET attempts = attempts + 1 WHERE alias = @alias`
+			if _, err := tx.Exec(ctx, query, pgx.NamedArgs{"alias": arg.Alias}); err != nil {
+				return err
+			}
+			mismatch = true
+			return nil
+		}
+
+		query = `UPDATE payment_aliases SET verified_at = now(), code_hash = NULL, code_expires_at = NULL
+					WHERE alias = @alias RETURNING ` + paymentAliasColumns
+		return scanPaymentAlias(tx.QueryRow(ctx, query, pgx.NamedArgs{"alias": arg.Alias}), &alias)
+	})
+
+	// the failed attempt has to be committed, so the mismatch only becomes an error here
+	if err == nil && mismatch {
+		return alias, models.ErrAliasCodeInvalid
+	}
+
+	return alias, err
+}
+
+func (r *repositoryImpl) ListPaymentAliases(ctx context.Context, username string) ([]models.PaymentAlias, error) {
+	query := `SELECT ` + paymentAliasColumns + ` FROM payment_aliases WHERE username = @username ORDER BY created_at`
+	args := pgx.NamedArgs{
+		"username": username,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	aliases := []models.PaymentAlias{}
+	for rows.Next() {
+		var alias models.PaymentAlias
+		if err := scanPaymentAlias(rows, &alias); err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, alias)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return aliases, nil
+}
+
+func (r *repositoryImpl) DeletePaymentAlias(ctx context.Context, alias, username string) error {
+	query := `DELETE FROM payment_aliases WHERE alias = @alias AND username = @username`
+	args := pgx.NamedArgs{
+		"alias":    alias,
+		"username": username,
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// SetDefaultAccount makes the account the user's receiving account for its currency. The user must be an
+// active owner or co-owner of an account that is not closed, otherwise models.ErrInvalidDefaultAccount.
+func (r *repositoryImpl) SetDefaultAccount(ctx context.Context, username string, accountID int64) (models.DefaultAccount, error) {
+	var defaultAccount models.DefaultAccount
+	query := `INSERT INTO default_accounts (username, currency, account_id)
+				SELECT h.username, a.currency, a.id FROM accounts a
+				JOIN account_holders h ON h.account_id = a.id
+				WHERE a.id = @accountID AND a.status <> @closed AND h.username = @username AND h.status = @active
+				AND h.role IN (@owner, @coOwner)
+			ON CONFLICT (username, currency) DO UPDATE SET account_id = EXCLUDED.account_id, updated_at = now()
+			RETURNING ` + defaultAccountColumns
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+		"username":  username,
+		"closed":    models.AccountStatusClosed,
+		"active":    models.HolderStatusActive,
+		"owner":     models.HolderRoleOwner,
+		"coOwner":   models.HolderRoleCoOwner,
+	}
+
+	err := scanDefaultAccount(r.pool.QueryRow(ctx, query, args), &defaultAccount)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return defaultAccount, models.ErrInvalidDefaultAccount
+	}
+
+	return defaultAccount, err
+}
+
+func (r *repositoryImpl) ListDefaultAccounts(ctx context.Context, username string) ([]models.DefaultAccount, error) {
+	query := `SELECT ` + defaultAccountColumns + ` FROM default_accounts WHERE username = @username ORDER BY currency`
+	args := pgx.NamedArgs{
+		"username": username,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	defaults := []models.DefaultAccount{}
+	for rows.Next() {
+		var defaultAccount models.DefaultAccount
+		if err := scanDefaultAccount(rows, &defaultAccount); err != nil {
+			return nil, err
+		}
+		defaults = append(defaults, defaultAccount)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return defaults, nil
+}
+
+// ResolvePaymentAlias finds the account that receives payments sent to a username, verified email or
+// verified alias in the currency. That is the recipient's default account for the currency, or their
+// oldest open account in it when they have not chosen one. A username wins over an email and an email over
+// an alias when they name different users. pgx.ErrNoRows when there is none.
+func (r *repositoryImpl) ResolvePaymentAlias(ctx context.Context, alias, currency string) (models.Account, error) {
+	var account models.Account
+	query := `WITH recipient AS (
+				SELECT username FROM (
+					SELECT username, 1 AS precedence FROM users WHERE username = @alias
+					UNION ALL
+					SELECT username, 2 AS precedence FROM users WHERE lower(email) = lower(@alias) AND is_email_verified
+					UNION ALL
+					SELECT username, 3 AS precedence FROM payment_aliases WHERE alias = @alias AND verified_at IS NOT NULL
+				) candidates ORDER BY precedence LIMIT 1
+			)
+			SELECT ` + accountColumns + ` FROM accounts WHERE id = COALESCE(
+				(SELECT d.account_id FROM default_accounts d JOIN recipient r ON r.username = d.username
+					JOIN accounts a ON a.id = d.account_id
+					WHERE d.currency = @currency AND a.status <> @closed),
+				(SELECT a.id FROM accounts a JOIN recipient r ON r.username = a.owner
+					WHERE a.currency = @currency AND a.status <> @closed ORDER BY a.id LIMIT 1)
+			)`
+	args := pgx.NamedArgs{
+		"alias":    alias,
+		"currency": currency,
+		"closed":   models.AccountStatusClosed,
+	}
+
+	err := scanAccount(r.pool.QueryRow(ctx, query, args), &account)
+	return account, err
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestResolvePaymentAlias(t *testing.T) {
+	first := createRandomAccount(t)
+	second, err := testRepo.R.CreateAccount(context.Background(), models.Account{
+		Owner:       first.Owner,
+		Currency:    first.Currency,
+		ProductCode: models.DefaultProductCode,
+	})
+	require.NoError(t, err)
+
+	// without a default the oldest account in the currency receives
+	account, err := testRepo.R.ResolvePaymentAlias(context.Background(), first.Owner, first.Currency)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, account.ID)
+
+	defaultAccount, err := testRepo.R.SetDefaultAccount(context.Background(), first.Owner, second.ID)
+	require.NoError(t, err)
+	require.Equal(t, first.Currency, defaultAccount.Currency)
+
+	account, err = testRepo.R.ResolvePaymentAlias(context.Background(), first.Owner, first.Currency)
+	require.NoError(t, err)
+	require.Equal(t, second.ID, account.ID)
+
+	phone := fmt.Sprintf("+1555%07d", helpers.RandomInt(0, 9999999))
+	alias, err := testRepo.R.CreatePaymentAlias(context.Background(), models.PaymentAlias{
+		Alias:    phone,
+		UserName: first.Owner,
+		Kind:     models.AliasKindPhone,
+	})
+	require.NoError(t, err)
+	require.Nil(t, alias.VerifiedAt)
+
+	// the alias receives nothing until its code is confirmed
+	_, err = testRepo.R.ResolvePaymentAlias(context.Background(), phone, first.Currency)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	verifyPaymentAlias(t, phone, first.Owner)
+
+	account, err = testRepo.R.ResolvePaymentAlias(context.Background(), phone, first.Currency)
+	require.NoError(t, err)
+	require.Equal(t, second.ID, account.ID)
+
+	_, err = testRepo.R.CreatePaymentAlias(context.Background(), models.PaymentAlias{
+		Alias:    phone,
+		UserName: createRandomUser(t).UserName,
+		Kind:     models.AliasKindPhone,
+	})
+	require.ErrorIs(t, err, models.ErrAliasTaken)
+
+	// emails only resolve once they are verified
+	user, err := testRepo.R.GetUser(context.Background(), first.Owner)
+	require.NoError(t, err)
+	require.False(t, user.IsEmailVerified)
+
+	_, err = testRepo.R.ResolvePaymentAlias(context.Background(), user.Email, first.Currency)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func verifyPaymentAlias(t *testing.T, alias, username string) {
+	codeHash, err := helpers.HashPassword("123456")
+	require.NoError(t, err)
+	err = testRepo.R.SetPaymentAliasCode(context.Background(), alias, username, codeHash, time.Now().Add(models.AliasCodeTTL))
+	require.NoError(t, err)
+
+	verified, err := testRepo.R.VerifyPaymentAliasTx(context.Background(), models.VerifyPaymentAliasParams{
+		Alias:    alias,
+		UserName: username,
+		Code:     "123456",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, verified.VerifiedAt)
+}
+
+func TestVerifyPaymentAlias(t *testing.T) {
+	user := createRandomUser(t)
+	phone := fmt.Sprintf("+1555%07d", helpers.RandomInt(0, 9999999))
+	_, err := testRepo.R.CreatePaymentAlias(context.Background(), models.PaymentAlias{
+		Alias:    phone,
+		UserName: user.UserName,
+		Kind:     models.AliasKindPhone,
+	})
+	require.NoError(t, err)
+
+	arg := models.VerifyPaymentAliasParams{Alias: phone, UserName: user.UserName, Code: "123456"}
+
+	// no code was sent yet
+	_, err = testRepo.R.VerifyPaymentAliasTx(context.Background(), arg)
+	require.ErrorIs(t, err, models.ErrAliasCodeInvalid)
+
+	codeHash, err := helpers.HashPassword("123456")
+	require.NoError(t, err)
+	err = testRepo.R.SetPaymentAliasCode(context.Background(), phone, user.UserName, codeHash, time.Now().Add(models.AliasCodeTTL))
+	require.NoError(t, err)
+
+	// only the user who registered the alias can verify it
+	_, err = testRepo.R.VerifyPaymentAliasTx(context.Background(), models.VerifyPaymentAliasParams{
+		Alias:    phone,
+		UserName: createRandomUser(t).UserName,
+		Code:     "123456",
+	})
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	// wrong codes are counted and lock the code out
+	wrong := arg
+	wrong.Code = "654321"
+	for i := 0; i < models.AliasCodeAttempts; i++ {
+		_, err = testRepo.R.VerifyPaymentAliasTx(context.Background(), wrong)
+		require.ErrorIs(t, err, models.ErrAliasCodeInvalid)
+	}
+	_, err = testRepo.R.VerifyPaymentAliasTx(context.Background(), arg)
+	require.ErrorIs(t, err, models.ErrAliasCodeInvalid)
+
+	// a new code resets the attempts
+	err = testRepo.R.SetPaymentAliasCode(context.Background(), phone, user.UserName, codeHash, time.Now().Add(models.AliasCodeTTL))
+	require.NoError(t, err)
+	alias, err := testRepo.R.VerifyPaymentAliasTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotNil(t, alias.VerifiedAt)
+
+	// a verified alias takes no new codes and can't be registered again
+	err = testRepo.R.SetPaymentAliasCode(context.Background(), phone, user.UserName, codeHash, time.Now().Add(models.AliasCodeTTL))
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+	_, err = testRepo.R.CreatePaymentAlias(context.Background(), models.PaymentAlias{
+		Alias:    phone,
+		UserName: user.UserName,
+		Kind:     models.AliasKindPhone,
+	})
+	require.ErrorIs(t, err, models.ErrAliasTaken)
+}
+
+func TestResolvePaymentAliasPrecedence(t *testing.T) {
+	// a phone alias registered under someone else's username never shadows that user
+	owner := createRandomAccount(t)
+	other := createRandomUser(t)
+
+	query := `INSERT INTO payment_aliases (alias, username, kind, verified_at) VALUES (@alias, @username, @kind, now())`
+	args := pgx.NamedArgs{
+		"alias":    owner.Owner,
+		"username": other.UserName,
+		"kind":     models.AliasKindPhone,
+	}
+	_, err := testRepo.R.(*repositoryImpl).pool.Exec(context.Background(), query, args)
+	require.NoError(t, err)
+
+	account, err := testRepo.R.ResolvePaymentAlias(context.Background(), owner.Owner, owner.Currency)
+	require.NoError(t, err)
+	require.Equal(t, owner.ID, account.ID)
+}
+
+func TestSetDefaultAccountMustBeOwned(t *testing.T) {
+	account := createRandomAccount(t)
+	user := createRandomUser(t)
+
+	_, err := testRepo.R.SetDefaultAccount(context.Background(), user.UserName, account.ID)
+	require.ErrorIs(t, err, models.ErrInvalidDefaultAccount)
+}
@@ -0,0 +1,325 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const pendingTransferColumns = `id, from_account_id, (SELECT account_number FROM accounts WHERE id = from_account_id),
+	to_account_id, (SELECT account_number FROM accounts WHERE id = to_account_id), amount, fee, currency, description,
+	status, initiated_by, decided_by, comment, hold_id, transaction_id, expires_at, decided_at, created_at`
+
+func scanPendingTransfer(row pgx.Row, pending *models.PendingTransfer) error {
+	return row.Scan(&pending.ID, &pending.FromAccountID, &pending.FromAccountNumber, &pending.ToAccountID,
+		&pending.ToAccountNumber, &pending.Amount, &pending.Fee, &pending.Currency, &pending.Description, &pending.Status,
+		&pending.InitiatedBy, &pending.DecidedBy, &pending.Comment, &pending.HoldID, &pending.TransactionID,
+		&pending.ExpiresAt, &pending.DecidedAt, &pending.CreatedAt)
+}
+
+// CreatePendingTransferTx reserves the transfer amount with a hold that lives as long as the pending transfer
+// and records the transfer as waiting for approval
+func (r *repositoryImpl) CreatePendingTransferTx(ctx context.Context, arg models.CreatePendingTransferParams) (models.PendingTransfer, error) {
+	var pending models.PendingTransfer
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		pending, err = createPendingTransfer(ctx, tx, arg)
+		return err
+	})
+
+	return pending, err
+}
+
+func createPendingTransfer(ctx context.Context, tx pgx.Tx, arg models.CreatePendingTransferParams) (models.PendingTransfer, error) {
+	var pending models.PendingTransfer
+
+	accounts, err := lockAccounts(ctx, tx, arg.Transfer.FromAccountID, arg.Transfer.ToAccountID)
+	if err != nil {
+		return pending, err
+	}
+
+	if err = checkCredit(accounts[arg.Transfer.ToAccountID]); err != nil {
+		return pending, err
+	}
+
+	hold, err := createHold(ctx, tx, models.CreateHoldParams{
+		AccountID:   arg.Transfer.FromAccountID,
+		ToAccountID: arg.Transfer.ToAccountID,
+		Amount:      arg.Transfer.Amount,
+		Currency:    arg.Transfer.Currency,
+		Description: arg.Transfer.Description,
+		ExpiresAt:   arg.ExpiresAt,
+	})
+	if err != nil {
+		return pending, err
+	}
+
+	query := `INSERT INTO pending_transfers (from_account_id, to_account_id, amount, fee, currency, description, initiated_by,
+				hold_id, expires_at) VALUES (@fromAccountID, @toAccountID, @amount, @fee, @currency, @description, @initiatedBy,
+				@holdID, @expiresAt) RETURNING ` + pendingTransferColumns
+	args := pgx.NamedArgs{
+		"fromAccountID": arg.Transfer.FromAccountID,
+		"toAccountID":   arg.Transfer.ToAccountID,
+		"amount":        arg.Transfer.Amount,
+		"fee":           arg.Transfer.Fee,
+		"currency":      arg.Transfer.Currency,
+		"description":   arg.Transfer.Description,
+		"initiatedBy":   arg.Transfer.InitiatedBy,
+		"holdID":        hold.ID,
+		"expiresAt":     arg.ExpiresAt,
+	}
+
+	if err = scanPendingTransfer(tx.QueryRow(ctx, query, args), &pending); err != nil {
+		return pending, err
+	}
+
+	if arg.Screening != nil {
+		screening := *arg.Screening
+		screening.PendingTransferID = &pending.ID
+		if _, err = createFraudScreening(ctx, tx, screening); err != nil {
+			return pending, err
+		}
+	}
+
+	err = createPendingTransferEvent(ctx, tx, pending.ID, "", pending.Status, &pending.InitiatedBy, "")
+	return pending, err
+}
+
+func (r *repositoryImpl) GetPendingTransfer(ctx context.Context, id int64) (models.PendingTransfer, error) {
+	var pending models.PendingTransfer
+	query := `SELECT ` + pendingTransferColumns + ` FROM pending_transfers WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	err := scanPendingTransfer(r.pool.QueryRow(ctx, query, args), &pending)
+	if err != nil {
+		return pending, err
+	}
+
+	return pending, nil
+}
+
+// ListPendingTransfers lists transfers oldest first so approvers work through the queue in order, an empty
+// status lists all of them
+func (r *repositoryImpl) ListPendingTransfers(ctx context.Context, status string, limit, offset int32) ([]models.PendingTransfer, error) {
+	query := `SELECT ` + pendingTransferColumns + ` FROM pending_transfers WHERE (@status = '' OR status = @status)
+				ORDER BY id LIMIT @limit OFFSET @offset`
+	args := pgx.NamedArgs{
+		"status": status,
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	transfers := []models.PendingTransfer{}
+	for rows.Next() {
+		var pending models.PendingTransfer
+		if err := scanPendingTransfer(rows, &pending); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, pending)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+func (r *repositoryImpl) ListPendingTransferEvents(ctx context.Context, pendingTransferID int64) ([]models.PendingTransferEvent, error) {
+	query := `SELECT id, pending_transfer_id, from_status, to_status, actor, comment, created_at FROM pending_transfer_events
+				WHERE pending_transfer_id = @pendingTransferID ORDER BY id`
+	args := pgx.NamedArgs{
+		"pendingTransferID": pendingTransferID,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	events := []models.PendingTransferEvent{}
+	for rows.Next() {
+		var event models.PendingTransferEvent
+		if err := rows.Scan(&event.ID, &event.PendingTransferID, &event.FromStatus, &event.ToStatus, &event.Actor,
+			&event.Comment, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// DecidePendingTransferTx approves a pending transfer by capturing its hold, or rejects it by voiding the hold.
+// The approver must not be the user who initiated the transfer, and a transfer with an open fraud screening
+// can't be approved until the screening is resolved.
+func (r *repositoryImpl) DecidePendingTransferTx(ctx context.Context, arg models.DecidePendingTransferParams) (models.DecidePendingTransferResult, error) {
+	var result models.DecidePendingTransferResult
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		result, err = decidePendingTransfer(ctx, tx, arg)
+		return err
+	})
+
+	return result, err
+}
+
+func decidePendingTransfer(ctx context.Context, tx pgx.Tx, arg models.DecidePendingTransferParams) (models.DecidePendingTransferResult, error) {
+	var result models.DecidePendingTransferResult
+
+	var pending models.PendingTransfer
+	query := `SELECT ` + pendingTransferColumns + ` FROM pending_transfers WHERE id = @id FOR UPDATE`
+	args := pgx.NamedArgs{
+		"id": arg.ID,
+	}
+
+	if err := scanPendingTransfer(tx.QueryRow(ctx, query, args), &pending); err != nil {
+		return result, err
+	}
+
+	if pending.Status != models.PendingTransferPendingApproval {
+		return result, models.ErrOperationNotPending
+	}
+
+	if arg.DecidedBy == pending.InitiatedBy {
+		return result, models.ErrApproverIsInitiator
+	}
+
+	// the expiry job may not have caught up yet
+	if !pending.ExpiresAt.After(time.Now()) {
+		return result, models.ErrPendingTransferExpired
+	}
+
+	status := models.PendingTransferRejected
+	var transactionID *int64
+	if arg.Approve {
+		// a transfer the fraud rules sent for review is released by clearing the screening, not by an approver
+		var screened bool
+		query = `SELECT EXISTS (SELECT 1 FROM fraud_screenings WHERE pending_transfer_id = @id AND status = @open)`
+		args = pgx.NamedArgs{
+			"id":   pending.ID,
+			"open": models.FraudScreeningOpen,
+		}
+
+		if err := tx.QueryRow(ctx, query, args).Scan(&screened); err != nil {
+			return result, err
+		}
+
+		if screened {
+			return result, models.ErrScreeningOpen
+		}
+
+		capture, err := captureHold(ctx, tx, pending.HoldID, pending.Amount, pending.Fee)
+		if err != nil {
+			return result, err
+		}
+
+		if err = settleTransfer(ctx, tx, &capture.Transfer); err != nil {
+			return result, err
+		}
+
+		status = models.PendingTransferApproved
+		transactionID = &capture.Transfer.Transaction.ID
+		result.Transfer = &capture.Transfer
+	} else if _, err := voidHold(ctx, tx, pending.HoldID); err != nil {
+		return result, err
+	}
+
+	query2 := `UPDATE pending_transfers SET status = @status, decided_by = @decidedBy, comment = @comment,
+				transaction_id = @transactionID, decided_at = now() WHERE id = @id RETURNING ` + pendingTransferColumns
+	args2 := pgx.NamedArgs{
+		"id":            pending.ID,
+		"status":        status,
+		"decidedBy":     arg.DecidedBy,
+		"comment":       arg.Comment,
+		"transactionID": transactionID,
+	}
+
+	if err := scanPendingTransfer(tx.QueryRow(ctx, query2, args2), &result.PendingTransfer); err != nil {
+		return result, err
+	}
+
+	err := createPendingTransferEvent(ctx, tx, pending.ID, pending.Status, status, &arg.DecidedBy, arg.Comment)
+	return result, err
+}
+
+// ExpirePendingTransfers expires every transfer nobody decided on in time and gives back its reserved funds
+// if the hold expiry job has not done so already
+func (r *repositoryImpl) ExpirePendingTransfers(ctx context.Context) (int64, error) {
+	// lock the accounts in id order first, as lockAccounts does, so the update can't deadlock with a transfer
+	query := `SELECT id FROM accounts WHERE id IN (SELECT h.account_id FROM pending_transfers p
+				JOIN holds h ON h.id = p.hold_id AND h.status = @holdActive
+				WHERE p.status = @pending AND p.expires_at <= now())
+				ORDER BY id FOR NO KEY UPDATE`
+
+	// data-modifying CTEs always run to completion, so the hold, account and event writes happen even though only the count is read
+	query2 := `WITH expired AS (
+				UPDATE pending_transfers SET status = @expired, decided_at = now()
+				WHERE status = @pending AND expires_at <= now() RETURNING id, hold_id
+			  ), holds_released AS (
+				UPDATE holds h SET status = @holdExpired, released_at = now()
+				FROM expired e WHERE h.id = e.hold_id AND h.status = @holdActive RETURNING h.account_id, h.amount
+			  ), released AS (
+				UPDATE accounts a SET hold_balance = a.hold_balance - r.total
+				FROM (SELECT account_id, SUM(amount) AS total FROM holds_released GROUP BY account_id) r
+				WHERE a.id = r.account_id RETURNING a.id
+			  ), events AS (
+				INSERT INTO pending_transfer_events (pending_transfer_id, from_status, to_status)
+				SELECT id, @pending, @expired FROM expired RETURNING id
+			  )
+			  SELECT count(*) FROM expired`
+	args := pgx.NamedArgs{
+		"pending":     models.PendingTransferPendingApproval,
+		"expired":     models.PendingTransferExpired,
+		"holdActive":  models.HoldStatusActive,
+		"holdExpired": models.HoldStatusExpired,
+	}
+
+	var count int64
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, query, args); err != nil {
+			return err
+		}
+
+		return tx.QueryRow(ctx, query2, args).Scan(&count)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func createPendingTransferEvent(ctx context.Context, tx pgx.Tx, id int64, fromStatus, toStatus string, actor *string, comment string) error {
+	query := `INSERT INTO pending_transfer_events (pending_transfer_id, from_status, to_status, actor, comment)
+				VALUES (@pendingTransferID, @fromStatus, @toStatus, @actor, @comment)`
+	args := pgx.NamedArgs{
+		"pendingTransferID": id,
+		"fromStatus":        fromStatus,
+		"toStatus":          toStatus,
+		"actor":             actor,
+		"comment":           comment,
+	}
+
+	_, err := tx.Exec(ctx, query, args)
+	return err
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func createRandomPendingTransfer(t *testing.T, from, to models.Account, expiresAt time.Time) models.PendingTransfer {
+	pending, err := testRepo.R.CreatePendingTransferTx(context.Background(), models.CreatePendingTransferParams{
+		Transfer: models.TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        600,
+			Currency:      from.Currency,
+			Fee:           1,
+			InitiatedBy:   from.Owner,
+		},
+		ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.PendingTransferPendingApproval, pending.Status)
+	require.Equal(t, from.Owner, pending.InitiatedBy)
+	require.Equal(t, from.AccountNumber, pending.FromAccountNumber)
+	require.Equal(t, to.AccountNumber, pending.ToAccountNumber)
+	require.NotZero(t, pending.HoldID)
+
+	return pending
+}
+
+func TestPendingTransferApproved(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+	approver := createRandomTeller(t, models.RoleTeller)
+
+	pending := createRandomPendingTransfer(t, from, to, time.Now().Add(time.Hour))
+
+	// the funds are reserved but not moved yet
+	reserved, err := testRepo.R.GetAccount(context.Background(), from.ID)
+	require.NoError(t, err)
+	require.Equal(t, from.Balance, reserved.Balance)
+	require.Equal(t, from.AvailableBalance-600, reserved.AvailableBalance)
+
+	// the initiator cannot approve their own transfer
+	_, err = testRepo.R.DecidePendingTransferTx(context.Background(), models.DecidePendingTransferParams{
+		ID:        pending.ID,
+		DecidedBy: from.Owner,
+		Approve:   true,
+	})
+	require.ErrorIs(t, err, models.ErrApproverIsInitiator)
+
+	result, err := testRepo.R.DecidePendingTransferTx(context.Background(), models.DecidePendingTransferParams{
+		ID:        pending.ID,
+		DecidedBy: approver.UserName,
+		Approve:   true,
+		Comment:   "checked with the customer",
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.PendingTransferApproved, result.PendingTransfer.Status)
+	require.NotNil(t, result.PendingTransfer.TransactionID)
+	require.NotNil(t, result.Transfer)
+	require.Equal(t, from.Balance-600, result.Transfer.FromAccount.Balance)
+	require.Equal(t, from.Balance-600, result.Transfer.FromAccount.AvailableBalance)
+
+	_, err = testRepo.R.DecidePendingTransferTx(context.Background(), models.DecidePendingTransferParams{
+		ID:        pending.ID,
+		DecidedBy: approver.UserName,
+	})
+	require.ErrorIs(t, err, models.ErrOperationNotPending)
+
+	events, err := testRepo.R.ListPendingTransferEvents(context.Background(), pending.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, models.PendingTransferPendingApproval, events[0].ToStatus)
+	require.Equal(t, models.PendingTransferApproved, events[1].ToStatus)
+	require.Equal(t, approver.UserName, *events[1].Actor)
+	require.Equal(t, "checked with the customer", events[1].Comment)
+}
+
+func TestPendingTransferRejected(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+	approver := createRandomTeller(t, models.RoleAdmin)
+
+	pending := createRandomPendingTransfer(t, from, to, time.Now().Add(time.Hour))
+
+	result, err := testRepo.R.DecidePendingTransferTx(context.Background(), models.DecidePendingTransferParams{
+		ID:        pending.ID,
+		DecidedBy: approver.UserName,
+		Comment:   "unusual payee",
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.PendingTransferRejected, result.PendingTransfer.Status)
+	require.Nil(t, result.Transfer)
+
+	released, err := testRepo.R.GetAccount(context.Background(), from.ID)
+	require.NoError(t, err)
+	require.Equal(t, from.Balance, released.Balance)
+	require.Equal(t, from.AvailableBalance, released.AvailableBalance)
+
+	hold, err := testRepo.R.GetHold(context.Background(), pending.HoldID)
+	require.NoError(t, err)
+	require.Equal(t, models.HoldStatusVoided, hold.Status)
+}
+
+func TestExpirePendingTransfers(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+	approver := createRandomTeller(t, models.RoleTeller)
+
+	pending := createRandomPendingTransfer(t, from, to, time.Now().Add(-time.Second))
+
+	_, err := testRepo.R.DecidePendingTransferTx(context.Background(), models.DecidePendingTransferParams{
+		ID:        pending.ID,
+		DecidedBy: approver.UserName,
+		Approve:   true,
+	})
+	require.ErrorIs(t, err, models.ErrPendingTransferExpired)
+
+	expired, err := testRepo.R.ExpirePendingTransfers(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, expired, int64(1))
+
+	got, err := testRepo.R.GetPendingTransfer(context.Background(), pending.ID)
+	require.NoError(t, err)
+	require.Equal(t, models.PendingTransferExpired, got.Status)
+
+	released, err := testRepo.R.GetAccount(context.Background(), from.ID)
+	require.NoError(t, err)
+	require.Equal(t, from.AvailableBalance, released.AvailableBalance)
+
+	events, err := testRepo.R.ListPendingTransferEvents(context.Background(), pending.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Nil(t, events[1].Actor)
+}
@@ -0,0 +1,494 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const pocketColumns = `id, account_id, (SELECT account_number FROM accounts WHERE id = account_id), name, target_amount,
+	target_date, balance, closed_at, created_at`
+
+func scanPocket(row pgx.Row, pocket *models.Pocket) error {
+	return row.Scan(&pocket.ID, &pocket.AccountID, &pocket.AccountNumber, &pocket.Name, &pocket.TargetAmount,
+		&pocket.TargetDate, &pocket.Balance, &pocket.ClosedAt, &pocket.CreatedAt)
+}
+
+const pocketRuleColumns = `id, pocket_id, kind, percent, created_at`
+
+func scanPocketRule(row pgx.Row, rule *models.PocketRule) error {
+	return row.Scan(&rule.ID, &rule.PocketID, &rule.Kind, &rule.Percent, &rule.CreatedAt)
+}
+
+const pocketEntryColumns = `id, pocket_id, amount, rule_id, transaction_id, created_at`
+
+func scanPocketEntry(row pgx.Row, entry *models.PocketEntry) error {
+	return row.Scan(&entry.ID, &entry.PocketID, &entry.Amount, &entry.RuleID, &entry.TransactionID, &entry.CreatedAt)
+}
+
+func pocketError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return models.ErrPocketExists
+	}
+	return err
+}
+
+func pocketRuleError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return models.ErrPocketRuleExists
+	}
+	return err
+}
+
+func (r *repositoryImpl) CreatePocket(ctx context.Context, pocket models.Pocket) (models.Pocket, error) {
+	var p models.Pocket
+	query := `INSERT INTO pockets (account_id, name, target_amount, target_date) VALUES
+				(@accountID, @name, @targetAmount, @targetDate) RETURNING ` + pocketColumns
+	args := pgx.NamedArgs{
+		"accountID":    pocket.AccountID,
+		"name":         pocket.Name,
+		"targetAmount": pocket.TargetAmount,
+		"targetDate":   pocket.TargetDate,
+	}
+
+	err := scanPocket(r.pool.QueryRow(ctx, query, args), &p)
+	if err != nil {
+		return p, pocketError(err)
+	}
+
+	p.Rules = []models.PocketRule{}
+	return p, nil
+}
+
+func (r *repositoryImpl) GetPocket(ctx context.Context, id int64) (models.Pocket, error) {
+	var pocket models.Pocket
+	query := `SELECT ` + pocketColumns + ` FROM pockets WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	err := scanPocket(r.pool.QueryRow(ctx, query, args), &pocket)
+	if err != nil {
+		return pocket, err
+	}
+
+	return pocket, nil
+}
+
+// ListPockets lists the account's open pockets together with their rules
+func (r *repositoryImpl) ListPockets(ctx context.Context, accountID int64) ([]models.Pocket, error) {
+	query := `SELECT ` + pocketColumns + ` FROM pockets WHERE account_id = @accountID AND closed_at IS NULL ORDER BY id`
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	pockets := []models.Pocket{}
+	index := make(map[int64]int)
+	for rows.Next() {
+		var pocket models.Pocket
+		if err := scanPocket(rows, &pocket); err != nil {
+			return nil, err
+		}
+		pocket.Rules = []models.PocketRule{}
+		index[pocket.ID] = len(pockets)
+		pockets = append(pockets, pocket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	query2 := `SELECT ` + pocketRuleColumns + ` FROM pocket_rules WHERE pocket_id IN
+				(SELECT id FROM pockets WHERE account_id = @accountID AND closed_at IS NULL) ORDER BY id`
+
+	rows2, err := r.pool.Query(ctx, query2, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows2.Close()
+
+	for rows2.Next() {
+		var rule models.PocketRule
+		if err := scanPocketRule(rows2, &rule); err != nil {
+			return nil, err
+		}
+		if i, ok := index[rule.PocketID]; ok {
+			pockets[i].Rules = append(pockets[i].Rules, rule)
+		}
+	}
+
+	if err := rows2.Err(); err != nil {
+		return nil, err
+	}
+
+	return pockets, nil
+}
+
+func (r *repositoryImpl) ListPocketEntries(ctx context.Context, pocketID int64, limit, offset int32) ([]models.PocketEntry, error) {
+	query := `SELECT ` + pocketEntryColumns + ` FROM pocket_entries WHERE pocket_id = @pocketID
+				ORDER BY id DESC LIMIT @limit OFFSET @offset`
+	args := pgx.NamedArgs{
+		"pocketID": pocketID,
+		"limit":    limit,
+		"offset":   offset,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	entries := []models.PocketEntry{}
+	for rows.Next() {
+		var entry models.PocketEntry
+		if err := scanPocketEntry(rows, &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// MovePocketFundsTx moves money between an account and one of its pockets. Only money the account has available
+// may go into a pocket, an overdraft can't be used to fill one.
+func (r *repositoryImpl) MovePocketFundsTx(ctx context.Context, arg models.MovePocketFundsParams) (models.MovePocketFundsResult, error) {
+	var result models.MovePocketFundsResult
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		pocket, account, err := lockPocket(ctx, tx, arg.PocketID)
+		if err != nil {
+			return err
+		}
+
+		if pocket.ClosedAt != nil {
+			return models.ErrPocketClosed
+		}
+
+		if err = checkDebit(account); err != nil {
+			return err
+		}
+
+		if arg.Amount > 0 && helpers.RoundMoney(account.AvailableBalance-arg.Amount) < 0 {
+			return models.ErrInsufficientFunds
+		}
+
+		if arg.Amount < 0 && helpers.RoundMoney(pocket.Balance+arg.Amount) < 0 {
+			return models.ErrInsufficientPocketFunds
+		}
+
+		result, err = movePocketFunds(ctx, tx, pocket, arg.Amount, nil, nil)
+		return err
+	})
+
+	return result, err
+}
+
+// ClosePocketTx moves whatever is left in the pocket back to its account and closes it, its rules stop applying
+func (r *repositoryImpl) ClosePocketTx(ctx context.Context, id int64) (models.Pocket, error) {
+	var pocket models.Pocket
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		pocket, _, err = lockPocket(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if pocket.ClosedAt != nil {
+			return models.ErrPocketClosed
+		}
+
+		pocket, err = closePocket(ctx, tx, pocket)
+		return err
+	})
+
+	return pocket, err
+}
+
+func (r *repositoryImpl) CreatePocketRule(ctx context.Context, rule models.PocketRule) (models.PocketRule, error) {
+	var pr models.PocketRule
+	query := `INSERT INTO pocket_rules (pocket_id, kind, percent) VALUES (@pocketID, @kind, @percent)
+				RETURNING ` + pocketRuleColumns
+	args := pgx.NamedArgs{
+		"pocketID": rule.PocketID,
+		"kind":     rule.Kind,
+		"percent":  rule.Percent,
+	}
+
+	err := scanPocketRule(r.pool.QueryRow(ctx, query, args), &pr)
+	if err != nil {
+		return pr, pocketRuleError(err)
+	}
+
+	return pr, nil
+}
+
+func (r *repositoryImpl) GetPocketRule(ctx context.Context, id int64) (models.PocketRule, error) {
+	var rule models.PocketRule
+	query := `SELECT ` + pocketRuleColumns + ` FROM pocket_rules WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	err := scanPocketRule(r.pool.QueryRow(ctx, query, args), &rule)
+	if err != nil {
+		return rule, err
+	}
+
+	return rule, nil
+}
+
+func (r *repositoryImpl) DeletePocketRule(ctx context.Context, id int64) error {
+	query := `DELETE FROM pocket_rules WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// lockPocket locks the pocket's account and then the pocket, the order applyPocketRules takes them in
+func lockPocket(ctx context.Context, tx pgx.Tx, id int64) (models.Pocket, models.Account, error) {
+	var pocket models.Pocket
+	var account models.Account
+
+	query := `SELECT account_id FROM pockets WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	var accountID int64
+	if err := tx.QueryRow(ctx, query, args).Scan(&accountID); err != nil {
+		return pocket, account, err
+	}
+
+	accounts, err := lockAccounts(ctx, tx, accountID)
+	if err != nil {
+		return pocket, account, err
+	}
+
+	query2 := `SELECT ` + pocketColumns + ` FROM pockets WHERE id = @id FOR UPDATE`
+	if err := scanPocket(tx.QueryRow(ctx, query2, args), &pocket); err != nil {
+		return pocket, account, err
+	}
+
+	return pocket, accounts[accountID], nil
+}
+
+// movePocketFunds moves money between a pocket and its account, both locked by the caller, and records the
+// entry. Positive amounts go into the pocket. The move is posted to the ledger as a pair of entries on the
+// account, one taking the money off the main balance and one putting it into the pocket, so the account's
+// ledger balance doesn't change.
+func movePocketFunds(ctx context.Context, tx pgx.Tx, pocket models.Pocket, amount float64, ruleID, transactionID *int64) (models.MovePocketFundsResult, error) {
+	var result models.MovePocketFundsResult
+
+	query := `UPDATE pockets SET balance = balance + @amount WHERE id = @id RETURNING ` + pocketColumns
+	args := pgx.NamedArgs{
+		"id":     pocket.ID,
+		"amount": amount,
+	}
+
+	if err := scanPocket(tx.QueryRow(ctx, query, args), &result.Pocket); err != nil {
+		return result, err
+	}
+
+	query2 := `UPDATE accounts SET pocket_balance = pocket_balance + @amount WHERE id = @id RETURNING ` + accountColumns
+	args2 := pgx.NamedArgs{
+		"id":     pocket.AccountID,
+		"amount": amount,
+	}
+
+	if err := scanAccount(tx.QueryRow(ctx, query2, args2), &result.Account); err != nil {
+		return result, err
+	}
+
+	query3 := `INSERT INTO pocket_entries (pocket_id, amount, rule_id, transaction_id) VALUES
+				(@pocketID, @amount, @ruleID, @transactionID) RETURNING ` + pocketEntryColumns
+	args3 := pgx.NamedArgs{
+		"pocketID":      pocket.ID,
+		"amount":        amount,
+		"ruleID":        ruleID,
+		"transactionID": transactionID,
+	}
+
+	if err := scanPocketEntry(tx.QueryRow(ctx, query3, args3), &result.Entry); err != nil {
+		return result, err
+	}
+
+	result.LedgerEntries = make([]models.Entry, 0, 2)
+	for _, side := range []float64{-amount, amount} {
+		var entry models.Entry
+		query4 := `INSERT INTO entries (account_id, amount, pocket_entry_id) VALUES (@accountID, @amount, @pocketEntryID)
+					RETURNING ` + entryColumns
+		args4 := pgx.NamedArgs{
+			"accountID":     pocket.AccountID,
+			"amount":        side,
+			"pocketEntryID": result.Entry.ID,
+		}
+
+		err := scanEntry(tx.QueryRow(ctx, query4, args4), &entry)
+		if err != nil {
+			return result, err
+		}
+		result.LedgerEntries = append(result.LedgerEntries, entry)
+	}
+
+	return result, nil
+}
+
+// closePocket empties a pocket locked by the caller back into its account and closes it
+func closePocket(ctx context.Context, tx pgx.Tx, pocket models.Pocket) (models.Pocket, error) {
+	if pocket.Balance != 0 {
+		moved, err := movePocketFunds(ctx, tx, pocket, -pocket.Balance, nil, nil)
+		if err != nil {
+			return pocket, err
+		}
+		pocket = moved.Pocket
+	}
+
+	var closed models.Pocket
+	query := `UPDATE pockets SET closed_at = now() WHERE id = @id RETURNING ` + pocketColumns
+	args := pgx.NamedArgs{
+		"id": pocket.ID,
+	}
+
+	err := scanPocket(tx.QueryRow(ctx, query, args), &closed)
+	return closed, err
+}
+
+// closeAccountPockets closes every open pocket of an account locked by the caller, so the money set aside is
+// part of the available balance again
+func closeAccountPockets(ctx context.Context, tx pgx.Tx, accountID int64) (models.Account, error) {
+	var account models.Account
+	query := `SELECT ` + pocketColumns + ` FROM pockets WHERE account_id = @accountID AND closed_at IS NULL
+				ORDER BY id FOR UPDATE`
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+	}
+
+	rows, err := tx.Query(ctx, query, args)
+	if err != nil {
+		return account, err
+	}
+
+	// read every pocket before closing any, the connection can't run another query while rows are open
+	var pockets []models.Pocket
+	for rows.Next() {
+		var pocket models.Pocket
+		if err := scanPocket(rows, &pocket); err != nil {
+			rows.Close()
+			return account, err
+		}
+		pockets = append(pockets, pocket)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return account, err
+	}
+
+	for _, pocket := range pockets {
+		if _, err := closePocket(ctx, tx, pocket); err != nil {
+			return account, err
+		}
+	}
+
+	return getAccount(ctx, tx, accountID)
+}
+
+// applyPocketRules runs the pocket rules of the account a transfer went into. A rule never sets aside more than
+// the account has available or more than its pocket still needs to reach the target, and it skips accounts that
+// money may not leave.
+func applyPocketRules(ctx context.Context, tx pgx.Tx, result *models.TransferTxResult) error {
+	query := `SELECT ` + pocketRuleColumns + ` FROM pocket_rules WHERE kind = @incoming AND pocket_id IN
+				(SELECT id FROM pockets WHERE closed_at IS NULL AND account_id = @toAccountID) ORDER BY id`
+	args := pgx.NamedArgs{
+		"toAccountID": result.ToAccount.ID,
+		"incoming":    models.PocketRulePercentOfIncoming,
+	}
+
+	rows, err := tx.Query(ctx, query, args)
+	if err != nil {
+		return err
+	}
+
+	var rules []models.PocketRule
+	for rows.Next() {
+		var rule models.PocketRule
+		if err := scanPocketRule(rows, &rule); err != nil {
+			rows.Close()
+			return err
+		}
+		rules = append(rules, rule)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		var pocket models.Pocket
+		query2 := `SELECT ` + pocketColumns + ` FROM pockets WHERE id = @id FOR UPDATE`
+		args2 := pgx.NamedArgs{
+			"id": rule.PocketID,
+		}
+
+		if err := scanPocket(tx.QueryRow(ctx, query2, args2), &pocket); err != nil {
+			return err
+		}
+
+		account := &result.ToAccount
+		amount := helpers.RoundMoney(result.Transaction.Amount * rule.Percent / 100)
+
+		if pocket.TargetAmount > 0 {
+			amount = math.Min(amount, helpers.RoundMoney(pocket.TargetAmount-pocket.Balance))
+		}
+		amount = math.Min(amount, helpers.RoundMoney(account.AvailableBalance))
+
+		if amount <= 0 || checkDebit(*account) != nil {
+			continue
+		}
+
+		moved, err := movePocketFunds(ctx, tx, pocket, amount, &rule.ID, &result.Transaction.ID)
+		if err != nil {
+			return err
+		}
+
+		*account = moved.Account
+		result.Pockets = append(result.Pockets, moved.Entry)
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestPocket(t *testing.T) {
+	account := createRandomAccount(t)
+	fundAccount(t, &account)
+	other := createRandomAccountIn(t, account.Currency)
+	fundAccount(t, &other)
+
+	holiday, err := testRepo.R.CreatePocket(context.Background(), models.Pocket{
+		AccountID:    account.ID,
+		Name:         "Holiday",
+		TargetAmount: 150,
+	})
+	require.NoError(t, err)
+	require.Zero(t, holiday.Balance)
+	require.Empty(t, holiday.Rules)
+
+	_, err = testRepo.R.CreatePocket(context.Background(), models.Pocket{
+		AccountID: account.ID,
+		Name:      holiday.Name,
+	})
+	require.ErrorIs(t, err, models.ErrPocketExists)
+
+	move := func(pocketID int64, amount float64) (models.MovePocketFundsResult, error) {
+		return testRepo.R.MovePocketFundsTx(context.Background(), models.MovePocketFundsParams{
+			PocketID: pocketID,
+			Amount:   amount,
+		})
+	}
+
+	// money in a pocket is still in the account but no longer available
+	moved, err := move(holiday.ID, 100)
+	require.NoError(t, err)
+	require.Equal(t, float64(100), moved.Pocket.Balance)
+	require.Equal(t, float64(1000), moved.Account.Balance)
+	require.Equal(t, float64(900), moved.Account.AvailableBalance)
+	require.Equal(t, float64(100), moved.Entry.Amount)
+	require.Nil(t, moved.Entry.RuleID)
+
+	// the move is posted as a pair of entries that leaves the ledger balance as it was
+	require.Len(t, moved.LedgerEntries, 2)
+	require.Equal(t, float64(-100), moved.LedgerEntries[0].Amount)
+	require.Equal(t, float64(100), moved.LedgerEntries[1].Amount)
+	for _, entry := range moved.LedgerEntries {
+		require.Equal(t, account.ID, entry.AccountID)
+	}
+
+	_, err = move(holiday.ID, 901)
+	require.ErrorIs(t, err, models.ErrInsufficientFunds)
+
+	_, err = move(holiday.ID, -101)
+	require.ErrorIs(t, err, models.ErrInsufficientPocketFunds)
+
+	_, err = testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   other.ID,
+		Amount:        901,
+		Currency:      account.Currency,
+	})
+	require.ErrorIs(t, err, models.ErrInsufficientFunds)
+
+	saveRule, err := testRepo.R.CreatePocketRule(context.Background(), models.PocketRule{
+		PocketID: holiday.ID,
+		Kind:     models.PocketRulePercentOfIncoming,
+		Percent:  10,
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.CreatePocketRule(context.Background(), models.PocketRule{
+		PocketID: holiday.ID,
+		Kind:     models.PocketRulePercentOfIncoming,
+		Percent:  20,
+	})
+	require.ErrorIs(t, err, models.ErrPocketRuleExists)
+
+	// 10% of an incoming transfer is set aside
+	result, err := testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: other.ID,
+		ToAccountID:   account.ID,
+		Amount:        200,
+		Currency:      account.Currency,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Pockets, 1)
+	require.Equal(t, float64(20), result.Pockets[0].Amount)
+	require.Equal(t, saveRule.ID, *result.Pockets[0].RuleID)
+	require.Equal(t, result.Transaction.ID, *result.Pockets[0].TransactionID)
+	require.Equal(t, float64(1200), result.ToAccount.Balance)
+	require.Equal(t, float64(1080), result.ToAccount.AvailableBalance)
+
+	// but never more than the pocket needs to reach its target
+	result, err = testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: other.ID,
+		ToAccountID:   account.ID,
+		Amount:        500,
+		Currency:      account.Currency,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Pockets, 1)
+	require.Equal(t, float64(30), result.Pockets[0].Amount)
+
+	// an outgoing transfer sets nothing aside
+	result, err = testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   other.ID,
+		Amount:        10.4,
+		Currency:      account.Currency,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.Pockets)
+
+	pockets, err := testRepo.R.ListPockets(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, pockets, 1)
+	require.Equal(t, float64(150), pockets[0].Balance)
+	require.Len(t, pockets[0].Rules, 1)
+
+	entries, err := testRepo.R.ListPocketEntries(context.Background(), holiday.ID, 5, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	err = testRepo.R.DeletePocketRule(context.Background(), saveRule.ID)
+	require.NoError(t, err)
+
+	closed, err := testRepo.R.ClosePocketTx(context.Background(), holiday.ID)
+	require.NoError(t, err)
+	require.NotNil(t, closed.ClosedAt)
+	require.Zero(t, closed.Balance)
+
+	_, err = move(holiday.ID, 10)
+	require.ErrorIs(t, err, models.ErrPocketClosed)
+
+	account, err = testRepo.R.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, account.Balance, account.AvailableBalance)
+
+	// closing the account gives back what is still set aside
+	closing, err := testRepo.R.CloseAccountTx(context.Background(), models.CloseAccountTxParams{
+		AccountID:        account.ID,
+		SweepToAccountID: other.ID,
+		ChangedBy:        account.Owner,
+	})
+	require.NoError(t, err)
+	require.Zero(t, closing.Account.Balance)
+
+	pockets, err = testRepo.R.ListPockets(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Empty(t, pockets)
+}
+
+func TestPocketRulesOnBatchAndApprovedTransfers(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+	approver := createRandomTeller(t, models.RoleTeller)
+
+	pocket, err := testRepo.R.CreatePocket(context.Background(), models.Pocket{
+		AccountID: to.ID,
+		Name:      "Savings",
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.CreatePocketRule(context.Background(), models.PocketRule{
+		PocketID: pocket.ID,
+		Kind:     models.PocketRulePercentOfIncoming,
+		Percent:  10,
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.BatchTransferTx(context.Background(), models.BatchTransferTxParams{
+		FromAccountID: from.ID,
+		Currency:      from.Currency,
+		Mode:          models.BatchModeAllOrNothing,
+		Lines: []models.BatchTransferLine{
+			{ToAccountID: to.ID, Amount: 100},
+			{ToAccountID: to.ID, Amount: 50},
+		},
+	})
+	require.NoError(t, err)
+
+	pending := createRandomPendingTransfer(t, from, to, time.Now().Add(time.Hour))
+	_, err = testRepo.R.DecidePendingTransferTx(context.Background(), models.DecidePendingTransferParams{
+		ID:        pending.ID,
+		DecidedBy: approver.UserName,
+		Approve:   true,
+	})
+	require.NoError(t, err)
+
+	entries, err := testRepo.R.ListPocketEntries(context.Background(), pocket.ID, 5, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, float64(60), entries[0].Amount)
+	require.Equal(t, float64(5), entries[1].Amount)
+	require.Equal(t, float64(10), entries[2].Amount)
+
+	updated, err := testRepo.R.GetAccount(context.Background(), to.ID)
+	require.NoError(t, err)
+	require.Equal(t, float64(750), updated.Balance)
+	require.Equal(t, float64(675), updated.AvailableBalance)
+}
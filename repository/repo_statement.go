@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+// ListStatementRecipients lists the active holders of the accounts that were open during the month starting at
+// period, one row per account and holder, for holders with a verified email who haven't opted out of statements
+// and haven't been sent that month's statement yet
+func (r *repositoryImpl) ListStatementRecipients(ctx context.Context, period time.Time) ([]models.StatementRecipient, error) {
+	query := `SELECT a.id, u.username, u.full_name, u.email, u.locale FROM accounts a
+				JOIN account_holders h ON h.account_id = a.id AND h.status = @active
+				JOIN users u ON u.username = h.username
+				WHERE u.is_email_verified AND NOT u.statements_opt_out AND a.created_at < @periodEnd
+				AND (a.closed_at IS NULL OR a.closed_at >= @period)
+				AND NOT EXISTS (SELECT 1 FROM statement_deliveries d WHERE d.account_id = a.id
+					AND d.username = h.username AND d.period = @period::date)
+				ORDER BY a.id, u.username`
+	args := pgx.NamedArgs{
+		"period":    period,
+		"periodEnd": period.AddDate(0, 1, 0),
+		"active":    models.HolderStatusActive,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	recipients := []models.StatementRecipient{}
+	for rows.Next() {
+		var recipient models.StatementRecipient
+		if err := rows.Scan(&recipient.AccountID, &recipient.UserName, &recipient.FullName, &recipient.Email,
+			&recipient.Locale); err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, recipient)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return recipients, nil
+}
+
+// GetStatement collects the account's entries for the month starting at period. The opening balance is the
+// balance just before the month started, the closing balance adds the month's entries to it.
+func (r *repositoryImpl) GetStatement(ctx context.Context, accountID int64, period time.Time) (models.Statement, error) {
+	statement := models.Statement{
+		PeriodStart: period,
+		PeriodEnd:   period.AddDate(0, 1, 0),
+	}
+
+	account, err := r.GetAccount(ctx, accountID)
+	if err != nil {
+		return statement, err
+	}
+	statement.Account = account
+
+	// timestamps are stored with microsecond precision, so this is the last instant before the month
+	opening, err := r.GetBalanceAt(ctx, accountID, period.Add(-time.Microsecond))
+	if err != nil {
+		return statement, err
+	}
+	statement.OpeningBalance = opening.Balance
+	statement.ClosingBalance = opening.Balance
+
+	query := `SELECT ` + entryColumns + ` FROM entries WHERE account_id = @accountID
+				AND created_at >= @periodStart AND created_at < @periodEnd ORDER BY id`
+	args := pgx.NamedArgs{
+		"accountID":   accountID,
+		"periodStart": statement.PeriodStart,
+		"periodEnd":   statement.PeriodEnd,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return statement, err
+	}
+
+	defer rows.Close()
+
+	statement.Entries = []models.Entry{}
+	for rows.Next() {
+		var entry models.Entry
+		if err := scanEntry(rows, &entry); err != nil {
+			return statement, err
+		}
+		statement.Entries = append(statement.Entries, entry)
+		statement.ClosingBalance = helpers.RoundMoney(statement.ClosingBalance + entry.Amount)
+	}
+
+	return statement, rows.Err()
+}
+
+// CreateStatementDelivery records a statement sent to one of the account's holders. It reports false when that
+// holder's statement for the month was already recorded.
+func (r *repositoryImpl) CreateStatementDelivery(ctx context.Context, delivery models.StatementDelivery) (bool, error) {
+	query := `INSERT INTO statement_deliveries (account_id, username, period, email)
+				VALUES (@accountID, @username, @period::date, @email)
+				ON CONFLICT (account_id, period, username) DO NOTHING`
+	args := pgx.NamedArgs{
+		"accountID": delivery.AccountID,
+		"username":  delivery.UserName,
+		"period":    delivery.Period,
+		"email":     delivery.Email,
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
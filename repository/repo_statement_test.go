@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func requireStatementRecipient(t *testing.T, period time.Time, accountID int64, username string, want bool) {
+	recipients, err := testRepo.R.ListStatementRecipients(context.Background(), period)
+	require.NoError(t, err)
+
+	var found bool
+	for _, recipient := range recipients {
+		if recipient.AccountID == accountID && recipient.UserName == username {
+			found = true
+		}
+	}
+	require.Equal(t, want, found)
+}
+
+func TestStatementDelivery(t *testing.T) {
+	account := createRandomAccount(t)
+	now := time.Now().UTC()
+	period := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	// unverified emails get no statements
+	requireStatementRecipient(t, period, account.ID, account.Owner, false)
+
+	_, err := testRepo.R.UpdateUser(context.Background(), models.UpdateUserParams{
+		UserName:        account.Owner,
+		IsEmailVerified: sql.NullBool{Bool: true, Valid: true},
+	})
+	require.NoError(t, err)
+	requireStatementRecipient(t, period, account.ID, account.Owner, true)
+
+	entry := createRandomEntry(t, &account)
+
+	statement, err := testRepo.R.GetStatement(context.Background(), account.ID, period)
+	require.NoError(t, err)
+	require.Equal(t, account.ID, statement.Account.ID)
+	require.Zero(t, statement.OpeningBalance)
+	require.Len(t, statement.Entries, 1)
+	require.Equal(t, entry.Amount, statement.ClosingBalance)
+
+	created, err := testRepo.R.CreateStatementDelivery(context.Background(), models.StatementDelivery{
+		AccountID: account.ID,
+		UserName:  account.Owner,
+		Period:    period,
+		Email:     "statements@example.com",
+	})
+	require.NoError(t, err)
+	require.True(t, created)
+	requireStatementRecipient(t, period, account.ID, account.Owner, false)
+
+	created, err = testRepo.R.CreateStatementDelivery(context.Background(), models.StatementDelivery{
+		AccountID: account.ID,
+		UserName:  account.Owner,
+		Period:    period,
+		Email:     "statements@example.com",
+	})
+	require.NoError(t, err)
+	require.False(t, created)
+
+	// opting out skips the following months too
+	user, err := testRepo.R.UpdateUser(context.Background(), models.UpdateUserParams{
+		UserName:         account.Owner,
+		StatementsOptOut: sql.NullBool{Bool: true, Valid: true},
+		Locale:           sql.NullString{String: "fr", Valid: true},
+	})
+	require.NoError(t, err)
+	require.True(t, user.StatementsOptOut)
+	require.Equal(t, "fr", user.Locale)
+	requireStatementRecipient(t, period.AddDate(0, 1, 0), account.ID, account.Owner, false)
+}
+
+func TestStatementRecipientsIncludeHolders(t *testing.T) {
+	account := createRandomAccount(t)
+	holder := createRandomUser(t)
+	now := time.Now().UTC()
+	period := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	for _, username := range []string{account.Owner, holder.UserName} {
+		_, err := testRepo.R.UpdateUser(context.Background(), models.UpdateUserParams{
+			UserName:        username,
+			IsEmailVerified: sql.NullBool{Bool: true, Valid: true},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := testRepo.R.InviteAccountHolder(context.Background(), models.InviteAccountHolderParams{
+		AccountID: account.ID,
+		UserName:  holder.UserName,
+		Role:      models.HolderRoleViewer,
+		InvitedBy: account.Owner,
+	})
+	require.NoError(t, err)
+
+	// an invitation that hasn't been accepted gets no statements
+	requireStatementRecipient(t, period, account.ID, account.Owner, true)
+	requireStatementRecipient(t, period, account.ID, holder.UserName, false)
+
+	_, err = testRepo.R.AcceptAccountInvitation(context.Background(), account.ID, holder.UserName)
+	require.NoError(t, err)
+	requireStatementRecipient(t, period, account.ID, holder.UserName, true)
+
+	// each holder's delivery is recorded on its own
+	created, err := testRepo.R.CreateStatementDelivery(context.Background(), models.StatementDelivery{
+		AccountID: account.ID,
+		UserName:  account.Owner,
+		Period:    period,
+		Email:     "owner@example.com",
+	})
+	require.NoError(t, err)
+	require.True(t, created)
+	requireStatementRecipient(t, period, account.ID, account.Owner, false)
+	requireStatementRecipient(t, period, account.ID, holder.UserName, true)
+}
@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const tellerOperationColumns = `id, kind, account_id, (SELECT account_number FROM accounts WHERE id = account_id), amount,
+	currency, reason_code, note, status, initiated_by, decided_by, transaction_id, decided_at, created_at`
+
+func scanTellerOperation(row pgx.Row, op *models.TellerOperation) error {
+	return row.Scan(&op.ID, &op.Kind, &op.AccountID, &op.AccountNumber, &op.Amount, &op.Currency, &op.ReasonCode, &op.Note,
+		&op.Status, &op.InitiatedBy, &op.DecidedBy, &op.TransactionID, &op.DecidedAt, &op.CreatedAt)
+}
+
+func (r *repositoryImpl) ListTellerReasonCodes(ctx context.Context) ([]models.TellerReasonCode, error) {
+	query := `SELECT code, kind, description FROM teller_reason_codes ORDER BY kind, code`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	codes := []models.TellerReasonCode{}
+	for rows.Next() {
+		var code models.TellerReasonCode
+		if err := rows.Scan(&code.Code, &code.Kind, &code.Description); err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// CreateTellerOperationTx records a deposit, withdrawal or adjustment. Operations that don't need a second
+// approver are posted straight away, the rest wait for DecideTellerOperationTx.
+func (r *repositoryImpl) CreateTellerOperationTx(ctx context.Context, arg models.CreateTellerOperationParams) (models.TellerOperation, error) {
+	var op models.TellerOperation
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		var kind string
+		query := `SELECT kind FROM teller_reason_codes WHERE code = @code`
+		args := pgx.NamedArgs{
+			"code": arg.ReasonCode,
+		}
+
+		err := tx.QueryRow(ctx, query, args).Scan(&kind)
+		if errors.Is(err, pgx.ErrNoRows) || (err == nil && kind != arg.Kind) {
+			return models.ErrInvalidReasonCode
+		}
+		if err != nil {
+			return err
+		}
+
+		account, err := getAccount(ctx, tx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Currency != arg.Currency {
+			return models.ErrCurrencyMismatch
+		}
+
+		query2 := `INSERT INTO teller_operations (kind, account_id, amount, currency, reason_code, note, status, initiated_by) VALUES
+					(@kind, @accountID, @amount, @currency, @reasonCode, @note, @status, @initiatedBy) RETURNING ` + tellerOperationColumns
+		args2 := pgx.NamedArgs{
+			"kind":        arg.Kind,
+			"accountID":   arg.AccountID,
+			"amount":      arg.Amount,
+			"currency":    arg.Currency,
+			"reasonCode":  arg.ReasonCode,
+			"note":        arg.Note,
+			"status":      models.TellerOperationPendingApproval,
+			"initiatedBy": arg.InitiatedBy,
+		}
+
+		if err = scanTellerOperation(tx.QueryRow(ctx, query2, args2), &op); err != nil {
+			return err
+		}
+
+		if arg.RequiresApproval {
+			return nil
+		}
+
+		op, err = postTellerOperation(ctx, tx, op, nil)
+		return err
+	})
+
+	return op, err
+}
+
+func (r *repositoryImpl) GetTellerOperation(ctx context.Context, id int64) (models.TellerOperation, error) {
+	var op models.TellerOperation
+	query := `SELECT ` + tellerOperationColumns + ` FROM teller_operations WHERE id = @id`
+	args := pgx.NamedArgs{
+		"id": id,
+	}
+
+	err := scanTellerOperation(r.pool.QueryRow(ctx, query, args), &op)
+	if err != nil {
+		return op, err
+	}
+
+	return op, nil
+}
+
+// ListTellerOperations lists operations newest first, an empty status lists all of them
+func (r *repositoryImpl) ListTellerOperations(ctx context.Context, status string, limit, offset int32) ([]models.TellerOperation, error) {
+	query := `SELECT ` + tellerOperationColumns + ` FROM teller_operations WHERE (@status = '' OR status = @status)
+				ORDER BY id DESC LIMIT @limit OFFSET @offset`
+	args := pgx.NamedArgs{
+		"status": status,
+		"limit":  limit,
+		"offset": offset,
+	}
+
+	rows, err := r.pool.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	ops := []models.TellerOperation{}
+	for rows.Next() {
+		var op models.TellerOperation
+		if err := scanTellerOperation(rows, &op); err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ops, nil
+}
+
+// DecideTellerOperationTx approves and posts, or rejects, an operation that is waiting for a second teller
+func (r *repositoryImpl) DecideTellerOperationTx(ctx context.Context, arg models.DecideTellerOperationParams) (models.TellerOperation, error) {
+	var op models.TellerOperation
+
+	err := r.execTx(ctx, func(tx pgx.Tx) error {
+		query := `SELECT ` + tellerOperationColumns + ` FROM teller_operations WHERE id = @id FOR UPDATE`
+		args := pgx.NamedArgs{
+			"id": arg.OperationID,
+		}
+
+		if err := scanTellerOperation(tx.QueryRow(ctx, query, args), &op); err != nil {
+			return err
+		}
+
+		if op.Status != models.TellerOperationPendingApproval {
+			return models.ErrOperationNotPending
+		}
+
+		if arg.Approve {
+			if arg.DecidedBy == op.InitiatedBy {
+				return models.ErrApproverIsInitiator
+			}
+
+			var err error
+			op, err = postTellerOperation(ctx, tx, op, &arg.DecidedBy)
+			return err
+		}
+
+		query2 := `UPDATE teller_operations SET status = @status, decided_by = @decidedBy, decided_at = now()
+					WHERE id = @id RETURNING ` + tellerOperationColumns
+		args2 := pgx.NamedArgs{
+			"id":        op.ID,
+			"status":    models.TellerOperationRejected,
+			"decidedBy": arg.DecidedBy,
+		}
+
+		return scanTellerOperation(tx.QueryRow(ctx, query2, args2), &op)
+	})
+
+	return op, err
+}
+
+// postTellerOperation writes the ledger entries for an operation against the cash account for deposits and
+// withdrawals or the suspense account for adjustments, and marks it completed
+func postTellerOperation(ctx context.Context, tx pgx.Tx, op models.TellerOperation, decidedBy *string) (models.TellerOperation, error) {
+	code := models.InternalAccountCash
+	if op.Kind == models.TellerOperationAdjustment {
+		code = models.InternalAccountSuspense
+	}
+
+	internalAccountID, err := getInternalAccountID(ctx, tx, code, op.Currency)
+	if err != nil {
+		return op, err
+	}
+
+	accounts, err := lockAccounts(ctx, tx, op.AccountID, internalAccountID)
+	if err != nil {
+		return op, err
+	}
+
+	// customer withdrawals follow the normal debit rules, adjustments may correct frozen accounts too
+	account := accounts[op.AccountID]
+	if op.Kind == models.TellerOperationWithdrawal {
+		err = checkDebit(account)
+	} else {
+		err = checkCredit(account)
+	}
+	if err != nil {
+		return op, err
+	}
+
+	arg := models.TransferTxParams{
+		FromAccountID: internalAccountID,
+		ToAccountID:   op.AccountID,
+		Amount:        op.Amount,
+		Currency:      op.Currency,
+		Description:   fmt.Sprintf("%s (%s)", op.Kind, op.ReasonCode),
+	}
+	if op.Amount < 0 {
+		arg.FromAccountID, arg.ToAccountID, arg.Amount = op.AccountID, internalAccountID, -op.Amount
+	}
+
+	transfer, err := createTransfer(ctx, tx, arg)
+	if err != nil {
+		return op, err
+	}
+
+	if op.Amount < 0 {
+		if err = checkFunds(transfer.FromAccount); err != nil {
+			return op, err
+		}
+	}
+
+	query := `UPDATE teller_operations SET status = @status, decided_by = @decidedBy, transaction_id = @transactionID,
+				decided_at = now() WHERE id = @id RETURNING ` + tellerOperationColumns
+	args := pgx.NamedArgs{
+		"id":            op.ID,
+		"status":        models.TellerOperationCompleted,
+		"decidedBy":     decidedBy,
+		"transactionID": transfer.Transaction.ID,
+	}
+
+	err = scanTellerOperation(tx.QueryRow(ctx, query, args), &op)
+	return op, err
+}
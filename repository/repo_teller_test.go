@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func createRandomTeller(t *testing.T, role string) models.User {
+	user := createRandomUser(t)
+	require.Equal(t, models.RoleCustomer, user.Role)
+
+	teller, err := testRepo.R.UpdateUser(context.Background(), models.UpdateUserParams{
+		UserName: user.UserName,
+		Role:     sql.NullString{String: role, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, role, teller.Role)
+
+	return teller
+}
+
+func TestTellerDeposit(t *testing.T) {
+	teller := createRandomTeller(t, models.RoleTeller)
+	account := createRandomAccount(t)
+
+	op, err := testRepo.R.CreateTellerOperationTx(context.Background(), models.CreateTellerOperationParams{
+		Kind:        models.TellerOperationDeposit,
+		AccountID:   account.ID,
+		Amount:      50,
+		Currency:    account.Currency,
+		ReasonCode:  "cash_deposit",
+		InitiatedBy: teller.UserName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.TellerOperationCompleted, op.Status)
+	require.NotNil(t, op.TransactionID)
+
+	updated, err := testRepo.R.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, account.Balance+50, updated.Balance)
+
+	// the money came out of the cash account, so an entry exists for the deposit
+	transaction, err := testRepo.R.GetTransaction(context.Background(), *op.TransactionID)
+	require.NoError(t, err)
+	require.Equal(t, account.ID, transaction.ToAccountID)
+	require.Equal(t, float64(50), transaction.Amount)
+}
+
+func TestTellerOperationReasonCodeMustMatchKind(t *testing.T) {
+	teller := createRandomTeller(t, models.RoleTeller)
+	account := createRandomAccount(t)
+
+	_, err := testRepo.R.CreateTellerOperationTx(context.Background(), models.CreateTellerOperationParams{
+		Kind:        models.TellerOperationWithdrawal,
+		AccountID:   account.ID,
+		Amount:      -1,
+		Currency:    account.Currency,
+		ReasonCode:  "cash_deposit",
+		InitiatedBy: teller.UserName,
+	})
+	require.ErrorIs(t, err, models.ErrInvalidReasonCode)
+}
+
+func TestTellerWithdrawalNeedsSecondApprover(t *testing.T) {
+	teller := createRandomTeller(t, models.RoleTeller)
+	approver := createRandomTeller(t, models.RoleAdmin)
+	account := createRandomAccount(t)
+	fundAccount(t, &account)
+
+	op, err := testRepo.R.CreateTellerOperationTx(context.Background(), models.CreateTellerOperationParams{
+		Kind:             models.TellerOperationWithdrawal,
+		AccountID:        account.ID,
+		Amount:           -600,
+		Currency:         account.Currency,
+		ReasonCode:       "cash_withdrawal",
+		InitiatedBy:      teller.UserName,
+		RequiresApproval: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.TellerOperationPendingApproval, op.Status)
+	require.Nil(t, op.TransactionID)
+
+	// nothing is posted until the operation is approved, and not by the teller who entered it
+	_, err = testRepo.R.DecideTellerOperationTx(context.Background(), models.DecideTellerOperationParams{
+		OperationID: op.ID,
+		DecidedBy:   teller.UserName,
+		Approve:     true,
+	})
+	require.ErrorIs(t, err, models.ErrApproverIsInitiator)
+
+	approved, err := testRepo.R.DecideTellerOperationTx(context.Background(), models.DecideTellerOperationParams{
+		OperationID: op.ID,
+		DecidedBy:   approver.UserName,
+		Approve:     true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.TellerOperationCompleted, approved.Status)
+	require.Equal(t, approver.UserName, *approved.DecidedBy)
+
+	updated, err := testRepo.R.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, account.Balance-600, updated.Balance)
+
+	_, err = testRepo.R.DecideTellerOperationTx(context.Background(), models.DecideTellerOperationParams{
+		OperationID: op.ID,
+		DecidedBy:   approver.UserName,
+		Approve:     false,
+	})
+	require.ErrorIs(t, err, models.ErrOperationNotPending)
+}
+
+func TestTellerWithdrawalInsufficientFunds(t *testing.T) {
+	teller := createRandomTeller(t, models.RoleTeller)
+	account := createRandomAccount(t)
+
+	_, err := testRepo.R.CreateTellerOperationTx(context.Background(), models.CreateTellerOperationParams{
+		Kind:        models.TellerOperationWithdrawal,
+		AccountID:   account.ID,
+		Amount:      -(account.Balance + 1),
+		Currency:    account.Currency,
+		ReasonCode:  "cash_withdrawal",
+		InitiatedBy: teller.UserName,
+	})
+	require.ErrorIs(t, err, models.ErrInsufficientFunds)
+}
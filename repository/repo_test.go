@@ -7,7 +7,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/require"
 	"github.com/zde37/Swift_Bank/helpers"
 	"github.com/zde37/Swift_Bank/models"
@@ -17,9 +16,10 @@ func createRandomAccount(t *testing.T) models.Account {
 	user := createRandomUser(t)
 
 	arg := models.Account{
-		Owner:    user.UserName,
-		Balance:  float64(helpers.RandomMoney()),
-		Currency: helpers.RandomCurrency(),
+		Owner:       user.UserName,
+		Balance:     float64(helpers.RandomMoney()),
+		Currency:    helpers.RandomCurrency(),
+		ProductCode: models.DefaultProductCode,
 	}
 
 	account, err := testRepo.R.CreateAccount(context.Background(), arg)
@@ -29,6 +29,7 @@ func createRandomAccount(t *testing.T) models.Account {
 	require.Equal(t, arg.Owner, account.Owner)
 	require.Equal(t, arg.Balance, account.Balance)
 	require.Equal(t, arg.Currency, account.Currency)
+	require.Equal(t, arg.ProductCode, account.ProductCode)
 
 	require.NotZero(t, account.ID)
 	require.NotZero(t, account.CreatedAt)
@@ -36,6 +37,14 @@ func createRandomAccount(t *testing.T) models.Account {
 	return account
 }
 
+// fundAccount sets a balance that is large enough for the transfer tests, which would otherwise
+// fail the available balance check whenever the random opening balance is too small
+func fundAccount(t *testing.T, account *models.Account) {
+	funded, err := testRepo.R.UpdateAccount(context.Background(), account.ID, 1000)
+	require.NoError(t, err)
+	*account = funded
+}
+
 func createRandomEntry(t *testing.T, account *models.Account) models.Entry {
 	arg := models.Entry{
 		AccountID: account.ID,
@@ -101,6 +110,7 @@ func createRandomUser(t *testing.T) models.User {
 	require.Equal(t, arg.HashedPassword, user.HashedPassword)
 	require.Equal(t, arg.FullName, user.FullName)
 	require.Equal(t, arg.Email, user.Email)
+	require.Equal(t, "en", user.Locale)
 
 	require.True(t, user.PasswordChangedAt.IsZero())
 	require.NotZero(t, user.CreatedAt)
@@ -280,17 +290,6 @@ func TestUpdateAccount(t *testing.T) {
 	require.WithinDuration(t, account1.CreatedAt, account2.CreatedAt, time.Second)
 }
 
-func TestDeleteAccount(t *testing.T) {
-	account1 := createRandomAccount(t)
-	err := testRepo.R.DeleteAccount(context.Background(), account1.ID)
-	require.NoError(t, err)
-
-	account2, err := testRepo.R.GetAccount(context.Background(), account1.ID)
-	require.Error(t, err)
-	require.EqualError(t, err, pgx.ErrNoRows.Error())
-	require.Empty(t, account2)
-}
-
 func TestListAccounts(t *testing.T) {
 	var lastAccount models.Account
 	for i := 0; i < 10; i++ {
@@ -388,6 +387,7 @@ func TestListTransactions(t *testing.T) {
 func TestTransferTx(t *testing.T) {
 	account1 := createRandomAccount(t)
 	account2 := createRandomAccount(t)
+	fundAccount(t, &account1)
 	fmt.Println(">== before: ", account1.Balance, account2.Balance)
 
 	// run 'n' concurrent transfer transactions
@@ -427,6 +427,8 @@ func TestTransferTx(t *testing.T) {
 		require.NotEmpty(t, transaction)
 		require.Equal(t, account1.ID, transaction.FromAccountID)
 		require.Equal(t, account2.ID, transaction.ToAccountID)
+		require.Equal(t, account1.AccountNumber, transaction.FromAccountNumber)
+		require.Equal(t, account2.AccountNumber, transaction.ToAccountNumber)
 		require.Equal(t, amount, transaction.Amount)
 		require.NotZero(t, transaction.ID)
 		require.NotZero(t, transaction.CreatedAt)
@@ -440,6 +442,7 @@ func TestTransferTx(t *testing.T) {
 		fromEntry := result.FromEntry
 		require.NotEmpty(t, fromEntry)
 		require.Equal(t, account1.ID, fromEntry.AccountID)
+		require.Equal(t, account1.AccountNumber, fromEntry.AccountNumber)
 		require.Equal(t, -amount, fromEntry.Amount)
 		require.NotZero(t, fromEntry.ID)
 		require.NotZero(t, fromEntry.CreatedAt)
@@ -500,6 +503,8 @@ func TestTransferTx(t *testing.T) {
 func TestTransferTxDeadLock(t *testing.T) {
 	account1 := createRandomAccount(t)
 	account2 := createRandomAccount(t)
+	fundAccount(t, &account1)
+	fundAccount(t, &account2)
 	fmt.Println(">== before: ", account1.Balance, account2.Balance)
 
 	// run 'n' concurrent transfer transactions
@@ -548,3 +553,21 @@ func TestTransferTxDeadLock(t *testing.T) {
 	require.Equal(t, account1.Balance, updatedAccount1.Balance)
 	require.Equal(t, account2.Balance, updatedAccount2.Balance)
 }
+
+func TestTransferTxInsufficientFunds(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	_, err := testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        account1.AvailableBalance + 1,
+		Currency:      account1.Currency,
+	})
+	require.ErrorIs(t, err, models.ErrInsufficientFunds)
+
+	// nothing was written
+	updatedAccount1, err := testRepo.R.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, updatedAccount1.Balance)
+}
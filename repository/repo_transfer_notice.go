@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+// transferNotices lists the notices a transfer sends once it commits: a debit notice to every holder who can view
+// the sending account and a credit notice to every holder who can view the receiving account
+func transferNotices(ctx context.Context, tx pgx.Tx, transfer models.TransferTxResult) ([]models.TransferNotice, error) {
+	notice := func(username, kind string, account models.Account) models.TransferNotice {
+		return models.TransferNotice{
+			Username:      username,
+			Kind:          kind,
+			AccountNumber: account.AccountNumber,
+			TransactionID: transfer.Transaction.ID,
+			Amount:        transfer.Transaction.Amount,
+			Fee:           transfer.Transaction.Fee,
+			Currency:      transfer.Transaction.Currency,
+			Description:   transfer.Transaction.Description,
+			Balance:       account.Balance,
+		}
+	}
+
+	debited, err := viewingHolders(ctx, tx, transfer.FromAccount.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	credited, err := viewingHolders(ctx, tx, transfer.ToAccount.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	notices := []models.TransferNotice{}
+	for _, username := range debited {
+		notices = append(notices, notice(username, models.TransferNoticeDebit, transfer.FromAccount))
+	}
+	for _, username := range credited {
+		notices = append(notices, notice(username, models.TransferNoticeCredit, transfer.ToAccount))
+	}
+
+	return notices, nil
+}
+
+// viewingHolders returns the active holders of the account whose role grants the view permission
+func viewingHolders(ctx context.Context, tx pgx.Tx, accountID int64) ([]string, error) {
+	query := `SELECT username, role, status FROM account_holders WHERE account_id = @accountID AND status = @status
+				ORDER BY created_at, username`
+	args := pgx.NamedArgs{
+		"accountID": accountID,
+		"status":    models.HolderStatusActive,
+	}
+
+	rows, err := tx.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var usernames []string
+	for rows.Next() {
+		var holder models.AccountHolder
+		if err := rows.Scan(&holder.UserName, &holder.Role, &holder.Status); err != nil {
+			return nil, err
+		}
+
+		if holder.Can(models.PermissionView) {
+			usernames = append(usernames, holder.UserName)
+		}
+	}
+
+	return usernames, rows.Err()
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestTransferTxNotices(t *testing.T) {
+	from := createRandomAccount(t)
+	fundAccount(t, &from)
+	to := createRandomAccountIn(t, from.Currency)
+
+	coOwner := createRandomUser(t)
+	_, err := testRepo.R.InviteAccountHolder(context.Background(), models.InviteAccountHolderParams{
+		AccountID: to.ID,
+		UserName:  coOwner.UserName,
+		Role:      models.HolderRoleCoOwner,
+		InvitedBy: to.Owner,
+	})
+	require.NoError(t, err)
+
+	// invited holders hear nothing until they accept
+	invited := createRandomUser(t)
+	_, err = testRepo.R.InviteAccountHolder(context.Background(), models.InviteAccountHolderParams{
+		AccountID: to.ID,
+		UserName:  invited.UserName,
+		Role:      models.HolderRoleViewer,
+		InvitedBy: to.Owner,
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.AcceptAccountInvitation(context.Background(), to.ID, coOwner.UserName)
+	require.NoError(t, err)
+
+	// a viewer of the sending account hears about the debit too
+	viewer := createRandomUser(t)
+	_, err = testRepo.R.InviteAccountHolder(context.Background(), models.InviteAccountHolderParams{
+		AccountID: from.ID,
+		UserName:  viewer.UserName,
+		Role:      models.HolderRoleViewer,
+		InvitedBy: from.Owner,
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.R.AcceptAccountInvitation(context.Background(), from.ID, viewer.UserName)
+	require.NoError(t, err)
+
+	result, err := testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        100,
+		Fee:           2,
+		Currency:      from.Currency,
+		Description:   "rent",
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Notices, 4)
+
+	var debited []string
+	for _, debit := range result.Notices[:2] {
+		require.Equal(t, models.TransferNoticeDebit, debit.Kind)
+		require.Equal(t, from.AccountNumber, debit.AccountNumber)
+		require.Equal(t, result.Transaction.ID, debit.TransactionID)
+		require.Equal(t, float64(100), debit.Amount)
+		require.Equal(t, float64(2), debit.Fee)
+		require.Equal(t, "rent", debit.Description)
+		require.Equal(t, result.FromAccount.Balance, debit.Balance)
+		debited = append(debited, debit.Username)
+	}
+	require.ElementsMatch(t, []string{from.Owner, viewer.UserName}, debited)
+
+	var credited []string
+	for _, notice := range result.Notices[2:] {
+		require.Equal(t, models.TransferNoticeCredit, notice.Kind)
+		require.Equal(t, to.AccountNumber, notice.AccountNumber)
+		require.Equal(t, result.ToAccount.Balance, notice.Balance)
+		credited = append(credited, notice.Username)
+	}
+	require.ElementsMatch(t, []string{to.Owner, coOwner.UserName}, credited)
+
+	// a transfer that is rolled back has nothing to notify about
+	result, err = testRepo.R.TransferTx(context.Background(), models.TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        100000,
+		Currency:      from.Currency,
+	})
+	require.Error(t, err)
+	require.Empty(t, result.Notices)
+}
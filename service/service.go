@@ -2,20 +2,25 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/zde37/Swift_Bank/blob"
+	"github.com/zde37/Swift_Bank/config"
 	"github.com/zde37/Swift_Bank/models"
 	"github.com/zde37/Swift_Bank/repository"
+	"github.com/zde37/Swift_Bank/worker"
 )
 
 type ServiceProvider interface {
 	CreateAccount(ctx context.Context, data models.CreateAccountRequest, username string) (models.Account, error)
 	GetAccount(ctx context.Context, id int64) (models.Account, error)
+	GetAccountByNumber(ctx context.Context, number string) (models.Account, error)
+	ListAccountIDsByNumber(ctx context.Context, numbers []string) (map[string]int64, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (models.Account, error)
 	ListAccounts(ctx context.Context, naem string, limit, offset int32) ([]models.Account, error)
-	UpdateAccount(ctx context.Context, id int64, balance float64) (models.Account, error)
-	AddAccountBalance(ctx context.Context, id int64, balance float64) (models.Account, error)
-	DeleteAccount(ctx context.Context, id int64) error
+	UpdateAccountStatus(ctx context.Context, arg models.UpdateAccountStatusParams) (models.Account, error)
+	CloseAccount(ctx context.Context, arg models.CloseAccountTxParams) (models.CloseAccountTxResult, error)
 	CreateEntry(ctx context.Context, entry models.Entry) (models.Entry, error)
 	GetEntry(ctx context.Context, id int64) (models.Entry, error)
 	ListEntries(ctx context.Context, accountID, limit, offset int64) ([]models.Entry, error)
@@ -23,6 +28,32 @@ type ServiceProvider interface {
 	GetTransaction(ctx context.Context, id int64) (models.Transaction, error)
 	ListTransactions(ctx context.Context, fromAccountID, toAccountID, limit, offset int64) ([]models.Transaction, error)
 	TransferTx(ctx context.Context, arg models.TransferTxParams) (models.TransferTxResult, error)
+	BatchTransferTx(ctx context.Context, arg models.BatchTransferTxParams) (models.BatchTransferTxResult, error)
+	GetPendingTransfer(ctx context.Context, id int64) (models.PendingTransfer, error)
+	ListPendingTransfers(ctx context.Context, status string, limit, offset int32) ([]models.PendingTransfer, error)
+	ListPendingTransferEvents(ctx context.Context, pendingTransferID int64) ([]models.PendingTransferEvent, error)
+	DecidePendingTransfer(ctx context.Context, arg models.DecidePendingTransferParams) (models.DecidePendingTransferResult, error)
+	GetFraudScreening(ctx context.Context, id int64) (models.FraudScreening, error)
+	ListFraudScreenings(ctx context.Context, status string, limit, offset int32) ([]models.FraudScreening, error)
+	ResolveFraudScreening(ctx context.Context, arg models.ResolveFraudScreeningParams) (models.FraudScreening, error)
+	GetAccountHolder(ctx context.Context, accountID int64, username string) (models.AccountHolder, error)
+	ListAccountHolders(ctx context.Context, accountID int64) ([]models.AccountHolder, error)
+	InviteAccountHolder(ctx context.Context, arg models.InviteAccountHolderParams) (models.AccountHolder, error)
+	AcceptAccountInvitation(ctx context.Context, accountID int64, username string) (models.AccountHolder, error)
+	CreateBeneficiary(ctx context.Context, beneficiary models.Beneficiary) (models.Beneficiary, error)
+	GetBeneficiary(ctx context.Context, id int64, owner string) (models.Beneficiary, error)
+	ListBeneficiaries(ctx context.Context, owner string) ([]models.Beneficiary, error)
+	UpdateBeneficiary(ctx context.Context, arg models.UpdateBeneficiaryParams) (models.Beneficiary, error)
+	DeleteBeneficiary(ctx context.Context, id int64, owner string) error
+	CreatePaymentAlias(ctx context.Context, alias models.PaymentAlias) (models.PaymentAlias, error)
+	VerifyPaymentAlias(ctx context.Context, arg models.VerifyPaymentAliasParams) (models.PaymentAlias, error)
+	ListPaymentAliases(ctx context.Context, username string) ([]models.PaymentAlias, error)
+	DeletePaymentAlias(ctx context.Context, alias, username string) error
+	SetDefaultAccount(ctx context.Context, username string, accountID int64) (models.DefaultAccount, error)
+	ListDefaultAccounts(ctx context.Context, username string) ([]models.DefaultAccount, error)
+	ResolvePaymentAlias(ctx context.Context, alias, currency string) (models.Account, error)
+	CheckBeneficiaryTransfer(beneficiary models.Beneficiary, amount float64) error
+	CheckPayeeTransfer(ctx context.Context, owner string, accountID int64, amount float64) error
 	CreateUserTx(ctx context.Context, data models.CreateUserTxParams) (models.User, error)
 	CreateVerifyEmail(ctx context.Context, data models.VerifyEmails) (models.VerifyEmails, error)
 	VerifyEmailTx(ctx context.Context, arg models.VerifyEmailTxParams) (models.VerifyEmailTxResult, error) 
@@ -33,14 +64,66 @@ type ServiceProvider interface {
 	ListUsers(ctx context.Context, limit, offset int32) ([]models.User, error)
 	NewSession(ctx context.Context, data models.Session) (models.Session, error)
 	FetchSession(ctx context.Context, id uuid.UUID) (models.Session, error)
+	CreateHold(ctx context.Context, data models.CreateHoldRequest) (models.Hold, error)
+	GetHold(ctx context.Context, id int64) (models.Hold, error)
+	ListHolds(ctx context.Context, accountID int64, limit, offset int32) ([]models.Hold, error)
+	CaptureHold(ctx context.Context, arg models.CaptureHoldParams) (models.CaptureHoldResult, error)
+	VoidHold(ctx context.Context, id int64) (models.Hold, error)
+	ListAccountProducts(ctx context.Context) ([]models.AccountProduct, error)
+	ListInterestAccruals(ctx context.Context, accountID int64, limit, offset int32) ([]models.InterestAccrual, error)
+	GetBalanceAt(ctx context.Context, accountID int64, at time.Time) (models.BalanceAt, error)
+	GetTrialBalances(ctx context.Context, day time.Time) ([]models.TrialBalance, error)
+	ExportGeneralLedger(ctx context.Context, arg models.ExportGLParams) (models.GLExport, error)
+	ListGLExports(ctx context.Context, limit, offset int32) ([]models.GLExport, error)
+	GetGLExportFile(ctx context.Context, id int64) (models.GLExport, []byte, error)
+	CreateCategoryRule(ctx context.Context, rule models.CategoryRule) (models.CategoryRule, error)
+	ListCategoryRules(ctx context.Context, owner string) ([]models.CategoryRule, error)
+	DeleteCategoryRule(ctx context.Context, id int64, owner string) error
+	SetTransactionCategory(ctx context.Context, category models.TransactionCategory) (models.TransactionCategory, error)
+	GetSpendingAnalytics(ctx context.Context, arg models.SpendingAnalyticsParams) (models.SpendingAnalytics, error)
+	CreateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error)
+	ListAlertRules(ctx context.Context, accountID int64, owner string) ([]models.AlertRule, error)
+	DeleteAlertRule(ctx context.Context, id int64, owner string) error
+	CreateOverdraftRequest(ctx context.Context, req models.OverdraftRequest) (models.OverdraftRequest, error)
+	ListOverdraftRequests(ctx context.Context, status string, limit, offset int32) ([]models.OverdraftRequest, error)
+	DecideOverdraftRequest(ctx context.Context, arg models.DecideOverdraftRequestParams) (models.OverdraftRequest, error)
+	DisburseLoan(ctx context.Context, loan models.Loan) (models.LoanSchedule, error)
+	GetLoan(ctx context.Context, id int64) (models.Loan, error)
+	GetLoanSchedule(ctx context.Context, id int64) (models.LoanSchedule, error)
+	ListLoans(ctx context.Context, accountID int64) ([]models.Loan, error)
+	PayOffLoan(ctx context.Context, id int64) (models.LoanSchedule, error)
+	CreatePocket(ctx context.Context, pocket models.Pocket) (models.Pocket, error)
+	GetPocket(ctx context.Context, id int64) (models.Pocket, error)
+	ListPockets(ctx context.Context, accountID int64) ([]models.Pocket, error)
+	ListPocketEntries(ctx context.Context, pocketID int64, limit, offset int32) ([]models.PocketEntry, error)
+	MovePocketFunds(ctx context.Context, arg models.MovePocketFundsParams) (models.MovePocketFundsResult, error)
+	ClosePocket(ctx context.Context, id int64) (models.Pocket, error)
+	CreatePocketRule(ctx context.Context, rule models.PocketRule) (models.PocketRule, error)
+	GetPocketRule(ctx context.Context, id int64) (models.PocketRule, error)
+	DeletePocketRule(ctx context.Context, id int64) error
+	SetTransactionNote(ctx context.Context, note models.TransactionNote) (models.TransactionNote, error)
+	GetTransactionAnnotations(ctx context.Context, transactionID int64, owner string) (models.TransactionAnnotations, error)
+	SearchTransactionsByTag(ctx context.Context, arg models.SearchTransactionsByTagParams) ([]models.TaggedTransaction, error)
+	UploadTransactionAttachment(ctx context.Context, attachment models.TransactionAttachment, content []byte) (models.TransactionAttachment, error)
+	GetTransactionAttachment(ctx context.Context, id int64, owner string) (models.TransactionAttachment, []byte, error)
+	DeleteTransactionAttachment(ctx context.Context, id int64, owner string) error
+	OpenDispute(ctx context.Context, arg models.OpenDisputeParams) (models.Dispute, error)
+	GetDispute(ctx context.Context, id int64) (models.Dispute, error)
+	ListDisputes(ctx context.Context, accountID int64, status string, limit, offset int32) ([]models.Dispute, error)
+	UpdateDispute(ctx context.Context, arg models.UpdateDisputeParams) (models.Dispute, error)
+	ListTellerReasonCodes(ctx context.Context) ([]models.TellerReasonCode, error)
+	CreateTellerOperation(ctx context.Context, arg models.CreateTellerOperationParams) (models.TellerOperation, error)
+	GetTellerOperation(ctx context.Context, id int64) (models.TellerOperation, error)
+	ListTellerOperations(ctx context.Context, status string, limit, offset int32) ([]models.TellerOperation, error)
+	DecideTellerOperation(ctx context.Context, arg models.DecideTellerOperationParams) (models.TellerOperation, error)
 }
 
 type Service struct {
 	S ServiceProvider
 }
 
-func NewService(repo repository.RepositoryProvider) *Service {
+func NewService(repo repository.RepositoryProvider, distributor worker.TaskDistributor, c config.Config, blobs blob.Store) *Service {
 	return &Service{
-		S: newServiceImpl(repo),
+		S: newServiceImpl(repo, distributor, c, blobs),
 	}
 }
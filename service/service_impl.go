@@ -1,61 +1,433 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+	"github.com/zde37/Swift_Bank/amortization"
+	"github.com/zde37/Swift_Bank/blob"
+	"github.com/zde37/Swift_Bank/config"
+	"github.com/zde37/Swift_Bank/fraud"
+	"github.com/zde37/Swift_Bank/glexport"
 	"github.com/zde37/Swift_Bank/helpers"
 	"github.com/zde37/Swift_Bank/models"
 	"github.com/zde37/Swift_Bank/repository"
+	"github.com/zde37/Swift_Bank/worker"
 )
 
 type serviceImpl struct {
-	repo repository.RepositoryProvider
+	repo        repository.RepositoryProvider
+	distributor worker.TaskDistributor
+	config      config.Config
+	fraud       *fraud.Engine
+	blobs       blob.Store
+	gl          *glexport.Exporter
 }
 
-func newServiceImpl(r repository.RepositoryProvider) *serviceImpl {
+func newServiceImpl(r repository.RepositoryProvider, d worker.TaskDistributor, c config.Config, b blob.Store) *serviceImpl {
 	return &serviceImpl{
-		repo: r,
+		repo:        r,
+		distributor: d,
+		config:      c,
+		fraud:       fraud.NewEngineFromConfig(c),
+		blobs:       b,
+		gl:          glexport.NewExporter(r, b, c.GLExportFormat, c.GLFixedWidthLayout),
 	}
 }
 
 func (s *serviceImpl) CreateAccount(ctx context.Context, data models.CreateAccountRequest, username string) (models.Account, error) {
+	if data.ProductCode == "" {
+		data.ProductCode = models.DefaultProductCode
+	}
+
+	product, err := s.repo.GetAccountProduct(ctx, data.ProductCode)
+	if err != nil {
+		return models.Account{}, err
+	}
+
 	arg := models.Account{
-		Owner:    username,
-		Balance:  0,
-		Currency: data.Currency,
+		Owner:       username,
+		Balance:     0,
+		Currency:    data.Currency,
+		ProductCode: product.Code,
+	}
+
+	if product.AccountType == models.AccountTypeFixedTerm {
+		maturesAt := time.Now().AddDate(0, int(product.TermMonths), 0)
+		arg.MaturesAt = &maturesAt
 	}
 
 	return s.repo.CreateAccount(ctx, arg)
 }
 
-func (s *serviceImpl) GetAccount(ctx context.Context, id int64) (models.Account, error) {
-	return s.repo.GetAccount(ctx, id)
+func (s *serviceImpl) ListAccountProducts(ctx context.Context) ([]models.AccountProduct, error) {
+	return s.repo.ListAccountProducts(ctx)
 }
 
-func (s *serviceImpl) GetAccountForUpdate(ctx context.Context, id int64) (models.Account, error) {
-	return s.repo.GetAccountForUpdate(ctx, id)
+func (s *serviceImpl) ListInterestAccruals(ctx context.Context, accountID int64, limit, offset int32) ([]models.InterestAccrual, error) {
+	return s.repo.ListInterestAccruals(ctx, accountID, limit, offset)
 }
 
-func (s *serviceImpl) ListAccounts(ctx context.Context, name string, limit, offset int32) ([]models.Account, error) {
-	return s.repo.ListAccounts(ctx, name, limit, offset)
+func (s *serviceImpl) GetBalanceAt(ctx context.Context, accountID int64, at time.Time) (models.BalanceAt, error) {
+	return s.repo.GetBalanceAt(ctx, accountID, at)
+}
+
+func (s *serviceImpl) GetTrialBalances(ctx context.Context, day time.Time) ([]models.TrialBalance, error) {
+	return s.repo.GetTrialBalances(ctx, day)
 }
 
-func (s *serviceImpl) UpdateAccount(ctx context.Context, id int64, balance float64) (models.Account, error) {
-	return s.repo.UpdateAccount(ctx, id, balance)
+func (s *serviceImpl) ExportGeneralLedger(ctx context.Context, arg models.ExportGLParams) (models.GLExport, error) {
+	return s.gl.Export(ctx, arg)
 }
 
-func (s *serviceImpl) AddAccountBalance(ctx context.Context, id int64, balance float64) (models.Account, error) {
-	return s.repo.AddAccountBalance(ctx, id, balance)
+func (s *serviceImpl) ListGLExports(ctx context.Context, limit, offset int32) ([]models.GLExport, error) {
+	return s.repo.ListGLExports(ctx, limit, offset)
 }
 
-func (s *serviceImpl) DeleteAccount(ctx context.Context, id int64) error {
-	_, err := s.repo.GetAccount(ctx, id)
+// GetGLExportFile returns the run with the content of its journal file
+func (s *serviceImpl) GetGLExportFile(ctx context.Context, id int64) (models.GLExport, []byte, error) {
+	export, file, err := s.gl.Open(ctx, id)
+	if err != nil {
+		return export, nil, err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return export, nil, err
+	}
+
+	return export, content, nil
+}
+
+func (s *serviceImpl) CreateCategoryRule(ctx context.Context, rule models.CategoryRule) (models.CategoryRule, error) {
+	return s.repo.CreateCategoryRule(ctx, rule)
+}
+
+func (s *serviceImpl) ListCategoryRules(ctx context.Context, owner string) ([]models.CategoryRule, error) {
+	return s.repo.ListCategoryRules(ctx, owner)
+}
+
+func (s *serviceImpl) DeleteCategoryRule(ctx context.Context, id int64, owner string) error {
+	return s.repo.DeleteCategoryRule(ctx, id, owner)
+}
+
+func (s *serviceImpl) SetTransactionCategory(ctx context.Context, category models.TransactionCategory) (models.TransactionCategory, error) {
+	return s.repo.SetTransactionCategory(ctx, category)
+}
+
+func (s *serviceImpl) GetSpendingAnalytics(ctx context.Context, arg models.SpendingAnalyticsParams) (models.SpendingAnalytics, error) {
+	return s.repo.GetSpendingAnalytics(ctx, arg)
+}
+
+func (s *serviceImpl) CreateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error) {
+	return s.repo.CreateAlertRule(ctx, rule)
+}
+
+func (s *serviceImpl) ListAlertRules(ctx context.Context, accountID int64, owner string) ([]models.AlertRule, error) {
+	return s.repo.ListAlertRules(ctx, accountID, owner)
+}
+
+func (s *serviceImpl) DeleteAlertRule(ctx context.Context, id int64, owner string) error {
+	return s.repo.DeleteAlertRule(ctx, id, owner)
+}
+
+func (s *serviceImpl) CreateOverdraftRequest(ctx context.Context, req models.OverdraftRequest) (models.OverdraftRequest, error) {
+	return s.repo.CreateOverdraftRequest(ctx, req)
+}
+
+func (s *serviceImpl) ListOverdraftRequests(ctx context.Context, status string, limit, offset int32) ([]models.OverdraftRequest, error) {
+	return s.repo.ListOverdraftRequests(ctx, status, limit, offset)
+}
+
+// DecideOverdraftRequest approves or rejects an overdraft request, approved overdrafts get the bank's current
+// interest rate and fee
+func (s *serviceImpl) DecideOverdraftRequest(ctx context.Context, arg models.DecideOverdraftRequestParams) (models.OverdraftRequest, error) {
+	arg.InterestRate = s.config.OverdraftInterestRate
+	arg.Fee = s.config.OverdraftFee
+	return s.repo.DecideOverdraftRequestTx(ctx, arg)
+}
+
+// DisburseLoan pays out a loan in the account's currency with the bank's current late fee, repayments start
+// a month from today
+func (s *serviceImpl) DisburseLoan(ctx context.Context, loan models.Loan) (models.LoanSchedule, error) {
+	account, err := s.repo.GetAccount(ctx, loan.AccountID)
+	if err != nil {
+		return models.LoanSchedule{}, err
+	}
+
+	loan.Currency = account.Currency
+	loan.LateFee = s.config.LoanLateFee
+
+	installments, err := amortization.Schedule(loan.Method, loan.Principal, loan.InterestRate, loan.TermMonths, today())
+	if err != nil {
+		return models.LoanSchedule{}, err
+	}
+
+	return s.repo.DisburseLoanTx(ctx, models.DisburseLoanParams{
+		Loan:         loan,
+		Installments: installments,
+	})
+}
+
+func (s *serviceImpl) GetLoan(ctx context.Context, id int64) (models.Loan, error) {
+	return s.repo.GetLoan(ctx, id)
+}
+
+func (s *serviceImpl) GetLoanSchedule(ctx context.Context, id int64) (models.LoanSchedule, error) {
+	return s.repo.GetLoanSchedule(ctx, id, today())
+}
+
+func (s *serviceImpl) ListLoans(ctx context.Context, accountID int64) ([]models.Loan, error) {
+	return s.repo.ListLoans(ctx, accountID)
+}
+
+func (s *serviceImpl) PayOffLoan(ctx context.Context, id int64) (models.LoanSchedule, error) {
+	return s.repo.PayOffLoanTx(ctx, id, today())
+}
+
+func (s *serviceImpl) CreatePocket(ctx context.Context, pocket models.Pocket) (models.Pocket, error) {
+	return s.repo.CreatePocket(ctx, pocket)
+}
+
+func (s *serviceImpl) GetPocket(ctx context.Context, id int64) (models.Pocket, error) {
+	return s.repo.GetPocket(ctx, id)
+}
+
+func (s *serviceImpl) ListPockets(ctx context.Context, accountID int64) ([]models.Pocket, error) {
+	return s.repo.ListPockets(ctx, accountID)
+}
+
+func (s *serviceImpl) ListPocketEntries(ctx context.Context, pocketID int64, limit, offset int32) ([]models.PocketEntry, error) {
+	return s.repo.ListPocketEntries(ctx, pocketID, limit, offset)
+}
+
+func (s *serviceImpl) MovePocketFunds(ctx context.Context, arg models.MovePocketFundsParams) (models.MovePocketFundsResult, error) {
+	return s.repo.MovePocketFundsTx(ctx, arg)
+}
+
+func (s *serviceImpl) ClosePocket(ctx context.Context, id int64) (models.Pocket, error) {
+	return s.repo.ClosePocketTx(ctx, id)
+}
+
+func (s *serviceImpl) CreatePocketRule(ctx context.Context, rule models.PocketRule) (models.PocketRule, error) {
+	return s.repo.CreatePocketRule(ctx, rule)
+}
+
+func (s *serviceImpl) GetPocketRule(ctx context.Context, id int64) (models.PocketRule, error) {
+	return s.repo.GetPocketRule(ctx, id)
+}
+
+func (s *serviceImpl) DeletePocketRule(ctx context.Context, id int64) error {
+	return s.repo.DeletePocketRule(ctx, id)
+}
+
+// SetTransactionNote saves the note with each tag kept once, in the order first given
+func (s *serviceImpl) SetTransactionNote(ctx context.Context, note models.TransactionNote) (models.TransactionNote, error) {
+	tags := make([]string, 0, len(note.Tags))
+	for _, tag := range note.Tags {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	note.Tags = tags
+
+	return s.repo.SetTransactionNote(ctx, note)
+}
+
+// GetTransactionAnnotations gets the user's note and attachments, a transaction they haven't annotated yet
+// comes back with an empty note
+func (s *serviceImpl) GetTransactionAnnotations(ctx context.Context, transactionID int64, owner string) (models.TransactionAnnotations, error) {
+	note, err := s.repo.GetTransactionNote(ctx, transactionID, owner)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return models.TransactionAnnotations{}, err
+		}
+		note = models.TransactionNote{TransactionID: transactionID, Owner: owner, Tags: []string{}}
+	}
+
+	attachments, err := s.repo.ListTransactionAttachments(ctx, transactionID, owner)
+	if err != nil {
+		return models.TransactionAnnotations{}, err
+	}
+
+	return models.TransactionAnnotations{
+		Note:        note,
+		Attachments: attachments,
+	}, nil
+}
+
+func (s *serviceImpl) SearchTransactionsByTag(ctx context.Context, arg models.SearchTransactionsByTagParams) ([]models.TaggedTransaction, error) {
+	return s.repo.SearchTransactionsByTag(ctx, arg)
+}
+
+// UploadTransactionAttachment stores the file in the blob store before recording it, the file is removed
+// again when it can't be recorded
+func (s *serviceImpl) UploadTransactionAttachment(ctx context.Context, attachment models.TransactionAttachment, content []byte) (models.TransactionAttachment, error) {
+	attachment.StorageKey = path.Join("attachments", uuid.NewString())
+	attachment.Size = int64(len(content))
+
+	if err := s.blobs.Put(ctx, attachment.StorageKey, bytes.NewReader(content)); err != nil {
+		return models.TransactionAttachment{}, err
+	}
+
+	created, err := s.repo.CreateTransactionAttachment(ctx, attachment)
+	if err != nil {
+		if err := s.blobs.Delete(ctx, attachment.StorageKey); err != nil {
+			log.Error().Err(err).Str("key", attachment.StorageKey).Msg("failed to delete unrecorded attachment")
+		}
+		return created, err
+	}
+
+	return created, nil
+}
+
+func (s *serviceImpl) GetTransactionAttachment(ctx context.Context, id int64, owner string) (models.TransactionAttachment, []byte, error) {
+	attachment, err := s.repo.GetTransactionAttachment(ctx, id, owner)
+	if err != nil {
+		return attachment, nil, err
+	}
+
+	file, err := s.blobs.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			err = models.ErrAttachmentMissing
+		}
+		return attachment, nil, err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return attachment, nil, err
+	}
+
+	return attachment, content, nil
+}
+
+// DeleteTransactionAttachment forgets the attachment first, a file left behind when the blob can't be
+// deleted is only logged
+func (s *serviceImpl) DeleteTransactionAttachment(ctx context.Context, id int64, owner string) error {
+	attachment, err := s.repo.DeleteTransactionAttachment(ctx, id, owner)
 	if err != nil {
 		return err
 	}
 
-	return s.repo.DeleteAccount(ctx, id)
+	if err := s.blobs.Delete(ctx, attachment.StorageKey); err != nil {
+		log.Error().Err(err).Str("key", attachment.StorageKey).Msg("failed to delete attachment file")
+	}
+
+	return nil
+}
+
+// OpenDispute opens a dispute on a transfer, held funds stay held for the configured dispute hold duration
+func (s *serviceImpl) OpenDispute(ctx context.Context, arg models.OpenDisputeParams) (models.Dispute, error) {
+	if arg.HoldFunds {
+		arg.HoldExpiresAt = time.Now().Add(s.config.DisputeHoldDuration)
+	}
+
+	update, err := s.repo.OpenDisputeTx(ctx, arg)
+	if err != nil {
+		return models.Dispute{}, err
+	}
+
+	s.distributeDisputeUpdate(ctx, update)
+	return update.Dispute, nil
+}
+
+func (s *serviceImpl) GetDispute(ctx context.Context, id int64) (models.Dispute, error) {
+	return s.repo.GetDispute(ctx, id)
+}
+
+func (s *serviceImpl) ListDisputes(ctx context.Context, accountID int64, status string, limit, offset int32) ([]models.Dispute, error) {
+	return s.repo.ListDisputes(ctx, accountID, status, limit, offset)
+}
+
+func (s *serviceImpl) UpdateDispute(ctx context.Context, arg models.UpdateDisputeParams) (models.Dispute, error) {
+	update, err := s.repo.UpdateDisputeTx(ctx, arg)
+	if err != nil {
+		return models.Dispute{}, err
+	}
+
+	s.distributeDisputeUpdate(ctx, update)
+	s.distributeAlerts(ctx, update.Alerts)
+	s.distributeTransferNotices(ctx, update.Notices)
+	return update.Dispute, nil
+}
+
+// distributeDisputeUpdate tells every party to the dispute about its new status. Like alerts, a notification
+// that can't be queued is only logged since the dispute has already moved on.
+func (s *serviceImpl) distributeDisputeUpdate(ctx context.Context, update models.DisputeUpdate) {
+	dispute := update.Dispute
+	var comment string
+	if dispute.Comment != nil {
+		comment = *dispute.Comment
+	}
+
+	for _, username := range update.Notify {
+		payload := &worker.PayloadSendDisputeUpdate{
+			DisputeID:     dispute.ID,
+			Username:      username,
+			Status:        dispute.Status,
+			TransactionID: dispute.TransactionID,
+			Amount:        dispute.Amount,
+			Currency:      dispute.Currency,
+		}
+		// the agent's comment is written for the customer who disputed the transfer
+		if username == dispute.OpenedBy {
+			payload.Comment = comment
+		}
+		opts := []asynq.Option{
+			asynq.MaxRetry(10),
+			asynq.Queue(worker.QueueCritical),
+			asynq.TaskID(fmt.Sprintf("dispute:%d:%s:%s", dispute.ID, dispute.Status, username)),
+		}
+
+		err := s.distributor.DistributeTaskSendDisputeUpdate(ctx, payload, opts...)
+		if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			log.Error().Err(err).Int64("dispute_id", dispute.ID).Str("username", username).
+				Msg("failed to distribute dispute update")
+		}
+	}
+}
+
+func (s *serviceImpl) GetAccount(ctx context.Context, id int64) (models.Account, error) {
+	return s.repo.GetAccount(ctx, id)
+}
+
+func (s *serviceImpl) GetAccountByNumber(ctx context.Context, number string) (models.Account, error) {
+	return s.repo.GetAccountByNumber(ctx, number)
+}
+
+func (s *serviceImpl) ListAccountIDsByNumber(ctx context.Context, numbers []string) (map[string]int64, error) {
+	return s.repo.ListAccountIDsByNumber(ctx, numbers)
+}
+
+func (s *serviceImpl) GetAccountForUpdate(ctx context.Context, id int64) (models.Account, error) {
+	return s.repo.GetAccountForUpdate(ctx, id)
+}
+
+func (s *serviceImpl) ListAccounts(ctx context.Context, name string, limit, offset int32) ([]models.Account, error) {
+	return s.repo.ListAccounts(ctx, name, limit, offset)
+}
+
+func (s *serviceImpl) UpdateAccountStatus(ctx context.Context, arg models.UpdateAccountStatusParams) (models.Account, error) {
+	return s.repo.UpdateAccountStatusTx(ctx, arg)
+}
+
+func (s *serviceImpl) CloseAccount(ctx context.Context, arg models.CloseAccountTxParams) (models.CloseAccountTxResult, error) {
+	return s.repo.CloseAccountTx(ctx, arg)
 }
 
 func (s *serviceImpl) CreateEntry(ctx context.Context, entry models.Entry) (models.Entry, error) {
@@ -141,6 +513,423 @@ func (s *serviceImpl) ListUsers(ctx context.Context, limit, offset int32) ([]mod
 	return s.repo.ListUsers(ctx, limit, offset)
 }
 
+// TransferTx screens the transfer for fraud and moves the money straight away unless a rule flagged it for
+// review or the amount is above the approval threshold, in which case the funds are reserved and the result
+// only carries the pending transfer
 func (s *serviceImpl) TransferTx(ctx context.Context, arg models.TransferTxParams) (models.TransferTxResult, error) {
-	return s.repo.TransferTx(ctx, arg)
+	screening, err := s.screenTransfer(ctx, arg, 0)
+	if err != nil {
+		return models.TransferTxResult{}, err
+	}
+
+	if screening != nil && screening.Decision == models.FraudDecisionBlock {
+		if _, err := s.repo.CreateFraudScreening(ctx, *screening); err != nil {
+			return models.TransferTxResult{}, err
+		}
+		return models.TransferTxResult{}, models.ErrTransferBlocked
+	}
+
+	if screening == nil && !s.needsApproval(arg.Amount) {
+		result, err := s.repo.TransferTx(ctx, arg)
+		if err != nil {
+			return result, err
+		}
+
+		s.distributeAlerts(ctx, result.Alerts)
+		s.distributeTransferNotices(ctx, result.Notices)
+		return result, nil
+	}
+
+	pending, err := s.repo.CreatePendingTransferTx(ctx, models.CreatePendingTransferParams{
+		Transfer:  arg,
+		ExpiresAt: time.Now().Add(s.config.PendingTransferTTL),
+		Screening: screening,
+	})
+	if err != nil {
+		return models.TransferTxResult{}, err
+	}
+
+	return models.TransferTxResult{Pending: &pending}, nil
+}
+
+// needsApproval reports whether a transfer of the amount is above the approval threshold
+func (s *serviceImpl) needsApproval(amount float64) bool {
+	return s.config.TransferApprovalThreshold > 0 && amount > s.config.TransferApprovalThreshold
+}
+
+// distributeAlerts queues a notification for every alert rule a committed transfer set off. The transfer already
+// went through, so failures are only logged. Each alert gets a task id derived from its rule and transaction,
+// so one that was already queued isn't queued twice.
+func (s *serviceImpl) distributeAlerts(ctx context.Context, alerts []models.TriggeredAlert) {
+	for _, alert := range alerts {
+		payload := &worker.PayloadSendAlert{
+			RuleID:        alert.Rule.ID,
+			Username:      alert.Rule.Owner,
+			AccountID:     alert.Rule.AccountID,
+			AccountNumber: alert.Rule.AccountNumber,
+			Kind:          alert.Rule.Kind,
+			Channel:       alert.Rule.Channel,
+			Threshold:     alert.Rule.Threshold,
+			TransactionID: alert.TransactionID,
+			Amount:        alert.Amount,
+			Currency:      alert.Currency,
+			Incoming:      alert.Incoming,
+			Balance:       alert.Balance,
+		}
+		opts := []asynq.Option{
+			asynq.MaxRetry(10),
+			asynq.Queue(worker.QueueCritical),
+			asynq.TaskID(worker.AlertTaskID(alert)),
+		}
+
+		err := s.distributor.DistributeTaskSendAlert(ctx, payload, opts...)
+		if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			log.Error().Err(err).Int64("rule_id", alert.Rule.ID).Int64("transaction_id", alert.TransactionID).
+				Msg("failed to distribute alert")
+		}
+	}
+}
+
+// distributeTransferNotices queues the debit and credit notices of a committed transfer. A transfer that failed
+// or was rolled back returns an error before getting here, so it never notifies anyone. Like alerts, a notice
+// that can't be queued is only logged.
+func (s *serviceImpl) distributeTransferNotices(ctx context.Context, notices []models.TransferNotice) {
+	for _, notice := range notices {
+		payload := &worker.PayloadSendTransferNotice{
+			Username:      notice.Username,
+			Kind:          notice.Kind,
+			AccountNumber: notice.AccountNumber,
+			TransactionID: notice.TransactionID,
+			Amount:        notice.Amount,
+			Fee:           notice.Fee,
+			Currency:      notice.Currency,
+			Description:   notice.Description,
+			Balance:       notice.Balance,
+		}
+		opts := []asynq.Option{
+			asynq.MaxRetry(10),
+			asynq.Queue(worker.QueueCritical),
+			asynq.TaskID(worker.TransferNoticeTaskID(notice)),
+		}
+
+		err := s.distributor.DistributeTaskSendTransferNotice(ctx, payload, opts...)
+		if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			log.Error().Err(err).Int64("transaction_id", notice.TransactionID).Str("username", notice.Username).
+				Msg("failed to distribute transfer notice")
+		}
+	}
+}
+
+// screenTransfer runs the fraud rules over a transfer a user initiated and returns the screening to record,
+// or nil when every rule allowed it. batched counts the transfers already accepted earlier in the same batch,
+// they aren't in the history yet but count toward the velocity rule all the same.
+func (s *serviceImpl) screenTransfer(ctx context.Context, arg models.TransferTxParams, batched int64) (*models.FraudScreening, error) {
+	if !s.fraud.Enabled() || arg.InitiatedBy == "" {
+		return nil, nil
+	}
+
+	now := time.Now()
+	signals, err := s.repo.GetTransferSignals(ctx, models.TransferSignalsParams{
+		UserName:         arg.InitiatedBy,
+		UserAgent:        arg.UserAgent,
+		FromAccountID:    arg.FromAccountID,
+		ToAccountID:      arg.ToAccountID,
+		DeviceSeenBefore: now.Add(-s.config.FraudNewDeviceWindow),
+		VelocitySince:    now.Add(-s.config.FraudVelocityWindow),
+		HistorySince:     now.Add(-s.config.FraudUnusualHistory),
+	})
+	if err != nil {
+		return nil, err
+	}
+	signals.Amount = arg.Amount
+	signals.RecentTransfers += batched
+
+	result := s.fraud.Screen(signals)
+	if result.Decision == models.FraudDecisionAllow {
+		return nil, nil
+	}
+
+	return &models.FraudScreening{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		Currency:      arg.Currency,
+		InitiatedBy:   arg.InitiatedBy,
+		Decision:      result.Decision,
+		Reasons:       result.Reasons,
+	}, nil
+}
+
+func (s *serviceImpl) GetFraudScreening(ctx context.Context, id int64) (models.FraudScreening, error) {
+	return s.repo.GetFraudScreening(ctx, id)
+}
+
+func (s *serviceImpl) ListFraudScreenings(ctx context.Context, status string, limit, offset int32) ([]models.FraudScreening, error) {
+	return s.repo.ListFraudScreenings(ctx, status, limit, offset)
+}
+
+func (s *serviceImpl) ResolveFraudScreening(ctx context.Context, arg models.ResolveFraudScreeningParams) (models.FraudScreening, error) {
+	result, err := s.repo.ResolveFraudScreeningTx(ctx, arg)
+	if err != nil {
+		return result.Screening, err
+	}
+
+	if result.Transfer != nil {
+		s.distributeAlerts(ctx, result.Transfer.Alerts)
+		s.distributeTransferNotices(ctx, result.Transfer.Notices)
+	}
+	return result.Screening, nil
+}
+
+func (s *serviceImpl) GetPendingTransfer(ctx context.Context, id int64) (models.PendingTransfer, error) {
+	return s.repo.GetPendingTransfer(ctx, id)
+}
+
+func (s *serviceImpl) ListPendingTransfers(ctx context.Context, status string, limit, offset int32) ([]models.PendingTransfer, error) {
+	return s.repo.ListPendingTransfers(ctx, status, limit, offset)
+}
+
+func (s *serviceImpl) ListPendingTransferEvents(ctx context.Context, pendingTransferID int64) ([]models.PendingTransferEvent, error) {
+	return s.repo.ListPendingTransferEvents(ctx, pendingTransferID)
+}
+
+func (s *serviceImpl) DecidePendingTransfer(ctx context.Context, arg models.DecidePendingTransferParams) (models.DecidePendingTransferResult, error) {
+	result, err := s.repo.DecidePendingTransferTx(ctx, arg)
+	if err != nil {
+		return result, err
+	}
+
+	if result.Transfer != nil {
+		s.distributeAlerts(ctx, result.Transfer.Alerts)
+		s.distributeTransferNotices(ctx, result.Transfer.Notices)
+	}
+	return result, nil
+}
+
+// BatchTransferTx screens every line and holds the ones above the approval threshold or sent for review the way
+// TransferTx holds a single transfer, the other lines move straight away
+func (s *serviceImpl) BatchTransferTx(ctx context.Context, arg models.BatchTransferTxParams) (models.BatchTransferTxResult, error) {
+	arg.PendingExpiresAt = time.Now().Add(s.config.PendingTransferTTL)
+	var accepted int64
+	for i, line := range arg.Lines {
+		// a line without a recipient or refused already fails in the batch, there is nothing to screen
+		if line.ToAccountID == 0 || line.Refused != nil {
+			continue
+		}
+
+		screening, err := s.screenTransfer(ctx, models.TransferTxParams{
+			FromAccountID: arg.FromAccountID,
+			ToAccountID:   line.ToAccountID,
+			Amount:        line.Amount,
+			Currency:      arg.Currency,
+			InitiatedBy:   arg.InitiatedBy,
+			UserAgent:     arg.UserAgent,
+		}, accepted)
+		if err != nil {
+			return models.BatchTransferTxResult{}, err
+		}
+
+		// blocked lines are recorded up front so a rejected batch keeps them
+		if screening != nil && screening.Decision == models.FraudDecisionBlock {
+			if _, err := s.repo.CreateFraudScreening(ctx, *screening); err != nil {
+				return models.BatchTransferTxResult{}, err
+			}
+		} else {
+			accepted++
+		}
+
+		arg.Lines[i].Screening = screening
+		arg.Lines[i].Pending = screening != nil || s.needsApproval(line.Amount)
+	}
+
+	result, err := s.repo.BatchTransferTx(ctx, arg)
+	if err != nil {
+		return result, err
+	}
+
+	s.distributeAlerts(ctx, result.Alerts)
+	s.distributeTransferNotices(ctx, result.Notices)
+	return result, nil
+}
+
+func (s *serviceImpl) GetAccountHolder(ctx context.Context, accountID int64, username string) (models.AccountHolder, error) {
+	return s.repo.GetAccountHolder(ctx, accountID, username)
+}
+
+func (s *serviceImpl) ListAccountHolders(ctx context.Context, accountID int64) ([]models.AccountHolder, error) {
+	return s.repo.ListAccountHolders(ctx, accountID)
+}
+
+func (s *serviceImpl) InviteAccountHolder(ctx context.Context, arg models.InviteAccountHolderParams) (models.AccountHolder, error) {
+	return s.repo.InviteAccountHolder(ctx, arg)
+}
+
+func (s *serviceImpl) AcceptAccountInvitation(ctx context.Context, accountID int64, username string) (models.AccountHolder, error) {
+	return s.repo.AcceptAccountInvitation(ctx, accountID, username)
+}
+
+func (s *serviceImpl) CreateHold(ctx context.Context, data models.CreateHoldRequest) (models.Hold, error) {
+	arg := models.CreateHoldParams{
+		AccountID:   data.AccountID,
+		ToAccountID: data.ToAccountID,
+		Amount:      data.Amount,
+		Currency:    data.Currency,
+		Description: data.Description,
+		ExpiresAt:   time.Now().Add(time.Duration(data.ExpiresInMinutes) * time.Minute),
+	}
+
+	return s.repo.CreateHoldTx(ctx, arg)
+}
+
+func (s *serviceImpl) GetHold(ctx context.Context, id int64) (models.Hold, error) {
+	return s.repo.GetHold(ctx, id)
+}
+
+func (s *serviceImpl) ListHolds(ctx context.Context, accountID int64, limit, offset int32) ([]models.Hold, error) {
+	return s.repo.ListHolds(ctx, accountID, limit, offset)
+}
+
+func (s *serviceImpl) CaptureHold(ctx context.Context, arg models.CaptureHoldParams) (models.CaptureHoldResult, error) {
+	return s.repo.CaptureHoldTx(ctx, arg)
+}
+
+func (s *serviceImpl) VoidHold(ctx context.Context, id int64) (models.Hold, error) {
+	return s.repo.VoidHoldTx(ctx, id)
+}
+
+func (s *serviceImpl) ListTellerReasonCodes(ctx context.Context) ([]models.TellerReasonCode, error) {
+	return s.repo.ListTellerReasonCodes(ctx)
+}
+
+func (s *serviceImpl) CreateTellerOperation(ctx context.Context, arg models.CreateTellerOperationParams) (models.TellerOperation, error) {
+	arg.RequiresApproval = math.Abs(arg.Amount) > s.config.TellerApprovalThreshold
+	return s.repo.CreateTellerOperationTx(ctx, arg)
+}
+
+func (s *serviceImpl) GetTellerOperation(ctx context.Context, id int64) (models.TellerOperation, error) {
+	return s.repo.GetTellerOperation(ctx, id)
+}
+
+func (s *serviceImpl) ListTellerOperations(ctx context.Context, status string, limit, offset int32) ([]models.TellerOperation, error) {
+	return s.repo.ListTellerOperations(ctx, status, limit, offset)
+}
+
+func (s *serviceImpl) DecideTellerOperation(ctx context.Context, arg models.DecideTellerOperationParams) (models.TellerOperation, error) {
+	return s.repo.DecideTellerOperationTx(ctx, arg)
+}
+
+// CreateBeneficiary checks that the payee account exists, is open and holds the beneficiary's currency
+func (s *serviceImpl) CreateBeneficiary(ctx context.Context, beneficiary models.Beneficiary) (models.Beneficiary, error) {
+	account, err := s.repo.GetAccount(ctx, beneficiary.AccountID)
+	if err != nil {
+		return models.Beneficiary{}, err
+	}
+
+	if account.Currency != beneficiary.Currency {
+		return models.Beneficiary{}, models.ErrCurrencyMismatch
+	}
+
+	if account.Status == models.AccountStatusClosed {
+		return models.Beneficiary{}, models.ErrAccountClosed
+	}
+
+	return s.repo.CreateBeneficiary(ctx, beneficiary)
+}
+
+func (s *serviceImpl) GetBeneficiary(ctx context.Context, id int64, owner string) (models.Beneficiary, error) {
+	return s.repo.GetBeneficiary(ctx, id, owner)
+}
+
+func (s *serviceImpl) ListBeneficiaries(ctx context.Context, owner string) ([]models.Beneficiary, error) {
+	return s.repo.ListBeneficiaries(ctx, owner)
+}
+
+func (s *serviceImpl) UpdateBeneficiary(ctx context.Context, arg models.UpdateBeneficiaryParams) (models.Beneficiary, error) {
+	return s.repo.UpdateBeneficiary(ctx, arg)
+}
+
+func (s *serviceImpl) DeleteBeneficiary(ctx context.Context, id int64, owner string) error {
+	return s.repo.DeleteBeneficiary(ctx, id, owner)
+}
+
+// CreatePaymentAlias registers the alias unverified and texts a code to it, the alias receives payments once
+// VerifyPaymentAlias confirmed the code. Registering it again sends a new code.
+func (s *serviceImpl) CreatePaymentAlias(ctx context.Context, alias models.PaymentAlias) (models.PaymentAlias, error) {
+	created, err := s.repo.CreatePaymentAlias(ctx, alias)
+	if err != nil {
+		return created, err
+	}
+
+	payload := &worker.PayloadSendAliasCode{
+		Alias:    created.Alias,
+		Username: created.UserName,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(3),
+		asynq.Queue(worker.QueueCritical),
+	}
+
+	if err := s.distributor.DistributeTaskSendAliasCode(ctx, payload, opts...); err != nil {
+		return created, fmt.Errorf("failed to distribute alias code: %w", err)
+	}
+
+	return created, nil
+}
+
+func (s *serviceImpl) VerifyPaymentAlias(ctx context.Context, arg models.VerifyPaymentAliasParams) (models.PaymentAlias, error) {
+	return s.repo.VerifyPaymentAliasTx(ctx, arg)
+}
+
+func (s *serviceImpl) ListPaymentAliases(ctx context.Context, username string) ([]models.PaymentAlias, error) {
+	return s.repo.ListPaymentAliases(ctx, username)
+}
+
+func (s *serviceImpl) DeletePaymentAlias(ctx context.Context, alias, username string) error {
+	return s.repo.DeletePaymentAlias(ctx, alias, username)
+}
+
+func (s *serviceImpl) SetDefaultAccount(ctx context.Context, username string, accountID int64) (models.DefaultAccount, error) {
+	return s.repo.SetDefaultAccount(ctx, username, accountID)
+}
+
+func (s *serviceImpl) ListDefaultAccounts(ctx context.Context, username string) ([]models.DefaultAccount, error) {
+	return s.repo.ListDefaultAccounts(ctx, username)
+}
+
+func (s *serviceImpl) ResolvePaymentAlias(ctx context.Context, alias, currency string) (models.Account, error) {
+	return s.repo.ResolvePaymentAlias(ctx, alias, currency)
+}
+
+// CheckBeneficiaryTransfer applies the beneficiary's own limit and, while it is younger than the cooling-off
+// period, refuses amounts above the configured large amount
+func (s *serviceImpl) CheckBeneficiaryTransfer(beneficiary models.Beneficiary, amount float64) error {
+	if beneficiary.TransferLimit != nil && amount > *beneficiary.TransferLimit {
+		return models.ErrBeneficiaryLimitExceeded
+	}
+
+	if amount > s.config.BeneficiaryLargeAmount && time.Since(beneficiary.CreatedAt) < s.config.BeneficiaryCoolingOff {
+		return models.ErrBeneficiaryCoolingOff
+	}
+
+	return nil
+}
+
+// CheckPayeeTransfer applies the limits of the owner's beneficiary for the account, if there is one. It is
+// checked however the recipient was named, so typing the account number doesn't get around a cooling-off period.
+func (s *serviceImpl) CheckPayeeTransfer(ctx context.Context, owner string, accountID int64, amount float64) error {
+	beneficiary, err := s.repo.GetBeneficiaryByAccount(ctx, owner, accountID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.CheckBeneficiaryTransfer(beneficiary, amount)
+}
+
+// today is the current UTC calendar date, the day loan schedules are worked out against
+func today() time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
 }
@@ -2,12 +2,19 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/blob"
+	"github.com/zde37/Swift_Bank/config"
 	"github.com/zde37/Swift_Bank/helpers"
 	mockedproviders "github.com/zde37/Swift_Bank/mock"
 	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/worker"
 	"go.uber.org/mock/gomock"
 )
 
@@ -26,7 +33,7 @@ func TestGetAccount(t *testing.T) {
 		Return(account, nil)
 
 	// create service
-	service := NewService(repo)
+	service := NewService(repo, nil, config.Config{}, nil)
 
 	createdAccount, err := service.S.GetAccount(context.Background(), account.ID)
 	require.NoError(t, err)
@@ -35,6 +42,367 @@ func TestGetAccount(t *testing.T) {
 
 }
 
+func TestCheckBeneficiaryTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	service := NewService(repo, nil, config.Config{
+		BeneficiaryCoolingOff:  24 * time.Hour,
+		BeneficiaryLargeAmount: 500,
+	}, nil)
+
+	limit := float64(1000)
+	newBeneficiary := models.Beneficiary{CreatedAt: time.Now().Add(-time.Hour)}
+	oldBeneficiary := models.Beneficiary{CreatedAt: time.Now().Add(-48 * time.Hour), TransferLimit: &limit}
+
+	require.NoError(t, service.S.CheckBeneficiaryTransfer(newBeneficiary, 500))
+	require.ErrorIs(t, service.S.CheckBeneficiaryTransfer(newBeneficiary, 501), models.ErrBeneficiaryCoolingOff)
+
+	require.NoError(t, service.S.CheckBeneficiaryTransfer(oldBeneficiary, 1000))
+	require.ErrorIs(t, service.S.CheckBeneficiaryTransfer(oldBeneficiary, 1001), models.ErrBeneficiaryLimitExceeded)
+}
+
+func TestCheckPayeeTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	service := NewService(repo, nil, config.Config{
+		BeneficiaryCoolingOff:  24 * time.Hour,
+		BeneficiaryLargeAmount: 500,
+	}, nil)
+
+	// an account saved as a new beneficiary is cooling off even when it is named by number
+	repo.EXPECT().
+		GetBeneficiaryByAccount(gomock.Any(), gomock.Eq("owner"), gomock.Eq(int64(1))).
+		Times(1).
+		Return(models.Beneficiary{AccountID: 1, CreatedAt: time.Now()}, nil)
+	require.ErrorIs(t, service.S.CheckPayeeTransfer(context.Background(), "owner", 1, 501), models.ErrBeneficiaryCoolingOff)
+
+	repo.EXPECT().
+		GetBeneficiaryByAccount(gomock.Any(), gomock.Eq("owner"), gomock.Eq(int64(2))).
+		Times(1).
+		Return(models.Beneficiary{}, pgx.ErrNoRows)
+	require.NoError(t, service.S.CheckPayeeTransfer(context.Background(), "owner", 2, 501))
+}
+
+func TestCreatePaymentAliasSendsCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	distributor := mockedproviders.NewMockTaskDistributor(ctrl)
+	service := NewService(repo, distributor, config.Config{}, nil)
+
+	alias := models.PaymentAlias{Alias: "+15551234567", UserName: "alice", Kind: models.AliasKindPhone}
+	repo.EXPECT().
+		CreatePaymentAlias(gomock.Any(), gomock.Eq(alias)).
+		Times(1).
+		Return(alias, nil)
+	distributor.EXPECT().
+		DistributeTaskSendAliasCode(gomock.Any(), gomock.Eq(&worker.PayloadSendAliasCode{Alias: alias.Alias, Username: "alice"}), gomock.Any()).
+		Times(1).
+		Return(nil)
+
+	created, err := service.S.CreatePaymentAlias(context.Background(), alias)
+	require.NoError(t, err)
+	require.Nil(t, created.VerifiedAt)
+
+	// a taken alias sends nothing
+	repo.EXPECT().
+		CreatePaymentAlias(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.PaymentAlias{}, models.ErrAliasTaken)
+
+	_, err = service.S.CreatePaymentAlias(context.Background(), alias)
+	require.ErrorIs(t, err, models.ErrAliasTaken)
+}
+
+func TestTransferTxApprovalThreshold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	service := NewService(repo, nil, config.Config{
+		TransferApprovalThreshold: 1000,
+		PendingTransferTTL:        time.Hour,
+	}, nil)
+
+	small := models.TransferTxParams{FromAccountID: 1, ToAccountID: 2, Amount: 1000, Currency: "USD"}
+	repo.EXPECT().
+		TransferTx(gomock.Any(), gomock.Eq(small)).
+		Times(1).
+		Return(models.TransferTxResult{}, nil)
+
+	result, err := service.S.TransferTx(context.Background(), small)
+	require.NoError(t, err)
+	require.Nil(t, result.Pending)
+
+	large := models.TransferTxParams{FromAccountID: 1, ToAccountID: 2, Amount: 1001, Currency: "USD", InitiatedBy: "alice"}
+	repo.EXPECT().
+		CreatePendingTransferTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg models.CreatePendingTransferParams) (models.PendingTransfer, error) {
+			require.Equal(t, large, arg.Transfer)
+			require.WithinDuration(t, time.Now().Add(time.Hour), arg.ExpiresAt, time.Second)
+			return models.PendingTransfer{ID: 1, Status: models.PendingTransferPendingApproval}, nil
+		})
+
+	result, err = service.S.TransferTx(context.Background(), large)
+	require.NoError(t, err)
+	require.NotNil(t, result.Pending)
+	require.Equal(t, models.PendingTransferPendingApproval, result.Pending.Status)
+}
+
+func TestBatchTransferTxApprovalThreshold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	service := NewService(repo, nil, config.Config{
+		TransferApprovalThreshold: 1000,
+		PendingTransferTTL:        time.Hour,
+	}, nil)
+
+	repo.EXPECT().
+		BatchTransferTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg models.BatchTransferTxParams) (models.BatchTransferTxResult, error) {
+			require.False(t, arg.Lines[0].Pending)
+			require.True(t, arg.Lines[1].Pending)
+			require.WithinDuration(t, time.Now().Add(time.Hour), arg.PendingExpiresAt, time.Second)
+			return models.BatchTransferTxResult{Completed: 1, Pending: 1}, nil
+		})
+
+	result, err := service.S.BatchTransferTx(context.Background(), models.BatchTransferTxParams{
+		FromAccountID: 1,
+		Currency:      "USD",
+		Lines: []models.BatchTransferLine{
+			{ToAccountID: 2, Amount: 1000},
+			{ToAccountID: 3, Amount: 1001},
+		},
+		InitiatedBy: "alice",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Pending)
+}
+
+func TestTransferTxFraudScreening(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	service := NewService(repo, nil, config.Config{
+		FraudNewPayeeAmount: 100,
+		FraudNewPayeeAction: models.FraudDecisionReview,
+		FraudVelocityLimit:  3,
+		FraudVelocityAction: models.FraudDecisionBlock,
+		PendingTransferTTL:  time.Hour,
+	}, nil)
+
+	arg := models.TransferTxParams{FromAccountID: 1, ToAccountID: 2, Amount: 100, Currency: "USD", InitiatedBy: "alice"}
+
+	// blocked transfers are recorded for the admins and never reach the ledger
+	repo.EXPECT().
+		GetTransferSignals(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.TransferSignals{RecentTransfers: 3}, nil)
+	repo.EXPECT().
+		CreateFraudScreening(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, screening models.FraudScreening) (models.FraudScreening, error) {
+			require.Equal(t, models.FraudDecisionBlock, screening.Decision)
+			require.Len(t, screening.Reasons, 1)
+			return screening, nil
+		})
+
+	_, err := service.S.TransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, models.ErrTransferBlocked)
+
+	// reviewed transfers wait for approval with the screening attached
+	repo.EXPECT().
+		GetTransferSignals(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.TransferSignals{NewPayee: true}, nil)
+	repo.EXPECT().
+		CreatePendingTransferTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg models.CreatePendingTransferParams) (models.PendingTransfer, error) {
+			require.NotNil(t, arg.Screening)
+			require.Equal(t, models.FraudDecisionReview, arg.Screening.Decision)
+			return models.PendingTransfer{ID: 1, Status: models.PendingTransferPendingApproval}, nil
+		})
+
+	result, err := service.S.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotNil(t, result.Pending)
+
+	// allowed transfers run straight away
+	repo.EXPECT().
+		GetTransferSignals(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.TransferSignals{}, nil)
+	repo.EXPECT().
+		TransferTx(gomock.Any(), gomock.Eq(arg)).
+		Times(1).
+		Return(models.TransferTxResult{}, nil)
+
+	result, err = service.S.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Nil(t, result.Pending)
+}
+
+func TestBatchTransferTxFraudScreening(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	service := NewService(repo, nil, config.Config{
+		FraudNewPayeeAmount: 100,
+		FraudNewPayeeAction: models.FraudDecisionReview,
+		FraudVelocityLimit:  3,
+		FraudVelocityAction: models.FraudDecisionBlock,
+		PendingTransferTTL:  time.Hour,
+	}, nil)
+
+	// every line is screened on its own, the unknown recipient is left to the batch
+	repo.EXPECT().
+		GetTransferSignals(gomock.Any(), gomock.Any()).
+		Times(3).
+		DoAndReturn(func(_ context.Context, arg models.TransferSignalsParams) (models.TransferSignals, error) {
+			require.Equal(t, "alice", arg.UserName)
+			require.Equal(t, "phone", arg.UserAgent)
+			switch arg.ToAccountID {
+			case 2:
+				return models.TransferSignals{RecentTransfers: 3}, nil
+			case 3:
+				return models.TransferSignals{NewPayee: true}, nil
+			}
+			return models.TransferSignals{}, nil
+		})
+
+	// the blocked line is recorded before the batch runs so a rejected batch keeps it
+	repo.EXPECT().
+		CreateFraudScreening(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, screening models.FraudScreening) (models.FraudScreening, error) {
+			require.Equal(t, int64(2), screening.ToAccountID)
+			require.Equal(t, models.FraudDecisionBlock, screening.Decision)
+			return screening, nil
+		})
+
+	repo.EXPECT().
+		BatchTransferTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg models.BatchTransferTxParams) (models.BatchTransferTxResult, error) {
+			require.Equal(t, models.FraudDecisionBlock, arg.Lines[0].Screening.Decision)
+			require.Equal(t, models.FraudDecisionReview, arg.Lines[1].Screening.Decision)
+			require.True(t, arg.Lines[1].Pending)
+			require.Nil(t, arg.Lines[2].Screening)
+			require.False(t, arg.Lines[2].Pending)
+			require.Nil(t, arg.Lines[3].Screening)
+			return models.BatchTransferTxResult{}, nil
+		})
+
+	_, err := service.S.BatchTransferTx(context.Background(), models.BatchTransferTxParams{
+		FromAccountID: 1,
+		Currency:      "USD",
+		Lines: []models.BatchTransferLine{
+			{ToAccountID: 2, Amount: 100},
+			{ToAccountID: 3, Amount: 100},
+			{ToAccountID: 4, Amount: 100},
+			{ToAccountID: 0, Amount: 100},
+		},
+		InitiatedBy: "alice",
+		UserAgent:   "phone",
+	})
+	require.NoError(t, err)
+}
+
+func TestBatchTransferTxVelocityCountsEarlierLines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	service := NewService(repo, nil, config.Config{
+		FraudVelocityLimit:  3,
+		FraudVelocityAction: models.FraudDecisionBlock,
+		PendingTransferTTL:  time.Hour,
+	}, nil)
+
+	// one transfer in the window before the batch, the lines accepted in the batch make up the rest
+	repo.EXPECT().
+		GetTransferSignals(gomock.Any(), gomock.Any()).
+		Times(4).
+		Return(models.TransferSignals{RecentTransfers: 1}, nil)
+
+	// blocked lines don't count toward the lines after them
+	repo.EXPECT().
+		CreateFraudScreening(gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(_ context.Context, screening models.FraudScreening) (models.FraudScreening, error) {
+			require.Equal(t, models.FraudDecisionBlock, screening.Decision)
+			return screening, nil
+		})
+
+	repo.EXPECT().
+		BatchTransferTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg models.BatchTransferTxParams) (models.BatchTransferTxResult, error) {
+			require.Nil(t, arg.Lines[0].Screening)
+			require.Nil(t, arg.Lines[1].Screening)
+			require.Equal(t, models.FraudDecisionBlock, arg.Lines[2].Screening.Decision)
+			require.Equal(t, models.FraudDecisionBlock, arg.Lines[3].Screening.Decision)
+			return models.BatchTransferTxResult{}, nil
+		})
+
+	_, err := service.S.BatchTransferTx(context.Background(), models.BatchTransferTxParams{
+		FromAccountID: 1,
+		Currency:      "USD",
+		Lines: []models.BatchTransferLine{
+			{ToAccountID: 2, Amount: 10},
+			{ToAccountID: 3, Amount: 10},
+			{ToAccountID: 4, Amount: 10},
+			{ToAccountID: 5, Amount: 10},
+		},
+		InitiatedBy: "alice",
+		UserAgent:   "phone",
+	})
+	require.NoError(t, err)
+}
+
+func TestResolveFraudScreeningDistributesReleasedTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	distributor := mockedproviders.NewMockTaskDistributor(ctrl)
+	service := NewService(repo, distributor, config.Config{}, nil)
+
+	repo.EXPECT().
+		ResolveFraudScreeningTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.ResolveFraudScreeningResult{
+			Screening: models.FraudScreening{ID: 1, Status: models.FraudScreeningLegitimate},
+			Transfer: &models.TransferTxResult{Notices: []models.TransferNotice{
+				{Username: "alice", Kind: models.TransferNoticeDebit, AccountNumber: "1000000000000001", TransactionID: 42, Amount: 300, Currency: "USD"},
+			}},
+		}, nil)
+	distributor.EXPECT().
+		DistributeTaskSendTransferNotice(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(nil)
+
+	screening, err := service.S.ResolveFraudScreening(context.Background(), models.ResolveFraudScreeningParams{
+		ID:         1,
+		ReviewedBy: "admin",
+		Resolution: models.FraudScreeningLegitimate,
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.FraudScreeningLegitimate, screening.Status)
+}
+
 func randomAccount() models.Account {
 	return models.Account{
 		ID:       helpers.RandomInt(1, 1000),
@@ -43,3 +411,334 @@ func randomAccount() models.Account {
 		Currency: helpers.RandomCurrency(),
 	}
 }
+
+func TestTransferTxAlerts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	distributor := mockedproviders.NewMockTaskDistributor(ctrl)
+	service := NewService(repo, distributor, config.Config{}, nil)
+
+	arg := models.TransferTxParams{FromAccountID: 1, ToAccountID: 2, Amount: 500, Currency: "USD"}
+	alert := models.TriggeredAlert{
+		Rule: models.AlertRule{
+			ID:        7,
+			AccountID: 1,
+			Owner:     "alice",
+			Kind:      models.AlertKindLowBalance,
+			Threshold: 100,
+			Channel:   models.AlertChannelEmail,
+		},
+		TransactionID: 42,
+		Amount:        500,
+		Currency:      "USD",
+		Balance:       50,
+	}
+	repo.EXPECT().
+		TransferTx(gomock.Any(), gomock.Eq(arg)).
+		Times(1).
+		Return(models.TransferTxResult{Alerts: []models.TriggeredAlert{alert}}, nil)
+
+	distributor.EXPECT().
+		DistributeTaskSendAlert(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, payload *worker.PayloadSendAlert, opts ...asynq.Option) error {
+			require.Equal(t, "alice", payload.Username)
+			require.Equal(t, int64(42), payload.TransactionID)
+			require.Equal(t, float64(50), payload.Balance)
+			require.Contains(t, opts, asynq.TaskID("alert:7:42"))
+			return asynq.ErrTaskIDConflict
+		})
+
+	// an alert that was already queued doesn't fail the transfer
+	_, err := service.S.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+}
+
+func TestPostingPathsDistributeAlerts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	distributor := mockedproviders.NewMockTaskDistributor(ctrl)
+	service := NewService(repo, distributor, config.Config{}, nil)
+
+	alert := func(transactionID int64) []models.TriggeredAlert {
+		return []models.TriggeredAlert{{
+			Rule:          models.AlertRule{ID: 7, AccountID: 1, Owner: "alice", Kind: models.AlertKindLargeTransaction},
+			TransactionID: transactionID,
+		}}
+	}
+
+	repo.EXPECT().
+		BatchTransferTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.BatchTransferTxResult{Alerts: alert(1)}, nil)
+	repo.EXPECT().
+		DecidePendingTransferTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.DecidePendingTransferResult{Transfer: &models.TransferTxResult{Alerts: alert(2)}}, nil)
+	repo.EXPECT().
+		UpdateDisputeTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.DisputeUpdate{Alerts: alert(3)}, nil)
+
+	var queued []int64
+	distributor.EXPECT().
+		DistributeTaskSendAlert(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(3).
+		DoAndReturn(func(_ context.Context, payload *worker.PayloadSendAlert, opts ...asynq.Option) error {
+			queued = append(queued, payload.TransactionID)
+			return nil
+		})
+
+	_, err := service.S.BatchTransferTx(context.Background(), models.BatchTransferTxParams{})
+	require.NoError(t, err)
+	_, err = service.S.DecidePendingTransfer(context.Background(), models.DecidePendingTransferParams{Approve: true})
+	require.NoError(t, err)
+	_, err = service.S.UpdateDispute(context.Background(), models.UpdateDisputeParams{})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3}, queued)
+}
+
+func TestTransferTxNotices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	distributor := mockedproviders.NewMockTaskDistributor(ctrl)
+	service := NewService(repo, distributor, config.Config{}, nil)
+
+	arg := models.TransferTxParams{FromAccountID: 1, ToAccountID: 2, Amount: 500, Currency: "USD"}
+	notices := []models.TransferNotice{
+		{Username: "alice", Kind: models.TransferNoticeDebit, AccountNumber: "1000000000000001", TransactionID: 42, Amount: 500, Currency: "USD", Balance: 500},
+		{Username: "bob", Kind: models.TransferNoticeCredit, AccountNumber: "1000000000000002", TransactionID: 42, Amount: 500, Currency: "USD", Balance: 700},
+		{Username: "carol", Kind: models.TransferNoticeCredit, AccountNumber: "1000000000000002", TransactionID: 42, Amount: 500, Currency: "USD", Balance: 700},
+	}
+
+	// a transfer that fails queues nothing
+	repo.EXPECT().
+		TransferTx(gomock.Any(), gomock.Eq(arg)).
+		Times(1).
+		Return(models.TransferTxResult{}, models.ErrInsufficientFunds)
+
+	_, err := service.S.TransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, models.ErrInsufficientFunds)
+
+	repo.EXPECT().
+		TransferTx(gomock.Any(), gomock.Eq(arg)).
+		Times(1).
+		Return(models.TransferTxResult{Notices: notices}, nil)
+
+	var queued []*worker.PayloadSendTransferNotice
+	distributor.EXPECT().
+		DistributeTaskSendTransferNotice(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(3).
+		DoAndReturn(func(_ context.Context, payload *worker.PayloadSendTransferNotice, opts ...asynq.Option) error {
+			require.Contains(t, opts, asynq.TaskID(fmt.Sprintf("transfer:42:%s:%s", payload.Kind, payload.Username)))
+			queued = append(queued, payload)
+			return nil
+		})
+
+	_, err = service.S.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, queued, 3)
+	require.Equal(t, "alice", queued[0].Username)
+	require.Equal(t, models.TransferNoticeDebit, queued[0].Kind)
+	require.Equal(t, float64(500), queued[0].Balance)
+	require.Equal(t, "carol", queued[2].Username)
+	require.Equal(t, models.TransferNoticeCredit, queued[2].Kind)
+	require.Equal(t, float64(700), queued[2].Balance)
+}
+
+func TestDecidePendingTransferNotices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	distributor := mockedproviders.NewMockTaskDistributor(ctrl)
+	service := NewService(repo, distributor, config.Config{}, nil)
+
+	notices := []models.TransferNotice{
+		{Username: "alice", Kind: models.TransferNoticeDebit, AccountNumber: "1000000000000001", TransactionID: 42, Amount: 1500, Currency: "USD", Balance: 500},
+		{Username: "bob", Kind: models.TransferNoticeCredit, AccountNumber: "1000000000000002", TransactionID: 42, Amount: 1500, Currency: "USD", Balance: 1700},
+	}
+
+	// a rejection moves no money and sends no notice
+	repo.EXPECT().
+		DecidePendingTransferTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.DecidePendingTransferResult{}, nil)
+
+	_, err := service.S.DecidePendingTransfer(context.Background(), models.DecidePendingTransferParams{ID: 1})
+	require.NoError(t, err)
+
+	repo.EXPECT().
+		DecidePendingTransferTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.DecidePendingTransferResult{Transfer: &models.TransferTxResult{Notices: notices}}, nil)
+
+	var queued []string
+	distributor.EXPECT().
+		DistributeTaskSendTransferNotice(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(_ context.Context, payload *worker.PayloadSendTransferNotice, opts ...asynq.Option) error {
+			queued = append(queued, payload.Username)
+			return nil
+		})
+
+	_, err = service.S.DecidePendingTransfer(context.Background(), models.DecidePendingTransferParams{ID: 2, Approve: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "bob"}, queued)
+}
+
+func TestPostingPathsDistributeTransferNotices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	distributor := mockedproviders.NewMockTaskDistributor(ctrl)
+	service := NewService(repo, distributor, config.Config{}, nil)
+
+	notice := func(transactionID int64) []models.TransferNotice {
+		return []models.TransferNotice{{Username: "alice", Kind: models.TransferNoticeDebit,
+			AccountNumber: "1000000000000001", TransactionID: transactionID, Amount: 100, Currency: "USD"}}
+	}
+
+	repo.EXPECT().
+		BatchTransferTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.BatchTransferTxResult{Notices: notice(1)}, nil)
+	repo.EXPECT().
+		UpdateDisputeTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.DisputeUpdate{Notices: notice(2)}, nil)
+
+	var queued []int64
+	distributor.EXPECT().
+		DistributeTaskSendTransferNotice(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(_ context.Context, payload *worker.PayloadSendTransferNotice, opts ...asynq.Option) error {
+			require.Equal(t, "1000000000000001", payload.AccountNumber)
+			queued = append(queued, payload.TransactionID)
+			return nil
+		})
+
+	_, err := service.S.BatchTransferTx(context.Background(), models.BatchTransferTxParams{})
+	require.NoError(t, err)
+	_, err = service.S.UpdateDispute(context.Background(), models.UpdateDisputeParams{})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, queued)
+}
+
+func TestUploadTransactionAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blobs, err := blob.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	service := NewService(repo, nil, config.Config{}, blobs)
+
+	var key string
+	gomock.InOrder(
+		repo.EXPECT().
+			CreateTransactionAttachment(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, attachment models.TransactionAttachment) (models.TransactionAttachment, error) {
+				require.Equal(t, int64(7), attachment.Size)
+				return attachment, nil
+			}),
+		repo.EXPECT().
+			CreateTransactionAttachment(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, attachment models.TransactionAttachment) (models.TransactionAttachment, error) {
+				key = attachment.StorageKey
+				return models.TransactionAttachment{}, pgx.ErrTxClosed
+			}),
+	)
+
+	attachment, err := service.S.UploadTransactionAttachment(context.Background(), models.TransactionAttachment{}, []byte("receipt"))
+	require.NoError(t, err)
+
+	file, err := blobs.Get(context.Background(), attachment.StorageKey)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	// a file that couldn't be recorded doesn't stay behind
+	_, err = service.S.UploadTransactionAttachment(context.Background(), models.TransactionAttachment{}, []byte("receipt"))
+	require.ErrorIs(t, err, pgx.ErrTxClosed)
+
+	_, err = blobs.Get(context.Background(), key)
+	require.ErrorIs(t, err, blob.ErrNotFound)
+}
+
+func TestOpenDispute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	distributor := mockedproviders.NewMockTaskDistributor(ctrl)
+	service := NewService(repo, distributor, config.Config{DisputeHoldDuration: time.Hour}, nil)
+
+	repo.EXPECT().
+		OpenDisputeTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg models.OpenDisputeParams) (models.DisputeUpdate, error) {
+			require.WithinDuration(t, time.Now().Add(time.Hour), arg.HoldExpiresAt, time.Minute)
+			return models.DisputeUpdate{
+				Dispute: models.Dispute{ID: 3, TransactionID: 42, Status: models.DisputeStatusOpen},
+				Notify:  []string{"alice", "bob"},
+			}, nil
+		})
+
+	var notified []string
+	distributor.EXPECT().
+		DistributeTaskSendDisputeUpdate(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(_ context.Context, payload *worker.PayloadSendDisputeUpdate, opts ...asynq.Option) error {
+			require.Equal(t, int64(42), payload.TransactionID)
+			require.Contains(t, opts, asynq.TaskID("dispute:3:open:"+payload.Username))
+			notified = append(notified, payload.Username)
+			return asynq.ErrTaskIDConflict
+		})
+
+	dispute, err := service.S.OpenDispute(context.Background(), models.OpenDisputeParams{HoldFunds: true})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), dispute.ID)
+	require.Equal(t, []string{"alice", "bob"}, notified)
+}
+
+func TestUpdateDisputeCommentGoesToCustomer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	distributor := mockedproviders.NewMockTaskDistributor(ctrl)
+	service := NewService(repo, distributor, config.Config{}, nil)
+
+	comment := "the merchant confirmed the item was never shipped"
+	repo.EXPECT().
+		UpdateDisputeTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(models.DisputeUpdate{
+			Dispute: models.Dispute{ID: 3, TransactionID: 42, Status: models.DisputeStatusResolvedCustomer,
+				OpenedBy: "alice", Comment: &comment},
+			Notify: []string{"alice", "bob", "carol"},
+		}, nil)
+
+	comments := map[string]string{}
+	distributor.EXPECT().
+		DistributeTaskSendDisputeUpdate(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(3).
+		DoAndReturn(func(_ context.Context, payload *worker.PayloadSendDisputeUpdate, opts ...asynq.Option) error {
+			comments[payload.Username] = payload.Comment
+			return nil
+		})
+
+	_, err := service.S.UpdateDispute(context.Background(), models.UpdateDisputeParams{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"alice": comment, "bob": "", "carol": ""}, comments)
+}
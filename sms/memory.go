@@ -0,0 +1,38 @@
+package sms
+
+import (
+	"slices"
+	"sync"
+)
+
+// Message is a text the MemorySender recorded
+type Message struct {
+	To   string
+	Body string
+}
+
+// MemorySender records messages instead of sending them, for tests that check what was sent
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemorySender() *MemorySender {
+	return &MemorySender{}
+}
+
+func (m *MemorySender) SendSMS(to string, body string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.messages = append(m.messages, Message{To: to, Body: body})
+	return nil
+}
+
+// Messages returns the messages recorded so far, oldest first
+func (m *MemorySender) Messages() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.messages)
+}
@@ -0,0 +1,41 @@
+package sms
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zde37/Swift_Bank/config"
+)
+
+// Transports a sender can be picked by with SMS_TRANSPORT
+const (
+	TransportWebhook = "webhook"
+	TransportLog     = "log"
+)
+
+var ErrNotConfigured = errors.New("no sms transport is configured")
+
+type Sender interface {
+	SendSMS(to string, body string) error
+}
+
+// NewSenderFromConfig creates the sender for the configured transport. Without one every message fails, so
+// nothing that has to reach a phone is ever reported as sent.
+func NewSenderFromConfig(c config.Config) (Sender, error) {
+	switch c.SMSTransport {
+	case "":
+		return disabledSender{}, nil
+	case TransportWebhook:
+		return NewWebhookSender(c.SMSWebhookURL)
+	case TransportLog:
+		return NewLogSender(), nil
+	}
+
+	return nil, fmt.Errorf("unsupported sms transport %q", c.SMSTransport)
+}
+
+type disabledSender struct{}
+
+func (disabledSender) SendSMS(to string, body string) error {
+	return ErrNotConfigured
+}
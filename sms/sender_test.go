@@ -0,0 +1,52 @@
+package sms
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/config"
+)
+
+func TestNewSenderFromConfig(t *testing.T) {
+	sender, err := NewSenderFromConfig(config.Config{SMSTransport: TransportLog})
+	require.NoError(t, err)
+	require.IsType(t, LogSender{}, sender)
+
+	sender, err = NewSenderFromConfig(config.Config{SMSTransport: TransportWebhook, SMSWebhookURL: "https://sms.example.com/send"})
+	require.NoError(t, err)
+	require.IsType(t, &WebhookSender{}, sender)
+
+	// nothing is reported as sent until a transport is configured
+	sender, err = NewSenderFromConfig(config.Config{})
+	require.NoError(t, err)
+	require.ErrorIs(t, sender.SendSMS("+15550000000", "hello"), ErrNotConfigured)
+
+	_, err = NewSenderFromConfig(config.Config{SMSTransport: TransportWebhook, SMSWebhookURL: "sms.example.com"})
+	require.Error(t, err)
+
+	_, err = NewSenderFromConfig(config.Config{SMSTransport: "pigeon"})
+	require.Error(t, err)
+}
+
+func TestWebhookSender(t *testing.T) {
+	var received map[string]string
+	status := http.StatusAccepted
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sender, err := NewWebhookSender(server.URL)
+	require.NoError(t, err)
+
+	require.NoError(t, sender.SendSMS("+15550000000", "Your code is 123456"))
+	require.Equal(t, map[string]string{"to": "+15550000000", "body": "Your code is 123456"}, received)
+
+	status = http.StatusBadGateway
+	require.Error(t, sender.SendSMS("+15550000000", "Your code is 123456"))
+}
@@ -0,0 +1,51 @@
+package sms
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WebhookSender posts every message as {"to": ..., "body": ...} to a gateway that delivers it, any 2xx
+// response counts as sent
+type WebhookSender struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSender(webhookURL string) (Sender, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid sms webhook url %q", webhookURL)
+	}
+
+	return &WebhookSender{
+		url:    webhookURL,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (w *WebhookSender) SendSMS(to string, body string) error {
+	payload, err := json.Marshal(map[string]string{
+		"to":   to,
+		"body": body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal sms: %w", err)
+	}
+
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to send sms: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("sms gateway responded with %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package statement
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+// WritePDF renders an account's monthly statement as a one column ledger, each entry followed by the running
+// balance, between the opening and closing balance
+func WritePDF(w io.Writer, fullName string, s models.Statement) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle(fmt.Sprintf("Statement %s", s.PeriodStart.Format("January 2006")), true)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.Cell(0, 10, "Swift Bank")
+	pdf.Ln(12)
+
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.Cell(0, 6, fmt.Sprintf("Statement for %s", s.PeriodStart.Format("January 2006")))
+	pdf.Ln(6)
+	pdf.Cell(0, 6, fullName)
+	pdf.Ln(6)
+	pdf.Cell(0, 6, fmt.Sprintf("Account %s (%s)", s.Account.AccountNumber, s.Account.Currency))
+	pdf.Ln(10)
+
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(60, 7, "Date", "B", 0, "L", false, 0, "")
+	pdf.CellFormat(60, 7, "Amount", "B", 0, "R", false, 0, "")
+	pdf.CellFormat(60, 7, "Balance", "B", 1, "R", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 11)
+	row := func(date, amount string, balance float64) {
+		pdf.CellFormat(60, 7, date, "", 0, "L", false, 0, "")
+		pdf.CellFormat(60, 7, amount, "", 0, "R", false, 0, "")
+		pdf.CellFormat(60, 7, formatAmount(balance), "", 1, "R", false, 0, "")
+	}
+
+	balance := s.OpeningBalance
+	row(s.PeriodStart.Format("2006-01-02"), "Opening balance", balance)
+	for _, entry := range s.Entries {
+		balance = helpers.RoundMoney(balance + entry.Amount)
+		row(entry.CreatedAt.UTC().Format("2006-01-02 15:04"), formatAmount(entry.Amount), balance)
+	}
+
+	pdf.SetFont("Helvetica", "B", 11)
+	row(s.PeriodEnd.AddDate(0, 0, -1).Format("2006-01-02"), "Closing balance", s.ClosingBalance)
+
+	return pdf.Output(w)
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
@@ -0,0 +1,29 @@
+package statement
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+func TestWritePDF(t *testing.T) {
+	period := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	err := WritePDF(&buf, "Jane Doe", models.Statement{
+		Account:        models.Account{ID: 7, AccountNumber: "SB77000000000001", Currency: "USD"},
+		PeriodStart:    period,
+		PeriodEnd:      period.AddDate(0, 1, 0),
+		OpeningBalance: 100,
+		ClosingBalance: 75.5,
+		Entries: []models.Entry{
+			{ID: 1, AccountID: 7, Amount: -30, CreatedAt: period.Add(time.Hour)},
+			{ID: 2, AccountID: 7, Amount: 5.5, CreatedAt: period.AddDate(0, 0, 3)},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+}
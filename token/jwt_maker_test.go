@@ -14,12 +14,13 @@ func TestJWTMaker(t *testing.T) {
 	require.NoError(t, err)
 
 	username := helpers.RandomOwner()
+	role := "customer"
 	duration := time.Minute
 
 	issuedAt := time.Now()
 	expiredAt := time.Now().Add(duration)
 
-	token,payload,  err := maker.CreateToken(username, duration)
+	token,payload,  err := maker.CreateToken(username, role, duration)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
@@ -30,6 +31,7 @@ func TestJWTMaker(t *testing.T) {
 
 	require.NotZero(t, payload.ID)
 	require.Equal(t, username, payload.UserName)
+	require.Equal(t, role, payload.Role)
 	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
 	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
 
@@ -39,7 +41,7 @@ func TestExpiredJWTToken(t *testing.T) {
 	maker, err := NewJWTMaker(helpers.RandomString(32))
 	require.NoError(t, err)
 
-	token, payload, err := maker.CreateToken(helpers.RandomOwner(), -time.Minute)
+	token, payload, err := maker.CreateToken(helpers.RandomOwner(), "customer", -time.Minute)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
@@ -50,7 +52,7 @@ func TestExpiredJWTToken(t *testing.T) {
 }
 
 func TestInvalidJWTTokenAlgNone(t *testing.T) {
-	payload, err := NewPayload(helpers.RandomOwner(), time.Minute)
+	payload, err := NewPayload(helpers.RandomOwner(), "customer", time.Minute)
 	require.NoError(t, err)
 
 	jwtToken := jwt.NewWithClaims(jwt.SigningMethodNone, payload) // only use for testing
@@ -2,13 +2,24 @@ package val
 
 import (
 	"fmt"
+	"net/http"
 	"net/mail"
 	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
 )
 
 var (
 	isValidUsername = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
 	isValidFullName = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidPhone    = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`).MatchString
+	isValidCode     = regexp.MustCompile(`^[0-9]{6}$`).MatchString
+	isValidTag      = regexp.MustCompile(`^[a-z0-9_-]+$`).MatchString
+	isValidLocale   = regexp.MustCompile(`^[a-zA-Z]{2,3}([_-][a-zA-Z0-9]{2,8})*$`).MatchString
 )
 
 func ValidateString(value string, minLength, maxLength int) error {
@@ -71,3 +82,385 @@ func ValidateEmailId(value int64) error {
 func ValidateSecretCode(value string) error {
 	return ValidateString(value, 32, 128)
 }
+
+func ValidateAccountID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+
+	return nil
+}
+
+// ValidateID checks the id of any other record
+func ValidateID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+
+	return nil
+}
+
+// ValidateAccountNumber checks the format and check digits so that a typo never reaches the database
+func ValidateAccountNumber(value string) error {
+	if !helpers.IsValidAccountNumber(value) {
+		return fmt.Errorf("must be %s followed by 14 digits with matching check digits", helpers.AccountNumberPrefix)
+	}
+
+	return nil
+}
+
+// ValidateInvitedRole accepts the holder roles that can be granted by invitation, every account has exactly one owner
+func ValidateInvitedRole(value string) error {
+	switch value {
+	case models.HolderRoleCoOwner, models.HolderRoleViewer, models.HolderRoleAuthorizedSigner:
+		return nil
+	}
+
+	return fmt.Errorf("must be one of %s, %s or %s", models.HolderRoleCoOwner, models.HolderRoleViewer, models.HolderRoleAuthorizedSigner)
+}
+
+func ValidateNickname(value string) error {
+	return ValidateString(value, 1, 50)
+}
+
+func ValidateCurrency(value string) error {
+	if !helpers.ISSupportedCurrency(value) {
+		return fmt.Errorf("unsupported currency")
+	}
+
+	return nil
+}
+
+func ValidatePhone(value string) error {
+	if !isValidPhone(value) {
+		return fmt.Errorf("must be in international format, e.g. +15551234567")
+	}
+
+	return nil
+}
+
+// ValidateAliasCode accepts the six digit code texted to a phone alias
+func ValidateAliasCode(value string) error {
+	if !isValidCode(value) {
+		return fmt.Errorf("must be 6 digits")
+	}
+
+	return nil
+}
+
+// ValidatePendingTransferStatus accepts a pending transfer status, empty matches every status
+func ValidatePendingTransferStatus(value string) error {
+	switch value {
+	case "", models.PendingTransferPendingApproval, models.PendingTransferApproved, models.PendingTransferRejected,
+		models.PendingTransferExpired:
+		return nil
+	}
+
+	return fmt.Errorf("must be one of %s, %s, %s or %s", models.PendingTransferPendingApproval, models.PendingTransferApproved,
+		models.PendingTransferRejected, models.PendingTransferExpired)
+}
+
+func ValidatePageID(value int32) error {
+	if value < 1 {
+		return fmt.Errorf("must be a positive integer")
+	}
+
+	return nil
+}
+
+func ValidatePageSize(value int32) error {
+	if value < 5 || value > 10 {
+		return fmt.Errorf("must be between 5 and 10")
+	}
+
+	return nil
+}
+
+func ValidateComment(value string) error {
+	return ValidateString(value, 0, 500)
+}
+
+// ValidateBusinessDate accepts a calendar date such as 2024-05-31
+func ValidateBusinessDate(value string) error {
+	if _, err := time.Parse(time.DateOnly, value); err != nil {
+		return fmt.Errorf("must be a date in the YYYY-MM-DD format")
+	}
+
+	return nil
+}
+
+func ValidateCategory(value string) error {
+	for _, category := range models.Categories {
+		if value == category {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown category")
+}
+
+func ValidateCategoryRuleKind(value string) error {
+	if value != models.CategoryRuleKeyword && value != models.CategoryRuleMerchant {
+		return fmt.Errorf("must be %s or %s", models.CategoryRuleKeyword, models.CategoryRuleMerchant)
+	}
+
+	return nil
+}
+
+func ValidateCategoryPattern(value string) error {
+	return ValidateString(value, 3, 50)
+}
+
+func ValidateAlertKind(value string) error {
+	if value != models.AlertKindLowBalance && value != models.AlertKindLargeTransaction {
+		return fmt.Errorf("must be %s or %s", models.AlertKindLowBalance, models.AlertKindLargeTransaction)
+	}
+
+	return nil
+}
+
+func ValidateAlertChannel(value string) error {
+	if value != models.AlertChannelEmail {
+		return fmt.Errorf("must be %s", models.AlertChannelEmail)
+	}
+
+	return nil
+}
+
+// ValidateOverdraftRequestStatus accepts an overdraft request status, empty matches every status
+func ValidateOverdraftRequestStatus(value string) error {
+	switch value {
+	case "", models.OverdraftRequestPendingApproval, models.OverdraftRequestApproved, models.OverdraftRequestRejected:
+		return nil
+	}
+
+	return fmt.Errorf("must be one of %s, %s or %s", models.OverdraftRequestPendingApproval, models.OverdraftRequestApproved,
+		models.OverdraftRequestRejected)
+}
+
+// ValidateOverdraftLimit accepts a limit in whole cents, 0 removes the overdraft
+func ValidateOverdraftLimit(value float64) error {
+	if value < 0 || value > 100000 {
+		return fmt.Errorf("must be between 0 and 100000")
+	}
+
+	if value != helpers.RoundMoney(value) {
+		return fmt.Errorf("must not have more than two decimal places")
+	}
+
+	return nil
+}
+
+func ValidateLoanMethod(value string) error {
+	if value != models.LoanMethodAnnuity && value != models.LoanMethodFlat {
+		return fmt.Errorf("must be %s or %s", models.LoanMethodAnnuity, models.LoanMethodFlat)
+	}
+
+	return nil
+}
+
+// ValidateLoanPrincipal accepts a positive amount in whole cents
+func ValidateLoanPrincipal(value float64) error {
+	if value <= 0 || value > 1000000 {
+		return fmt.Errorf("must be greater than 0 and at most 1000000")
+	}
+
+	if value != helpers.RoundMoney(value) {
+		return fmt.Errorf("must not have more than two decimal places")
+	}
+
+	return nil
+}
+
+// ValidateLoanInterestRate accepts an annual rate as a fraction, 0.08 is 8%
+func ValidateLoanInterestRate(value float64) error {
+	if value < 0 || value > 1 {
+		return fmt.Errorf("must be between 0 and 1")
+	}
+
+	return nil
+}
+
+func ValidateLoanTerm(value int32) error {
+	if value < 1 || value > 360 {
+		return fmt.Errorf("must be between 1 and 360 months")
+	}
+
+	return nil
+}
+
+func ValidatePocketName(value string) error {
+	return ValidateString(value, 1, 50)
+}
+
+// ValidatePocketAmount accepts an amount in whole cents, it is also used for targets where 0 means none
+func ValidatePocketAmount(value float64) error {
+	if value < 0 || value > 1000000 {
+		return fmt.Errorf("must be between 0 and 1000000")
+	}
+
+	if value != helpers.RoundMoney(value) {
+		return fmt.Errorf("must not have more than two decimal places")
+	}
+
+	return nil
+}
+
+// ValidatePocketTargetDate accepts a calendar date such as 2024-05-31 that has not passed yet
+func ValidatePocketTargetDate(value string) error {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return fmt.Errorf("must be a date in the YYYY-MM-DD format")
+	}
+
+	if date.Before(time.Now().UTC().Truncate(24 * time.Hour)) {
+		return fmt.Errorf("must not be in the past")
+	}
+
+	return nil
+}
+
+func ValidatePocketRuleKind(value string) error {
+	if value != models.PocketRulePercentOfIncoming {
+		return fmt.Errorf("must be %s", models.PocketRulePercentOfIncoming)
+	}
+
+	return nil
+}
+
+func ValidatePocketRulePercent(value float64) error {
+	if value <= 0 || value > 100 {
+		return fmt.Errorf("must be greater than 0 and at most 100")
+	}
+
+	return nil
+}
+
+func ValidateTransactionNote(value string) error {
+	return ValidateString(value, 0, 500)
+}
+
+func ValidateTransactionTags(values []string) error {
+	if len(values) > models.MaxTransactionTags {
+		return fmt.Errorf("must not have more than %d tags", models.MaxTransactionTags)
+	}
+
+	for _, value := range values {
+		if err := ValidateTransactionTag(value); err != nil {
+			return fmt.Errorf("tag %q %w", value, err)
+		}
+	}
+
+	return nil
+}
+
+func ValidateTransactionTag(value string) error {
+	if err := ValidateString(value, 1, 32); err != nil {
+		return err
+	}
+
+	if !isValidTag(value) {
+		return fmt.Errorf("must contain lowercase letters, numbers, dash or underscore")
+	}
+
+	return nil
+}
+
+// ValidateAttachmentFileName accepts a plain file name, without any directories
+func ValidateAttachmentFileName(value string) error {
+	if err := ValidateString(value, 1, 255); err != nil {
+		return err
+	}
+
+	if strings.ContainsAny(value, `/\`) {
+		return fmt.Errorf("must not contain a path")
+	}
+
+	return nil
+}
+
+// ValidateAttachment checks the declared content type against the receipt formats and what the content
+// actually looks like
+func ValidateAttachment(contentType string, content []byte) error {
+	if !slices.Contains(models.AttachmentContentTypes, contentType) {
+		return fmt.Errorf("must be one of %s", strings.Join(models.AttachmentContentTypes, ", "))
+	}
+
+	if len(content) == 0 || len(content) > models.MaxAttachmentSize {
+		return fmt.Errorf("must contain from 1 - %d bytes", models.MaxAttachmentSize)
+	}
+
+	if detected := http.DetectContentType(content); detected != contentType {
+		return fmt.Errorf("content looks like %s, not %s", detected, contentType)
+	}
+
+	return nil
+}
+
+func ValidateDisputeReason(value string) error {
+	return ValidateString(value, 1, 500)
+}
+
+func ValidateDisputeEvidence(value string) error {
+	return ValidateString(value, 0, 2000)
+}
+
+// ValidateDisputeAmount accepts an amount in whole cents, 0 disputes the whole transfer
+func ValidateDisputeAmount(value float64) error {
+	if value < 0 {
+		return fmt.Errorf("must not be negative")
+	}
+
+	if value != helpers.RoundMoney(value) {
+		return fmt.Errorf("must not have more than two decimal places")
+	}
+
+	return nil
+}
+
+// ValidateDisputeStatus accepts any dispute status, or none to list all of them
+func ValidateDisputeStatus(value string) error {
+	switch value {
+	case "", models.DisputeStatusOpen, models.DisputeStatusInvestigating, models.DisputeStatusRefundProposed,
+		models.DisputeStatusResolvedCustomer, models.DisputeStatusRejected:
+		return nil
+	}
+
+	return fmt.Errorf("must be one of %s, %s, %s, %s or %s", models.DisputeStatusOpen, models.DisputeStatusInvestigating,
+		models.DisputeStatusRefundProposed, models.DisputeStatusResolvedCustomer, models.DisputeStatusRejected)
+}
+
+// ValidateDisputeTransition accepts the statuses an agent can move a dispute to
+func ValidateDisputeTransition(value string) error {
+	switch value {
+	case models.DisputeStatusInvestigating, models.DisputeStatusRefundProposed, models.DisputeStatusResolvedCustomer,
+		models.DisputeStatusRejected:
+		return nil
+	}
+
+	return fmt.Errorf("must be one of %s, %s, %s or %s", models.DisputeStatusInvestigating, models.DisputeStatusRefundProposed,
+		models.DisputeStatusResolvedCustomer, models.DisputeStatusRejected)
+}
+
+// ValidateGLExportFormat accepts a journal file format, or none for the configured default
+func ValidateGLExportFormat(value string) error {
+	switch value {
+	case "", models.GLFormatCSV, models.GLFormatFixedWidth:
+		return nil
+	}
+
+	return fmt.Errorf("must be %s or %s", models.GLFormatCSV, models.GLFormatFixedWidth)
+}
+
+// ValidateLocale accepts a language tag such as en or fr-CA
+func ValidateLocale(value string) error {
+	if err := ValidateString(value, 2, 35); err != nil {
+		return err
+	}
+
+	if !isValidLocale(value) {
+		return fmt.Errorf("must be a language tag, e.g. en or fr-CA")
+	}
+
+	return nil
+}
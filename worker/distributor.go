@@ -12,6 +12,26 @@ type TaskDistributor interface {
 		payload *PayloadSendVerifyEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskSendAlert(
+		ctx context.Context,
+		payload *PayloadSendAlert,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendDisputeUpdate(
+		ctx context.Context,
+		payload *PayloadSendDisputeUpdate,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendTransferNotice(
+		ctx context.Context,
+		payload *PayloadSendTransferNotice,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendAliasCode(
+		ctx context.Context,
+		payload *PayloadSendAliasCode,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
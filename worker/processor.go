@@ -5,8 +5,11 @@ import (
 
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
+	"github.com/zde37/Swift_Bank/glexport"
 	"github.com/zde37/Swift_Bank/mail"
+	"github.com/zde37/Swift_Bank/mail/templates"
 	"github.com/zde37/Swift_Bank/repository"
+	"github.com/zde37/Swift_Bank/sms"
 )
 
 const (
@@ -17,15 +20,33 @@ const (
 type TaskProcessor interface {
 	Start() error
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendTransferNotice(ctx context.Context, task *asynq.Task) error
+	ProcessTaskReleaseExpiredHolds(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExpirePendingTransfers(ctx context.Context, task *asynq.Task) error
+	ProcessTaskAccrueInterest(ctx context.Context, task *asynq.Task) error
+	ProcessTaskPayInterest(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSnapshotBalances(ctx context.Context, task *asynq.Task) error
+	ProcessTaskCloseBusinessDay(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendStatements(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendAlert(ctx context.Context, task *asynq.Task) error
+	ProcessTaskChargeOverdrafts(ctx context.Context, task *asynq.Task) error
+	ProcessTaskCollectLoanRepayments(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendDisputeUpdate(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExportGeneralLedger(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendAliasCode(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
-	server *asynq.Server
-	repo   repository.RepositoryProvider
-	mailer mail.EmailSender
+	server    *asynq.Server
+	repo      repository.RepositoryProvider
+	mailer    mail.EmailSender
+	texter    sms.Sender
+	templates *templates.Renderer
+	gl        *glexport.Exporter
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, repo repository.RepositoryProvider, mailer mail.EmailSender) TaskProcessor {
+func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, repo repository.RepositoryProvider, mailer mail.EmailSender,
+	texter sms.Sender, templates *templates.Renderer, gl *glexport.Exporter) TaskProcessor {
 	server := asynq.NewServer(
 		redisOpt,
 		asynq.Config{
@@ -41,9 +62,12 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, repo repository.Reposi
 	)
 
 	return &RedisTaskProcessor{
-		server: server,
-		repo:   repo,
-		mailer: mailer,
+		server:    server,
+		repo:      repo,
+		mailer:    mailer,
+		texter:    texter,
+		templates: templates,
+		gl:        gl,
 	}
 }
 
@@ -51,6 +75,20 @@ func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskSendTransferNotice, processor.ProcessTaskSendTransferNotice)
+	mux.HandleFunc(TaskReleaseExpiredHolds, processor.ProcessTaskReleaseExpiredHolds)
+	mux.HandleFunc(TaskExpirePendingTransfers, processor.ProcessTaskExpirePendingTransfers)
+	mux.HandleFunc(TaskAccrueInterest, processor.ProcessTaskAccrueInterest)
+	mux.HandleFunc(TaskPayInterest, processor.ProcessTaskPayInterest)
+	mux.HandleFunc(TaskSnapshotBalances, processor.ProcessTaskSnapshotBalances)
+	mux.HandleFunc(TaskCloseBusinessDay, processor.ProcessTaskCloseBusinessDay)
+	mux.HandleFunc(TaskSendStatements, processor.ProcessTaskSendStatements)
+	mux.HandleFunc(TaskSendAlert, processor.ProcessTaskSendAlert)
+	mux.HandleFunc(TaskChargeOverdrafts, processor.ProcessTaskChargeOverdrafts)
+	mux.HandleFunc(TaskCollectLoanRepayments, processor.ProcessTaskCollectLoanRepayments)
+	mux.HandleFunc(TaskSendDisputeUpdate, processor.ProcessTaskSendDisputeUpdate)
+	mux.HandleFunc(TaskExportGeneralLedger, processor.ProcessTaskExportGeneralLedger)
+	mux.HandleFunc(TaskSendAliasCode, processor.ProcessTaskSendAliasCode)
 
 	return processor.server.Start(mux)
 }
@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+// cron specs for the periodic tasks
+const (
+	releaseExpiredHoldsSchedule    = "@every 1m"
+	expirePendingTransfersSchedule = "@every 5m"
+	accrueInterestSchedule         = "CRON_TZ=UTC 0 1 * * *"  // daily, for the previous day
+	payInterestSchedule            = "CRON_TZ=UTC 0 3 1 * *"  // monthly, after the last accrual of the month
+	snapshotBalancesSchedule       = "CRON_TZ=UTC 30 0 * * *" // daily, for the previous day
+	closeBusinessDaySchedule       = "CRON_TZ=UTC 15 0 * * *" // daily, for the previous day
+	sendStatementsSchedule         = "CRON_TZ=UTC 0 6 1 * *"  // monthly, for the previous month
+	chargeOverdraftsSchedule       = "CRON_TZ=UTC 45 0 * * *" // daily, interest for the previous day
+	collectLoanRepaymentsSchedule  = "CRON_TZ=UTC 0 5 * * *"  // daily, installments due today
+	exportGeneralLedgerSchedule    = "CRON_TZ=UTC 30 1 * * *" // daily, after the business day is closed
+)
+
+type scheduledTask struct {
+	spec     string
+	taskType string
+}
+
+var scheduledTasks = []scheduledTask{
+	{releaseExpiredHoldsSchedule, TaskReleaseExpiredHolds},
+	{expirePendingTransfersSchedule, TaskExpirePendingTransfers},
+	{accrueInterestSchedule, TaskAccrueInterest},
+	{payInterestSchedule, TaskPayInterest},
+	{snapshotBalancesSchedule, TaskSnapshotBalances},
+	{closeBusinessDaySchedule, TaskCloseBusinessDay},
+	{sendStatementsSchedule, TaskSendStatements},
+	{chargeOverdraftsSchedule, TaskChargeOverdrafts},
+	{collectLoanRepaymentsSchedule, TaskCollectLoanRepayments},
+	{exportGeneralLedgerSchedule, TaskExportGeneralLedger},
+}
+
+type TaskScheduler interface {
+	Start() error
+}
+
+type RedisTaskScheduler struct {
+	scheduler *asynq.Scheduler
+}
+
+func NewRedisTaskScheduler(redisOpt asynq.RedisClientOpt) TaskScheduler {
+	scheduler := asynq.NewScheduler(
+		redisOpt,
+		&asynq.SchedulerOpts{
+			PostEnqueueFunc: func(info *asynq.TaskInfo, err error) {
+				if err != nil {
+					log.Error().Err(err).Msg("failed to enqueue scheduled task")
+				}
+			},
+			Logger: NewLogger(),
+		},
+	)
+
+	return &RedisTaskScheduler{
+		scheduler: scheduler,
+	}
+}
+
+func (s *RedisTaskScheduler) Start() error {
+	for _, t := range scheduledTasks {
+		if _, err := s.scheduler.Register(t.spec, asynq.NewTask(t.taskType, nil), asynq.Queue(QueueDefault)); err != nil {
+			return fmt.Errorf("failed to register %s: %w", t.taskType, err)
+		}
+	}
+
+	return s.scheduler.Start()
+}
@@ -0,0 +1,69 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const TaskAccrueInterest = "task:accrue_interest"
+
+// interestBatchSize is how many accounts are read per query while accruing interest
+const interestBatchSize = 500
+
+// ProcessTaskAccrueInterest accrues one day of interest on every interest bearing account for the previous
+// day. Accruals are unique per account and day, so a retried task only fills in the accounts it missed.
+func (processor *RedisTaskProcessor) ProcessTaskAccrueInterest(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+
+	var accrued int
+	var afterID int64
+	for {
+		accounts, err := processor.repo.ListInterestBearingAccounts(ctx, day, afterID, interestBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list interest bearing accounts: %w", err)
+		}
+
+		for _, account := range accounts {
+			amount, err := helpers.DailyInterest(account.DayCountConvention, account.Balance, account.InterestRate, day)
+			if err != nil {
+				return fmt.Errorf("failed to compute interest for account %d: %w", account.AccountID, err)
+			}
+
+			created, err := processor.repo.CreateInterestAccrual(ctx, models.InterestAccrual{
+				AccountID:          account.AccountID,
+				AccrualDate:        day,
+				Balance:            account.Balance,
+				InterestRate:       account.InterestRate,
+				DayCountConvention: account.DayCountConvention,
+				Amount:             amount,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to accrue interest for account %d: %w", account.AccountID, err)
+			}
+			if created {
+				accrued++
+			}
+
+			afterID = account.AccountID
+		}
+
+		if len(accounts) < interestBatchSize {
+			break
+		}
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Time("day", day).
+		Int("accrued", accrued).
+		Msg("processed task")
+
+	return nil
+}
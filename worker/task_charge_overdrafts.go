@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const TaskChargeOverdrafts = "task:charge_overdrafts"
+
+// ProcessTaskChargeOverdrafts charges the fee for every time an account went overdrawn that hasn't been charged
+// yet, then one day of interest on every account that closed the previous day with a negative balance. Charges are unique per event
+// and per account and day, so a retried task only posts the ones it missed.
+func (processor *RedisTaskProcessor) ProcessTaskChargeOverdrafts(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+
+	overdrafts, err := processor.repo.ListUnchargedOverdrafts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list uncharged overdrafts: %w", err)
+	}
+
+	var fees int
+	for _, overdraft := range overdrafts {
+		eventID := overdraft.Event.ID
+		charged, err := processor.repo.ChargeOverdraftTx(ctx, models.OverdraftCharge{
+			AccountID:  overdraft.Event.AccountID,
+			Kind:       models.OverdraftChargeFee,
+			ChargeDate: overdraft.Event.CreatedAt,
+			Balance:    overdraft.Event.Balance,
+			Amount:     overdraft.Fee,
+			EventID:    &eventID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to charge overdraft fee for account %d: %w", overdraft.Event.AccountID, err)
+		}
+		if charged {
+			fees++
+		}
+	}
+
+	var interest int
+	var afterID int64
+	for {
+		accounts, err := processor.repo.ListOverdrawnAccounts(ctx, day, afterID, interestBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list overdrawn accounts: %w", err)
+		}
+
+		for _, account := range accounts {
+			amount, err := helpers.DailyInterest(helpers.DayCountActual365, -account.Balance, account.InterestRate, day)
+			if err != nil {
+				return fmt.Errorf("failed to compute overdraft interest for account %d: %w", account.AccountID, err)
+			}
+
+			charged, err := processor.repo.ChargeOverdraftTx(ctx, models.OverdraftCharge{
+				AccountID:  account.AccountID,
+				Kind:       models.OverdraftChargeInterest,
+				ChargeDate: day,
+				Balance:    account.Balance,
+				Amount:     helpers.RoundMoney(amount),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to charge overdraft interest for account %d: %w", account.AccountID, err)
+			}
+			if charged {
+				interest++
+			}
+
+			afterID = account.AccountID
+		}
+
+		if len(accounts) < interestBatchSize {
+			break
+		}
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Time("day", day).
+		Int("fees", fees).
+		Int("interest", interest).
+		Msg("processed task")
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskCloseBusinessDay = "task:close_business_day"
+
+// ProcessTaskCloseBusinessDay closes every UTC day since the last closed one up to yesterday, storing a trial
+// balance for each. Days are closed in order and the task stops at the first day that doesn't balance or
+// whose account balances don't match their entries.
+func (processor *RedisTaskProcessor) ProcessTaskCloseBusinessDay(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	yesterday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+
+	lastClosed, err := processor.repo.GetLastClosedBusinessDate(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get last closed business date: %w", err)
+	}
+
+	day := yesterday
+	if lastClosed != nil {
+		day = lastClosed.UTC().AddDate(0, 0, 1)
+	}
+
+	for ; !day.After(yesterday); day = day.AddDate(0, 0, 1) {
+		trialBalances, err := processor.repo.CloseBusinessDayTx(ctx, day)
+		if err != nil {
+			return fmt.Errorf("failed to close business day %s: %w", day.Format(time.DateOnly), err)
+		}
+
+		log.Info().
+			Str("type", task.Type()).
+			Time("day", day).
+			Int("currencies", len(trialBalances)).
+			Msg("processed task")
+	}
+
+	return nil
+}
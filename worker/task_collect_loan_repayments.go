@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskCollectLoanRepayments = "task:collect_loan_repayments"
+
+// ProcessTaskCollectLoanRepayments debits every loan installment that is due today or overdue from its loan's
+// account. An installment the account can't cover is marked overdue and tried again on the next run, the
+// loan's later installments are left alone until it is paid so that they are always collected in order. Each
+// installment is collected in its own transaction, so a retried task skips the ones already paid.
+func (processor *RedisTaskProcessor) ProcessTaskCollectLoanRepayments(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	installments, err := processor.repo.ListDueLoanInstallments(ctx, day)
+	if err != nil {
+		return fmt.Errorf("failed to list due loan installments: %w", err)
+	}
+
+	var paid, missed, held int
+	blocked := map[int64]bool{}
+	for _, installment := range installments {
+		if blocked[installment.LoanID] {
+			held++
+			continue
+		}
+
+		result, err := processor.repo.RepayLoanInstallmentTx(ctx, installment.ID, day)
+		if err != nil {
+			return fmt.Errorf("failed to collect loan installment %d: %w", installment.ID, err)
+		}
+
+		if result.Paid {
+			paid++
+			continue
+		}
+
+		missed++
+		blocked[installment.LoanID] = true
+		log.Info().
+			Str("type", task.Type()).
+			Int64("loan_id", result.Loan.ID).
+			Int32("installment", result.Installment.Number).
+			Str("loan_status", result.Loan.Status).
+			Msg("loan installment not collected")
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Time("day", day).
+		Int("paid", paid).
+		Int("missed", missed).
+		Int("held", held).
+		Msg("processed task")
+
+	return nil
+}
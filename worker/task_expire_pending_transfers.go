@@ -0,0 +1,25 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskExpirePendingTransfers = "task:expire_pending_transfers"
+
+func (processor *RedisTaskProcessor) ProcessTaskExpirePendingTransfers(ctx context.Context, task *asynq.Task) error {
+	expired, err := processor.repo.ExpirePendingTransfers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to expire pending transfers: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Int64("expired", expired).
+		Msg("processed task")
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskExportGeneralLedger = "task:export_general_ledger"
+
+// ProcessTaskExportGeneralLedger writes a journal file for every business day closed since the last daily
+// export. Exports are idempotent, a retry skips the days that were already exported.
+func (processor *RedisTaskProcessor) ProcessTaskExportGeneralLedger(ctx context.Context, task *asynq.Task) error {
+	exports, err := processor.gl.ExportClosedDays(ctx)
+	for _, export := range exports {
+		log.Info().
+			Str("type", task.Type()).
+			Str("run_id", export.RunID).
+			Time("day", export.StartDate).
+			Int64("lines", export.LineCount).
+			Msg("exported general ledger")
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to export general ledger: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Int("days", len(exports)).
+		Msg("processed task")
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const TaskPayInterest = "task:pay_interest"
+
+// ProcessTaskPayInterest pays out all interest accrued before the start of the current month. Each account is
+// paid in its own transaction, a failure stops the run and the retry picks up the accounts that are still unpaid.
+func (processor *RedisTaskProcessor) ProcessTaskPayInterest(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	description := fmt.Sprintf("interest %s", monthStart.AddDate(0, -1, 0).Format("January 2006"))
+
+	accountIDs, err := processor.repo.ListAccountsWithUnpaidInterest(ctx, monthStart)
+	if err != nil {
+		return fmt.Errorf("failed to list accounts with unpaid interest: %w", err)
+	}
+
+	var paid int
+	for _, accountID := range accountIDs {
+		result, err := processor.repo.PayInterestTx(ctx, models.PayInterestTxParams{
+			AccountID:   accountID,
+			Before:      monthStart,
+			Description: description,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to pay interest for account %d: %w", accountID, err)
+		}
+		if result.Transfer != nil {
+			paid++
+		}
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Int("accounts", len(accountIDs)).
+		Int("paid", paid).
+		Msg("processed task")
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskReleaseExpiredHolds = "task:release_expired_holds"
+
+func (processor *RedisTaskProcessor) ProcessTaskReleaseExpiredHolds(ctx context.Context, task *asynq.Task) error {
+	released, err := processor.repo.ReleaseExpiredHolds(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release expired holds: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Int64("released", released).
+		Msg("processed task")
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/zde37/Swift_Bank/mail/templates"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const TaskSendAlert = "task:send_alert"
+
+type PayloadSendAlert struct {
+	RuleID        int64   `json:"rule_id"`
+	Username      string  `json:"username"`
+	AccountID     int64   `json:"account_id"`
+	AccountNumber string  `json:"account_number"`
+	Kind          string  `json:"kind"`
+	Channel       string  `json:"channel"`
+	Threshold     float64 `json:"threshold"`
+	TransactionID int64   `json:"transaction_id"`
+	Amount        float64 `json:"amount"`
+	Currency      string  `json:"currency"`
+	Incoming      bool    `json:"incoming"`
+	Balance       float64 `json:"balance"`
+}
+
+// AlertTaskID identifies the notification for one rule and transaction, enqueueing it again is rejected
+// with asynq.ErrTaskIDConflict while the first one is still around. Alerts sent without a rule are told
+// apart by their kind and account instead.
+func AlertTaskID(alert models.TriggeredAlert) string {
+	if alert.Rule.ID == 0 {
+		return fmt.Sprintf("alert:%s:%d:%d", alert.Rule.Kind, alert.Rule.AccountID, alert.TransactionID)
+	}
+	return fmt.Sprintf("alert:%d:%d", alert.Rule.ID, alert.TransactionID)
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendAlert(
+	ctx context.Context,
+	payload *PayloadSendAlert,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendAlert, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskSendAlert notifies the rule's owner through the rule's channel. Users without a verified email
+// are skipped rather than retried.
+func (processor *RedisTaskProcessor) ProcessTaskSendAlert(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendAlert
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	if payload.Channel != models.AlertChannelEmail {
+		return fmt.Errorf("unsupported alert channel %q: %w", payload.Channel, asynq.SkipRetry)
+	}
+
+	user, err := processor.repo.GetUser(ctx, payload.Username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !user.IsEmailVerified {
+		log.Info().
+			Str("type", task.Type()).
+			Str("username", user.UserName).
+			Msg("skipped alert, email not verified")
+		return nil
+	}
+
+	email, err := processor.templates.Render(templates.Alert, user.Locale, templates.AlertData{
+		FullName:      user.FullName,
+		Kind:          payload.Kind,
+		AccountNumber: payload.AccountNumber,
+		TransactionID: payload.TransactionID,
+		Amount:        payload.Amount,
+		Balance:       payload.Balance,
+		Threshold:     payload.Threshold,
+		Currency:      payload.Currency,
+		Incoming:      payload.Incoming,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render alert email: %w", err)
+	}
+
+	if err = processor.mailer.SendEmailWithText(email.Subject, email.HTML, email.Text, []string{user.Email}, nil, nil, nil); err != nil {
+		return fmt.Errorf("failed to send alert email: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("email", user.Email).
+		Msg("processed task")
+
+	return nil
+}
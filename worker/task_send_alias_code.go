@@ -0,0 +1,104 @@
+package worker
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const TaskSendAliasCode = "task:send_alias_code"
+
+// aliasCodeDigits is the length of the code texted to a phone alias
+const aliasCodeDigits = 6
+
+type PayloadSendAliasCode struct {
+	Alias    string `json:"alias"`
+	Username string `json:"username"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendAliasCode(
+	ctx context.Context,
+	payload *PayloadSendAliasCode,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendAliasCode, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskSendAliasCode texts a new code to an unverified phone alias. Only the hash of the code is kept,
+// so the code never sits in the queue or the database. An alias that was verified or claimed by someone
+// else in the meantime is skipped.
+func (processor *RedisTaskProcessor) ProcessTaskSendAliasCode(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendAliasCode
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	code, err := newAliasCode()
+	if err != nil {
+		return fmt.Errorf("failed to generate alias code: %w", err)
+	}
+
+	codeHash, err := helpers.HashPassword(code)
+	if err != nil {
+		return fmt.Errorf("failed to hash alias code: %w", err)
+	}
+
+	err = processor.repo.SetPaymentAliasCode(ctx, payload.Alias, payload.Username, codeHash, time.Now().Add(models.AliasCodeTTL))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("alias %s is not waiting for verification by %s: %w", payload.Alias, payload.Username, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to store alias code: %w", err)
+	}
+
+	body := fmt.Sprintf("Your Swift Bank verification code is %s. It expires in %d minutes.", code, int(models.AliasCodeTTL.Minutes()))
+	if err = processor.texter.SendSMS(payload.Alias, body); err != nil {
+		return fmt.Errorf("failed to send alias code: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Str("alias", payload.Alias).
+		Msg("processed task")
+
+	return nil
+}
+
+// newAliasCode draws the code from crypto/rand, anyone who could predict it could claim someone else's number
+func newAliasCode() (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(aliasCodeDigits), nil)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", aliasCodeDigits, n.Int64()), nil
+}
@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/zde37/Swift_Bank/mail/templates"
+)
+
+const TaskSendDisputeUpdate = "task:send_dispute_update"
+
+type PayloadSendDisputeUpdate struct {
+	DisputeID     int64   `json:"dispute_id"`
+	Username      string  `json:"username"`
+	Status        string  `json:"status"`
+	TransactionID int64   `json:"transaction_id"`
+	Amount        float64 `json:"amount"`
+	Currency      string  `json:"currency"`
+	Comment       string  `json:"comment"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendDisputeUpdate(
+	ctx context.Context,
+	payload *PayloadSendDisputeUpdate,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendDisputeUpdate, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskSendDisputeUpdate tells one of the parties to a dispute that it moved to a new status. Users
+// without a verified email are skipped rather than retried.
+func (processor *RedisTaskProcessor) ProcessTaskSendDisputeUpdate(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendDisputeUpdate
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	user, err := processor.repo.GetUser(ctx, payload.Username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !user.IsEmailVerified {
+		log.Info().
+			Str("type", task.Type()).
+			Str("username", user.UserName).
+			Msg("skipped dispute update, email not verified")
+		return nil
+	}
+
+	email, err := processor.templates.Render(templates.DisputeUpdate, user.Locale, templates.DisputeUpdateData{
+		FullName:      user.FullName,
+		DisputeID:     payload.DisputeID,
+		TransactionID: payload.TransactionID,
+		Status:        payload.Status,
+		Amount:        payload.Amount,
+		Currency:      payload.Currency,
+		Comment:       payload.Comment,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render dispute update email: %w", err)
+	}
+
+	if err = processor.mailer.SendEmailWithText(email.Subject, email.HTML, email.Text, []string{user.Email}, nil, nil, nil); err != nil {
+		return fmt.Errorf("failed to send dispute update email: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("email", user.Email).
+		Msg("processed task")
+
+	return nil
+}
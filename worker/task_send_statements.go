@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/zde37/Swift_Bank/mail/templates"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/statement"
+)
+
+const TaskSendStatements = "task:send_statements"
+
+// ProcessTaskSendStatements emails last month's statement as a pdf to every active account holder with a
+// verified email who hasn't opted out. Each send is recorded, a failure stops the run and the retry only picks up the accounts
+// that haven't been sent their statement yet.
+func (processor *RedisTaskProcessor) ProcessTaskSendStatements(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	period := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0)
+
+	recipients, err := processor.repo.ListStatementRecipients(ctx, period)
+	if err != nil {
+		return fmt.Errorf("failed to list statement recipients: %w", err)
+	}
+
+	for _, recipient := range recipients {
+		if err := processor.sendStatement(ctx, recipient, period); err != nil {
+			return fmt.Errorf("failed to send statement for account %d to %s: %w", recipient.AccountID, recipient.UserName, err)
+		}
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Time("period", period).
+		Int("sent", len(recipients)).
+		Msg("processed task")
+
+	return nil
+}
+
+func (processor *RedisTaskProcessor) sendStatement(ctx context.Context, recipient models.StatementRecipient, period time.Time) error {
+	s, err := processor.repo.GetStatement(ctx, recipient.AccountID, period)
+	if err != nil {
+		return fmt.Errorf("failed to get statement: %w", err)
+	}
+
+	// the mailer attaches files by path
+	file, err := os.CreateTemp("", fmt.Sprintf("statement-%d-%s-*.pdf", recipient.AccountID, period.Format("2006-01")))
+	if err != nil {
+		return fmt.Errorf("failed to create statement file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	err = statement.WritePDF(file, recipient.FullName, s)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write statement: %w", err)
+	}
+
+	email, err := processor.templates.Render(templates.Statement, recipient.Locale, templates.StatementData{
+		FullName:      recipient.FullName,
+		AccountNumber: s.Account.AccountNumber,
+		Period:        period,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render statement email: %w", err)
+	}
+	to := []string{recipient.Email}
+
+	if err = processor.mailer.SendEmailWithText(email.Subject, email.HTML, email.Text, to, nil, nil, []string{file.Name()}); err != nil {
+		return fmt.Errorf("failed to send statement email: %w", err)
+	}
+
+	_, err = processor.repo.CreateStatementDelivery(ctx, models.StatementDelivery{
+		AccountID: recipient.AccountID,
+		UserName:  recipient.UserName,
+		Period:    period,
+		Email:     recipient.Email,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record statement delivery: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+	"github.com/zde37/Swift_Bank/mail/templates"
+	"github.com/zde37/Swift_Bank/models"
+)
+
+const TaskSendTransferNotice = "task:send_transfer_notice"
+
+type PayloadSendTransferNotice struct {
+	Username      string  `json:"username"`
+	Kind          string  `json:"kind"`
+	AccountNumber string  `json:"account_number"`
+	TransactionID int64   `json:"transaction_id"`
+	Amount        float64 `json:"amount"`
+	Fee           float64 `json:"fee"`
+	Currency      string  `json:"currency"`
+	Description   string  `json:"description"`
+	Balance       float64 `json:"balance"`
+}
+
+// TransferNoticeTaskID identifies the notice one user gets for a transaction, enqueueing it again is rejected
+// with asynq.ErrTaskIDConflict while the first one is still around
+func TransferNoticeTaskID(notice models.TransferNotice) string {
+	return fmt.Sprintf("transfer:%d:%s:%s", notice.TransactionID, notice.Kind, notice.Username)
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendTransferNotice(
+	ctx context.Context,
+	payload *PayloadSendTransferNotice,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendTransferNotice, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+
+	return nil
+}
+
+// ProcessTaskSendTransferNotice sends a debit or credit notice for a transfer. Notices are only queued once the
+// transfer committed, a transaction that can't be found is skipped so that nothing is ever said about money
+// that didn't move. Users without a verified email are skipped rather than retried.
+func (processor *RedisTaskProcessor) ProcessTaskSendTransferNotice(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendTransferNotice
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	if _, err := processor.repo.GetTransaction(ctx, payload.TransactionID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("transaction %d doesn't exist: %w", payload.TransactionID, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	user, err := processor.repo.GetUser(ctx, payload.Username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !user.IsEmailVerified {
+		log.Info().
+			Str("type", task.Type()).
+			Str("username", user.UserName).
+			Msg("skipped transfer notice, email not verified")
+		return nil
+	}
+
+	email, err := processor.templates.Render(templates.TransferNotice, user.Locale, templates.TransferNoticeData{
+		FullName:      user.FullName,
+		Kind:          payload.Kind,
+		AccountNumber: payload.AccountNumber,
+		TransactionID: payload.TransactionID,
+		Amount:        payload.Amount,
+		Fee:           payload.Fee,
+		Currency:      payload.Currency,
+		Description:   payload.Description,
+		Balance:       payload.Balance,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render transfer notice email: %w", err)
+	}
+
+	if err = processor.mailer.SendEmailWithText(email.Subject, email.HTML, email.Text, []string{user.Email}, nil, nil, nil); err != nil {
+		return fmt.Errorf("failed to send transfer notice email: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("email", user.Email).
+		Msg("processed task")
+
+	return nil
+}
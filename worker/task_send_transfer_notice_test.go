@@ -0,0 +1,77 @@
+package worker_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/zde37/Swift_Bank/mail"
+	"github.com/zde37/Swift_Bank/mail/templates"
+	mockedproviders "github.com/zde37/Swift_Bank/mock"
+	"github.com/zde37/Swift_Bank/models"
+	"github.com/zde37/Swift_Bank/worker"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProcessTaskSendTransferNotice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mockedproviders.NewMockRepositoryProvider(ctrl)
+	mailer := mail.NewMemorySender()
+	renderer, err := templates.NewRenderer("", "https://bank.example.com", "")
+	require.NoError(t, err)
+
+	processor := worker.NewRedisTaskProcessor(asynq.RedisClientOpt{Addr: "localhost:6379"}, repo, mailer, nil, renderer, nil)
+
+	task := func(payload worker.PayloadSendTransferNotice) *asynq.Task {
+		jsonPayload, err := json.Marshal(payload)
+		require.NoError(t, err)
+		return asynq.NewTask(worker.TaskSendTransferNotice, jsonPayload)
+	}
+	payload := worker.PayloadSendTransferNotice{
+		Username:      "alice",
+		Kind:          models.TransferNoticeCredit,
+		AccountNumber: "1000000000000002",
+		TransactionID: 42,
+		Amount:        75,
+		Currency:      "EUR",
+		Balance:       175,
+	}
+
+	// a transaction that doesn't exist never moved any money
+	repo.EXPECT().GetTransaction(gomock.Any(), int64(7)).Times(1).Return(models.Transaction{}, pgx.ErrNoRows)
+
+	missing := payload
+	missing.TransactionID = 7
+	err = processor.ProcessTaskSendTransferNotice(context.Background(), task(missing))
+	require.ErrorIs(t, err, asynq.SkipRetry)
+
+	repo.EXPECT().GetTransaction(gomock.Any(), int64(42)).Times(2).Return(models.Transaction{ID: 42}, nil)
+	gomock.InOrder(
+		repo.EXPECT().
+			GetUser(gomock.Any(), "alice").
+			Times(1).
+			Return(models.User{UserName: "alice", FullName: "Alice", Email: "alice@example.com", Locale: "en"}, nil),
+		repo.EXPECT().
+			GetUser(gomock.Any(), "alice").
+			Times(1).
+			Return(models.User{UserName: "alice", FullName: "Alice", Email: "alice@example.com", Locale: "en",
+				IsEmailVerified: true}, nil),
+	)
+
+	// users without a verified email are skipped
+	require.NoError(t, processor.ProcessTaskSendTransferNotice(context.Background(), task(payload)))
+	require.Empty(t, mailer.Messages())
+
+	require.NoError(t, processor.ProcessTaskSendTransferNotice(context.Background(), task(payload)))
+
+	messages := mailer.Messages()
+	require.Len(t, messages, 1)
+	require.Equal(t, []string{"alice@example.com"}, messages[0].To)
+	require.Equal(t, "75.00 EUR received in account 1000000000000002", messages[0].Subject)
+	require.Contains(t, messages[0].Text, "arrived in account 1000000000000002 (transaction 42)")
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
 	"github.com/zde37/Swift_Bank/helpers"
+	"github.com/zde37/Swift_Bank/mail/templates"
 	"github.com/zde37/Swift_Bank/models"
 )
 
@@ -67,14 +68,17 @@ func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Cont
 		return fmt.Errorf("failed to create verify email: %w", err)
 	}
 
-	subject := "Welcome to Swift Bank"
-	verifyURL := fmt.Sprintf("http://localhost:8080/sb/api/v1/verify_email?email_id=%d&secret_code=%s", verifyEmail.ID, verifyEmail.SecretCode)
-	content := fmt.Sprintf(`Hello %s,<br/>
-	Thank you for registering with us!<br/>
-	Please <a href="%s">Click here</a> to verify your email address.<br/>`, user.FullName, verifyURL)
+	email, err := processor.templates.Render(templates.VerifyEmail, user.Locale, templates.VerifyEmailData{
+		FullName:   user.FullName,
+		EmailID:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render verify email: %w", err)
+	}
 	to := []string{user.Email}
 
-	if err = processor.mailer.SendEmail(subject, content, to, nil, nil, nil); err != nil {
+	if err = processor.mailer.SendEmailWithText(email.Subject, email.HTML, email.Text, to, nil, nil, nil); err != nil {
 		return fmt.Errorf("failed to send verify email: %w", err)
 	}
 
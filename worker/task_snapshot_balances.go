@@ -0,0 +1,32 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSnapshotBalances = "task:snapshot_balances"
+
+// ProcessTaskSnapshotBalances records every account's balance at the end of the previous UTC day, so
+// point in time balances only replay the entries since the day before
+func (processor *RedisTaskProcessor) ProcessTaskSnapshotBalances(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+
+	created, err := processor.repo.CreateBalanceSnapshots(ctx, day)
+	if err != nil {
+		return fmt.Errorf("failed to snapshot balances: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Time("day", day).
+		Int64("created", created).
+		Msg("processed task")
+
+	return nil
+}